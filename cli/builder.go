@@ -73,6 +73,12 @@ func BuildClientCommand(basePrefix ...string) []string {
 	return append(BuildJasperCommand(basePrefix...), ClientCommand)
 }
 
+// BuildClientSessionCommand is a convenience function to generate the slice of
+// strings to invoke the Jasper.Client.Session subcommand.
+func BuildClientSessionCommand(basePrefix ...string) []string {
+	return append(BuildClientCommand(basePrefix...), SessionCommand)
+}
+
 // Jasper.Client.Manager builders
 
 // BuildManagerCommand is a convenience function to generate the slice of strings
@@ -121,6 +127,20 @@ func BuildManagerListCommand(basePrefix ...string) []string {
 	return append(BuildManagerCommand(basePrefix...), ListCommand)
 }
 
+// BuildManagerHistoryCommand is a convenience function to generate the
+// slice of strings to invoke the Jasper.Client.Manager.History
+// subcommand.
+func BuildManagerHistoryCommand(basePrefix ...string) []string {
+	return append(BuildManagerCommand(basePrefix...), HistoryCommand)
+}
+
+// BuildManagerSubscribeCommand is a convenience function to generate the
+// slice of strings to invoke the Jasper.Client.Manager.Subscribe
+// subcommand.
+func BuildManagerSubscribeCommand(basePrefix ...string) []string {
+	return append(BuildManagerCommand(basePrefix...), SubscribeCommand)
+}
+
 // BuildManagerClearCommand is a convenience function to generate the
 // slice of strings to invoke the Jasper.Client.Manager.Clear
 // subcommand.
@@ -197,6 +217,24 @@ func BuildProcessSignalCommand(basePrefix ...string) []string {
 	return append(BuildProcessCommand(basePrefix...), SignalCommand)
 }
 
+// BuildProcessResizeCommand is a convenience function to generate the slice of
+// strings to invoke the Jasper.Client.Process.Resize subcommand.
+func BuildProcessResizeCommand(basePrefix ...string) []string {
+	return append(BuildProcessCommand(basePrefix...), ResizeCommand)
+}
+
+// BuildProcessStopCommand is a convenience function to generate the slice of
+// strings to invoke the Jasper.Client.Process.Stop subcommand.
+func BuildProcessStopCommand(basePrefix ...string) []string {
+	return append(BuildProcessCommand(basePrefix...), StopCommand)
+}
+
+// BuildProcessWaitReadyCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Process.WaitReady subcommand.
+func BuildProcessWaitReadyCommand(basePrefix ...string) []string {
+	return append(BuildProcessCommand(basePrefix...), WaitReadyCommand)
+}
+
 // BuildProcessWaitCommand is a convenience function to generate the slice of
 // strings to invoke the Jasper.Client.Process.Wait subcommand.
 func BuildProcessWaitCommand(basePrefix ...string) []string {
@@ -239,6 +277,31 @@ func BuildRemoteGetLogStreamCommand(basePrefix ...string) []string {
 	return append(BuildRemoteCommand(basePrefix...), GetLogStreamCommand)
 }
 
+// BuildRemoteGetCapturedOutputCommand is a convenience function to generate
+// the slice of strings to invoke the Jasper.Client.Remote.GetCapturedOutput
+// subcommand.
+func BuildRemoteGetCapturedOutputCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), GetCapturedOutputCommand)
+}
+
+// BuildRemoteFollowLogsCommand is a convenience function to generate the
+// slice of strings to invoke the Jasper.Client.Remote.FollowLogs subcommand.
+func BuildRemoteFollowLogsCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), FollowLogsCommand)
+}
+
+// BuildRemoteWriteStdinCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.WriteStdin subcommand.
+func BuildRemoteWriteStdinCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), WriteStdinCommand)
+}
+
+// BuildRemoteCloseStdinCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.CloseStdin subcommand.
+func BuildRemoteCloseStdinCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), CloseStdinCommand)
+}
+
 // BuildRemoteGetBuildloggerURLsCommand is a convenience function to generate the
 // slice of strings to invoke the Jasper.Client.Remote.GetBuildloggerURLs
 // subcommand.
@@ -26,6 +26,7 @@ func TestBuildSubcommands(t *testing.T) {
 		{subcommand: []string{binary, JasperCommand, ServiceCommand, ServiceStatusCommand}, buildSubcommand: BuildServiceStatusCommand},
 		{subcommand: []string{binary, JasperCommand, ServiceCommand, ServiceForceReinstallCommand}, buildSubcommand: BuildServiceForceReinstallCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand}, buildSubcommand: BuildClientCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, SessionCommand}, buildSubcommand: BuildClientSessionCommand},
 
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand}, buildSubcommand: BuildManagerCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand, IDCommand}, buildSubcommand: BuildManagerIDCommand},
@@ -34,6 +35,8 @@ func TestBuildSubcommands(t *testing.T) {
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand, GetCommand}, buildSubcommand: BuildManagerGetCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand, GroupCommand}, buildSubcommand: BuildManagerGroupCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand, ListCommand}, buildSubcommand: BuildManagerListCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand, HistoryCommand}, buildSubcommand: BuildManagerHistoryCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand, SubscribeCommand}, buildSubcommand: BuildManagerSubscribeCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand, ClearCommand}, buildSubcommand: BuildManagerClearCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ManagerCommand, CloseCommand}, buildSubcommand: BuildManagerCloseCommand},
 
@@ -47,13 +50,20 @@ func TestBuildSubcommands(t *testing.T) {
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, RespawnCommand}, buildSubcommand: BuildProcessRespawnCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, RegisterSignalTriggerIDCommand}, buildSubcommand: BuildProcessRegisterSignalTriggerIDCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, SignalCommand}, buildSubcommand: BuildProcessSignalCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, ResizeCommand}, buildSubcommand: BuildProcessResizeCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, StopCommand}, buildSubcommand: BuildProcessStopCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, WaitCommand}, buildSubcommand: BuildProcessWaitCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, WaitReadyCommand}, buildSubcommand: BuildProcessWaitReadyCommand},
 
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand}, buildSubcommand: BuildRemoteCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, ConfigureCacheCommand}, buildSubcommand: BuildRemoteConfigureCacheCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, DownloadFileCommand}, buildSubcommand: BuildRemoteDownloadFileCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, DownloadMongoDBCommand}, buildSubcommand: BuildRemoteDownloadMongoDBCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, GetLogStreamCommand}, buildSubcommand: BuildRemoteGetLogStreamCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, GetCapturedOutputCommand}, buildSubcommand: BuildRemoteGetCapturedOutputCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, FollowLogsCommand}, buildSubcommand: BuildRemoteFollowLogsCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, WriteStdinCommand}, buildSubcommand: BuildRemoteWriteStdinCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, CloseStdinCommand}, buildSubcommand: BuildRemoteCloseStdinCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, GetBuildloggerURLsCommand}, buildSubcommand: BuildRemoteGetBuildloggerURLsCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, SignalEventCommand}, buildSubcommand: BuildRemoteSignalEventCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, WriteFileCommand}, buildSubcommand: BuildRemoteWriteFileCommand},
@@ -11,6 +11,7 @@ const (
 	hostFlagName          = "host"
 	portFlagName          = "port"
 	credsFilePathFlagName = "creds_path"
+	authFilePathFlagName  = "auth_path"
 
 	defaultLocalHostName = "localhost"
 )
@@ -30,6 +31,8 @@ func Jasper() cli.Command {
 			list(),
 			clear(),
 			kill(),
+			stop(),
+			waitReady(),
 			killAll(),
 			download(),
 		},
@@ -32,6 +32,7 @@ func client() cli.Command {
 			Process(),
 			Remote(),
 			LoggingCache(),
+			clientSession(),
 		},
 	}
 }
@@ -54,7 +55,7 @@ func clientFlags() []cli.Flag {
 		},
 		cli.StringFlag{
 			Name:  credsFilePathFlagName,
-			Usage: "The path to the file containing the server credentials.",
+			Usage: "The path to the file containing the server credentials. For the REST service, the file may also contain a bearer token or API key.",
 		},
 	}
 }
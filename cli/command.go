@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 	"os"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/cheynewallace/tabby"
@@ -219,9 +221,18 @@ func printLogs(ctx context.Context, client remote.Manager, cmd *jasper.Command,
 // human-readable table.
 func list() cli.Command {
 	const (
-		filterFlagName = "filter"
-		groupFlagName  = "group"
+		filterFlagName           = "filter"
+		groupFlagName            = "group"
+		tagsFlagName             = "tags"
+		minExitCodeFlagName      = "min_exit_code"
+		maxExitCodeFlagName      = "max_exit_code"
+		startedAfterFlagName     = "started_after"
+		startedBeforeFlagName    = "started_before"
+		argsFlagName             = "args"
+		workingDirectoryFlagName = "working_directory"
+		processHostFlagName      = "process_host"
 	)
+	queryFlagNames := []string{tagsFlagName, minExitCodeFlagName, maxExitCodeFlagName, startedAfterFlagName, startedBeforeFlagName, argsFlagName, workingDirectoryFlagName, processHostFlagName}
 	return cli.Command{
 		Name:  "list",
 		Usage: "List Jasper managed processes with human readable output.",
@@ -234,6 +245,38 @@ func list() cli.Command {
 				Name:  groupFlagName,
 				Usage: "Return a list of processes matching the tag.",
 			},
+			cli.StringSliceFlag{
+				Name:  tagsFlagName,
+				Usage: "Filter processes that have all of the comma-separated tags. Specify multiple times to match any of the tag sets.",
+			},
+			cli.IntFlag{
+				Name:  minExitCodeFlagName,
+				Usage: "Filter completed processes whose exit code is at least this value.",
+			},
+			cli.IntFlag{
+				Name:  maxExitCodeFlagName,
+				Usage: "Filter completed processes whose exit code is at most this value.",
+			},
+			cli.StringFlag{
+				Name:  startedAfterFlagName,
+				Usage: "Filter processes that started at or after this RFC3339 time.",
+			},
+			cli.StringFlag{
+				Name:  startedBeforeFlagName,
+				Usage: "Filter processes that started at or before this RFC3339 time.",
+			},
+			cli.StringFlag{
+				Name:  argsFlagName,
+				Usage: "Filter processes whose arguments contain this substring.",
+			},
+			cli.StringFlag{
+				Name:  workingDirectoryFlagName,
+				Usage: "Filter processes whose working directory contains this substring.",
+			},
+			cli.StringFlag{
+				Name:  processHostFlagName,
+				Usage: "Filter processes that run on this host.",
+			},
 		),
 		Before: mergeBeforeFuncs(clientBefore(),
 			func(c *cli.Context) error {
@@ -241,6 +284,11 @@ func list() cli.Command {
 					return errors.New("cannot set both filter and group")
 				}
 				if c.String(groupFlagName) != "" {
+					for _, name := range queryFlagNames {
+						if c.IsSet(name) {
+							return errors.Errorf("cannot set both group and %s", name)
+						}
+					}
 					return nil
 				}
 				filter := options.Filter(c.String(filterFlagName))
@@ -251,20 +299,44 @@ func list() cli.Command {
 				return errors.Wrapf(filter.Validate(), "invalid filter '%s'", filter)
 			}),
 		Action: func(c *cli.Context) error {
-			filter := options.Filter(c.String(filterFlagName))
 			group := c.String(groupFlagName)
+			query := options.ProcessQuery{
+				Status:           options.Filter(c.String(filterFlagName)),
+				Args:             c.String(argsFlagName),
+				WorkingDirectory: c.String(workingDirectoryFlagName),
+				Host:             c.String(processHostFlagName),
+			}
+			for _, tags := range c.StringSlice(tagsFlagName) {
+				query.Tags = append(query.Tags, strings.Split(tags, ","))
+			}
+			if c.IsSet(minExitCodeFlagName) {
+				minExitCode := c.Int(minExitCodeFlagName)
+				query.MinExitCode = &minExitCode
+			}
+			if c.IsSet(maxExitCodeFlagName) {
+				maxExitCode := c.Int(maxExitCodeFlagName)
+				query.MaxExitCode = &maxExitCode
+			}
+			var err error
+			if val := c.String(startedAfterFlagName); val != "" {
+				if query.StartedAfter, err = time.Parse(time.RFC3339, val); err != nil {
+					return errors.Wrapf(err, "parsing %s", startedAfterFlagName)
+				}
+			}
+			if val := c.String(startedBeforeFlagName); val != "" {
+				if query.StartedBefore, err = time.Parse(time.RFC3339, val); err != nil {
+					return errors.Wrapf(err, "parsing %s", startedBeforeFlagName)
+				}
+			}
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
 			return withConnection(ctx, c, func(client remote.Manager) error {
-				var (
-					procs []jasper.Process
-					err   error
-				)
+				var procs []jasper.Process
 
 				if group == "" {
-					procs, err = client.List(ctx, filter)
+					procs, err = client.Query(ctx, query)
 				} else {
 					procs, err = client.Group(ctx, group)
 
@@ -338,6 +410,148 @@ func kill() cli.Command {
 	}
 }
 
+// stop stops a single process by id, escalating through a sequence of signals
+// until it exits.
+func stop() cli.Command {
+	const (
+		idFlagName     = "id"
+		stepFlagName   = "step"
+		targetFlagName = "target"
+	)
+	return cli.Command{
+		Name:  "stop",
+		Usage: "Stop a process, escalating from SIGTERM to SIGKILL by default.",
+		Flags: append(clientFlags(),
+			cli.StringFlag{
+				Name:  joinFlagNames(idFlagName, "i"),
+				Usage: "Specify the ID of the process to stop.",
+			},
+			cli.StringSliceFlag{
+				Name:  stepFlagName,
+				Usage: "Specify a step as '<signal number>:<grace period>' (e.g. '15:30s'). Steps are taken in the order given. May specify more than once.",
+			},
+			cli.StringFlag{
+				Name:  targetFlagName,
+				Usage: "Specify the processes to signal (process, group, tree).",
+				Value: string(options.StopTargetProcess),
+			},
+		),
+		Before: mergeBeforeFuncs(
+			clientBefore(),
+			func(c *cli.Context) error {
+				if len(c.String(idFlagName)) == 0 {
+					if c.NArg() != 1 {
+						return errors.New("must specify a process ID")
+					}
+					return errors.Wrap(c.Set(idFlagName, c.Args().First()), "setting ID from positional flags")
+				}
+				return nil
+			}),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			steps, err := parseStopSteps(c.StringSlice(stepFlagName))
+			if err != nil {
+				return errors.Wrap(err, "parsing stop steps")
+			}
+			policy := options.StopPolicy{
+				Steps:  steps,
+				Target: options.StopTarget(c.String(targetFlagName)),
+			}
+			if err := policy.Validate(); err != nil {
+				return errors.Wrap(err, "invalid stop policy")
+			}
+
+			procID := c.String(idFlagName)
+			return withConnection(ctx, c, func(client remote.Manager) error {
+				proc, err := client.Get(ctx, procID)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+
+				return errors.WithStack(proc.Stop(ctx, policy))
+			})
+		},
+	}
+}
+
+// parseStopSteps parses stop steps of the form '<signal number>:<grace
+// period>'.
+func parseStopSteps(steps []string) ([]options.StopStep, error) {
+	var out []options.StopStep
+	for _, step := range steps {
+		sig, grace, ok := strings.Cut(step, ":")
+		if !ok {
+			return nil, errors.Errorf("step '%s' must have the form '<signal number>:<grace period>'", step)
+		}
+		sigNum, err := strconv.Atoi(sig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing signal of step '%s'", step)
+		}
+		gracePeriod, err := time.ParseDuration(grace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing grace period of step '%s'", step)
+		}
+		out = append(out, options.StopStep{Signal: syscall.Signal(sigNum), GracePeriod: gracePeriod})
+	}
+	return out, nil
+}
+
+// waitReady waits for a single process by id to pass its readiness probes.
+func waitReady() cli.Command {
+	const (
+		idFlagName      = "id"
+		timeoutFlagName = "timeout"
+	)
+	return cli.Command{
+		Name:  "wait-ready",
+		Usage: "Wait for a process to pass its readiness probes.",
+		Flags: append(clientFlags(),
+			cli.StringFlag{
+				Name:  joinFlagNames(idFlagName, "i"),
+				Usage: "Specify the ID of the process to wait for.",
+			},
+			cli.DurationFlag{
+				Name:  timeoutFlagName,
+				Usage: "Specify how long to wait for the process to become ready (e.g. '30s'). If unset, wait indefinitely.",
+			},
+		),
+		Before: mergeBeforeFuncs(
+			clientBefore(),
+			func(c *cli.Context) error {
+				if len(c.String(idFlagName)) == 0 {
+					if c.NArg() != 1 {
+						return errors.New("must specify a process ID")
+					}
+					return errors.Wrap(c.Set(idFlagName, c.Args().First()), "setting ID from positional flags")
+				}
+				if c.Duration(timeoutFlagName) < 0 {
+					return errors.New("timeout cannot be negative")
+				}
+				return nil
+			}),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if timeout := c.Duration(timeoutFlagName); timeout > 0 {
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			procID := c.String(idFlagName)
+			return withConnection(ctx, c, func(client remote.Manager) error {
+				proc, err := client.Get(ctx, procID)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+
+				return errors.WithStack(proc.WaitReady(ctx))
+			})
+		},
+	}
+}
+
 // killAll terminates all processes with a given tag, sending either TERM or
 // KILL.
 func killAll() cli.Command {
@@ -209,6 +209,60 @@ func ExtractLogStreamResponse(input json.RawMessage) (LogStreamResponse, error)
 	return resp, resp.successOrError()
 }
 
+// CapturedOutputResponse represents CLI-specific output containing part of
+// the captured output of a process.
+type CapturedOutputResponse struct {
+	OutcomeResponse       `json:"outcome"`
+	jasper.CapturedOutput `json:"captured_output,omitempty"`
+}
+
+// ExtractCapturedOutputResponse unmarshals the input bytes into a
+// CapturedOutputResponse and checks if the request was successful.
+func ExtractCapturedOutputResponse(input json.RawMessage) (CapturedOutputResponse, error) {
+	var resp CapturedOutputResponse
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, errors.Wrap(err, unmarshalFailed)
+	}
+	return resp, resp.successOrError()
+}
+
+// LogChunkResponse represents CLI-specific output containing a chunk of logs
+// from following a process's logs. The output of following logs is a stream
+// of newline-delimited LogChunkResponses.
+type LogChunkResponse struct {
+	OutcomeResponse `json:"outcome"`
+	jasper.LogChunk `json:"log_chunk,omitempty"`
+}
+
+// ExtractLogChunkResponse unmarshals the input bytes into a LogChunkResponse
+// and checks if the request was successful.
+func ExtractLogChunkResponse(input json.RawMessage) (LogChunkResponse, error) {
+	var resp LogChunkResponse
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, errors.Wrap(err, unmarshalFailed)
+	}
+	return resp, resp.successOrError()
+}
+
+// ProcessEventResponse represents CLI-specific output containing a process
+// event from subscribing to process events. The output of subscribing is a
+// stream of newline-delimited ProcessEventResponses. The first response has no
+// event and acknowledges that the subscription has started.
+type ProcessEventResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Event           *jasper.ProcessEvent `json:"event,omitempty"`
+}
+
+// ExtractProcessEventResponse unmarshals the input bytes into a
+// ProcessEventResponse and checks if the request was successful.
+func ExtractProcessEventResponse(input json.RawMessage) (ProcessEventResponse, error) {
+	var resp ProcessEventResponse
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, errors.Wrap(err, unmarshalFailed)
+	}
+	return resp, resp.successOrError()
+}
+
 // BuildloggerURLsResponse represents CLI-specific output containing the
 // Buildlogger URLs for a process.
 type BuildloggerURLsResponse struct {
@@ -226,6 +280,23 @@ func ExtractBuildloggerURLsResponse(input json.RawMessage) (BuildloggerURLsRespo
 	return resp, resp.successOrError()
 }
 
+// CommandResponse represents CLI-specific output containing the results of
+// running a command.
+type CommandResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Results         []jasper.CommandResult `json:"results,omitempty"`
+}
+
+// ExtractCommandResponse unmarshals the input bytes into a CommandResponse and
+// checks if the request was successful.
+func ExtractCommandResponse(input json.RawMessage) (CommandResponse, error) {
+	var resp CommandResponse
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, errors.Wrap(err, unmarshalFailed)
+	}
+	return resp, resp.successOrError()
+}
+
 // IDResponse represents represents CLI-specific output containing the ID of the
 // resources requested (e.g. a Jasper process ID).
 type IDResponse struct {
@@ -276,6 +347,45 @@ func (in *SignalInput) Validate() error {
 	return catcher.Resolve()
 }
 
+// ResizeInput represents CLI-specific input to resize the pseudo-terminal of a
+// Jasper process.
+type ResizeInput struct {
+	ID   string `json:"id"`
+	Rows uint16 `json:"rows"`
+	Cols uint16 `json:"cols"`
+}
+
+// Validate checks that the ResizeInput has a non-empty Jasper process ID and
+// a non-empty window size.
+func (in *ResizeInput) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	if len(in.ID) == 0 {
+		catcher.New("Jasper process ID must not be empty")
+	}
+	if in.Rows == 0 || in.Cols == 0 {
+		catcher.New("rows and columns must be greater than 0")
+	}
+	return catcher.Resolve()
+}
+
+// StopInput represents CLI-specific input to stop a Jasper process with a stop
+// policy.
+type StopInput struct {
+	ID     string             `json:"id"`
+	Policy options.StopPolicy `json:"policy"`
+}
+
+// Validate checks that the StopInput has a non-empty Jasper process ID and a
+// valid stop policy.
+func (in *StopInput) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	if len(in.ID) == 0 {
+		catcher.New("Jasper process ID must not be empty")
+	}
+	catcher.Wrap(in.Policy.Validate(), "invalid stop policy")
+	return catcher.Resolve()
+}
+
 // SignalTriggerIDInput represents CLI-specific input to attach a signal trigger
 // to a Jasper process.
 type SignalTriggerIDInput struct {
@@ -328,14 +438,23 @@ func (in *TagInput) Validate() error {
 	return nil
 }
 
-// FilterInput represents the CLI-specific input to filter processes.
+// FilterInput represents the CLI-specific input to filter processes. If Query
+// is set, the processes are filtered by the query instead of by Filter.
 type FilterInput struct {
 	Filter options.Filter
+	Query  *options.ProcessQuery `json:",omitempty"`
 }
 
-// Validate checks that the jasper.Filter is a recognized filter.
+// Validate checks that the jasper.Filter is a recognized filter or, if there
+// is a query, that the query is valid and there is no filter.
 func (in *FilterInput) Validate() error {
-	return in.Filter.Validate()
+	if in.Query == nil {
+		return in.Filter.Validate()
+	}
+	if in.Filter != "" {
+		return errors.New("cannot specify both a filter and a query")
+	}
+	return errors.Wrap(in.Query.Validate(), "invalid query")
 }
 
 // LogStreamInput represents the CLI-specific input to stream in-memory logs.
@@ -352,6 +471,47 @@ func (in *LogStreamInput) Validate() error {
 	return nil
 }
 
+// CapturedOutputInput represents the CLI-specific input to get the captured
+// output of a process.
+type CapturedOutputInput struct {
+	ID    string              `json:"id"`
+	Query options.OutputQuery `json:"query"`
+}
+
+// Validate checks that the output query is valid.
+func (in *CapturedOutputInput) Validate() error {
+	return errors.Wrap(in.Query.Validate(), "invalid output query")
+}
+
+// FollowLogsInput represents the CLI-specific input to follow in-memory logs.
+type FollowLogsInput struct {
+	ID     string `json:"id"`
+	Offset int    `json:"offset"`
+}
+
+// Validate checks that the offset is not negative.
+func (in *FollowLogsInput) Validate() error {
+	if in.Offset < 0 {
+		return errors.New("offset cannot be negative")
+	}
+	return nil
+}
+
+// WriteStdinInput represents the CLI-specific input to write to the standard
+// input of a process.
+type WriteStdinInput struct {
+	ID   string `json:"id"`
+	Data []byte `json:"data"`
+}
+
+// Validate checks that the process ID is non-empty.
+func (in *WriteStdinInput) Validate() error {
+	if len(in.ID) == 0 {
+		return errors.New("ID must not be empty")
+	}
+	return nil
+}
+
 // EventInput represents the CLI-specific input to signal a named event.
 type EventInput struct {
 	Name string `json:"name"`
@@ -1,11 +1,13 @@
 package cli
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -296,6 +298,43 @@ func TestExtractResponse(t *testing.T) {
 						assert.True(t, resp.LogStream.Done)
 					},
 				},
+				"CapturedOutputResponse": {
+					input: fmt.Sprintf(`{
+					"outcome": {
+						"success": %t,
+						"message": "%s"
+					},
+					"captured_output": {
+						"stream": "stderr",
+						"data": "%s",
+						"offset": 4,
+						"size": 7,
+						"truncated": true
+					}
+					}`, outcome.Success, outcome.Message, base64.StdEncoding.EncodeToString([]byte("foo"))),
+					extractAndCheck: func(t *testing.T, input json.RawMessage) {
+						resp, err := ExtractCapturedOutputResponse(input)
+						if outcome.Success {
+							require.NoError(t, err)
+							assert.True(t, resp.Successful())
+						} else {
+							require.Error(t, err)
+							assert.False(t, resp.Successful())
+
+							if outcome.Message != "" {
+								assert.Contains(t, resp.ErrorMessage(), outcome.Message)
+							} else {
+								assert.Contains(t, resp.ErrorMessage(), unspecifiedRequestFailure)
+							}
+						}
+
+						assert.Equal(t, options.OutputStreamStderr, resp.CapturedOutput.Stream)
+						assert.Equal(t, "foo", string(resp.CapturedOutput.Data))
+						assert.EqualValues(t, 4, resp.CapturedOutput.Offset)
+						assert.EqualValues(t, 7, resp.CapturedOutput.Size)
+						assert.True(t, resp.CapturedOutput.Truncated)
+					},
+				},
 				"BuildloggerURLsResponse": {
 					input: fmt.Sprintf(`{
 					"outcome": {
@@ -326,6 +365,38 @@ func TestExtractResponse(t *testing.T) {
 						assert.Equal(t, "foo", resp.URLs[0])
 					},
 				},
+				"CommandResponse": {
+					input: fmt.Sprintf(`{
+					"outcome": {
+						"success": %t,
+						"message": "%s"
+					},
+					"results": [{
+						"args": ["%s"],
+						"attempts": %d
+					}]
+					}`, outcome.Success, outcome.Message, s1, n1),
+					extractAndCheck: func(t *testing.T, input json.RawMessage) {
+						resp, err := ExtractCommandResponse(input)
+						if outcome.Success {
+							require.NoError(t, err)
+							assert.True(t, resp.Successful())
+						} else {
+							require.Error(t, err)
+							assert.False(t, resp.Successful())
+
+							if outcome.Message != "" {
+								assert.Contains(t, resp.ErrorMessage(), outcome.Message)
+							} else {
+								assert.Contains(t, resp.ErrorMessage(), unspecifiedRequestFailure)
+							}
+						}
+
+						require.Len(t, resp.Results, 1)
+						assert.Equal(t, []string{s1}, resp.Results[0].Args)
+						assert.Equal(t, n1, resp.Results[0].Attempts)
+					},
+				},
 				"CachedLoggerResponse": {
 					input: fmt.Sprintf(`{
 					"outcome": {
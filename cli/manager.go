@@ -19,6 +19,8 @@ const (
 	GetCommand           = "get"
 	GroupCommand         = "group"
 	ListCommand          = "list"
+	HistoryCommand       = "history"
+	SubscribeCommand     = "subscribe"
 	ClearCommand         = "clear"
 	CloseCommand         = "close"
 	WriteFileCommand     = "write-file"
@@ -38,6 +40,8 @@ func Manager() cli.Command {
 			managerGet(),
 			managerList(),
 			managerGroup(),
+			managerHistory(),
+			managerSubscribe(),
 			managerClear(),
 			managerClose(),
 			managerWriteFile(),
@@ -89,6 +93,9 @@ func managerCreateCommand() cli.Command {
 					Background(opts.RunBackground).
 					ContinueOnError(opts.ContinueOnError).
 					IgnoreError(opts.IgnoreError).
+					SetRetryOptions(opts.Retry).
+					CaptureOutputTail(opts.OutputTail).
+					SetGraphOptions(opts.Graph).
 					Sudo(opts.Sudo).
 					ApplyFromOpts(&opts.Process)
 				if opts.SudoUser != "" {
@@ -97,7 +104,8 @@ func managerCreateCommand() cli.Command {
 				if opts.Priority == 0 || opts.Priority.IsValid() {
 					cmd = cmd.Priority(opts.Priority)
 				}
-				return makeOutcomeResponse(cmd.Run(ctx))
+				err := cmd.Run(ctx)
+				return &CommandResponse{Results: cmd.Results(ctx), OutcomeResponse: *makeOutcomeResponse(err)}
 			})
 		},
 	}
@@ -129,9 +137,17 @@ func managerList() cli.Command {
 		Action: func(c *cli.Context) error {
 			input := &FilterInput{}
 			return doPassthroughInputOutput(c, input, func(ctx context.Context, client remote.Manager) interface{} {
-				procs, err := client.List(ctx, input.Filter)
+				var procs []jasper.Process
+				var err error
+				if input.Query != nil {
+					procs, err = client.Query(ctx, *input.Query)
+					err = errors.Wrap(err, "querying processes")
+				} else {
+					procs, err = client.List(ctx, input.Filter)
+					err = errors.Wrapf(err, "listing processes with filter '%s'", input.Filter)
+				}
 				if err != nil {
-					return &InfosResponse{OutcomeResponse: *makeOutcomeResponse(errors.Wrapf(err, "listing processes with filter '%s'", input.Filter))}
+					return &InfosResponse{OutcomeResponse: *makeOutcomeResponse(err)}
 				}
 				infos := make([]jasper.ProcessInfo, 0, len(procs))
 				for _, proc := range procs {
@@ -165,6 +181,54 @@ func managerGroup() cli.Command {
 	}
 }
 
+func managerHistory() cli.Command {
+	return cli.Command{
+		Name:   HistoryCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := &options.HistoryQuery{}
+			return doPassthroughInputOutput(c, input, func(ctx context.Context, client remote.Manager) interface{} {
+				history := client.History(ctx)
+				if history == nil {
+					return &InfosResponse{OutcomeResponse: *makeOutcomeResponse(jasper.ErrProcessHistoryNotSupported)}
+				}
+				infos, err := history.Find(ctx, *input)
+				if err != nil {
+					return &InfosResponse{OutcomeResponse: *makeOutcomeResponse(errors.Wrap(err, "finding process history"))}
+				}
+				return &InfosResponse{Infos: infos, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
+func managerSubscribe() cli.Command {
+	return cli.Command{
+		Name:   SubscribeCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := &options.ProcessEventFilter{}
+			return doPassthroughInputStreamingOutput(c, input, func(ctx context.Context, client remote.Manager, send func(response interface{}) error) error {
+				events, err := client.Subscribe(ctx, *input)
+				if err != nil {
+					return send(&ProcessEventResponse{OutcomeResponse: *makeOutcomeResponse(errors.Wrap(err, "subscribing to process events"))})
+				}
+				if err := send(&ProcessEventResponse{OutcomeResponse: *makeOutcomeResponse(nil)}); err != nil {
+					return err
+				}
+				for event := range events {
+					if err := send(&ProcessEventResponse{Event: &event, OutcomeResponse: *makeOutcomeResponse(nil)}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+}
+
 func managerClear() cli.Command {
 	return cli.Command{
 		Name:   ClearCommand,
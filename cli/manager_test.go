@@ -59,9 +59,47 @@ func TestCLIManager(t *testing.T) {
 						Commands: [][]string{{"true"}},
 					})
 					require.NoError(t, err)
-					resp := &OutcomeResponse{}
+					resp := &CommandResponse{}
 					require.NoError(t, execCLICommandInputOutput(t, c, managerCreateCommand(), input, resp))
 					require.True(t, resp.Successful())
+					require.Len(t, resp.Results, 1)
+					assert.Equal(t, []string{"true"}, resp.Results[0].Args)
+					assert.Equal(t, 1, resp.Results[0].Attempts)
+					assert.True(t, resp.Results[0].Successful)
+				},
+				"CreateCommandRunsGraph": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(options.Command{
+						Graph: &options.CommandGraph{
+							Steps: []options.CommandStep{
+								{Name: "install", Args: []string{"true"}, DependsOn: []string{"download"}},
+								{Name: "download", Args: []string{"true"}},
+							},
+						},
+					})
+					require.NoError(t, err)
+					resp := &CommandResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, managerCreateCommand(), input, resp))
+					require.True(t, resp.Successful())
+					require.Len(t, resp.Results, 2)
+					assert.Equal(t, "install", resp.Results[0].Name)
+					assert.Equal(t, "download", resp.Results[1].Name)
+					for _, res := range resp.Results {
+						assert.True(t, res.Successful)
+					}
+				},
+				"CreateCommandReturnsResultsOfFailedCommand": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(options.Command{
+						Commands:        [][]string{{"true"}, {"false"}},
+						ContinueOnError: true,
+					})
+					require.NoError(t, err)
+					resp := &CommandResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, managerCreateCommand(), input, resp))
+					assert.False(t, resp.Successful())
+					require.Len(t, resp.Results, 2)
+					assert.True(t, resp.Results[0].Successful)
+					assert.False(t, resp.Results[1].Successful)
+					assert.NotZero(t, resp.Results[1].ExitCode)
 				},
 				"GetExistingIDPasses": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
 					input, err := json.Marshal(IDInput{jasperProcID})
@@ -85,7 +123,7 @@ func TestCLIManager(t *testing.T) {
 					assert.Error(t, execCLICommandInputOutput(t, c, managerGet(), input, &InfoResponse{}))
 				},
 				"ListValidFilterPasses": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
-					input, err := json.Marshal(FilterInput{options.All})
+					input, err := json.Marshal(FilterInput{Filter: options.All})
 					require.NoError(t, err)
 					resp := &InfosResponse{}
 					require.NoError(t, execCLICommandInputOutput(t, c, managerList(), input, resp))
@@ -94,10 +132,38 @@ func TestCLIManager(t *testing.T) {
 					assert.Equal(t, jasperProcID, resp.Infos[0].ID)
 				},
 				"ListInvalidFilterFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
-					input, err := json.Marshal(FilterInput{options.Filter("foo")})
+					input, err := json.Marshal(FilterInput{Filter: options.Filter("foo")})
 					require.NoError(t, err)
 					assert.Error(t, execCLICommandInputOutput(t, c, managerList(), input, &InfosResponse{}))
 				},
+				"ListQueryFindsMatchingProcess": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					tag := "foo"
+					require.True(t, tagProcess(t, c, jasperProcID, tag).Successful())
+
+					input, err := json.Marshal(FilterInput{Query: &options.ProcessQuery{Tags: [][]string{{"bar"}, {tag}}}})
+					require.NoError(t, err)
+					resp := &InfosResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, managerList(), input, resp))
+					require.True(t, resp.Successful())
+					require.Len(t, resp.Infos, 1)
+					assert.Equal(t, jasperProcID, resp.Infos[0].ID)
+				},
+				"ListInvalidQueryFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(FilterInput{Query: &options.ProcessQuery{Status: options.Filter("foo")}})
+					require.NoError(t, err)
+					assert.Error(t, execCLICommandInputOutput(t, c, managerList(), input, &InfosResponse{}))
+				},
+				"ListFilterAndQueryFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(FilterInput{Filter: options.All, Query: &options.ProcessQuery{}})
+					require.NoError(t, err)
+					assert.Error(t, execCLICommandInputOutput(t, c, managerList(), input, &InfosResponse{}))
+				},
+				"SubscribeInvalidFilterFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(options.ProcessEventFilter{Types: []options.ProcessEventType{"foo"}})
+					require.NoError(t, err)
+					_, err = execCLICommandInputStreamingOutput(t, c, managerSubscribe(), input)
+					assert.Error(t, err)
+				},
 				"GroupFindsTaggedProcess": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
 					tag := "foo"
 					require.True(t, tagProcess(t, c, jasperProcID, tag).Successful())
@@ -123,6 +189,19 @@ func TestCLIManager(t *testing.T) {
 					require.True(t, resp.Successful())
 					assert.Len(t, resp.Infos, 0)
 				},
+				"HistoryInvalidQueryFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(options.HistoryQuery{Status: options.Running})
+					require.NoError(t, err)
+					assert.Error(t, execCLICommandInputOutput(t, c, managerHistory(), input, &InfosResponse{}))
+				},
+				"HistoryFailsWithoutServerHistory": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(options.HistoryQuery{})
+					require.NoError(t, err)
+					resp := &InfosResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, managerHistory(), input, resp))
+					assert.False(t, resp.Successful())
+					assert.NotEmpty(t, resp.ErrorMessage())
+				},
 				"ClearPasses": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
 					resp := &OutcomeResponse{}
 					require.NoError(t, execCLICommandOutput(t, c, managerClear(), resp))
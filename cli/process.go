@@ -18,10 +18,13 @@ const (
 	RespawnCommand                 = "respawn"
 	RunningCommand                 = "running"
 	SignalCommand                  = "signal"
+	ResizeCommand                  = "resize"
+	StopCommand                    = "stop"
 	TagCommand                     = "tag"
 	GetTagsCommand                 = "get-tags"
 	ResetTagsCommand               = "reset-tags"
 	WaitCommand                    = "wait"
+	WaitReadyCommand               = "wait-ready"
 )
 
 // Process creates a cli.Command that interfaces with a Jasper process. Due to
@@ -40,7 +43,10 @@ func Process() cli.Command {
 			processRespawn(),
 			processRegisterSignalTriggerID(),
 			processSignal(),
+			processResize(),
+			processStop(),
 			processWait(),
+			processWaitReady(),
 		},
 	}
 }
@@ -117,6 +123,42 @@ func processSignal() cli.Command {
 	}
 }
 
+func processResize() cli.Command {
+	return cli.Command{
+		Name:   ResizeCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := &ResizeInput{}
+			return doPassthroughInputOutput(c, input, func(ctx context.Context, client remote.Manager) interface{} {
+				proc, err := client.Get(ctx, input.ID)
+				if err != nil {
+					return makeOutcomeResponse(errors.Wrapf(err, "finding process '%s'", input.ID))
+				}
+				return makeOutcomeResponse(proc.Resize(ctx, input.Rows, input.Cols))
+			})
+		},
+	}
+}
+
+func processStop() cli.Command {
+	return cli.Command{
+		Name:   StopCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := &StopInput{}
+			return doPassthroughInputOutput(c, input, func(ctx context.Context, client remote.Manager) interface{} {
+				proc, err := client.Get(ctx, input.ID)
+				if err != nil {
+					return makeOutcomeResponse(errors.Wrapf(err, "finding process '%s'", input.ID))
+				}
+				return makeOutcomeResponse(proc.Stop(ctx, input.Policy))
+			})
+		},
+	}
+}
+
 func processWait() cli.Command {
 	return cli.Command{
 		Name:   WaitCommand,
@@ -139,6 +181,24 @@ func processWait() cli.Command {
 	}
 }
 
+func processWaitReady() cli.Command {
+	return cli.Command{
+		Name:   WaitReadyCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := &IDInput{}
+			return doPassthroughInputOutput(c, input, func(ctx context.Context, client remote.Manager) interface{} {
+				proc, err := client.Get(ctx, input.ID)
+				if err != nil {
+					return makeOutcomeResponse(errors.Wrapf(err, "finding process '%s'", input.ID))
+				}
+				return makeOutcomeResponse(proc.WaitReady(ctx))
+			})
+		},
+	}
+}
+
 func processRespawn() cli.Command {
 	return cli.Command{
 		Name:   RespawnCommand,
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/testutil"
 	testoptions "github.com/mongodb/jasper/testutil/options"
 	"github.com/mongodb/jasper/util"
@@ -113,6 +114,58 @@ func TestCLIProcess(t *testing.T) {
 					require.NoError(t, err)
 					assert.Error(t, execCLICommandInputOutput(t, c, processWait(), input, &WaitResponse{}))
 				},
+				"ResizeWithoutTTYFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(ResizeInput{ID: jasperProcID, Rows: 50, Cols: 132})
+					require.NoError(t, err)
+					resp := &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, processResize(), input, resp))
+					assert.False(t, resp.Successful())
+				},
+				"ResizeWithNonexistentIDFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(ResizeInput{ID: nonexistentID, Rows: 50, Cols: 132})
+					require.NoError(t, err)
+					resp := &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, processResize(), input, resp))
+					assert.False(t, resp.Successful())
+				},
+				"ResizeWithEmptySizeFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(ResizeInput{ID: jasperProcID})
+					require.NoError(t, err)
+					assert.Error(t, execCLICommandInputOutput(t, c, processResize(), input, &OutcomeResponse{}))
+				},
+				"StopSucceeds": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(StopInput{ID: jasperProcID})
+					require.NoError(t, err)
+					resp := &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, processStop(), input, resp))
+					assert.True(t, resp.Successful())
+				},
+				"StopWithNonexistentIDFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(StopInput{ID: nonexistentID})
+					require.NoError(t, err)
+					resp := &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, processStop(), input, resp))
+					assert.False(t, resp.Successful())
+				},
+				"StopWithInvalidPolicyFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(StopInput{ID: jasperProcID, Policy: options.StopPolicy{Target: "foo"}})
+					require.NoError(t, err)
+					assert.Error(t, execCLICommandInputOutput(t, c, processStop(), input, &OutcomeResponse{}))
+				},
+				"WaitReadySucceeds": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(IDInput{jasperProcID})
+					require.NoError(t, err)
+					resp := &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, processWaitReady(), input, resp))
+					assert.True(t, resp.Successful())
+				},
+				"WaitReadyWithNonexistentIDFails": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
+					input, err := json.Marshal(IDInput{nonexistentID})
+					require.NoError(t, err)
+					resp := &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, processWaitReady(), input, resp))
+					assert.False(t, resp.Successful())
+				},
 				"RespawnSucceeds": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
 					input, err := json.Marshal(IDInput{jasperProcID})
 					require.NoError(t, err)
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 
+	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/remote"
 	"github.com/urfave/cli"
@@ -16,6 +18,10 @@ const (
 	DownloadMongoDBCommand    = "download-mongodb"
 	GetBuildloggerURLsCommand = "get-buildlogger-urls"
 	GetLogStreamCommand       = "get-log-stream"
+	GetCapturedOutputCommand  = "get-captured-output"
+	FollowLogsCommand         = "follow-logs"
+	WriteStdinCommand         = "write-stdin"
+	CloseStdinCommand         = "close-stdin"
 	SignalEventCommand        = "signal-event"
 	SendMessagesCommand       = "send-messages"
 )
@@ -31,7 +37,11 @@ func Remote() cli.Command {
 			remoteDownloadFile(),
 			remoteDownloadMongoDB(),
 			remoteGetLogStream(),
+			remoteGetCapturedOutput(),
+			remoteFollowLogs(),
 			remoteGetBuildloggerURLs(),
+			remoteWriteStdin(),
+			remoteCloseStdin(),
 			remoteSignalEvent(),
 			remoteSendMessages(),
 		},
@@ -97,6 +107,43 @@ func remoteGetLogStream() cli.Command {
 	}
 }
 
+func remoteGetCapturedOutput() cli.Command {
+	return cli.Command{
+		Name:   GetCapturedOutputCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := CapturedOutputInput{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				out, err := client.GetCapturedOutput(ctx, input.ID, input.Query)
+				if err != nil {
+					return &CapturedOutputResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &CapturedOutputResponse{CapturedOutput: *out, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
+func remoteFollowLogs() cli.Command {
+	return cli.Command{
+		Name:   FollowLogsCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := FollowLogsInput{}
+			return doPassthroughInputStreamingOutput(c, &input, func(ctx context.Context, client remote.Manager, send func(response interface{}) error) error {
+				if err := client.FollowLogs(ctx, input.ID, input.Offset, func(chunk jasper.LogChunk) error {
+					return send(&LogChunkResponse{LogChunk: chunk, OutcomeResponse: *makeOutcomeResponse(nil)})
+				}); err != nil {
+					return send(&LogChunkResponse{OutcomeResponse: *makeOutcomeResponse(err)})
+				}
+				return nil
+			})
+		},
+	}
+}
+
 func remoteGetBuildloggerURLs() cli.Command {
 	return cli.Command{
 		Name:   GetBuildloggerURLsCommand,
@@ -115,6 +162,34 @@ func remoteGetBuildloggerURLs() cli.Command {
 	}
 }
 
+func remoteWriteStdin() cli.Command {
+	return cli.Command{
+		Name:   WriteStdinCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := WriteStdinInput{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				return makeOutcomeResponse(client.WriteStdin(ctx, input.ID, bytes.NewReader(input.Data)))
+			})
+		},
+	}
+}
+
+func remoteCloseStdin() cli.Command {
+	return cli.Command{
+		Name:   CloseStdinCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			input := IDInput{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				return makeOutcomeResponse(client.CloseStdin(ctx, input.ID))
+			})
+		},
+	}
+}
+
 func remoteSignalEvent() cli.Command {
 	return cli.Command{
 		Name:   SignalEventCommand,
@@ -89,6 +89,88 @@ func TestCLIRemote(t *testing.T) {
 
 					assert.True(t, resp.Successful())
 				},
+				"GetCapturedOutputSucceeds": func(ctx context.Context, t *testing.T, c *cli.Context) {
+					opts := &options.Create{Args: []string{"echo", "foo"}}
+					opts.Output.Capture = &options.OutputCapture{}
+					createInput, err := json.Marshal(opts)
+					require.NoError(t, err)
+					createResp := &InfoResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, managerCreateProcess(), createInput, createResp))
+					require.True(t, createResp.Successful())
+
+					waitInput, err := json.Marshal(IDInput{ID: createResp.Info.ID})
+					require.NoError(t, err)
+					require.NoError(t, execCLICommandInputOutput(t, c, processWait(), waitInput, &WaitResponse{}))
+
+					input, err := json.Marshal(CapturedOutputInput{ID: createResp.Info.ID})
+					require.NoError(t, err)
+					resp := &CapturedOutputResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, remoteGetCapturedOutput(), input, resp))
+					require.True(t, resp.Successful())
+					assert.Equal(t, "foo\n", string(resp.Data))
+				},
+				"GetCapturedOutputFailsWithInvalidQuery": func(ctx context.Context, t *testing.T, c *cli.Context) {
+					input, err := json.Marshal(CapturedOutputInput{ID: "foo", Query: options.OutputQuery{Stream: "foo"}})
+					require.NoError(t, err)
+					assert.Error(t, execCLICommandInputOutput(t, c, remoteGetCapturedOutput(), input, &CapturedOutputResponse{}))
+				},
+				"WriteAndCloseStdinSucceed": func(ctx context.Context, t *testing.T, c *cli.Context) {
+					opts := &options.Create{Args: []string{"cat"}, StandardInputStream: true}
+					createInput, err := json.Marshal(opts)
+					require.NoError(t, err)
+					createResp := &InfoResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, managerCreateProcess(), createInput, createResp))
+					require.True(t, createResp.Successful())
+
+					input, err := json.Marshal(WriteStdinInput{ID: createResp.Info.ID, Data: []byte("foo\n")})
+					require.NoError(t, err)
+					resp := &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, remoteWriteStdin(), input, resp))
+					assert.True(t, resp.Successful())
+
+					input, err = json.Marshal(IDInput{ID: createResp.Info.ID})
+					require.NoError(t, err)
+					resp = &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, remoteCloseStdin(), input, resp))
+					assert.True(t, resp.Successful())
+				},
+				"WriteStdinFailsWithNonexistentProcess": func(ctx context.Context, t *testing.T, c *cli.Context) {
+					input, err := json.Marshal(WriteStdinInput{ID: "foo", Data: []byte("bar")})
+					require.NoError(t, err)
+					resp := &OutcomeResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, remoteWriteStdin(), input, resp))
+					assert.False(t, resp.Successful())
+				},
+				"FollowLogsSucceeds": func(ctx context.Context, t *testing.T, c *cli.Context) {
+					logger, err := jasper.NewInMemoryLogger(10)
+					require.NoError(t, err)
+					opts := testoptions.TrueCreateOpts()
+					opts.Output.Loggers = []*options.LoggerConfig{logger}
+					createInput, err := json.Marshal(opts)
+					require.NoError(t, err)
+					createResp := &InfoResponse{}
+					require.NoError(t, execCLICommandInputOutput(t, c, managerCreateProcess(), createInput, createResp))
+
+					input, err := json.Marshal(FollowLogsInput{ID: createResp.Info.ID})
+					require.NoError(t, err)
+					output, err := execCLICommandInputStreamingOutput(t, c, remoteFollowLogs(), input)
+					require.NoError(t, err)
+					require.NotEmpty(t, output)
+
+					resp, err := ExtractLogChunkResponse(output[len(output)-1])
+					require.NoError(t, err)
+					assert.True(t, resp.Done)
+				},
+				"FollowLogsFailsWithNonexistentProcess": func(ctx context.Context, t *testing.T, c *cli.Context) {
+					input, err := json.Marshal(FollowLogsInput{ID: "foo"})
+					require.NoError(t, err)
+					output, err := execCLICommandInputStreamingOutput(t, c, remoteFollowLogs(), input)
+					require.NoError(t, err)
+					require.Len(t, output, 1)
+
+					_, err = ExtractLogChunkResponse(output[0])
+					assert.Error(t, err)
+				},
 			} {
 				t.Run(testName, func(t *testing.T) {
 					ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
@@ -41,12 +41,16 @@ const (
 
 // Constants representing service flags.
 const (
-	quietFlagName            = "quiet"
-	userFlagName             = "user"
-	passwordFlagName         = "password"
-	interactiveFlagName      = "interactive"
-	envFlagName              = "env"
-	preconditionCmdsFlagName = "precondition"
+	quietFlagName             = "quiet"
+	userFlagName              = "user"
+	passwordFlagName          = "password"
+	interactiveFlagName       = "interactive"
+	envFlagName               = "env"
+	preconditionCmdsFlagName  = "precondition"
+	journalPathFlagName       = "journal_path"
+	historyPathFlagName       = "history_path"
+	auditLogPathFlagName      = "audit_log_path"
+	authorizationPathFlagName = "authorization_path"
 
 	logNameFlagName  = "log_name"
 	defaultLogName   = "jasper"
@@ -112,6 +116,22 @@ func serviceFlags() []cli.Flag {
 			Name:  preconditionCmdsFlagName,
 			Usage: "Execute command(s) that must be run and must succeed before the Jasper service can start.",
 		},
+		cli.StringFlag{
+			Name:  journalPathFlagName,
+			Usage: "The path to the file in which to record the running processes so that they can be recovered if the service restarts. If unset, processes are not recovered.",
+		},
+		cli.StringFlag{
+			Name:  historyPathFlagName,
+			Usage: "The path to the file in which to record the history of completed processes. If unset, no history is kept.",
+		},
+		cli.StringFlag{
+			Name:  auditLogPathFlagName,
+			Usage: "The path to the file to which to append a JSON audit record of each request that could modify the manager, its processes or the host. If unset, requests are not audited.",
+		},
+		cli.StringFlag{
+			Name:  authorizationPathFlagName,
+			Usage: "The path to the JSON file describing which operations each client is allowed to perform. If unset, clients can perform any operation.",
+		},
 		cli.StringFlag{
 			Name:  logNameFlagName,
 			Usage: "The name of the logger.",
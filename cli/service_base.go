@@ -8,24 +8,39 @@ import (
 
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/recovery"
+	"github.com/mongodb/grip/send"
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/remote/audit"
 	"github.com/pkg/errors"
 )
 
 // daemonOptions represent common options to initialize a daemon service.
 type daemonOptions struct {
-	host             string
-	port             int
-	manager          jasper.Manager
-	logger           *options.LoggerConfig
-	preconditionCmds []string
+	host              string
+	port              int
+	manager           jasper.Manager
+	logger            *options.LoggerConfig
+	preconditionCmds  []string
+	journalPath       string
+	historyPath       string
+	auditLogPath      string
+	authorizationPath string
 }
 
 // baseDaemon represents common functionality for a daemon service.
 type baseDaemon struct {
 	daemonOptions
-	exit chan struct{}
+	audit send.Sender
+	// authorization limits the operations that each client can perform.
+	// It is only set if the daemon has an authorization path.
+	authorization *options.Authorization
+	exit          chan struct{}
+	// done is closed once the service stops running. It is only set once
+	// the service has started.
+	done chan struct{}
+	// closers close the resources that the daemon opened once it stops.
+	closers []func() error
 }
 
 // newBaseDaemon initializes a base daemon service.
@@ -44,11 +59,16 @@ func (d *baseDaemon) setup(ctx context.Context, cancel context.CancelFunc) error
 		}
 	}
 
-	if d.manager == nil {
-		var err error
-		if d.manager, err = jasper.NewSynchronizedManager(false); err != nil {
-			return errors.Wrap(err, "initializing process manager")
-		}
+	if err := d.setupManager(ctx); err != nil {
+		return errors.Wrap(err, "setting up process manager")
+	}
+
+	if err := d.setupAudit(); err != nil {
+		return errors.Wrap(err, "setting up audit log")
+	}
+
+	if err := d.setupAuthorization(); err != nil {
+		return errors.Wrap(err, "setting up authorization")
 	}
 
 	if err := d.checkPreconditions(ctx); err != nil {
@@ -69,6 +89,103 @@ func (d *baseDaemon) setupLogger(opts *options.LoggerConfig) error {
 	return errors.Wrap(grip.SetSender(sender), "setting Grip logger")
 }
 
+// setupManager initializes the manager if it is not set and wraps it to use
+// the process journal and history, if any. Processes are restarted by the
+// outermost manager so that restarted processes are also journaled and
+// recorded in the history. The journal and history are only opened once, so
+// it is safe to call multiple times.
+func (d *baseDaemon) setupManager(ctx context.Context) error {
+	if d.manager == nil {
+		var err error
+		if d.manager, err = jasper.NewSynchronizedManager(false); err != nil {
+			return errors.Wrap(err, "initializing process manager")
+		}
+	}
+
+	var wrapped bool
+	if d.journalPath != "" {
+		journal, err := jasper.NewBoltProcessJournal(d.journalPath)
+		if err != nil {
+			return errors.Wrapf(err, "opening process journal file '%s'", d.journalPath)
+		}
+		if d.manager, err = jasper.MakeJournaledManager(ctx, d.manager, journal); err != nil {
+			catcher := grip.NewBasicCatcher()
+			catcher.Wrap(err, "recovering processes")
+			catcher.Add(journal.Close())
+			return catcher.Resolve()
+		}
+		d.closers = append(d.closers, journal.Close)
+		d.journalPath = ""
+		wrapped = true
+	}
+
+	if d.historyPath != "" {
+		history, err := jasper.NewBoltProcessHistory(d.historyPath)
+		if err != nil {
+			return errors.Wrapf(err, "opening process history file '%s'", d.historyPath)
+		}
+		d.manager = jasper.MakeHistoryManager(d.manager, history)
+		d.closers = append(d.closers, history.Close)
+		d.historyPath = ""
+		wrapped = true
+	}
+
+	if wrapped {
+		d.manager = jasper.MakeRestartingManager(d.manager)
+	}
+
+	return nil
+}
+
+// setupAudit opens the audit log, if any. The audit log is only opened once,
+// so it is safe to call multiple times.
+func (d *baseDaemon) setupAudit() error {
+	if d.auditLogPath == "" {
+		return nil
+	}
+
+	sender, err := audit.NewFileSender(d.auditLogPath)
+	if err != nil {
+		return errors.Wrapf(err, "opening audit log file '%s'", d.auditLogPath)
+	}
+	d.audit = sender
+	d.closers = append(d.closers, sender.Close)
+	d.auditLogPath = ""
+
+	return nil
+}
+
+// setupAuthorization reads the authorization, if any.
+func (d *baseDaemon) setupAuthorization() error {
+	if d.authorizationPath == "" {
+		return nil
+	}
+
+	authz, err := options.NewAuthorizationFromFile(d.authorizationPath)
+	if err != nil {
+		return errors.Wrapf(err, "reading authorization file '%s'", d.authorizationPath)
+	}
+	d.authorization = authz
+
+	return nil
+}
+
+// teardown waits for the service to stop running and then closes the
+// resources that the daemon opened.
+func (d *baseDaemon) teardown() error {
+	if d.done != nil {
+		<-d.done
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for i := len(d.closers) - 1; i >= 0; i-- {
+		catcher.Add(d.closers[i]())
+	}
+	d.closers = nil
+
+	return catcher.Resolve()
+}
+
 // checkPreconditions runs the daemon's precondition commands.
 func (d *baseDaemon) checkPreconditions(ctx context.Context) error {
 	catcher := grip.NewBasicCatcher()
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/evergreen-ci/baobab"
@@ -11,8 +12,10 @@ import (
 )
 
 const (
-	restHostFlagName = "rest_host"
-	restPortFlagName = "rest_port"
+	restHostFlagName          = "rest_host"
+	restPortFlagName          = "rest_port"
+	restCredsFilePathFlagName = "rest_creds_path"
+	restAuthFilePathFlagName  = "rest_auth_path"
 
 	rpcHostFlagName          = "rpc_host"
 	rpcPortFlagName          = "rpc_port"
@@ -36,6 +39,14 @@ func serviceCommandCombined(cmd string, operation serviceOperation) cli.Command
 				Usage:  "the port running the REST service ",
 				Value:  defaultRESTPort,
 			},
+			cli.StringFlag{
+				Name:  restCredsFilePathFlagName,
+				Usage: "the path to the REST service TLS credentials file",
+			},
+			cli.StringFlag{
+				Name:  restAuthFilePathFlagName,
+				Usage: "the path to the file containing the bearer tokens and API keys accepted by the REST service",
+			},
 			cli.StringFlag{
 				Name:   rpcHostFlagName,
 				EnvVar: rpcHostEnvVar,
@@ -66,21 +77,29 @@ func serviceCommandCombined(cmd string, operation serviceOperation) cli.Command
 			}
 
 			restOpts := daemonOptions{
-				host:             c.String(restHostFlagName),
-				port:             c.Int(restPortFlagName),
-				manager:          manager,
-				logger:           makeLogger(c),
-				preconditionCmds: c.StringSlice(preconditionCmdsFlagName),
+				host:              c.String(restHostFlagName),
+				port:              c.Int(restPortFlagName),
+				manager:           manager,
+				logger:            makeLogger(c),
+				preconditionCmds:  c.StringSlice(preconditionCmdsFlagName),
+				journalPath:       c.String(journalPathFlagName),
+				historyPath:       c.String(historyPathFlagName),
+				auditLogPath:      c.String(auditLogPathFlagName),
+				authorizationPath: c.String(authorizationPathFlagName),
 			}
 			rpcOpts := daemonOptions{
-				host:             c.String(rpcHostFlagName),
-				port:             c.Int(rpcPortFlagName),
-				manager:          manager,
-				logger:           makeLogger(c),
-				preconditionCmds: c.StringSlice(preconditionCmdsFlagName),
+				host:              c.String(rpcHostFlagName),
+				port:              c.Int(rpcPortFlagName),
+				manager:           manager,
+				logger:            makeLogger(c),
+				preconditionCmds:  c.StringSlice(preconditionCmdsFlagName),
+				journalPath:       c.String(journalPathFlagName),
+				historyPath:       c.String(historyPathFlagName),
+				auditLogPath:      c.String(auditLogPathFlagName),
+				authorizationPath: c.String(authorizationPathFlagName),
 			}
 			daemon := newCombinedDaemon(
-				newRESTDaemon(restOpts),
+				newRESTDaemon(restOpts, c.String(restCredsFilePathFlagName), c.String(restAuthFilePathFlagName)),
 				newRPCDaemon(rpcOpts, c.String(rpcCredsFilePathFlagName)),
 			)
 
@@ -107,6 +126,20 @@ func newCombinedDaemon(rest *restDaemon, rpc *rpcDaemon) *combinedDaemon {
 }
 
 func (d *combinedDaemon) Start(s baobab.Service) error {
+	// The services share the same manager, so the process journal, history
+	// and audit log must only be opened once.
+	if err := d.rpcDaemon.setupManager(context.Background()); err != nil {
+		return errors.Wrap(err, "setting up process manager")
+	}
+	d.restDaemon.manager = d.rpcDaemon.manager
+	d.restDaemon.journalPath = ""
+	d.restDaemon.historyPath = ""
+	if err := d.rpcDaemon.setupAudit(); err != nil {
+		return errors.Wrap(err, "setting up audit log")
+	}
+	d.restDaemon.audit = d.rpcDaemon.audit
+	d.restDaemon.auditLogPath = ""
+
 	catcher := grip.NewBasicCatcher()
 	catcher.Wrap(d.rpcDaemon.Start(s), "starting RPC service")
 	catcher.Wrap(d.restDaemon.Start(s), "starting REST service")
@@ -114,8 +147,10 @@ func (d *combinedDaemon) Start(s baobab.Service) error {
 }
 
 func (d *combinedDaemon) Stop(s baobab.Service) error {
+	// The RPC daemon owns the resources shared by both services, so the REST
+	// service must stop before the RPC daemon closes them.
 	catcher := grip.NewBasicCatcher()
-	catcher.Wrap(d.rpcDaemon.Stop(s), "stopping RPC service")
 	catcher.Wrap(d.restDaemon.Stop(s), "stopping REST service")
+	catcher.Wrap(d.rpcDaemon.Stop(s), "stopping RPC service")
 	return catcher.Resolve()
 }
@@ -3,11 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/evergreen-ci/baobab"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/recovery"
+	"github.com/mongodb/grip/send"
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/remote"
 	"github.com/mongodb/jasper/util"
 	"github.com/pkg/errors"
@@ -37,6 +40,14 @@ func serviceCommandREST(cmd string, operation serviceOperation) cli.Command {
 				Usage:  "the port running the REST service",
 				Value:  defaultRESTPort,
 			},
+			cli.StringFlag{
+				Name:  credsFilePathFlagName,
+				Usage: "the path to the file containing the REST service TLS credentials",
+			},
+			cli.StringFlag{
+				Name:  authFilePathFlagName,
+				Usage: "the path to the file containing the bearer tokens and API keys accepted by the REST service",
+			},
 		),
 		Before: mergeBeforeFuncs(
 			validatePort(portFlagName),
@@ -50,13 +61,17 @@ func serviceCommandREST(cmd string, operation serviceOperation) cli.Command {
 			}
 
 			opts := daemonOptions{
-				host:             c.String(hostFlagName),
-				port:             c.Int(portFlagName),
-				manager:          manager,
-				logger:           makeLogger(c),
-				preconditionCmds: c.StringSlice(preconditionCmdsFlagName),
+				host:              c.String(hostFlagName),
+				port:              c.Int(portFlagName),
+				manager:           manager,
+				logger:            makeLogger(c),
+				preconditionCmds:  c.StringSlice(preconditionCmdsFlagName),
+				journalPath:       c.String(journalPathFlagName),
+				historyPath:       c.String(historyPathFlagName),
+				auditLogPath:      c.String(auditLogPathFlagName),
+				authorizationPath: c.String(authorizationPathFlagName),
 			}
-			daemon := newRESTDaemon(opts)
+			daemon := newRESTDaemon(opts, c.String(credsFilePathFlagName), c.String(authFilePathFlagName))
 
 			config := serviceConfig(RESTService, c, buildServiceRunCommand(c, RESTService))
 
@@ -70,10 +85,16 @@ func serviceCommandREST(cmd string, operation serviceOperation) cli.Command {
 
 type restDaemon struct {
 	baseDaemon
+	credsFilePath string
+	authFilePath  string
 }
 
-func newRESTDaemon(opts daemonOptions) *restDaemon {
-	return &restDaemon{newBaseDaemon(opts)}
+func newRESTDaemon(opts daemonOptions, credsFilePath, authFilePath string) *restDaemon {
+	return &restDaemon{
+		baseDaemon:    newBaseDaemon(opts),
+		credsFilePath: credsFilePath,
+		authFilePath:  authFilePath,
+	}
 }
 
 func (d *restDaemon) Start(s baobab.Service) error {
@@ -82,7 +103,9 @@ func (d *restDaemon) Start(s baobab.Service) error {
 		return errors.Wrap(err, "setup")
 	}
 
+	d.done = make(chan struct{})
 	go func(ctx context.Context, d *restDaemon) {
+		defer close(d.done)
 		defer recovery.LogStackTraceAndContinue("REST service")
 		grip.Error(ctx, errors.Wrap(d.run(ctx), "running REST service"))
 	}(ctx, d)
@@ -92,7 +115,7 @@ func (d *restDaemon) Start(s baobab.Service) error {
 
 func (d *restDaemon) Stop(s baobab.Service) error {
 	close(d.exit)
-	return nil
+	return errors.Wrap(d.teardown(), "tearing down REST service")
 }
 
 func (d *restDaemon) run(ctx context.Context) error {
@@ -104,26 +127,30 @@ func (d *restDaemon) newService(ctx context.Context) (util.CloseFunc, error) {
 		return nil, errors.New("manager is not set on REST service")
 	}
 	grip.Infof(ctx, "starting REST service at '%s:%d'", d.host, d.port)
-	return newRESTService(ctx, d.host, d.port, d.manager)
+	return newRESTService(ctx, d.host, d.port, d.manager, d.credsFilePath, d.authFilePath, d.audit, d.authorization)
 }
 
 // newRESTService creates a REST service around the manager serving requests on
-// the host and port.
-func newRESTService(ctx context.Context, host string, port int, manager jasper.Manager) (util.CloseFunc, error) {
-	srv := remote.NewRESTService(manager)
-	app := srv.App(ctx)
-	app.SetPrefix("jasper")
-	if err := app.SetHost(host); err != nil {
-		return nil, errors.Wrap(err, "setting REST host")
-	}
-	if err := app.SetPort(ctx, port); err != nil {
-		return nil, errors.Wrap(err, "setting REST port")
+// the host and port. The service uses TLS if credsFilePath is non-empty,
+// requires authentication if authFilePath is non-empty, audits requests if
+// auditSender is non-nil and limits the operations that each client can
+// perform if authz is non-nil.
+func newRESTService(ctx context.Context, host string, port int, manager jasper.Manager, credsFilePath, authFilePath string, auditSender send.Sender, authz *options.Authorization) (util.CloseFunc, error) {
+	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", host, port))
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving REST address")
 	}
 
-	go func() {
-		defer recovery.LogStackTraceAndContinue("REST service")
-		grip.Warning(ctx, errors.Wrap(app.Run(ctx), "running REST app"))
-	}()
+	opts, err := remote.NewRESTServiceOptionsFromFiles(credsFilePath, authFilePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting REST service options")
+	}
+	opts.Audit = auditSender
+	opts.Authorization = authz
 
-	return func() error { return nil }, nil
+	closeService, err := remote.StartRESTService(ctx, manager, addr, *opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "starting REST service")
+	}
+	return closeService, nil
 }
@@ -6,9 +6,12 @@ import (
 	"net"
 
 	"github.com/evergreen-ci/baobab"
+	"github.com/evergreen-ci/certdepot"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/recovery"
+	"github.com/mongodb/grip/send"
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/remote"
 	"github.com/mongodb/jasper/util"
 	"github.com/pkg/errors"
@@ -55,11 +58,15 @@ func serviceCommandRPC(cmd string, operation serviceOperation) cli.Command {
 			}
 
 			opts := daemonOptions{
-				host:             c.String(hostFlagName),
-				port:             c.Int(portFlagName),
-				manager:          manager,
-				logger:           makeLogger(c),
-				preconditionCmds: c.StringSlice(preconditionCmdsFlagName),
+				host:              c.String(hostFlagName),
+				port:              c.Int(portFlagName),
+				manager:           manager,
+				logger:            makeLogger(c),
+				preconditionCmds:  c.StringSlice(preconditionCmdsFlagName),
+				journalPath:       c.String(journalPathFlagName),
+				historyPath:       c.String(historyPathFlagName),
+				auditLogPath:      c.String(auditLogPathFlagName),
+				authorizationPath: c.String(authorizationPathFlagName),
 			}
 			daemon := newRPCDaemon(opts, c.String(credsFilePathFlagName))
 
@@ -91,7 +98,9 @@ func (d *rpcDaemon) Start(s baobab.Service) error {
 		return errors.Wrap(err, "setup")
 	}
 
+	d.done = make(chan struct{})
 	go func(ctx context.Context, d *rpcDaemon) {
+		defer close(d.done)
 		defer recovery.LogStackTraceAndContinue("RPC service")
 		grip.Error(ctx, errors.Wrap(d.run(ctx), "running RPC service"))
 	}(ctx, d)
@@ -101,7 +110,7 @@ func (d *rpcDaemon) Start(s baobab.Service) error {
 
 func (d *rpcDaemon) Stop(s baobab.Service) error {
 	close(d.exit)
-	return nil
+	return errors.Wrap(d.teardown(), "tearing down RPC service")
 }
 
 func (d *rpcDaemon) run(ctx context.Context) error {
@@ -115,18 +124,27 @@ func (d *rpcDaemon) newService(ctx context.Context) (util.CloseFunc, error) {
 
 	grip.Infof(ctx, "starting RPC service at '%s:%d'", d.host, d.port)
 
-	return newRPCService(ctx, d.host, d.port, d.manager, d.credsFilePath)
+	return newRPCService(ctx, d.host, d.port, d.manager, d.credsFilePath, d.audit, d.authorization)
 }
 
 // newRPCService creates an RPC service around the manager serving requests on
-// the host and port.
-func newRPCService(ctx context.Context, host string, port int, manager jasper.Manager, credsFilePath string) (util.CloseFunc, error) {
+// the host and port. The service uses TLS if credsFilePath is non-empty,
+// audits calls if auditSender is non-nil and limits the operations that each
+// client can perform if authz is non-nil.
+func newRPCService(ctx context.Context, host string, port int, manager jasper.Manager, credsFilePath string, auditSender send.Sender, authz *options.Authorization) (util.CloseFunc, error) {
 	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", host, port))
 	if err != nil {
 		return nil, errors.Wrap(err, "resolving RPC address")
 	}
 
-	closeService, err := remote.StartRPCServiceWithFile(ctx, manager, addr, credsFilePath)
+	opts := remote.RPCServiceOptions{Audit: auditSender, Authorization: authz}
+	if credsFilePath != "" {
+		if opts.Credentials, err = certdepot.NewCredentialsFromFile(credsFilePath); err != nil {
+			return nil, errors.Wrap(err, "getting RPC service credentials from file")
+		}
+	}
+
+	closeService, err := remote.StartRPCServiceWithOptions(ctx, manager, addr, opts)
 	if err != nil {
 		return nil, errors.Wrap(err, "starting RPC service")
 	}
@@ -3,10 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	service "github.com/evergreen-ci/baobab"
 	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/remote"
@@ -82,6 +86,95 @@ func TestBaseDaemon(t *testing.T) {
 			})
 			assert.Error(t, d.setup(sctx, scancel))
 		})
+		t.Run("OpensProcessHistory", func(t *testing.T) {
+			d := newBaseDaemon(daemonOptions{
+				historyPath: filepath.Join(t.TempDir(), "history.db"),
+			})
+			require.NoError(t, d.setup(sctx, scancel))
+			assert.NotNil(t, d.manager.History(sctx))
+			assert.Empty(t, d.historyPath)
+		})
+		t.Run("TeardownClosesProcessHistory", func(t *testing.T) {
+			historyPath := filepath.Join(t.TempDir(), "history.db")
+			d := newBaseDaemon(daemonOptions{
+				historyPath: historyPath,
+			})
+			require.NoError(t, d.setup(sctx, scancel))
+			require.NoError(t, d.teardown())
+
+			history, err := jasper.NewBoltProcessHistory(historyPath)
+			require.NoError(t, err)
+			assert.NoError(t, history.Close())
+		})
+		t.Run("OpensProcessJournal", func(t *testing.T) {
+			journalPath := filepath.Join(t.TempDir(), "journal.db")
+			d := newBaseDaemon(daemonOptions{
+				journalPath: journalPath,
+			})
+			require.NoError(t, d.setup(sctx, scancel))
+			assert.NotNil(t, d.manager)
+			assert.Empty(t, d.journalPath)
+			assert.FileExists(t, journalPath)
+
+			require.NoError(t, d.teardown())
+			journal, err := jasper.NewBoltProcessJournal(journalPath)
+			require.NoError(t, err)
+			assert.NoError(t, journal.Close())
+		})
+		t.Run("FailsWithInvalidHistoryPath", func(t *testing.T) {
+			d := newBaseDaemon(daemonOptions{
+				historyPath: filepath.Join(t.TempDir(), "nonexistent", "history.db"),
+			})
+			assert.Error(t, d.setup(sctx, scancel))
+		})
+		t.Run("OpensAuditLog", func(t *testing.T) {
+			auditLogPath := filepath.Join(t.TempDir(), "audit.jsonl")
+			d := newBaseDaemon(daemonOptions{
+				auditLogPath: auditLogPath,
+			})
+			require.NoError(t, d.setup(sctx, scancel))
+			assert.NotNil(t, d.audit)
+			assert.Empty(t, d.auditLogPath)
+			assert.FileExists(t, auditLogPath)
+		})
+		t.Run("TeardownClosesAuditLog", func(t *testing.T) {
+			auditLogPath := filepath.Join(t.TempDir(), "audit.jsonl")
+			d := newBaseDaemon(daemonOptions{
+				auditLogPath: auditLogPath,
+			})
+			require.NoError(t, d.setup(sctx, scancel))
+			d.audit.Send(sctx, message.NewDefaultMessage(level.Info, "before teardown"))
+			require.NoError(t, d.teardown())
+			d.audit.Send(sctx, message.NewDefaultMessage(level.Info, "after teardown"))
+
+			contents, err := os.ReadFile(auditLogPath)
+			require.NoError(t, err)
+			assert.Contains(t, string(contents), "before teardown")
+			assert.NotContains(t, string(contents), "after teardown")
+		})
+		t.Run("ReadsAuthorization", func(t *testing.T) {
+			authorizationPath := filepath.Join(t.TempDir(), "authorization.json")
+			require.NoError(t, os.WriteFile(authorizationPath, []byte(`{"default_access": {"role": "read-only"}}`), 0600))
+			d := newBaseDaemon(daemonOptions{
+				authorizationPath: authorizationPath,
+			})
+			require.NoError(t, d.setup(sctx, scancel))
+			require.NotZero(t, d.authorization)
+			require.NotZero(t, d.authorization.DefaultAccess)
+			assert.Equal(t, options.RoleReadOnly, d.authorization.DefaultAccess.Role)
+		})
+		t.Run("FailsWithInvalidAuthorizationPath", func(t *testing.T) {
+			d := newBaseDaemon(daemonOptions{
+				authorizationPath: filepath.Join(t.TempDir(), "nonexistent.json"),
+			})
+			assert.Error(t, d.setup(sctx, scancel))
+		})
+		t.Run("FailsWithInvalidAuditLogPath", func(t *testing.T) {
+			d := newBaseDaemon(daemonOptions{
+				auditLogPath: filepath.Join(t.TempDir(), "nonexistent", "audit.jsonl"),
+			})
+			assert.Error(t, d.setup(sctx, scancel))
+		})
 	})
 }
 
@@ -117,7 +210,7 @@ func TestDaemon(t *testing.T) {
 				port:    port,
 				manager: manager,
 			}
-			daemon := newRESTDaemon(opts)
+			daemon := newRESTDaemon(opts, "", "")
 			svc, err := service.New(daemon, &service.Config{Name: "foo"})
 			require.NoError(t, err)
 			require.NoError(t, daemon.Start(svc))
@@ -142,7 +235,7 @@ func TestDaemon(t *testing.T) {
 				manager: manager,
 			}
 			daemon := newCombinedDaemon(
-				newRESTDaemon(restOpts),
+				newRESTDaemon(restOpts, "", ""),
 				newRPCDaemon(rpcOpts, ""),
 			)
 			svc, err := service.New(daemon, &service.Config{Name: "foo"})
@@ -169,7 +262,7 @@ func TestDaemon(t *testing.T) {
 				manager: manager,
 			}
 			daemon := newCombinedDaemon(
-				newRESTDaemon(restOpts),
+				newRESTDaemon(restOpts, "", ""),
 				newRPCDaemon(rpcOpts, ""),
 			)
 			svc, err := service.New(daemon, &service.Config{Name: "foo"})
@@ -205,3 +298,92 @@ func TestDaemon(t *testing.T) {
 		})
 	}
 }
+
+func TestDaemonAuthorization(t *testing.T) {
+	for daemonName, makeDaemonAndClient := range map[string]func(ctx context.Context, t *testing.T, opts daemonOptions) (util.CloseFunc, remote.Manager){
+		"RPCService": func(ctx context.Context, t *testing.T, opts daemonOptions) (util.CloseFunc, remote.Manager) {
+			daemon := newRPCDaemon(opts, "")
+			svc, err := service.New(daemon, &service.Config{Name: "foo"})
+			require.NoError(t, err)
+			require.NoError(t, daemon.Start(svc))
+
+			client, err := newRemoteManager(ctx, RPCService, "localhost", opts.port, "")
+			require.NoError(t, err)
+
+			return func() error { return daemon.Stop(svc) }, client
+		},
+		"RESTService": func(ctx context.Context, t *testing.T, opts daemonOptions) (util.CloseFunc, remote.Manager) {
+			daemon := newRESTDaemon(opts, "", "")
+			svc, err := service.New(daemon, &service.Config{Name: "foo"})
+			require.NoError(t, err)
+			require.NoError(t, daemon.Start(svc))
+			httpClient := utility.GetHTTPClient()
+			defer utility.PutHTTPClient(httpClient)
+			require.NoError(t, testutil.WaitForHTTPService(ctx, fmt.Sprintf("http://localhost:%d/jasper/v1", opts.port), httpClient))
+
+			client, err := newRemoteManager(ctx, RESTService, "localhost", opts.port, "")
+			require.NoError(t, err)
+
+			return func() error { return daemon.Stop(svc) }, client
+		},
+	} {
+		t.Run(daemonName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			authorizationPath := filepath.Join(t.TempDir(), "authorization.json")
+			require.NoError(t, os.WriteFile(authorizationPath, []byte(`{"default_access": {"role": "read-only"}}`), 0600))
+			manager, err := jasper.NewSynchronizedManager(false)
+			require.NoError(t, err)
+			closeDaemon, client := makeDaemonAndClient(ctx, t, daemonOptions{
+				host:              "localhost",
+				port:              testutil.GetPortNumber(),
+				manager:           manager,
+				authorizationPath: authorizationPath,
+			})
+			defer func() {
+				assert.NoError(t, closeDaemon())
+			}()
+
+			_, err = client.List(ctx, options.All)
+			assert.NoError(t, err)
+			_, err = client.CreateProcess(ctx, &options.Create{Args: []string{"echo", "hello", "world"}})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCombinedDaemon(t *testing.T) {
+	t.Run("StopClosesSharedResourcesOnce", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+		defer cancel()
+
+		dir := t.TempDir()
+		newOpts := func() daemonOptions {
+			return daemonOptions{
+				host:         "localhost",
+				port:         testutil.GetPortNumber(),
+				journalPath:  filepath.Join(dir, "journal.db"),
+				historyPath:  filepath.Join(dir, "history.db"),
+				auditLogPath: filepath.Join(dir, "audit.jsonl"),
+			}
+		}
+		restOpts := newOpts()
+		daemon := newCombinedDaemon(newRESTDaemon(restOpts, "", ""), newRPCDaemon(newOpts(), ""))
+		svc, err := service.New(daemon, &service.Config{Name: "foo"})
+		require.NoError(t, err)
+		require.NoError(t, daemon.Start(svc))
+		httpClient := utility.GetHTTPClient()
+		defer utility.PutHTTPClient(httpClient)
+		require.NoError(t, testutil.WaitForHTTPService(ctx, fmt.Sprintf("http://localhost:%d/jasper/v1", restOpts.port), httpClient))
+
+		assert.Empty(t, daemon.restDaemon.closers)
+		assert.NotEmpty(t, daemon.rpcDaemon.closers)
+		require.NoError(t, daemon.Stop(svc))
+
+		daemon.rpcDaemon.audit.Send(ctx, message.NewDefaultMessage(level.Info, "after stop"))
+		contents, err := os.ReadFile(filepath.Join(dir, "audit.jsonl"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(contents), "after stop")
+	})
+}
@@ -0,0 +1,297 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/jasper/remote"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+// SessionCommand represents a long-lived client session as a CLI command.
+const SessionCommand = "session"
+
+// sessionCommandEnvMetadataKey is the key of the app metadata that contains
+// the environment of a client command that runs in a client session.
+const sessionCommandEnvMetadataKey = "session_command_env"
+
+// clientSession creates a cli.Command that runs client commands sent as lines
+// of JSON on standard input over a single connection to the Jasper service.
+// Commands run concurrently and the responses are written as lines of JSON to
+// standard output, so that a client can multiplex any number of commands over
+// a single SSH session.
+func clientSession() cli.Command {
+	return cli.Command{
+		Name:   SessionCommand,
+		Usage:  "Run client commands sent as lines of JSON over a single connection to the Jasper service, intended for automation.",
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			return withConnection(ctx, c, func(client remote.Manager) error {
+				s := newSession(client, []string{
+					fmt.Sprintf("--%s=%s", serviceFlagName, c.String(serviceFlagName)),
+					fmt.Sprintf("--%s=%s", hostFlagName, c.String(hostFlagName)),
+					fmt.Sprintf("--%s=%d", portFlagName, c.Int(portFlagName)),
+				})
+				return s.serve(ctx, os.Stdin, os.Stdout)
+			})
+		},
+	}
+}
+
+// SessionRequest represents a request to run a client command in a client
+// session.
+type SessionRequest struct {
+	// ID identifies the request in the session.
+	ID int `json:"id"`
+	// Command is the client subcommand to run (e.g. ["manager", "list"]).
+	Command []string `json:"command,omitempty"`
+	// Input is the JSON input to the client subcommand, if any.
+	Input json.RawMessage `json:"input,omitempty"`
+	// Cancel cancels the running request with the ID instead of running a
+	// new command.
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// SessionResponse represents output from a client session. The session sends
+// a response with Ready set once it is ready to run commands. Each command
+// then results in a response for each JSON output that it writes followed by
+// a response with Done set once the command finishes.
+type SessionResponse struct {
+	ID     int             `json:"id"`
+	Ready  bool            `json:"ready,omitempty"`
+	Output json.RawMessage `json:"output,omitempty"`
+	Done   bool            `json:"done,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// sessionCommandEnv is the environment of a client command that runs in a
+// client session.
+type sessionCommandEnv struct {
+	ctx     context.Context
+	input   io.Reader
+	output  io.Writer
+	manager remote.Manager
+}
+
+// sessionCommandEnvFromContext returns the environment of the client command
+// if it runs in a client session.
+func sessionCommandEnvFromContext(c *cli.Context) *sessionCommandEnv {
+	if c.App == nil {
+		return nil
+	}
+	env, _ := c.App.Metadata[sessionCommandEnvMetadataKey].(*sessionCommandEnv)
+	return env
+}
+
+// clientContext returns the base context of the client command.
+func clientContext(c *cli.Context) context.Context {
+	if env := sessionCommandEnvFromContext(c); env != nil {
+		return env.ctx
+	}
+	return context.Background()
+}
+
+// clientStdin returns the reader for the input to the client command.
+func clientStdin(c *cli.Context) io.Reader {
+	if env := sessionCommandEnvFromContext(c); env != nil {
+		return env.input
+	}
+	return os.Stdin
+}
+
+// clientStdout returns the writer for the output of the client command.
+func clientStdout(c *cli.Context) io.Writer {
+	if env := sessionCommandEnvFromContext(c); env != nil {
+		return env.output
+	}
+	return os.Stdout
+}
+
+// session runs client commands over a single connection to the Jasper
+// service.
+type session struct {
+	manager remote.Manager
+	// flags are the client flags passed to every command.
+	flags []string
+
+	outputMu sync.Mutex
+	encoder  *json.Encoder
+
+	requestsMu sync.Mutex
+	requests   map[int]context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+func newSession(manager remote.Manager, flags []string) *session {
+	return &session{
+		manager:  manager,
+		flags:    flags,
+		requests: map[int]context.CancelFunc{},
+	}
+}
+
+// serve runs the requests read from the input until the input is exhausted or
+// the context is done, writing the responses to the output.
+func (s *session) serve(ctx context.Context, input io.Reader, output io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		s.wg.Wait()
+	}()
+
+	s.encoder = json.NewEncoder(output)
+	if err := s.send(SessionResponse{Ready: true}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// Reading the input blocks, so it is read separately to stop serving as
+	// soon as the context is done.
+	reqs := make(chan SessionRequest)
+	readErr := make(chan error, 1)
+	go func() {
+		decoder := json.NewDecoder(input)
+		for {
+			var req SessionRequest
+			if err := decoder.Decode(&req); err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				readErr <- errors.Wrap(err, "reading request")
+				return
+			}
+			select {
+			case reqs <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case req := <-reqs:
+			if req.Cancel {
+				s.cancel(req.ID)
+				continue
+			}
+			s.start(ctx, req)
+		case err := <-readErr:
+			// Once the client is done sending requests, it still waits for
+			// the responses to the requests in progress.
+			s.wg.Wait()
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// start runs the request in the background.
+func (s *session) start(ctx context.Context, req SessionRequest) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	s.requestsMu.Lock()
+	s.requests[req.ID] = cancel
+	s.requestsMu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			s.requestsMu.Lock()
+			defer s.requestsMu.Unlock()
+			cancel()
+			delete(s.requests, req.ID)
+		}()
+
+		resp := SessionResponse{ID: req.ID, Done: true}
+		if err := s.run(ctx, req); err != nil {
+			resp.Error = err.Error()
+		}
+		grip.Warning(ctx, errors.Wrap(s.send(resp), "sending response"))
+	}()
+}
+
+// cancel cancels the request with the given ID if it is running.
+func (s *session) cancel(id int) {
+	s.requestsMu.Lock()
+	defer s.requestsMu.Unlock()
+	if cancel, ok := s.requests[id]; ok {
+		cancel()
+	}
+}
+
+// run runs the client command for the request and sends each JSON output that
+// it writes as a response.
+func (s *session) run(ctx context.Context, req SessionRequest) error {
+	outputReader, outputWriter := io.Pipe()
+	outputDone := make(chan error, 1)
+	go func() {
+		decoder := json.NewDecoder(outputReader)
+		for {
+			var output json.RawMessage
+			if err := decoder.Decode(&output); err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				outputReader.CloseWithError(err)
+				outputDone <- errors.Wrap(err, "reading command output")
+				return
+			}
+			if err := s.send(SessionResponse{ID: req.ID, Output: output}); err != nil {
+				outputReader.CloseWithError(err)
+				outputDone <- errors.Wrap(err, "sending command output")
+				return
+			}
+		}
+	}()
+
+	app := cli.NewApp()
+	app.Name = SessionCommand
+	app.Commands = []cli.Command{
+		Manager(),
+		Process(),
+		Remote(),
+		LoggingCache(),
+	}
+	app.Writer = outputWriter
+	app.ErrWriter = io.Discard
+	app.ExitErrHandler = func(*cli.Context, error) {}
+	app.Metadata = map[string]interface{}{
+		sessionCommandEnvMetadataKey: &sessionCommandEnv{
+			ctx:     ctx,
+			input:   bytes.NewReader(req.Input),
+			output:  outputWriter,
+			manager: s.manager,
+		},
+	}
+
+	args := append(append([]string{SessionCommand}, req.Command...), s.flags...)
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrapf(app.Run(args), "running command '%s'", strings.Join(req.Command, " "))
+	catcher.Add(outputWriter.Close())
+	catcher.Add(<-outputDone)
+	return catcher.Resolve()
+}
+
+// send writes the response to the session's output.
+func (s *session) send(resp SessionResponse) error {
+	s.outputMu.Lock()
+	defer s.outputMu.Unlock()
+	return errors.Wrap(s.encoder.Encode(resp), "writing response")
+}
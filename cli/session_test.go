@@ -0,0 +1,193 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/mock"
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, session *sshSession, served <-chan error, manager *mock.RemoteManager){
+		"RunsCommandWithoutInput": func(ctx context.Context, t *testing.T, session *sshSession, _ <-chan error, manager *mock.RemoteManager) {
+			output, err := runTestSession(ctx, session, []string{ManagerCommand, IDCommand}, nil)
+			require.NoError(t, err)
+			resp, err := ExtractIDResponse(output)
+			require.NoError(t, err)
+			assert.Equal(t, manager.ManagerID, resp.ID)
+		},
+		"RunsCommandWithInput": func(ctx context.Context, t *testing.T, session *sshSession, _ <-chan error, manager *mock.RemoteManager) {
+			output, err := runTestSession(ctx, session, []string{ManagerCommand, GetCommand}, &IDInput{ID: "proc0"})
+			require.NoError(t, err)
+			resp, err := ExtractInfoResponse(output)
+			require.NoError(t, err)
+			assert.Equal(t, "proc0", resp.Info.ID)
+		},
+		"RunsConcurrentCommands": func(ctx context.Context, t *testing.T, session *sshSession, _ <-chan error, manager *mock.RemoteManager) {
+			var wg sync.WaitGroup
+			errs := make(chan error, len(manager.Procs))
+			for _, proc := range manager.Procs {
+				wg.Add(1)
+				go func(id string) {
+					defer wg.Done()
+					output, err := runTestSession(ctx, session, []string{ProcessCommand, InfoCommand}, &IDInput{ID: id})
+					if err != nil {
+						errs <- err
+						return
+					}
+					resp, err := ExtractInfoResponse(output)
+					if err != nil {
+						errs <- err
+						return
+					}
+					if resp.Info.ID != id {
+						errs <- errors.Errorf("expected info for process '%s' but got '%s'", id, resp.Info.ID)
+					}
+				}(proc.ID())
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				assert.NoError(t, err)
+			}
+		},
+		"PassesStreamedOutputToHandler": func(ctx context.Context, t *testing.T, session *sshSession, _ <-chan error, manager *mock.RemoteManager) {
+			manager.Events = []jasper.ProcessEvent{
+				{Type: options.ProcessStarted, Info: jasper.ProcessInfo{ID: "proc0"}},
+				{Type: options.ProcessExited, Info: jasper.ProcessInfo{ID: "proc0"}},
+			}
+			input, err := clientInput(&options.ProcessEventFilter{})
+			require.NoError(t, err)
+
+			var resps []ProcessEventResponse
+			errDone := errors.New("done")
+			err = session.run(ctx, []string{ManagerCommand, SubscribeCommand}, input, func(output json.RawMessage) error {
+				resp, err := ExtractProcessEventResponse(output)
+				if err != nil {
+					return err
+				}
+				resps = append(resps, resp)
+				if len(resps) == len(manager.Events)+1 {
+					return errDone
+				}
+				return nil
+			})
+			assert.Equal(t, errDone, errors.Cause(err))
+			require.Len(t, resps, len(manager.Events)+1)
+			assert.Nil(t, resps[0].Event)
+			for i, event := range manager.Events {
+				require.NotNil(t, resps[i+1].Event)
+				assert.Equal(t, event.Type, resps[i+1].Event.Type)
+			}
+
+			// The cancelled command should not prevent other commands from
+			// running.
+			_, err = runTestSession(ctx, session, []string{ManagerCommand, IDCommand}, nil)
+			assert.NoError(t, err)
+		},
+		"ContextCancellationCancelsCommand": func(ctx context.Context, t *testing.T, session *sshSession, _ <-chan error, _ *mock.RemoteManager) {
+			input, err := clientInput(&options.ProcessEventFilter{})
+			require.NoError(t, err)
+
+			cctx, ccancel := context.WithCancel(ctx)
+			err = session.run(cctx, []string{ManagerCommand, SubscribeCommand}, input, func(json.RawMessage) error {
+				ccancel()
+				return nil
+			})
+			assert.Equal(t, context.Canceled, errors.Cause(err))
+
+			_, err = runTestSession(ctx, session, []string{ManagerCommand, IDCommand}, nil)
+			assert.NoError(t, err)
+		},
+		"InvalidCommandErrors": func(ctx context.Context, t *testing.T, session *sshSession, _ <-chan error, _ *mock.RemoteManager) {
+			_, err := runTestSession(ctx, session, []string{"foo", "bar"}, nil)
+			assert.Error(t, err)
+		},
+		"InvalidInputErrors": func(ctx context.Context, t *testing.T, session *sshSession, _ <-chan error, _ *mock.RemoteManager) {
+			_, err := runTestSession(ctx, session, []string{ManagerCommand, GetCommand}, &IDInput{})
+			assert.Error(t, err)
+		},
+		"ClosingSessionStopsServing": func(ctx context.Context, t *testing.T, session *sshSession, served <-chan error, _ *mock.RemoteManager) {
+			require.NoError(t, session.close())
+			select {
+			case err := <-served:
+				assert.NoError(t, err)
+			case <-ctx.Done():
+				assert.FailNow(t, "session did not stop serving")
+			}
+
+			_, err := runTestSession(ctx, session, []string{ManagerCommand, IDCommand}, nil)
+			assert.Error(t, err)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			tctx, cancel := context.WithTimeout(ctx, testutil.TestTimeout)
+			defer cancel()
+
+			manager := &mock.RemoteManager{Manager: mock.Manager{ManagerID: "foo"}}
+			for i := 0; i < 50; i++ {
+				manager.Procs = append(manager.Procs, &mock.Process{ProcInfo: jasper.ProcessInfo{ID: fmt.Sprintf("proc%d", i), IsRunning: true}})
+			}
+
+			session, served := newTestSession(tctx, t, manager)
+			defer func() {
+				assert.NoError(t, session.close())
+			}()
+
+			testCase(tctx, t, session, served, manager)
+		})
+	}
+}
+
+// newTestSession starts serving a client session for the manager and returns
+// the client side of the session. The error from serving the session is sent
+// on the returned channel once it stops serving.
+func newTestSession(ctx context.Context, t *testing.T, manager *mock.RemoteManager) (*sshSession, <-chan error) {
+	requestsReader, requestsWriter := io.Pipe()
+	responsesReader, responsesWriter := io.Pipe()
+
+	served := make(chan error, 1)
+	go func() {
+		s := newSession(manager, []string{
+			fmt.Sprintf("--%s=%s", serviceFlagName, RPCService),
+			fmt.Sprintf("--%s=%s", hostFlagName, defaultLocalHostName),
+			fmt.Sprintf("--%s=%d", portFlagName, defaultRPCPort),
+		})
+		err := s.serve(ctx, requestsReader, responsesWriter)
+		responsesWriter.Close()
+		served <- err
+	}()
+
+	session := newSSHSession(requestsWriter, responsesReader, requestsWriter.Close)
+	require.NoError(t, session.waitReady(ctx))
+
+	return session, served
+}
+
+// runTestSession runs the client subcommand with the input in the session and
+// returns its output.
+func runTestSession(ctx context.Context, session *sshSession, subcommand []string, subcommandInput interface{}) (json.RawMessage, error) {
+	input, err := clientInput(subcommandInput)
+	if err != nil {
+		return nil, err
+	}
+	var output json.RawMessage
+	err = session.run(ctx, subcommand, input, func(resp json.RawMessage) error {
+		output = resp
+		return nil
+	})
+	return output, err
+}
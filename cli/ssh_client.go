@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"io"
 	"strings"
+	"sync"
 
 	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/remote"
@@ -83,17 +85,17 @@ func (c *sshClient) CreateProcess(ctx context.Context, opts *options.Create) (ja
 // CreateCommand creates a command that logically will execute via the remote
 // CLI. Users should not use (*jasper.Command).SetRunFunc().
 func (c *sshClient) CreateCommand(ctx context.Context) *jasper.Command {
-	return c.client.manager.CreateCommand(ctx).SetRunFunc(func(opts options.Command) error {
+	cmd := c.client.manager.CreateCommand(ctx)
+	return cmd.SetRunFunc(func(opts options.Command) error {
 		output, err := c.runManagerCommand(ctx, CreateCommand, &opts)
 		if err != nil {
 			return errors.Wrap(err, "running command")
 		}
 
-		if _, err := ExtractOutcomeResponse(output); err != nil {
-			return errors.WithStack(err)
-		}
+		resp, err := ExtractCommandResponse(output)
+		cmd.SetResults(resp.Results)
 
-		return nil
+		return errors.WithStack(err)
 	})
 }
 
@@ -122,6 +124,77 @@ func (c *sshClient) List(ctx context.Context, f options.Filter) ([]jasper.Proces
 	return procs, nil
 }
 
+func (c *sshClient) Query(ctx context.Context, q options.ProcessQuery) ([]jasper.Process, error) {
+	output, err := c.runManagerCommand(ctx, ListCommand, &FilterInput{Query: &q})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resp, err := ExtractInfosResponse(output)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	procs := make([]jasper.Process, len(resp.Infos))
+	for i := range resp.Infos {
+		if procs[i], err = newSSHProcess(c.client, resp.Infos[i]); err != nil {
+			return nil, errors.Wrap(err, "creating SSH process")
+		}
+	}
+
+	return procs, nil
+}
+
+func (c *sshClient) Subscribe(ctx context.Context, f options.ProcessEventFilter) (<-chan jasper.ProcessEvent, error) {
+	if err := f.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid filter")
+	}
+
+	events := make(chan jasper.ProcessEvent, jasper.ProcessEventBufferSize)
+	subscribed := make(chan error, 1)
+	go func() {
+		defer close(events)
+
+		var acknowledged bool
+		err := c.client.runStreamingClientCommand(ctx, []string{ManagerCommand, SubscribeCommand}, &f, func(output json.RawMessage) error {
+			resp, err := ExtractProcessEventResponse(output)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if !acknowledged {
+				acknowledged = true
+				subscribed <- nil
+				return nil
+			}
+			if resp.Event == nil {
+				return nil
+			}
+			select {
+			case events <- *resp.Event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if !acknowledged {
+			if err == nil {
+				err = errors.New("event stream ended before subscribing")
+			}
+			subscribed <- err
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			grip.Warning(ctx, errors.Wrap(err, "receiving process events"))
+		}
+	}()
+
+	if err := <-subscribed; err != nil {
+		return nil, errors.Wrap(err, "subscribing to process events")
+	}
+
+	return events, nil
+}
+
 func (c *sshClient) Group(ctx context.Context, tag string) ([]jasper.Process, error) {
 	output, err := c.runManagerCommand(ctx, GroupCommand, &TagInput{Tag: tag})
 	if err != nil {
@@ -191,8 +264,9 @@ func (c *sshClient) WriteFile(ctx context.Context, opts options.WriteFile) error
 	})
 }
 
+// CloseConnection closes the client session, if any.
 func (c *sshClient) CloseConnection() error {
-	return nil
+	return c.client.closeSession()
 }
 
 func (c *sshClient) ConfigureCache(ctx context.Context, opts options.Cache) error {
@@ -248,6 +322,72 @@ func (c *sshClient) GetLogStream(ctx context.Context, id string, count int) (jas
 	return resp.LogStream, nil
 }
 
+func (c *sshClient) GetCapturedOutput(ctx context.Context, id string, query options.OutputQuery) (*jasper.CapturedOutput, error) {
+	output, err := c.runRemoteCommand(ctx, GetCapturedOutputCommand, &CapturedOutputInput{ID: id, Query: query})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resp, err := ExtractCapturedOutputResponse(output)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &resp.CapturedOutput, nil
+}
+
+func (c *sshClient) FollowLogs(ctx context.Context, id string, offset int, handler func(jasper.LogChunk) error) error {
+	var done bool
+	if err := c.client.runStreamingClientCommand(ctx, []string{RemoteCommand, FollowLogsCommand}, &FollowLogsInput{ID: id, Offset: offset}, func(output json.RawMessage) error {
+		resp, err := ExtractLogChunkResponse(output)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		done = resp.LogChunk.Done
+		return handler(resp.LogChunk)
+	}); err != nil {
+		return errors.WithStack(err)
+	}
+	if !done {
+		return errors.New("log stream ended before the process completed")
+	}
+
+	return nil
+}
+
+// WriteStdin reads all of the input before sending it to the remote process
+// since the input to the CLI is not streamed.
+func (c *sshClient) WriteStdin(ctx context.Context, id string, input io.Reader) error {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return errors.Wrap(err, "reading standard input")
+	}
+
+	output, err := c.runRemoteCommand(ctx, WriteStdinCommand, &WriteStdinInput{ID: id, Data: data})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := ExtractOutcomeResponse(output); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (c *sshClient) CloseStdin(ctx context.Context, id string) error {
+	output, err := c.runRemoteCommand(ctx, CloseStdinCommand, &IDInput{ID: id})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := ExtractOutcomeResponse(output); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
 func (c *sshClient) GetBuildloggerURLs(ctx context.Context, id string) ([]string, error) {
 	output, err := c.runRemoteCommand(ctx, GetBuildloggerURLsCommand, &IDInput{ID: id})
 	if err != nil {
@@ -275,6 +415,10 @@ func (c *sshClient) SignalEvent(ctx context.Context, name string) error {
 	return nil
 }
 
+func (c *sshClient) History(ctx context.Context) jasper.ProcessHistory {
+	return newSSHProcessHistory(c.client)
+}
+
 func (c *sshClient) LoggingCache(ctx context.Context) jasper.LoggingCache {
 	return newSSHLoggingCache(ctx, c.client)
 }
@@ -300,11 +444,23 @@ func (c *sshClient) runRemoteCommand(ctx context.Context, remoteSubcommand strin
 	return c.client.runClientCommand(ctx, []string{RemoteCommand, remoteSubcommand}, subcommandInput)
 }
 
-// sshRunner is a client to help run Jasper CLI commands over SSH.
+// sshRunner is a client to help run Jasper CLI commands over SSH. Unless
+// sessions are disabled, all commands run in a single client session so that
+// they do not each have to set up a new SSH session and connection to the
+// remote Jasper service.
 type sshRunner struct {
 	manager    jasper.Manager
 	clientOpts ClientOptions
 	remoteOpts options.Remote
+
+	sessionMu sync.Mutex
+	session   *sshSession
+	// sessionUnsupported is set if the remote Jasper CLI could not start a
+	// client session, such as because it is too old, in which case each
+	// command runs in its own SSH session.
+	sessionUnsupported bool
+	// sessionStarted is set once a client session has started.
+	sessionStarted bool
 }
 
 func newSSHRunner(clientOpts ClientOptions, remoteOpts options.Remote) (*sshRunner, error) {
@@ -328,8 +484,19 @@ func (r *sshRunner) runClientCommand(ctx context.Context, subcommand []string, s
 	if err != nil {
 		return nil, errors.Wrap(err, "creating client input")
 	}
-	output := clientOutput()
 
+	if session := r.getSession(ctx); session != nil {
+		var output json.RawMessage
+		if err := session.run(ctx, subcommand, input, func(resp json.RawMessage) error {
+			output = resp
+			return nil
+		}); err != nil {
+			return nil, errors.Wrapf(err, "running command '%s' in SSH client session", r.clientOpts.buildCommand(subcommand...))
+		}
+		return output, nil
+	}
+
+	output := clientOutput()
 	cmd := r.newCommand(ctx, subcommand, input, output)
 	if err := cmd.Run(ctx); err != nil {
 		return nil, errors.Wrapf(err, "running command '%s' over SSH", r.clientOpts.buildCommand(subcommand...))
@@ -338,6 +505,158 @@ func (r *sshRunner) runClientCommand(ctx context.Context, subcommand []string, s
 	return output.Bytes(), nil
 }
 
+// runStreamingClientCommand is the same as runClientCommand, except that the
+// CLI client subcommand is expected to output a stream of JSON responses. Each
+// response is passed to the handler as soon as it is read. If the handler
+// returns an error, the command is aborted.
+func (r *sshRunner) runStreamingClientCommand(ctx context.Context, subcommand []string, subcommandInput interface{}, handler func(json.RawMessage) error) error {
+	input, err := clientInput(subcommandInput)
+	if err != nil {
+		return errors.Wrap(err, "creating client input")
+	}
+
+	if session := r.getSession(ctx); session != nil {
+		return errors.Wrapf(session.run(ctx, subcommand, input, handler), "running command '%s' in SSH client session", r.clientOpts.buildCommand(subcommand...))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outputReader, outputWriter := io.Pipe()
+	handlerErr := make(chan error, 1)
+	go func() {
+		decoder := json.NewDecoder(outputReader)
+		for {
+			var resp json.RawMessage
+			err := decoder.Decode(&resp)
+			if err == io.EOF {
+				handlerErr <- nil
+				return
+			}
+			if err == nil {
+				err = handler(resp)
+			}
+			if err != nil {
+				cancel()
+				outputReader.CloseWithError(err)
+				handlerErr <- err
+				return
+			}
+		}
+	}()
+
+	cmd := r.newCommand(ctx, subcommand, input, outputWriter)
+	runErr := cmd.Run(ctx)
+	grip.Warning(ctx, outputWriter.Close())
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(<-handlerErr, "handling streamed output")
+	if !catcher.HasErrors() {
+		catcher.Wrapf(runErr, "running command '%s' over SSH", r.clientOpts.buildCommand(subcommand...))
+	}
+	return catcher.Resolve()
+}
+
+// getSession returns the client session in which to run commands, starting a
+// new one if there is none. It returns nil if commands should each run in their
+// own SSH session instead.
+func (r *sshRunner) getSession(ctx context.Context) *sshSession {
+	if r.clientOpts.DisableSession {
+		return nil
+	}
+
+	r.sessionMu.Lock()
+	defer r.sessionMu.Unlock()
+
+	if r.session != nil && !r.session.isDone() {
+		return r.session
+	}
+	if r.session != nil {
+		grip.Debug(ctx, errors.Wrap(r.session.close(), "closing ended SSH client session"))
+		r.session = nil
+	}
+	if r.sessionUnsupported {
+		return nil
+	}
+
+	session, err := r.startSession(ctx)
+	if err != nil {
+		// If the remote Jasper CLI has never been able to start a session,
+		// it is assumed that it does not support them.
+		if !r.sessionStarted && ctx.Err() == nil {
+			r.sessionUnsupported = true
+		}
+		grip.Debug(ctx, message.WrapError(err, "starting SSH client session, running command in its own SSH session instead"))
+		return nil
+	}
+	r.session = session
+	r.sessionStarted = true
+
+	return session
+}
+
+// startSession starts a client session on the remote host and waits for it to
+// be ready.
+func (r *sshRunner) startSession(ctx context.Context) (*sshSession, error) {
+	// The session outlives the command that starts it, so it is not bound to
+	// the command's context.
+	sessionCtx, cancel := context.WithCancel(context.Background())
+
+	responsesReader, responsesWriter := io.Pipe()
+	opts := &options.Create{
+		Args:                r.clientOpts.buildCommand(SessionCommand),
+		Remote:              &r.remoteOpts,
+		StandardInputStream: true,
+		Output:              options.Output{Output: responsesWriter},
+	}
+	opts.RegisterCloser(responsesWriter.Close)
+
+	proc, err := r.manager.CreateProcess(sessionCtx, opts)
+	if err != nil {
+		cancel()
+		grip.Debug(ctx, errors.Wrap(responsesWriter.Close(), "closing session output"))
+		return nil, errors.Wrap(err, "starting session process")
+	}
+
+	session := newSSHSession(&sshSessionInput{ctx: sessionCtx, proc: proc}, responsesReader, func() error {
+		defer cancel()
+
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(jasper.CloseStandardInput(sessionCtx, proc), "closing session input")
+		waitCtx, waitCancel := context.WithTimeout(sessionCtx, clientConnectionTimeout)
+		defer waitCancel()
+		if _, err := proc.Wait(waitCtx); err != nil && waitCtx.Err() != nil {
+			catcher.Wrap(err, "waiting for session to end")
+		}
+		return catcher.Resolve()
+	})
+
+	readyCtx, readyCancel := context.WithTimeout(ctx, clientConnectionTimeout)
+	defer readyCancel()
+	if err := session.waitReady(readyCtx); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(err)
+		catcher.Wrap(session.close(), "closing session")
+		return nil, catcher.Resolve()
+	}
+
+	return session, nil
+}
+
+// closeSession closes the client session, if any.
+func (r *sshRunner) closeSession() error {
+	r.sessionMu.Lock()
+	defer r.sessionMu.Unlock()
+
+	if r.session == nil {
+		return nil
+	}
+	session := r.session
+	r.session = nil
+
+	return errors.Wrap(session.close(), "closing SSH client session")
+}
+
 // newCommand creates the command that runs the Jasper CLI client command
 // over SSH.
 func (r *sshRunner) newCommand(ctx context.Context, clientSubcommand []string, input json.RawMessage, output io.WriteCloser) *jasper.Command {
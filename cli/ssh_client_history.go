@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// sshProcessHistory is the client-side representation of a
+// jasper.ProcessHistory for making requests to the remote service via the CLI
+// over SSH.
+type sshProcessHistory struct {
+	client *sshRunner
+}
+
+func newSSHProcessHistory(client *sshRunner) *sshProcessHistory {
+	return &sshProcessHistory{client: client}
+}
+
+func (h *sshProcessHistory) Put(ctx context.Context, info jasper.ProcessInfo) error {
+	return errors.New("operation not supported for remote managers")
+}
+
+func (h *sshProcessHistory) Find(ctx context.Context, q options.HistoryQuery) ([]jasper.ProcessInfo, error) {
+	output, err := h.client.runClientCommand(ctx, []string{ManagerCommand, HistoryCommand}, &q)
+	if err != nil {
+		return nil, errors.Wrap(err, "running command")
+	}
+
+	resp, err := ExtractInfosResponse(output)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading infos response")
+	}
+
+	return resp.Infos, nil
+}
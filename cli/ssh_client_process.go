@@ -6,6 +6,7 @@ import (
 	"syscall"
 
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 	"github.com/pkg/errors"
 )
 
@@ -103,6 +104,45 @@ func (p *sshProcess) Signal(ctx context.Context, sig syscall.Signal) error {
 	return nil
 }
 
+func (p *sshProcess) Resize(ctx context.Context, rows, cols uint16) error {
+	output, err := p.runCommand(ctx, ResizeCommand, &ResizeInput{ID: p.info.ID, Rows: rows, Cols: cols})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err = ExtractOutcomeResponse(output); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (p *sshProcess) Stop(ctx context.Context, policy options.StopPolicy) error {
+	output, err := p.runCommand(ctx, StopCommand, &StopInput{ID: p.info.ID, Policy: policy})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err = ExtractOutcomeResponse(output); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+func (p *sshProcess) WaitReady(ctx context.Context) error {
+	output, err := p.runCommand(ctx, WaitReadyCommand, &IDInput{ID: p.info.ID})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err = ExtractOutcomeResponse(output); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
 func (p *sshProcess) Wait(ctx context.Context) (int, error) {
 	output, err := p.runCommand(ctx, WaitCommand, &IDInput{ID: p.info.ID})
 	if err != nil {
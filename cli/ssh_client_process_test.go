@@ -7,6 +7,7 @@ import (
 
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/mock"
+	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/testutil"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -211,6 +212,82 @@ func TestSSHProcess(t *testing.T) {
 			assert.Equal(t, proc.ID(), inputChecker.ID)
 			assert.EqualValues(t, sig, inputChecker.Signal)
 		},
+		"ResizePassesWithValidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := ResizeInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, ResizeCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+
+			require.NoError(t, proc.Resize(ctx, 50, 132))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+			assert.EqualValues(t, 50, inputChecker.Rows)
+			assert.EqualValues(t, 132, inputChecker.Cols)
+		},
+		"ResizeFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := ResizeInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, ResizeCommand},
+				&inputChecker,
+				&struct{}{},
+			)
+
+			assert.Error(t, proc.Resize(ctx, 50, 132))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+		},
+		"StopPassesWithValidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := StopInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, StopCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+
+			policy := options.StopPolicy{Target: options.StopTargetTree}
+			require.NoError(t, proc.Stop(ctx, policy))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+			assert.Equal(t, policy, inputChecker.Policy)
+		},
+		"StopFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := StopInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, StopCommand},
+				&inputChecker,
+				&struct{}{},
+			)
+
+			assert.Error(t, proc.Stop(ctx, options.StopPolicy{}))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+		},
+		"WaitReadyPassesWithValidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := IDInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, WaitReadyCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+
+			require.NoError(t, proc.WaitReady(ctx))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+		},
+		"WaitReadyFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := IDInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, WaitReadyCommand},
+				&inputChecker,
+				&struct{}{},
+			)
+
+			assert.Error(t, proc.WaitReady(ctx))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+		},
 		"WaitPassesWithValidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
 			inputChecker := IDInput{}
 			expectedExitCode := 1
@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/jasper"
+	"github.com/pkg/errors"
+)
+
+// sshSession is the client side of a client session, which multiplexes any
+// number of concurrent CLI client commands over a single SSH session and a
+// single connection to the remote Jasper service.
+type sshSession struct {
+	writeMu       sync.Mutex
+	requests      io.Writer
+	closeRequests func() error
+
+	mu      sync.Mutex
+	nextID  int
+	pending map[int]*sshSessionRequest
+
+	ready     chan struct{}
+	readyOnce sync.Once
+	done      chan struct{}
+	doneOnce  sync.Once
+	err       error
+}
+
+// sshSessionRequest is a request that is waiting for its responses. The
+// responses are queued so that a request whose handler is slow does not block
+// the responses of other requests in the session.
+type sshSessionRequest struct {
+	mu        sync.Mutex
+	responses []SessionResponse
+	received  chan struct{}
+}
+
+// push queues the response and notifies the request that it was received.
+func (r *sshSessionRequest) push(resp SessionResponse) {
+	r.mu.Lock()
+	r.responses = append(r.responses, resp)
+	r.mu.Unlock()
+
+	select {
+	case r.received <- struct{}{}:
+	default:
+	}
+}
+
+// pop removes the next queued response, if there is one.
+func (r *sshSessionRequest) pop() (SessionResponse, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.responses) == 0 {
+		return SessionResponse{}, false
+	}
+	resp := r.responses[0]
+	r.responses = r.responses[1:]
+	return resp, true
+}
+
+// newSSHSession returns a session that sends requests to the requests writer
+// and reads their responses from the responses reader. closeRequests is
+// called to stop sending requests once the session is closed.
+func newSSHSession(requests io.Writer, responses io.Reader, closeRequests func() error) *sshSession {
+	s := &sshSession{
+		requests:      requests,
+		closeRequests: closeRequests,
+		pending:       map[int]*sshSessionRequest{},
+		ready:         make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+	go s.readResponses(responses)
+	return s
+}
+
+// waitReady waits for the remote session to be ready to run commands.
+func (s *sshSession) waitReady(ctx context.Context) error {
+	select {
+	case <-s.ready:
+		return nil
+	case <-s.done:
+		return errors.Wrap(s.err, "session ended before it was ready")
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "waiting for session to be ready")
+	}
+}
+
+// readResponses reads the responses and passes each to the request that it
+// belongs to until the session ends.
+func (s *sshSession) readResponses(responses io.Reader) {
+	decoder := json.NewDecoder(responses)
+	for {
+		var resp SessionResponse
+		if err := decoder.Decode(&resp); err != nil {
+			if err == io.EOF {
+				err = errors.New("session ended")
+			}
+			s.end(errors.Wrap(err, "reading session response"))
+			return
+		}
+		if resp.Ready {
+			s.readyOnce.Do(func() { close(s.ready) })
+			continue
+		}
+
+		s.mu.Lock()
+		req, ok := s.pending[resp.ID]
+		s.mu.Unlock()
+		if ok {
+			req.push(resp)
+		}
+	}
+}
+
+// end marks the session as ended with the given reason.
+func (s *sshSession) end(err error) {
+	s.doneOnce.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+// isDone returns whether or not the session has ended.
+func (s *sshSession) isDone() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// run runs the CLI client subcommand with the given JSON input in the session.
+// Each JSON output of the command is passed to the handler as soon as it is
+// received. If the handler returns an error or the context is done, the
+// command is cancelled.
+func (s *sshSession) run(ctx context.Context, subcommand []string, input json.RawMessage, handler func(json.RawMessage) error) error {
+	req := &sshSessionRequest{received: make(chan struct{}, 1)}
+	s.mu.Lock()
+	s.nextID++
+	id := s.nextID
+	s.pending[id] = req
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, id)
+		s.mu.Unlock()
+	}()
+
+	if err := s.send(SessionRequest{ID: id, Command: subcommand, Input: input}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for {
+		for resp, ok := req.pop(); ok; resp, ok = req.pop() {
+			if resp.Done {
+				if resp.Error != "" {
+					return errors.New(resp.Error)
+				}
+				return nil
+			}
+			if err := handler(resp.Output); err != nil {
+				s.cancel(ctx, id)
+				return errors.Wrap(err, "handling output")
+			}
+		}
+
+		select {
+		case <-req.received:
+		case <-ctx.Done():
+			s.cancel(ctx, id)
+			return ctx.Err()
+		case <-s.done:
+			return errors.Wrap(s.err, "session ended before the command finished")
+		}
+	}
+}
+
+// cancel cancels the request with the given ID.
+func (s *sshSession) cancel(ctx context.Context, id int) {
+	grip.Debug(ctx, errors.Wrapf(s.send(SessionRequest{ID: id, Cancel: true}), "cancelling request %d", id))
+}
+
+// send writes the request to the session.
+func (s *sshSession) send(req SessionRequest) error {
+	line, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "encoding request")
+	}
+	line = append(line, '\n')
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if s.isDone() {
+		return errors.Wrap(s.err, "session has ended")
+	}
+	_, err = s.requests.Write(line)
+	return errors.Wrap(err, "writing request")
+}
+
+// close stops sending requests to the session. The remote session ends once
+// its commands in progress finish.
+func (s *sshSession) close() error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return s.closeRequests()
+}
+
+// sshSessionInput writes to the standard input of the process running the
+// remote session.
+type sshSessionInput struct {
+	ctx  context.Context
+	proc jasper.Process
+}
+
+func (in *sshSessionInput) Write(p []byte) (int, error) {
+	if err := jasper.WriteStandardInput(in.ctx, in.proc, bytes.NewReader(p)); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/mock"
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSHClientSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newClient := func(t *testing.T, baseManager *mock.Manager) *sshClient {
+		clientOpts := mockClientOptions()
+		clientOpts.DisableSession = false
+		client, err := NewSSHClient(clientOpts, mockRemoteOptions())
+		require.NoError(t, err)
+		sshClient, ok := client.(*sshClient)
+		require.True(t, ok)
+		sshClient.client.manager = baseManager
+		return sshClient
+	}
+
+	t.Run("RoutesCommandsThroughSession", func(t *testing.T) {
+		tctx, tcancel := context.WithTimeout(ctx, testutil.TestTimeout)
+		defer tcancel()
+
+		remoteManager := &mock.RemoteManager{Manager: mock.Manager{ManagerID: "foo"}}
+		for i := 0; i < 50; i++ {
+			remoteManager.Procs = append(remoteManager.Procs, &mock.Process{ProcInfo: jasper.ProcessInfo{ID: fmt.Sprintf("proc%d", i), IsRunning: true}})
+		}
+
+		// Commands that do not run in the session try to create a process
+		// on the base manager, which fails.
+		client := newClient(t, &mock.Manager{FailCreate: true})
+		session, served := newTestSession(tctx, t, remoteManager)
+		client.client.session = session
+		client.client.sessionStarted = true
+
+		assert.Equal(t, remoteManager.ManagerID, client.ID())
+
+		procs, err := client.List(tctx, options.All)
+		require.NoError(t, err)
+		require.Len(t, procs, len(remoteManager.Procs))
+
+		var wg sync.WaitGroup
+		for _, proc := range procs {
+			wg.Add(1)
+			go func(proc jasper.Process) {
+				defer wg.Done()
+				assert.Equal(t, proc.ID(), proc.Info(tctx).ID)
+				assert.True(t, proc.Running(tctx))
+				_, err := proc.Wait(tctx)
+				assert.NoError(t, err)
+			}(proc)
+		}
+		wg.Wait()
+
+		require.NoError(t, client.CloseConnection())
+		select {
+		case err := <-served:
+			assert.NoError(t, err)
+		case <-tctx.Done():
+			assert.FailNow(t, "session did not stop serving")
+		}
+	})
+	t.Run("SlowRequestDoesNotBlockOtherRequests", func(t *testing.T) {
+		tctx, tcancel := context.WithTimeout(ctx, testutil.TestTimeout)
+		defer tcancel()
+
+		requestsReader, requestsWriter := io.Pipe()
+		responsesReader, responsesWriter := io.Pipe()
+		session := newSSHSession(requestsWriter, responsesReader, requestsWriter.Close)
+		defer func() {
+			assert.NoError(t, responsesWriter.Close())
+		}()
+
+		requests := make(chan SessionRequest)
+		go func() {
+			decoder := json.NewDecoder(requestsReader)
+			for {
+				var req SessionRequest
+				if err := decoder.Decode(&req); err != nil {
+					return
+				}
+				requests <- req
+			}
+		}()
+		// Responses are written in order in the background so that a
+		// blocked session cannot block the test.
+		responses := make(chan SessionResponse, 10)
+		go func() {
+			encoder := json.NewEncoder(responsesWriter)
+			for resp := range responses {
+				if err := encoder.Encode(resp); err != nil {
+					return
+				}
+			}
+		}()
+		defer close(responses)
+		respond := func(resp SessionResponse) { responses <- resp }
+		respond(SessionResponse{Ready: true})
+		require.NoError(t, session.waitReady(tctx))
+
+		unblock := make(chan struct{})
+		slowDone := make(chan error, 1)
+		go func() {
+			slowDone <- session.run(tctx, []string{"slow"}, nil, func(json.RawMessage) error {
+				<-unblock
+				return nil
+			})
+		}()
+		slowReq := <-requests
+
+		fastDone := make(chan error, 1)
+		go func() {
+			fastDone <- session.run(tctx, []string{"fast"}, nil, func(json.RawMessage) error { return nil })
+		}()
+		fastReq := <-requests
+
+		// The slow request's handler blocks on its first output, so its
+		// remaining responses must not hold up the fast request's.
+		respond(SessionResponse{ID: slowReq.ID, Output: json.RawMessage(`{}`)})
+		respond(SessionResponse{ID: slowReq.ID, Output: json.RawMessage(`{}`)})
+		respond(SessionResponse{ID: fastReq.ID, Output: json.RawMessage(`{}`)})
+		respond(SessionResponse{ID: fastReq.ID, Done: true})
+		select {
+		case err := <-fastDone:
+			assert.NoError(t, err)
+		case <-tctx.Done():
+			require.FailNow(t, "fast request was blocked by slow request")
+		}
+
+		close(unblock)
+		respond(SessionResponse{ID: slowReq.ID, Done: true})
+		select {
+		case err := <-slowDone:
+			assert.NoError(t, err)
+		case <-tctx.Done():
+			require.FailNow(t, "slow request did not finish")
+		}
+	})
+	t.Run("FallsBackToSeparateSSHSessionsIfSessionIsUnsupported", func(t *testing.T) {
+		tctx, tcancel := context.WithTimeout(ctx, testutil.TestTimeout)
+		defer tcancel()
+
+		baseManager := &mock.Manager{}
+		client := newClient(t, baseManager)
+
+		var sessionAttempts int
+		sessionCommand := strings.Join(client.client.clientOpts.buildCommand(SessionCommand), " ")
+		idCommand := makeCreateFunc(t, client, []string{ManagerCommand, IDCommand}, nil, &IDResponse{
+			OutcomeResponse: *makeOutcomeResponse(nil),
+			ID:              "foo",
+		})
+		baseManager.Create = func(opts *options.Create) mock.Process {
+			if strings.Join(opts.Args, " ") != sessionCommand {
+				return idCommand(opts)
+			}
+
+			// A remote Jasper CLI that does not support sessions fails
+			// without writing any responses.
+			sessionAttempts++
+			go func() {
+				_, err := io.WriteString(opts.Output.Output, "unknown command")
+				assert.NoError(t, err)
+				assert.NoError(t, opts.Close())
+			}()
+			return mock.Process{}
+		}
+
+		for i := 0; i < 3; i++ {
+			output, err := client.client.runClientCommand(tctx, []string{ManagerCommand, IDCommand}, nil)
+			require.NoError(t, err)
+			resp, err := ExtractIDResponse(output)
+			require.NoError(t, err)
+			assert.Equal(t, "foo", resp.ID)
+		}
+		assert.Equal(t, 1, sessionAttempts)
+		assert.True(t, client.client.sessionUnsupported)
+		assert.Nil(t, client.client.session)
+	})
+	t.Run("DisabledSessionRunsCommandsInSeparateSSHSessions", func(t *testing.T) {
+		tctx, tcancel := context.WithTimeout(ctx, testutil.TestTimeout)
+		defer tcancel()
+
+		baseManager := &mock.Manager{}
+		client := newClient(t, baseManager)
+		client.client.clientOpts.DisableSession = true
+		baseManager.Create = makeCreateFunc(t, client, []string{ManagerCommand, IDCommand}, nil, &IDResponse{
+			OutcomeResponse: *makeOutcomeResponse(nil),
+			ID:              "foo",
+		})
+
+		output, err := client.client.runClientCommand(tctx, []string{ManagerCommand, IDCommand}, nil)
+		require.NoError(t, err)
+		resp, err := ExtractIDResponse(output)
+		require.NoError(t, err)
+		assert.Equal(t, "foo", resp.ID)
+		assert.False(t, client.client.sessionStarted)
+	})
+}
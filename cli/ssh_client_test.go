@@ -8,12 +8,14 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/mock"
 	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/testutil"
 	testoptions "github.com/mongodb/jasper/testutil/options"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -112,7 +114,7 @@ func TestSSHClient(t *testing.T) {
 				t, client,
 				[]string{ManagerCommand, CreateCommand},
 				&inputChecker,
-				makeOutcomeResponse(nil),
+				&CommandResponse{OutcomeResponse: *makeOutcomeResponse(nil)},
 			)
 			cmd := []string{"echo", "foo"}
 			require.NoError(t, client.CreateCommand(ctx).Add(cmd).Run(ctx))
@@ -120,6 +122,34 @@ func TestSSHClient(t *testing.T) {
 			require.Len(t, inputChecker.Commands, 1)
 			assert.Equal(t, cmd, inputChecker.Commands[0])
 		},
+		"RunCommandSetsResults": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			results := []jasper.CommandResult{
+				{Args: []string{"echo", "foo"}, ProcessID: "foo", Attempts: 1, Complete: true, Successful: true},
+				{Args: []string{"false"}, ProcessID: "bar", Attempts: 1, Complete: true, ExitCode: 1},
+			}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, CreateCommand},
+				nil,
+				&CommandResponse{OutcomeResponse: *makeOutcomeResponse(errors.New("foo")), Results: results},
+			)
+			cmd := client.CreateCommand(ctx).Extend([][]string{{"echo", "foo"}, {"false"}})
+			assert.Error(t, cmd.Run(ctx))
+			assert.Equal(t, results, cmd.Results(ctx))
+		},
+		"RunCommandPassesRetryOptions": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := options.Command{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, CreateCommand},
+				&inputChecker,
+				&CommandResponse{OutcomeResponse: *makeOutcomeResponse(nil)},
+			)
+			retry := &options.CommandRetry{MaxAttempts: 3, Backoff: time.Second, ExitCodes: []int{1}, OnTimeout: true}
+			require.NoError(t, client.CreateCommand(ctx).Add([]string{"echo", "foo"}).SetRetryOptions(retry).Run(ctx))
+
+			assert.Equal(t, retry, inputChecker.Retry)
+		},
 		"RunCommandFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
 			baseManager.FailCreate = true
 			assert.Error(t, client.CreateCommand(ctx).Add([]string{"echo", "foo"}).Run(ctx))
@@ -192,6 +222,96 @@ func TestSSHClient(t *testing.T) {
 			_, err := client.List(ctx, options.All)
 			assert.Error(t, err)
 		},
+		"QueryPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			info := jasper.ProcessInfo{
+				ID:        "running",
+				IsRunning: true,
+			}
+
+			inputChecker := FilterInput{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, ListCommand},
+				&inputChecker,
+				&InfosResponse{
+					OutcomeResponse: *makeOutcomeResponse(nil),
+					Infos:           []jasper.ProcessInfo{info},
+				},
+			)
+			q := options.ProcessQuery{Status: options.Running, Tags: [][]string{{"foo", "bar"}}, Args: "sleep"}
+			procs, err := client.Query(ctx, q)
+			require.NoError(t, err)
+			require.NotNil(t, inputChecker.Query)
+			assert.Equal(t, q, *inputChecker.Query)
+			assert.Empty(t, inputChecker.Filter)
+
+			require.Len(t, procs, 1)
+			sshProc, ok := procs[0].(*sshProcess)
+			require.True(t, ok)
+			assert.Equal(t, info, sshProc.info)
+		},
+		"QueryFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, ListCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.Query(ctx, options.ProcessQuery{})
+			assert.Error(t, err)
+		},
+		"SubscribePassesWithValidResponses": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			event := jasper.ProcessEvent{
+				Type: options.ProcessExited,
+				Info: jasper.ProcessInfo{ID: "foo", Complete: true},
+			}
+
+			inputChecker := options.ProcessEventFilter{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, SubscribeCommand},
+				&inputChecker,
+				&ProcessEventResponse{OutcomeResponse: *makeOutcomeResponse(nil)},
+				&ProcessEventResponse{Event: &event, OutcomeResponse: *makeOutcomeResponse(nil)},
+			)
+			filter := options.ProcessEventFilter{Tags: []string{"bar"}, Types: []options.ProcessEventType{options.ProcessExited}}
+			events, err := client.Subscribe(ctx, filter)
+			require.NoError(t, err)
+
+			var received []jasper.ProcessEvent
+			for event := range events {
+				received = append(received, event)
+			}
+			assert.Equal(t, filter, inputChecker)
+			require.Len(t, received, 1)
+			assert.Equal(t, event.Type, received[0].Type)
+			assert.Equal(t, event.Info.ID, received[0].Info.ID)
+		},
+		"SubscribeFailsWithErrorResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, SubscribeCommand},
+				nil,
+				&ProcessEventResponse{OutcomeResponse: *makeOutcomeResponse(errors.New("subscribe error"))},
+			)
+			_, err := client.Subscribe(ctx, options.ProcessEventFilter{})
+			assert.Error(t, err)
+		},
+		"SubscribeFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, SubscribeCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.Subscribe(ctx, options.ProcessEventFilter{})
+			assert.Error(t, err)
+		},
+		"SubscribeFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			_, err := client.Subscribe(ctx, options.ProcessEventFilter{})
+			assert.Error(t, err)
+		},
 		"GroupPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
 			info := jasper.ProcessInfo{
 				ID:        "running",
@@ -233,6 +353,41 @@ func TestSSHClient(t *testing.T) {
 			_, err := client.Group(ctx, "foo")
 			assert.Error(t, err)
 		},
+		"HistoryFindPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			info := jasper.ProcessInfo{
+				ID:       "complete",
+				Complete: true,
+			}
+
+			inputChecker := options.HistoryQuery{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, HistoryCommand},
+				&inputChecker,
+				&InfosResponse{
+					OutcomeResponse: *makeOutcomeResponse(nil),
+					Infos:           []jasper.ProcessInfo{info},
+				},
+			)
+			query := options.HistoryQuery{Tags: []string{"foo"}, Status: options.Failed, Limit: 1}
+			infos, err := client.History(ctx).Find(ctx, query)
+			require.NoError(t, err)
+			assert.Equal(t, query, inputChecker)
+			assert.Equal(t, []jasper.ProcessInfo{info}, infos)
+		},
+		"HistoryFindFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, HistoryCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.History(ctx).Find(ctx, options.HistoryQuery{})
+			assert.Error(t, err)
+		},
+		"HistoryPutFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			assert.Error(t, client.History(ctx).Put(ctx, jasper.ProcessInfo{ID: "foo", Complete: true}))
+		},
 		"GetPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
 			id := "foo"
 			info := jasper.ProcessInfo{
@@ -453,6 +608,107 @@ func TestSSHClient(t *testing.T) {
 			_, err := client.GetLogStream(ctx, "foo", 10)
 			assert.Error(t, err)
 		},
+		"GetCapturedOutputPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := CapturedOutputInput{}
+			resp := &CapturedOutputResponse{
+				CapturedOutput:  jasper.CapturedOutput{Stream: options.OutputStreamStderr, Data: []byte("foo"), Size: 3},
+				OutcomeResponse: *makeOutcomeResponse(nil),
+			}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, GetCapturedOutputCommand},
+				&inputChecker,
+				resp,
+			)
+			id := "foo"
+			query := options.OutputQuery{Stream: options.OutputStreamStderr, Lines: &options.LineRange{Start: -10}}
+			out, err := client.GetCapturedOutput(ctx, id, query)
+			require.NoError(t, err)
+
+			assert.Equal(t, id, inputChecker.ID)
+			assert.Equal(t, query, inputChecker.Query)
+			assert.Equal(t, resp.CapturedOutput, *out)
+		},
+		"GetCapturedOutputFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, GetCapturedOutputCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.GetCapturedOutput(ctx, "foo", options.OutputQuery{})
+			assert.Error(t, err)
+		},
+		"FollowLogsPassesWithValidResponses": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := FollowLogsInput{}
+			resps := []interface{}{
+				&LogChunkResponse{
+					LogChunk:        jasper.LogChunk{Logs: []string{"foo"}, Offset: 5, NextOffset: 6},
+					OutcomeResponse: *makeOutcomeResponse(nil),
+				},
+				&LogChunkResponse{
+					LogChunk:        jasper.LogChunk{Offset: 6, NextOffset: 6, Done: true},
+					OutcomeResponse: *makeOutcomeResponse(nil),
+				},
+			}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, FollowLogsCommand},
+				&inputChecker,
+				resps...,
+			)
+			id := "foo"
+			offset := 5
+			var chunks []jasper.LogChunk
+			require.NoError(t, client.FollowLogs(ctx, id, offset, func(chunk jasper.LogChunk) error {
+				chunks = append(chunks, chunk)
+				return nil
+			}))
+
+			assert.Equal(t, id, inputChecker.ID)
+			assert.Equal(t, offset, inputChecker.Offset)
+			require.Len(t, chunks, len(resps))
+			for i, resp := range resps {
+				assert.Equal(t, resp.(*LogChunkResponse).LogChunk, chunks[i])
+			}
+		},
+		"FollowLogsFailsWithoutDoneResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, FollowLogsCommand},
+				nil,
+				&LogChunkResponse{
+					LogChunk:        jasper.LogChunk{Logs: []string{"foo"}, NextOffset: 1},
+					OutcomeResponse: *makeOutcomeResponse(nil),
+				},
+			)
+			assert.Error(t, client.FollowLogs(ctx, "foo", 0, func(jasper.LogChunk) error { return nil }))
+		},
+		"FollowLogsFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, FollowLogsCommand},
+				nil,
+				invalidResponse(),
+			)
+			assert.Error(t, client.FollowLogs(ctx, "foo", 0, func(jasper.LogChunk) error { return nil }))
+		},
+		"FollowLogsFailsIfHandlerFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, FollowLogsCommand},
+				nil,
+				&LogChunkResponse{
+					LogChunk:        jasper.LogChunk{Done: true},
+					OutcomeResponse: *makeOutcomeResponse(nil),
+				},
+			)
+			assert.Error(t, client.FollowLogs(ctx, "foo", 0, func(jasper.LogChunk) error { return errors.New("handler error") }))
+		},
+		"FollowLogsFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			assert.Error(t, client.FollowLogs(ctx, "foo", 0, func(jasper.LogChunk) error { return nil }))
+		},
 		"GetBuildloggerURLsPassesWithValidInput": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
 			inputChecker := &IDInput{}
 			resp := &BuildloggerURLsResponse{URLs: []string{"bar"}, OutcomeResponse: *makeOutcomeResponse(nil)}
@@ -485,6 +741,58 @@ func TestSSHClient(t *testing.T) {
 			_, err := client.GetBuildloggerURLs(ctx, "foo")
 			assert.Error(t, err)
 		},
+		"WriteStdinPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := WriteStdinInput{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, WriteStdinCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+			id := "foo"
+			data := []byte("bar")
+			require.NoError(t, client.WriteStdin(ctx, id, bytes.NewReader(data)))
+			assert.Equal(t, id, inputChecker.ID)
+			assert.Equal(t, data, inputChecker.Data)
+		},
+		"WriteStdinFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, WriteStdinCommand},
+				nil,
+				invalidResponse(),
+			)
+			assert.Error(t, client.WriteStdin(ctx, "foo", bytes.NewReader([]byte("bar"))))
+		},
+		"WriteStdinFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			assert.Error(t, client.WriteStdin(ctx, "foo", bytes.NewReader([]byte("bar"))))
+		},
+		"CloseStdinPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := IDInput{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, CloseStdinCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+			id := "foo"
+			require.NoError(t, client.CloseStdin(ctx, id))
+			assert.Equal(t, id, inputChecker.ID)
+		},
+		"CloseStdinFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, CloseStdinCommand},
+				nil,
+				invalidResponse(),
+			)
+			assert.Error(t, client.CloseStdin(ctx, "foo"))
+		},
+		"CloseStdinFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			assert.Error(t, client.CloseStdin(ctx, "foo"))
+		},
 		"SignalEventPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
 			inputChecker := EventInput{}
 			baseManager.Create = makeCreateFunc(
@@ -534,7 +842,7 @@ func TestSSHClient(t *testing.T) {
 // inputChecker for verification by the caller, verifies that the
 // expectedClientSubcommand is the CLI command that is being run, and writes the
 // expectedResponse back to the user.
-func makeCreateFunc(t *testing.T, client *sshClient, expectedClientSubcommand []string, inputChecker interface{}, expectedResponse interface{}) func(*options.Create) mock.Process {
+func makeCreateFunc(t *testing.T, client *sshClient, expectedClientSubcommand []string, inputChecker interface{}, expectedResponses ...interface{}) func(*options.Create) mock.Process {
 	return func(opts *options.Create) mock.Process {
 		if opts.StandardInputBytes != nil && inputChecker != nil {
 			input, err := io.ReadAll(bytes.NewBuffer(opts.StandardInputBytes))
@@ -544,8 +852,11 @@ func makeCreateFunc(t *testing.T, client *sshClient, expectedClientSubcommand []
 
 		cliCommand := strings.Join(client.client.clientOpts.buildCommand(expectedClientSubcommand...), " ")
 		assert.Equal(t, cliCommand, strings.Join(opts.Args, " "))
-		require.NotNil(t, expectedResponse)
-		require.NoError(t, writeOutput(opts.Output.Output, expectedResponse))
+		require.NotEmpty(t, expectedResponses)
+		for _, expectedResponse := range expectedResponses {
+			require.NotNil(t, expectedResponse)
+			require.NoError(t, writeOutput(opts.Output.Output, expectedResponse))
+		}
 		return mock.Process{}
 	}
 }
@@ -561,9 +872,12 @@ func mockRemoteOptions() options.Remote {
 	return opts
 }
 
+// mockClientOptions returns client options that run each command in its own
+// SSH session so that the commands can be checked against the base manager.
 func mockClientOptions() ClientOptions {
 	return ClientOptions{
-		BinaryPath: "binary",
-		Type:       RPCService,
+		BinaryPath:     "binary",
+		Type:           RPCService,
+		DisableSession: true,
 	}
 }
@@ -14,6 +14,9 @@ type ClientOptions struct {
 	Host                string
 	Port                int
 	CredentialsFilePath string
+	// DisableSession runs each client command in its own SSH session instead
+	// of multiplexing all commands over a single long-lived client session.
+	DisableSession bool
 }
 
 // Validate checks that the binary path is set and it is a recognized Jasper
@@ -10,7 +10,9 @@ import (
 	"math/rand"
 	"net"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	service "github.com/evergreen-ci/baobab"
@@ -106,7 +108,7 @@ func newRemoteManager(ctx context.Context, service, host string, port int, creds
 	}
 
 	if service == RESTService {
-		return remote.NewRESTClient(addr), nil
+		return remote.NewRESTClientWithFile(addr, credsFilePath)
 	} else if service == RPCService {
 		return remote.NewRPCClientWithFile(ctx, addr, credsFilePath)
 	}
@@ -117,10 +119,10 @@ func newRemoteManager(ctx context.Context, service, host string, port int, creds
 // validator, validates the input, runs the request, and writes the response of
 // the request to standard output.
 func doPassthroughInputOutput(c *cli.Context, input Validator, request func(context.Context, remote.Manager) (response interface{})) error {
-	ctx, cancel := context.WithTimeout(context.Background(), clientConnectionTimeout)
+	ctx, cancel := context.WithTimeout(clientContext(c), clientConnectionTimeout)
 	defer cancel()
 
-	if err := readInput(os.Stdin, input); err != nil {
+	if err := readInput(clientStdin(c), input); err != nil {
 		return errors.Wrap(err, "reading from standard input")
 	}
 	if err := input.Validate(); err != nil {
@@ -128,24 +130,54 @@ func doPassthroughInputOutput(c *cli.Context, input Validator, request func(cont
 	}
 
 	return withConnection(ctx, c, func(client remote.Manager) error {
-		return errors.Wrap(writeOutput(os.Stdout, request(ctx, client)), "writing to standard output")
+		return errors.Wrap(writeOutput(clientStdout(c), request(ctx, client)), "writing to standard output")
+	})
+}
+
+// doPassthroughInputStreamingOutput is the same as doPassthroughInputOutput,
+// except that the request can send any number of responses, each of which is
+// written to standard output as a line of JSON as soon as it is sent. Since
+// streaming requests can be long-lived, the request is not bounded by the
+// client connection timeout and instead runs until it returns or the command
+// is interrupted.
+func doPassthroughInputStreamingOutput(c *cli.Context, input Validator, request func(ctx context.Context, client remote.Manager, send func(response interface{}) error) error) error {
+	ctx, cancel := signal.NotifyContext(clientContext(c), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := readInput(clientStdin(c), input); err != nil {
+		return errors.Wrap(err, "reading from standard input")
+	}
+	if err := input.Validate(); err != nil {
+		return errors.Wrap(err, "input is invalid")
+	}
+
+	encoder := json.NewEncoder(clientStdout(c))
+	return withConnection(ctx, c, func(client remote.Manager) error {
+		return request(ctx, client, func(response interface{}) error {
+			return errors.Wrap(encoder.Encode(response), "writing to standard output")
+		})
 	})
 }
 
 // doPassthroughOutput runs the request and writes the output of the request to
 // standard output.
 func doPassthroughOutput(c *cli.Context, request func(context.Context, remote.Manager) (response interface{})) error {
-	ctx, cancel := context.WithTimeout(context.Background(), clientConnectionTimeout)
+	ctx, cancel := context.WithTimeout(clientContext(c), clientConnectionTimeout)
 	defer cancel()
 
 	return withConnection(ctx, c, func(client remote.Manager) error {
-		return errors.Wrap(writeOutput(os.Stdout, request(ctx, client)), "writing to standard output")
+		return errors.Wrap(writeOutput(clientStdout(c), request(ctx, client)), "writing to standard output")
 	})
 }
 
 // withConnection runs the operation within the scope of a remote client
-// connection.
+// connection. Client commands that run in a client session share the
+// session's connection.
 func withConnection(ctx context.Context, c *cli.Context, operation func(remote.Manager) error) error {
+	if env := sessionCommandEnvFromContext(c); env != nil {
+		return operation(env.manager)
+	}
+
 	host := c.String(hostFlagName)
 	port := c.Int(portFlagName)
 	service := c.String(serviceFlagName)
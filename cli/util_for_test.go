@@ -116,10 +116,38 @@ func execCLICommandOutput(t *testing.T, c *cli.Context, cmd cli.Command, output
 	})
 }
 
+// execCLICommandInputStreamingOutput runs the CLI command with the given input
+// to stdin and returns each of the JSON responses in the output stream from
+// stdout.
+func execCLICommandInputStreamingOutput(t *testing.T, c *cli.Context, cmd cli.Command, input json.RawMessage) ([]json.RawMessage, error) {
+	var output []json.RawMessage
+	err := withMockStdin(t, string(input), func(*os.File) error {
+		return withMockStdout(t, func(stdout *os.File) error {
+			if err := cli.HandleAction(cmd.Action, c); err != nil {
+				return err
+			}
+			if _, err := stdout.Seek(0, 0); err != nil {
+				return err
+			}
+			decoder := json.NewDecoder(stdout)
+			for {
+				var resp json.RawMessage
+				if err := decoder.Decode(&resp); err == io.EOF {
+					return nil
+				} else if err != nil {
+					return err
+				}
+				output = append(output, resp)
+			}
+		})
+	})
+	return output, err
+}
+
 // makeTestRESTService creates a REST service for testing purposes only on
 // localhost.
 func makeTestRESTService(ctx context.Context, t *testing.T, port int, manager jasper.Manager) util.CloseFunc {
-	closeService, err := newRESTService(ctx, "localhost", port, manager)
+	closeService, err := newRESTService(ctx, "localhost", port, manager, "", "", nil, nil)
 	require.NoError(t, err)
 	httpClient := utility.GetHTTPClient()
 	defer utility.PutHTTPClient(httpClient)
@@ -139,7 +167,7 @@ func makeTestRESTServiceAndClient(ctx context.Context, t *testing.T, port int, m
 // makeTestRPCService creates an RPC service for testing purposes only on
 // localhost with no credentials.
 func makeTestRPCService(ctx context.Context, t *testing.T, port int, manager jasper.Manager) util.CloseFunc {
-	closeService, err := newRPCService(ctx, "localhost", port, manager, "")
+	closeService, err := newRPCService(ctx, "localhost", port, manager, "", nil, nil)
 	require.NoError(t, err)
 	return closeService
 }
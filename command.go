@@ -1,10 +1,13 @@
 package jasper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/shlex"
 	"github.com/mongodb/amboy"
@@ -24,8 +27,11 @@ import (
 type Command struct {
 	opts     options.Command
 	procs    []Process
+	runs     []subcommandRun
 	runFunc  func(options.Command) error
 	makeProc ProcessConstructor
+	// results are the subcommand results reported by the run function.
+	results []CommandResult
 }
 
 func (c *Command) sudoCmd() []string {
@@ -67,6 +73,11 @@ func (c *Command) ProcConstructor(processConstructor ProcessConstructor) *Comman
 // function given all the given inputs to the command.
 func (c *Command) SetRunFunc(f func(options.Command) error) *Command { c.runFunc = f; return c }
 
+// SetResults sets the subcommand results that Results returns when the
+// command's run function is set, such as the results of a command that the run
+// function ran remotely.
+func (c *Command) SetResults(results []CommandResult) *Command { c.results = results; return c }
+
 // GetProcIDs returns an array of Process IDs associated with the sub-commands
 // being run. This method will return a nil slice until processes have actually
 // been created by the Command for execution.
@@ -78,6 +89,87 @@ func (c *Command) GetProcIDs() []string {
 	return ids
 }
 
+// Attempts returns the number of times each subcommand was run by the last
+// call to Run or RunParallel, in the order that the subcommands were added. A
+// subcommand that was retried has more than one attempt, whereas a subcommand
+// that was never run has none. Attempts are not tracked when the command's run
+// function is set.
+func (c *Command) Attempts() []int {
+	attempts := make([]int, 0, len(c.runs))
+	for _, run := range c.runs {
+		attempts = append(attempts, run.attempts)
+	}
+	return attempts
+}
+
+// CommandResult describes the outcome of a single subcommand of a Command.
+type CommandResult struct {
+	// Name is the name of the step if the subcommand is a step of the
+	// command's graph.
+	Name string `json:"name,omitempty" bson:"name,omitempty"`
+	// Args are the arguments that the subcommand ran with.
+	Args []string `json:"args" bson:"args"`
+	// ProcessID is the ID of the process that ran the last attempt of the
+	// subcommand. It is empty if the subcommand never ran.
+	ProcessID string `json:"process_id,omitempty" bson:"process_id,omitempty"`
+	// Attempts is the number of times that the subcommand ran.
+	Attempts int `json:"attempts" bson:"attempts"`
+	// Skipped is whether or not the subcommand never ran because an earlier
+	// failure stopped it from starting, either due to the failure policy of
+	// the command's graph or due to fail-fast.
+	Skipped    bool `json:"skipped,omitempty" bson:"skipped,omitempty"`
+	Complete   bool `json:"complete" bson:"complete"`
+	Successful bool `json:"successful" bson:"successful"`
+	ExitCode   int  `json:"exit_code" bson:"exit_code"`
+	// Timeout is whether or not the last attempt of the subcommand exceeded
+	// its process timeout.
+	Timeout bool `json:"timeout" bson:"timeout"`
+	// Duration is how long the subcommand took to complete across all of its
+	// attempts. If it is still running, it is how long it has run so far.
+	Duration time.Duration `json:"duration" bson:"duration"`
+	// Output contains the last lines of the combined standard output and
+	// standard error of the last attempt, if output capture is enabled.
+	Output []string `json:"output,omitempty" bson:"output,omitempty"`
+}
+
+// Results returns the outcome of each subcommand from the last call to Run or
+// RunParallel, in the order that the subcommands were added. If the command's
+// run function is set, it returns the results set by SetResults instead.
+func (c *Command) Results(ctx context.Context) []CommandResult {
+	if c.runFunc != nil {
+		return c.results
+	}
+
+	results := make([]CommandResult, 0, len(c.runs))
+	for _, run := range c.runs {
+		res := CommandResult{
+			Name:     run.name,
+			Args:     run.args,
+			Attempts: run.attempts,
+			Skipped:  run.skipped,
+			Output:   run.output.get(),
+		}
+		if run.proc != nil {
+			info := run.proc.Info(ctx)
+			res.ProcessID = run.proc.ID()
+			res.Complete = info.Complete
+			res.Successful = info.Successful
+			res.ExitCode = info.ExitCode
+			res.Timeout = info.Timeout
+			switch {
+			case !run.endAt.IsZero():
+				res.Duration = run.endAt.Sub(run.startAt)
+			case info.Complete:
+				res.Duration = info.EndAt.Sub(info.StartAt)
+			case !info.StartAt.IsZero():
+				res.Duration = time.Since(info.StartAt)
+			}
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
 // ApplyFromOpts uses the options.Create to configure the Command. All existing
 // options will be overwritten. Use of this function is discouraged unless all
 // desired options are populated in the given opts.
@@ -195,6 +287,53 @@ func (c *Command) Background(runBackground bool) *Command {
 // executing its sub-commands even if one of them errors.
 func (c *Command) ContinueOnError(cont bool) *Command { c.opts.ContinueOnError = cont; return c }
 
+// SetRetryOptions sets the options for retrying subcommands that fail. This
+// overwrites any existing retry options.
+func (c *Command) SetRetryOptions(opts *options.CommandRetry) *Command {
+	c.opts.Retry = opts
+	return c
+}
+
+// CaptureOutputTail sets the number of lines at the end of each subcommand's
+// combined output to capture in its result. Output is only captured from
+// processes that write to their output writers, so it is not captured from
+// processes created through a remote client.
+func (c *Command) CaptureOutputTail(lines int) *Command {
+	c.opts.OutputTail = lines
+	return c
+}
+
+// SetGraphOptions sets the graph of named steps to run instead of the
+// sub-commands. This overwrites any existing graph.
+func (c *Command) SetGraphOptions(opts *options.CommandGraph) *Command {
+	c.opts.Graph = opts
+	return c
+}
+
+// AddStep adds a named step to the command's graph that runs once all of the
+// steps it depends on have finished. Once a command has steps, Run and
+// RunParallel run the graph of steps instead of the sub-commands.
+func (c *Command) AddStep(name string, args []string, dependsOn ...string) *Command {
+	if c.opts.Graph == nil {
+		c.opts.Graph = &options.CommandGraph{}
+	}
+	c.opts.Graph.Steps = append(c.opts.Graph.Steps, options.CommandStep{
+		Name:      name,
+		Args:      args,
+		DependsOn: dependsOn,
+	})
+	return c
+}
+
+// MaxParallel sets the maximum number of sub-commands that RunParallel runs at
+// the same time. If it is 0, there is no limit.
+func (c *Command) MaxParallel(limit int) *Command { c.opts.MaxParallel = limit; return c }
+
+// FailFast sets a flag for determining if RunParallel should cancel the other
+// sub-commands once one of them errors. It has no effect if ContinueOnError is
+// set or if the command runs in the background.
+func (c *Command) FailFast(failFast bool) *Command { c.opts.FailFast = failFast; return c }
+
 // IgnoreError sets a flag for determining if the Command should return a nil
 // error despite errors in its sub-command executions.
 func (c *Command) IgnoreError(ignore bool) *Command { c.opts.IgnoreError = ignore; return c }
@@ -413,14 +552,24 @@ func (c *Command) SetGroupLeader() *Command {
 	return c
 }
 
+// KillTree makes signals and timeouts apply to the whole process tree of each
+// subcommand so that processes spawned by scripts do not outlive them. This is
+// only supported on Linux and is a noop for remote commands.
+func (c *Command) KillTree(killTree bool) *Command {
+	c.opts.Process.KillTree = killTree
+	return c
+}
+
 func (c *Command) setupEnv() {
 	if c.opts.Process.Environment == nil {
 		c.opts.Process.Environment = map[string]string{}
 	}
 }
 
-// Run starts and then waits on the Command's execution.
+// Run starts and then waits on the Command's execution. If the command has a
+// graph of steps, it runs the graph instead of the sub-commands.
 func (c *Command) Run(ctx context.Context) error {
+	c.resetRuns()
 	if c.opts.Prerequisite != nil && !c.opts.Prerequisite() {
 		grip.Debug(ctx, message.Fields{
 			"op":  "no-op after prerequisite returned false",
@@ -434,6 +583,10 @@ func (c *Command) Run(ctx context.Context) error {
 		return c.runFunc(c.opts)
 	}
 
+	if c.opts.Graph != nil {
+		return c.runGraph(ctx)
+	}
+
 	catcher := grip.NewBasicCatcher()
 
 	opts, err := c.getCreateOpts()
@@ -454,7 +607,7 @@ func (c *Command) Run(ctx context.Context) error {
 			c.opts.PreHook(&c.opts, opt)
 		}
 
-		err := c.exec(ctx, opt, idx)
+		err := c.execWithRetries(ctx, opt, idx)
 
 		if c.opts.PostHook != nil {
 			catcher.AddWhen(!c.opts.IgnoreError, c.opts.PostHook(err))
@@ -472,9 +625,17 @@ func (c *Command) Run(ctx context.Context) error {
 	return catcher.Resolve()
 }
 
-// RunParallel is the same as Run(), but will run all sub-commands in parallel.
-// Use of this function effectively ignores the ContinueOnError flag.
+// RunParallel is the same as Run(), but will run the sub-commands in parallel.
+// If the parallelism is limited, the sub-commands wait to start in the order
+// that they were added. If fail-fast is enabled and ContinueOnError is not, the
+// first sub-command to fail cancels the others and prevents the ones that have
+// not yet started from running. If the command has a graph of steps, it is the
+// same as Run().
 func (c *Command) RunParallel(ctx context.Context) error {
+	if c.opts.Graph != nil {
+		return c.Run(ctx)
+	}
+
 	// Avoid paying the copy-costs in between command structs by doing the work
 	// before executing the commands.
 	parallelCmds := make([]Command, len(c.opts.Commands))
@@ -489,34 +650,74 @@ func (c *Command) RunParallel(ctx context.Context) error {
 	}
 
 	type cmdResult struct {
+		idx   int
 		procs []Process
+		run   *subcommandRun
 		err   error
 	}
+	c.resetRuns()
+	// Sibling sub-commands are canceled through runCtx if one fails fast.
+	// Background sub-commands are never canceled since their failures are not
+	// observed.
+	failFast := c.opts.FailFast && !c.opts.ContinueOnError && !c.opts.RunBackground
+	runCtx, cancelRun := ctx, context.CancelFunc(func() {})
+	if failFast {
+		runCtx, cancelRun = context.WithCancel(ctx)
+		defer cancelRun()
+	}
 	cmdResults := make(chan cmdResult, len(c.opts.Commands))
-	for _, parallelCmd := range parallelCmds {
+	start := func(idx int) {
 		go func(innerCmd Command) {
 			defer func() {
 				err := recovery.HandlePanicWithError(recover(), nil, "parallel command execution")
 				if err != nil {
-					cmdResults <- cmdResult{err: err}
+					cmdResults <- cmdResult{idx: idx, err: err}
 				}
 			}()
-			err := innerCmd.Run(ctx)
+			err := innerCmd.Run(runCtx)
+			res := cmdResult{idx: idx, procs: innerCmd.procs, err: err}
+			if len(innerCmd.runs) != 0 {
+				res.run = &innerCmd.runs[0]
+			}
 			select {
-			case cmdResults <- cmdResult{procs: innerCmd.procs, err: err}:
+			case cmdResults <- res:
 			case <-ctx.Done():
 			}
-		}(parallelCmd)
+		}(parallelCmds[idx])
+	}
+
+	// Start the sub-commands in order as long as there are free slots.
+	var next, running int
+	failedFast := false
+	startNext := func() {
+		for !failedFast && next < len(parallelCmds) && (c.opts.MaxParallel <= 0 || running < c.opts.MaxParallel) {
+			start(next)
+			next++
+			running++
+		}
 	}
+	startNext()
 
 	catcher := grip.NewBasicCatcher()
-	for i := 0; i < len(c.opts.Commands); i++ {
+	for running > 0 {
 		select {
 		case cmdRes := <-cmdResults:
+			running--
 			if !c.opts.IgnoreError {
 				catcher.Add(cmdRes.err)
 			}
 			c.procs = append(c.procs, cmdRes.procs...)
+			if cmdRes.run != nil {
+				c.runs[cmdRes.idx] = *cmdRes.run
+			}
+			if cmdRes.err != nil && failFast && !failedFast {
+				failedFast = true
+				cancelRun()
+				for idx := next; idx < len(parallelCmds); idx++ {
+					c.runs[idx].skipped = true
+				}
+			}
+			startNext()
 		case <-ctx.Done():
 			c.procs = []Process{}
 			catcher.Add(c.Close())
@@ -734,7 +935,104 @@ func (c *Command) getCreateOpts() ([]*options.Create, error) {
 	return out, nil
 }
 
-func (c *Command) exec(ctx context.Context, opts *options.Create, idx int) error {
+// execWithRetries runs the subcommand at the given index, running it again
+// according to the command's retry options if it fails.
+func (c *Command) execWithRetries(ctx context.Context, opts *options.Create, idx int) error {
+	run := &c.runs[idx]
+	run.startAt = time.Now()
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			// Drop the failed attempt so that it is not waited on with the
+			// next one.
+			c.procs = c.procs[:len(c.procs)-1]
+			opts = opts.Copy()
+		}
+		run.args = opts.Args
+		run.attempts = attempt
+		run.output = c.captureOutputTail(opts)
+
+		proc, err := c.exec(ctx, opts, idx)
+		if proc != nil {
+			run.proc = proc
+			if !c.opts.RunBackground {
+				run.endAt = time.Now()
+			}
+		}
+		if err == nil || !c.shouldRetry(ctx, proc, attempt) {
+			return err
+		}
+
+		delay := c.opts.Retry.Delay(attempt)
+		grip.Info(ctx, message.WrapError(err, message.Fields{
+			"message": "retrying failed subcommand",
+			"id":      c.opts.ID,
+			"cmd":     strings.Join(opts.Args, " "),
+			"index":   idx,
+			"attempt": attempt,
+			"delay":   delay,
+		}))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrapf(err, "context done before retrying after attempt %d", attempt)
+		}
+	}
+}
+
+// resetRuns clears the state of the subcommands from any previous run.
+func (c *Command) resetRuns() {
+	c.results = nil
+	if c.opts.Graph != nil {
+		c.runs = make([]subcommandRun, len(c.opts.Graph.Steps))
+		for idx, step := range c.opts.Graph.Steps {
+			c.runs[idx].name = step.Name
+			c.runs[idx].args = step.Args
+		}
+		return
+	}
+
+	c.runs = make([]subcommandRun, len(c.opts.Commands))
+	for idx, args := range c.opts.Commands {
+		c.runs[idx].args = args
+	}
+}
+
+// captureOutputTail makes the process created with the given options also
+// write its output to a new tail if output capture is enabled.
+func (c *Command) captureOutputTail(opts *options.Create) *outputTail {
+	if c.opts.OutputTail <= 0 {
+		return nil
+	}
+
+	tail := newOutputTail(c.opts.OutputTail)
+	if !opts.Output.SuppressOutput {
+		opts.Output.Output = tail.tee(opts.Output.Output)
+	}
+	if !opts.Output.SuppressError {
+		opts.Output.Error = tail.tee(opts.Output.Error)
+	}
+	return tail
+}
+
+// shouldRetry returns whether or not the subcommand should be run again after
+// the given attempt's process failed.
+func (c *Command) shouldRetry(ctx context.Context, proc Process, attempt int) bool {
+	if c.opts.Retry == nil || c.opts.RunBackground || proc == nil || ctx.Err() != nil {
+		return false
+	}
+
+	info := proc.Info(ctx)
+	if !info.Complete || info.Successful {
+		return false
+	}
+
+	return c.opts.Retry.ShouldRetry(attempt, info.ExitCode, info.Timeout)
+}
+
+func (c *Command) exec(ctx context.Context, opts *options.Create, idx int) (Process, error) {
 	msg := message.Fields{
 		"id":         c.opts.ID,
 		"cmd":        strings.Join(opts.Args, " "),
@@ -747,7 +1045,7 @@ func (c *Command) exec(ctx context.Context, opts *options.Create, idx int) error
 	writeOutput := getMsgOutput(opts.Output)
 	proc, err := c.makeProc(ctx, opts)
 	if err != nil {
-		return errors.Wrap(err, "creating process")
+		return nil, errors.Wrap(err, "creating process")
 	}
 	c.procs = append(c.procs, proc)
 
@@ -762,7 +1060,7 @@ func (c *Command) exec(ctx context.Context, opts *options.Create, idx int) error
 		grip.Log(ctx, c.opts.Priority, writeOutput(msg))
 	}
 
-	return errors.WithStack(err)
+	return proc, errors.WithStack(err)
 }
 
 func getMsgOutput(opts options.Output) func(msg message.Fields) message.Fields {
@@ -866,3 +1164,90 @@ func BuildCommandGroup(id string, pri level.Priority, cmds [][]string, dir strin
 func BuildRemoteCommandGroup(id string, pri level.Priority, host string, cmds [][]string, dir string) *Command {
 	return NewCommand().ID(id).Priority(pri).Host(host).Extend(cmds).Directory(dir)
 }
+
+// subcommandRun tracks the execution of a single subcommand.
+type subcommandRun struct {
+	name     string
+	skipped  bool
+	args     []string
+	proc     Process
+	attempts int
+	startAt  time.Time
+	endAt    time.Time
+	output   *outputTail
+}
+
+// maxOutputTailLineSize is the maximum length of a line kept in an output
+// tail. Longer lines are truncated to their end.
+const maxOutputTailLineSize = 64 * 1024
+
+// outputTail is a writer that keeps the last lines written to it. It is
+// thread-safe.
+type outputTail struct {
+	mu      sync.Mutex
+	size    int
+	lines   []string
+	partial []byte
+}
+
+func newOutputTail(size int) *outputTail {
+	return &outputTail{size: size}
+}
+
+// tee returns a writer that writes to both the given writer and the tail.
+func (t *outputTail) tee(w io.Writer) io.Writer {
+	if w == nil || w == io.Discard {
+		return t
+	}
+	return io.MultiWriter(w, t)
+}
+
+func (t *outputTail) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.partial = append(t.partial, p...)
+	for {
+		i := bytes.IndexByte(t.partial, '\n')
+		if i < 0 {
+			break
+		}
+		t.add(string(t.partial[:i]))
+		t.partial = t.partial[i+1:]
+	}
+	if len(t.partial) > maxOutputTailLineSize {
+		t.partial = t.partial[len(t.partial)-maxOutputTailLineSize:]
+	}
+	t.partial = append([]byte(nil), t.partial...)
+
+	return len(p), nil
+}
+
+func (t *outputTail) add(line string) {
+	t.lines = append(t.lines, line)
+	// Only discard old lines once there are twice as many as needed to avoid
+	// copying the lines on every write.
+	if len(t.lines) >= 2*t.size {
+		t.lines = append([]string(nil), t.lines[len(t.lines)-t.size:]...)
+	}
+}
+
+// get returns the last lines written, including any trailing line without a
+// newline.
+func (t *outputTail) get() []string {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lines := append([]string(nil), t.lines...)
+	if len(t.partial) != 0 {
+		lines = append(lines, string(t.partial))
+	}
+	if len(lines) > t.size {
+		lines = lines[len(lines)-t.size:]
+	}
+	return lines
+}
@@ -0,0 +1,161 @@
+package jasper
+
+import (
+	"context"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/recovery"
+	"github.com/mongodb/jasper/options"
+)
+
+// stepStatus is the state of a single step of a command graph.
+type stepStatus int
+
+const (
+	stepPending stepStatus = iota
+	stepRunning
+	stepSucceeded
+	stepFailed
+	stepSkipped
+)
+
+// runGraph runs each step of the command's graph once the steps that it
+// depends on have finished, applying the graph's concurrency limit and failure
+// policy.
+func (c *Command) runGraph(ctx context.Context) error {
+	catcher := grip.NewBasicCatcher()
+
+	graph := c.opts.Graph.Copy()
+	if err := graph.Validate(); err != nil {
+		catcher.Wrap(err, "invalid graph")
+		catcher.Wrap(c.Close(), "closing command")
+		return catcher.Resolve()
+	}
+
+	index := make(map[string]int, len(graph.Steps))
+	for idx, step := range graph.Steps {
+		index[step.Name] = idx
+	}
+	status := make([]stepStatus, len(graph.Steps))
+	depsFinished := func(step options.CommandStep) (ready bool, failed bool) {
+		for _, dep := range step.DependsOn {
+			switch status[index[dep]] {
+			case stepFailed, stepSkipped:
+				failed = true
+			case stepSucceeded:
+			default:
+				return false, false
+			}
+		}
+		return true, failed
+	}
+
+	type stepResult struct {
+		idx   int
+		procs []Process
+		run   *subcommandRun
+		err   error
+	}
+	results := make(chan stepResult, len(graph.Steps))
+	start := func(idx int) {
+		stepCmd := c.stepCommand(graph.Steps[idx])
+		go func() {
+			defer func() {
+				err := recovery.HandlePanicWithError(recover(), nil, "graph step execution")
+				if err != nil {
+					results <- stepResult{idx: idx, err: err}
+				}
+			}()
+			err := stepCmd.Run(ctx)
+			res := stepResult{idx: idx, procs: stepCmd.procs, err: err}
+			if len(stepCmd.runs) != 0 {
+				res.run = &stepCmd.runs[0]
+			}
+			results <- res
+		}()
+	}
+
+	var running, finished int
+	var aborted, canceled bool
+	for finished < len(graph.Steps) {
+		// Skipping a step can make the steps that depend on it ready, so keep
+		// scheduling until no more steps change state.
+		for progressed := true; progressed; {
+			progressed = false
+			for idx, step := range graph.Steps {
+				if status[idx] != stepPending {
+					continue
+				}
+				ready, depFailed := depsFinished(step)
+				if !ready {
+					continue
+				}
+				if ctx.Err() != nil {
+					canceled = true
+				}
+				if aborted || canceled || (depFailed && graph.OnFailure == options.GraphFailureSkipDependents) {
+					status[idx] = stepSkipped
+					c.runs[idx].skipped = true
+					finished++
+					progressed = true
+					continue
+				}
+				if graph.MaxConcurrency > 0 && running >= graph.MaxConcurrency {
+					continue
+				}
+				status[idx] = stepRunning
+				running++
+				start(idx)
+			}
+		}
+
+		if finished == len(graph.Steps) {
+			break
+		}
+		if running == 0 {
+			// This should not be possible with a valid graph, but avoid
+			// blocking forever if it happens.
+			catcher.New("no remaining steps in the graph can run")
+			break
+		}
+
+		res := <-results
+		running--
+		finished++
+		if res.run != nil {
+			c.runs[res.idx] = *res.run
+			c.runs[res.idx].name = graph.Steps[res.idx].Name
+		}
+		c.procs = append(c.procs, res.procs...)
+		if res.err != nil {
+			status[res.idx] = stepFailed
+			aborted = aborted || graph.OnFailure == options.GraphFailureAbort
+			if !c.opts.IgnoreError {
+				catcher.Wrapf(res.err, "running step '%s'", graph.Steps[res.idx].Name)
+			}
+			continue
+		}
+		status[res.idx] = stepSucceeded
+	}
+
+	if canceled {
+		catcher.Wrap(ctx.Err(), "running graph")
+	}
+	catcher.Add(c.Close())
+	return catcher.Resolve()
+}
+
+// stepCommand returns a command that runs just the given step with the same
+// options as the graph's command. The step always waits for its process to
+// finish so that the steps that depend on it do not start early.
+func (c *Command) stepCommand(step options.CommandStep) Command {
+	stepCmd := *c
+	stepCmd.opts.Process = *c.opts.Process.Copy()
+	stepCmd.opts.Commands = [][]string{step.Args}
+	stepCmd.opts.Graph = nil
+	stepCmd.opts.Prerequisite = nil
+	stepCmd.opts.RunBackground = false
+	stepCmd.procs = nil
+	stepCmd.runs = nil
+	return stepCmd
+}
@@ -0,0 +1,143 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandGraph(t *testing.T) {
+	// resultsByName returns the command's results keyed by step name.
+	resultsByName := func(ctx context.Context, cmd *Command) map[string]CommandResult {
+		results := map[string]CommandResult{}
+		for _, res := range cmd.Results(ctx) {
+			results[res.Name] = res
+		}
+		return results
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, cmd *Command){
+		"StepsRunAfterTheirDependencies": func(ctx context.Context, t *testing.T, cmd *Command) {
+			marker := filepath.Join(t.TempDir(), "marker")
+			cmd.AddStep("install", []string{"test", "-f", marker}, "download").
+				AddStep("download", []string{"sh", "-c", fmt.Sprintf("sleep 0.2; touch %s", marker)})
+			require.NoError(t, cmd.Run(ctx))
+
+			results := cmd.Results(ctx)
+			require.Len(t, results, 2)
+			assert.Equal(t, "install", results[0].Name)
+			assert.Equal(t, "download", results[1].Name)
+			for _, res := range results {
+				assert.True(t, res.Successful)
+				assert.Equal(t, 1, res.Attempts)
+				assert.False(t, res.Skipped)
+			}
+			assert.Len(t, cmd.GetProcIDs(), 2)
+		},
+		"IndependentStepsRunConcurrently": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.AddStep("a", []string{"sleep", "1"}).
+				AddStep("b", []string{"sleep", "1"}).
+				AddStep("c", []string{"sleep", "1"})
+			start := time.Now()
+			require.NoError(t, cmd.Run(ctx))
+			assert.Less(t, time.Since(start), 2*time.Second)
+		},
+		"MaxConcurrencyLimitsRunningSteps": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.AddStep("a", []string{"sleep", "0.5"}).
+				AddStep("b", []string{"sleep", "0.5"})
+			cmd.opts.Graph.MaxConcurrency = 1
+			start := time.Now()
+			require.NoError(t, cmd.Run(ctx))
+			assert.GreaterOrEqual(t, time.Since(start), time.Second)
+		},
+		"SkipDependentsSkipsStepsThatDependOnFailedStep": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.AddStep("fail", []string{"false"}).
+				AddStep("dependent", []string{"true"}, "fail").
+				AddStep("indirect", []string{"true"}, "dependent").
+				AddStep("independent", []string{"true"})
+			assert.Error(t, cmd.Run(ctx))
+
+			results := resultsByName(ctx, cmd)
+			assert.False(t, results["fail"].Successful)
+			assert.False(t, results["fail"].Skipped)
+			for _, name := range []string{"dependent", "indirect"} {
+				assert.True(t, results[name].Skipped)
+				assert.Zero(t, results[name].Attempts)
+				assert.Empty(t, results[name].ProcessID)
+			}
+			assert.True(t, results["independent"].Successful)
+		},
+		"RunDependentsRunsStepsThatDependOnFailedStep": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.SetGraphOptions(&options.CommandGraph{
+				Steps: []options.CommandStep{
+					{Name: "fail", Args: []string{"false"}},
+					{Name: "dependent", Args: []string{"true"}, DependsOn: []string{"fail"}},
+				},
+				OnFailure: options.GraphFailureRunDependents,
+			})
+			assert.Error(t, cmd.Run(ctx))
+
+			results := resultsByName(ctx, cmd)
+			assert.False(t, results["fail"].Successful)
+			assert.True(t, results["dependent"].Successful)
+		},
+		"AbortSkipsStepsThatHaveNotStarted": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.SetGraphOptions(&options.CommandGraph{
+				Steps: []options.CommandStep{
+					{Name: "fail", Args: []string{"false"}},
+					{Name: "independent", Args: []string{"true"}},
+				},
+				MaxConcurrency: 1,
+				OnFailure:      options.GraphFailureAbort,
+			})
+			assert.Error(t, cmd.Run(ctx))
+
+			results := resultsByName(ctx, cmd)
+			assert.False(t, results["fail"].Successful)
+			assert.True(t, results["independent"].Skipped)
+		},
+		"IgnoreErrorSucceedsWithFailedSteps": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.AddStep("fail", []string{"false"}).IgnoreError(true)
+			assert.NoError(t, cmd.Run(ctx))
+		},
+		"InvalidGraphErrors": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.AddStep("a", []string{"true"}, "b").
+				AddStep("b", []string{"true"}, "a")
+			assert.Error(t, cmd.Run(ctx))
+			assert.Empty(t, cmd.GetProcIDs())
+		},
+		"RunParallelRunsGraph": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.AddStep("a", []string{"true"}).
+				AddStep("b", []string{"true"}, "a")
+			require.NoError(t, cmd.RunParallel(ctx))
+			assert.Len(t, cmd.Results(ctx), 2)
+			assert.Len(t, cmd.GetProcIDs(), 2)
+		},
+		"RunFuncReceivesGraph": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.AddStep("a", []string{"true"})
+			var graph *options.CommandGraph
+			cmd.SetRunFunc(func(opts options.Command) error {
+				graph = opts.Graph
+				return nil
+			})
+			require.NoError(t, cmd.Run(ctx))
+			require.NotNil(t, graph)
+			require.Len(t, graph.Steps, 1)
+			assert.Equal(t, "a", graph.Steps[0].Name)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			testCase(ctx, t, NewCommand())
+		})
+	}
+}
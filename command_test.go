@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -490,16 +491,93 @@ func TestCommandImplementation(t *testing.T) {
 							assert.Equal(t, []string{"echo", "hello world"}, optslist[1].Args)
 							assert.Equal(t, []string{"echo", "hello\"world\""}, optslist[2].Args)
 						},
+						"SuccessfulSubcommandIsNotRetried": func(ctx context.Context, t *testing.T, cmd Command) {
+							cmd.Append("true").SetRetryOptions(&options.CommandRetry{MaxAttempts: 3})
+							require.NoError(t, runFunc(&cmd, ctx))
+							assert.Equal(t, []int{1}, cmd.Attempts())
+						},
+						"ResultsIncludeSubcommandsThatDidNotRun": func(ctx context.Context, t *testing.T, cmd Command) {
+							cmd.Append("false", "true")
+							assert.Error(t, cmd.Run(ctx))
+
+							results := cmd.Results(ctx)
+							require.Len(t, results, 2)
+							assert.Equal(t, 1, results[0].Attempts)
+							assert.False(t, results[0].Successful)
+							assert.Equal(t, []string{"true"}, results[1].Args)
+							assert.Zero(t, results[1].Attempts)
+							assert.Empty(t, results[1].ProcessID)
+							assert.False(t, results[1].Complete)
+						},
+						"FailedSubcommandIsRetriedUntilMaxAttempts": func(ctx context.Context, t *testing.T, cmd Command) {
+							cmd.Append("false").SetRetryOptions(&options.CommandRetry{MaxAttempts: 3})
+							assert.Error(t, runFunc(&cmd, ctx))
+							assert.Equal(t, []int{3}, cmd.Attempts())
+							assert.Len(t, cmd.procs, 1)
+						},
+						"RetriedSubcommandCanSucceed": func(ctx context.Context, t *testing.T, cmd Command) {
+							marker := filepath.Join(t.TempDir(), "marker")
+							cmd.Append("true").
+								ShellScript("sh", fmt.Sprintf("if [ -f %s ]; then exit 0; fi; touch %s; exit 1", marker, marker)).
+								SetRetryOptions(&options.CommandRetry{MaxAttempts: 3, Backoff: 10 * time.Millisecond})
+							require.NoError(t, runFunc(&cmd, ctx))
+							assert.Equal(t, []int{1, 2}, cmd.Attempts())
+							assert.Len(t, cmd.procs, 2)
+							exitCode, err := cmd.Wait(ctx)
+							assert.NoError(t, err)
+							assert.Zero(t, exitCode)
+						},
+						"FailedSubcommandIsOnlyRetriedOnListedExitCodes": func(ctx context.Context, t *testing.T, cmd Command) {
+							cmd.Sh("exit 3").SetRetryOptions(&options.CommandRetry{MaxAttempts: 3, ExitCodes: []int{2}})
+							assert.Error(t, runFunc(&cmd, ctx))
+							assert.Equal(t, []int{1}, cmd.Attempts())
+
+							cmd.SetRetryOptions(&options.CommandRetry{MaxAttempts: 3, ExitCodes: []int{2, 3}})
+							assert.Error(t, runFunc(&cmd, ctx))
+							assert.Equal(t, []int{3}, cmd.Attempts())
+						},
+						"ResultsDescribeEachSubcommand": func(ctx context.Context, t *testing.T, cmd Command) {
+							cmd.Append("true", "false").ContinueOnError(true)
+							assert.Error(t, runFunc(&cmd, ctx))
+
+							results := cmd.Results(ctx)
+							require.Len(t, results, 2)
+							assert.Equal(t, []string{"true"}, results[0].Args)
+							assert.Equal(t, []string{"false"}, results[1].Args)
+							for _, res := range results {
+								assert.NotEmpty(t, res.ProcessID)
+								assert.Equal(t, 1, res.Attempts)
+								assert.True(t, res.Complete)
+								assert.False(t, res.Timeout)
+								assert.NotZero(t, res.Duration)
+								assert.Empty(t, res.Output)
+							}
+							assert.True(t, results[0].Successful)
+							assert.Zero(t, results[0].ExitCode)
+							assert.False(t, results[1].Successful)
+							assert.Equal(t, 1, results[1].ExitCode)
+						},
+						"ResultsCaptureOutputTail": func(ctx context.Context, t *testing.T, cmd Command) {
+							cmd.Sh("echo foo; echo bar; echo bat").CaptureOutputTail(2)
+							require.NoError(t, runFunc(&cmd, ctx))
+
+							results := cmd.Results(ctx)
+							require.Len(t, results, 1)
+							assert.Equal(t, []string{"bar", "bat"}, results[0].Output)
+						},
 						"RunFuncReceivesPopulatedOptions": func(ctx context.Context, t *testing.T, cmd Command) {
 							prio := level.Warning
 							user := "user"
+							retry := &options.CommandRetry{MaxAttempts: 5, ExitCodes: []int{1}}
 							runFuncCalled := false
 							cmd.Add([]string{echo, arg1}).
 								ContinueOnError(true).IgnoreError(true).
 								Priority(prio).Background(true).
 								Sudo(true).SudoAs(user).
+								SetRetryOptions(retry).
 								SetRunFunc(func(opts options.Command) error {
 									runFuncCalled = true
+									assert.Equal(t, retry, opts.Retry)
 									assert.True(t, opts.ContinueOnError)
 									assert.True(t, opts.IgnoreError)
 									assert.True(t, opts.RunBackground)
@@ -537,3 +615,105 @@ func TestRunParallelRunsInParallel(t *testing.T) {
 	// get an error.
 	assert.NoError(t, cmd.RunParallel(cctx))
 }
+
+func TestRunParallelWithMaxParallel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	sleepCmd := []string{"sleep", "0.5"}
+	cmd := NewCommand().Extend([][]string{sleepCmd, sleepCmd, sleepCmd}).MaxParallel(1)
+	start := time.Now()
+	require.NoError(t, cmd.RunParallel(ctx))
+	assert.GreaterOrEqual(t, time.Since(start), 1500*time.Millisecond)
+
+	// The sub-commands should start in the order that they were added.
+	var lastStart time.Time
+	for _, res := range cmd.Results(ctx) {
+		proc, err := getProcByID(cmd.procs, res.ProcessID)
+		require.NoError(t, err)
+		startAt := proc.Info(ctx).StartAt
+		assert.True(t, startAt.After(lastStart))
+		lastStart = startAt
+	}
+}
+
+func TestRunParallelWithFailFast(t *testing.T) {
+	t.Run("CancelsOtherSubcommands", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+		defer cancel()
+
+		sleepCmd := testoptions.SleepCreateOpts(10).Args
+		cmd := NewCommand().Extend([][]string{sleepCmd, {"false"}, sleepCmd}).MaxParallel(2).FailFast(true)
+		start := time.Now()
+		assert.Error(t, cmd.RunParallel(ctx))
+		assert.Less(t, time.Since(start), 5*time.Second)
+
+		results := cmd.Results(ctx)
+		require.Len(t, results, 3)
+		assert.False(t, results[0].Successful)
+		assert.False(t, results[1].Successful)
+		assert.True(t, results[2].Skipped)
+		assert.Zero(t, results[2].Attempts)
+	})
+	t.Run("IsIgnoredWithContinueOnError", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+		defer cancel()
+
+		cmd := NewCommand().Extend([][]string{{"false"}, {"true"}}).MaxParallel(1).FailFast(true).ContinueOnError(true)
+		assert.Error(t, cmd.RunParallel(ctx))
+
+		results := cmd.Results(ctx)
+		require.Len(t, results, 2)
+		assert.False(t, results[0].Successful)
+		assert.True(t, results[1].Successful)
+	})
+}
+
+func getProcByID(procs []Process, id string) (Process, error) {
+	for _, proc := range procs {
+		if proc.ID() == id {
+			return proc, nil
+		}
+	}
+	return nil, fmt.Errorf("process '%s' not found", id)
+}
+
+func TestCommandRetriesTimedOutSubcommandOnlyOnTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	cmd := NewCommand().
+		ApplyFromOpts(&options.Create{Timeout: time.Second}).
+		Add(testoptions.SleepCreateOpts(10).Args).
+		SetRetryOptions(&options.CommandRetry{MaxAttempts: 2})
+	assert.Error(t, cmd.Run(ctx))
+	assert.Equal(t, []int{1}, cmd.Attempts())
+
+	cmd.SetRetryOptions(&options.CommandRetry{MaxAttempts: 2, OnTimeout: true})
+	assert.Error(t, cmd.Run(ctx))
+	assert.Equal(t, []int{2}, cmd.Attempts())
+}
+
+func TestOutputTail(t *testing.T) {
+	t.Run("KeepsLastLines", func(t *testing.T) {
+		tail := newOutputTail(2)
+		for i := 0; i < 10; i++ {
+			_, err := fmt.Fprintf(tail, "line %d\n", i)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []string{"line 8", "line 9"}, tail.get())
+	})
+	t.Run("IncludesLineWithoutNewline", func(t *testing.T) {
+		tail := newOutputTail(2)
+		_, err := tail.Write([]byte("foo\nbar\nba"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bar", "ba"}, tail.get())
+		_, err = tail.Write([]byte("t\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bar", "bat"}, tail.get())
+	})
+	t.Run("NilTailIsEmpty", func(t *testing.T) {
+		var tail *outputTail
+		assert.Empty(t, tail.get())
+	})
+}
@@ -0,0 +1,213 @@
+package jasper
+
+import (
+	"context"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// ProcessEventBufferSize is the number of events that are buffered for each
+// subscriber. If a subscriber falls this far behind, new events for it are
+// dropped until it catches up.
+const ProcessEventBufferSize = 1024
+
+// ProcessEvent is a lifecycle event of a process in a manager.
+type ProcessEvent struct {
+	Type options.ProcessEventType `json:"type" bson:"type"`
+	Time time.Time                `json:"time" bson:"time"`
+	// Signal is the signal that is sent to the process. It is only set for
+	// ProcessSignaled events.
+	Signal syscall.Signal `json:"signal,omitempty" bson:"signal,omitempty"`
+	// Info is the state of the process at the time of the event.
+	Info ProcessInfo `json:"info" bson:"info"`
+}
+
+// MatchesProcessEventFilter returns whether or not the event satisfies every
+// criterion of the filter.
+func MatchesProcessEventFilter(event ProcessEvent, f options.ProcessEventFilter) bool {
+	if len(f.Types) != 0 {
+		var matchesType bool
+		for _, t := range f.Types {
+			if event.Type == t {
+				matchesType = true
+				break
+			}
+		}
+		if !matchesType {
+			return false
+		}
+	}
+
+	return hasAllTags(event.Info.Options.Tags, f.Tags)
+}
+
+// processEventBus publishes process events to its subscribers. The zero value
+// is ready to use and is thread-safe.
+type processEventBus struct {
+	mu          sync.Mutex
+	subscribers map[*processEventSubscriber]struct{}
+}
+
+type processEventSubscriber struct {
+	filter options.ProcessEventFilter
+	events chan ProcessEvent
+}
+
+// subscribe returns a channel that receives the published events that match
+// the filter. The channel is closed once the context is done.
+func (b *processEventBus) subscribe(ctx context.Context, f options.ProcessEventFilter) (<-chan ProcessEvent, error) {
+	if err := f.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid filter")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	sub := &processEventSubscriber{
+		filter: f,
+		events: make(chan ProcessEvent, ProcessEventBufferSize),
+	}
+
+	b.mu.Lock()
+	if b.subscribers == nil {
+		b.subscribers = map[*processEventSubscriber]struct{}{}
+	}
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}()
+
+	return sub.events, nil
+}
+
+// publish sends the event to every subscriber whose filter it matches without
+// blocking.
+func (b *processEventBus) publish(event ProcessEvent) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	event.Info = redactProcessInfo(event.Info)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		if !MatchesProcessEventFilter(event, sub.filter) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			grip.Warning(context.Background(), message.Fields{
+				"message": "dropping process event for subscriber that is not keeping up",
+				"event":   event.Type,
+				"process": event.Info.ID,
+			})
+		}
+	}
+}
+
+// watch publishes the lifecycle events of the process for the rest of its
+// life, starting with a ProcessCreated event. If started is set, a
+// ProcessStarted event is also published.
+func (b *processEventBus) watch(ctx context.Context, proc Process, started bool) {
+	info := proc.Info(ctx)
+	b.publish(ProcessEvent{Type: options.ProcessCreated, Info: info})
+	if started {
+		b.publish(ProcessEvent{Type: options.ProcessStarted, Time: info.StartAt, Info: info})
+	}
+
+	if err := proc.RegisterSignalTrigger(ctx, func(info ProcessInfo, sig syscall.Signal) bool {
+		b.publish(ProcessEvent{Type: options.ProcessSignaled, Signal: sig, Info: info})
+		return false
+	}); err != nil && !proc.Complete(ctx) {
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not register signal trigger to publish process events",
+			"process": proc.ID(),
+		}))
+	}
+
+	if err := proc.RegisterTrigger(ctx, b.publishExit); err != nil {
+		// The trigger cannot be registered if the process has already
+		// completed.
+		if info := proc.Info(ctx); info.Complete {
+			b.publishExit(info)
+			return
+		}
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not register trigger to publish process events",
+			"process": proc.ID(),
+		}))
+	}
+}
+
+// publishExit publishes the events for a completed process.
+func (b *processEventBus) publishExit(info ProcessInfo) {
+	b.publish(ProcessEvent{Type: options.ProcessExited, Time: info.EndAt, Info: info})
+	if info.Timeout {
+		b.publish(ProcessEvent{Type: options.ProcessTimedOut, Time: info.EndAt, Info: info})
+		return
+	}
+	if info.ExitCode == int(syscall.SIGKILL) && !info.Successful {
+		// Checking the kernel log is slow, so it must not block the
+		// process's triggers.
+		go b.publishIfOOMKilled(info)
+	}
+}
+
+// publishIfOOMKilled publishes a ProcessOOMKilled event if the kernel reports
+// that it killed the process because the system ran out of memory.
+func (b *processEventBus) publishIfOOMKilled(info ProcessInfo) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tracker := NewOOMTracker()
+	if err := tracker.Check(ctx); err != nil {
+		grip.Debug(ctx, message.WrapError(err, message.Fields{
+			"message": "could not check if process was killed by the OOM killer",
+			"process": info.ID,
+		}))
+		return
+	}
+
+	lines, _ := tracker.Report()
+	if wasOOMKilled(info, lines) {
+		b.publish(ProcessEvent{Type: options.ProcessOOMKilled, Time: info.EndAt, Info: info})
+	}
+}
+
+// oomKillTimeTolerance is how long after the process ended that the kernel
+// log can report that the OOM killer killed it, since the log timestamps are
+// not exact.
+const oomKillTimeTolerance = time.Second
+
+// wasOOMKilled returns whether or not the kernel log lines report that the OOM
+// killer killed the process while it was running. PIDs can be reused, so an
+// OOM kill of the process's PID only counts if it happened while the process
+// was running.
+func wasOOMKilled(info ProcessInfo, lines []string) bool {
+	for _, line := range lines {
+		pid, at, ok := getOOMKillFromLog(line)
+		if !ok || pid != info.PID {
+			continue
+		}
+		if at.Before(info.StartAt) || at.After(info.EndAt.Add(oomKillTimeTolerance)) {
+			continue
+		}
+		return true
+	}
+	return false
+}
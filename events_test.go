@@ -0,0 +1,111 @@
+package jasper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesProcessEventFilter(t *testing.T) {
+	event := ProcessEvent{
+		Type: options.ProcessExited,
+		Info: ProcessInfo{Options: options.Create{Tags: []string{"foo", "bar"}}},
+	}
+
+	for testName, testCase := range map[string]struct {
+		filter  options.ProcessEventFilter
+		matches bool
+	}{
+		"EmptyFilterMatches":                {filter: options.ProcessEventFilter{}, matches: true},
+		"MatchingTypeMatches":               {filter: options.ProcessEventFilter{Types: []options.ProcessEventType{options.ProcessCreated, options.ProcessExited}}, matches: true},
+		"NonmatchingTypeDoesNotMatch":       {filter: options.ProcessEventFilter{Types: []options.ProcessEventType{options.ProcessCreated}}, matches: false},
+		"MatchingTagsMatch":                 {filter: options.ProcessEventFilter{Tags: []string{"bar", "foo"}}, matches: true},
+		"PartiallyMatchingTagsDoNotMatch":   {filter: options.ProcessEventFilter{Tags: []string{"foo", "bat"}}, matches: false},
+		"MatchingTypeAndTagsMatch":          {filter: options.ProcessEventFilter{Tags: []string{"foo"}, Types: []options.ProcessEventType{options.ProcessExited}}, matches: true},
+		"NonmatchingTypeAndTagDoesNotMatch": {filter: options.ProcessEventFilter{Tags: []string{"foo"}, Types: []options.ProcessEventType{options.ProcessStarted}}, matches: false},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testCase.matches, MatchesProcessEventFilter(event, testCase.filter))
+		})
+	}
+}
+
+func TestProcessEventBus(t *testing.T) {
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, bus *processEventBus){
+		"PublishSendsToMatchingSubscribers": func(ctx context.Context, t *testing.T, bus *processEventBus) {
+			all, err := bus.subscribe(ctx, options.ProcessEventFilter{})
+			require.NoError(t, err)
+			exits, err := bus.subscribe(ctx, options.ProcessEventFilter{Types: []options.ProcessEventType{options.ProcessExited}})
+			require.NoError(t, err)
+
+			bus.publish(ProcessEvent{Type: options.ProcessCreated})
+			bus.publish(ProcessEvent{Type: options.ProcessExited})
+
+			assert.Equal(t, options.ProcessCreated, (<-all).Type)
+			assert.Equal(t, options.ProcessExited, (<-all).Type)
+			event := <-exits
+			assert.Equal(t, options.ProcessExited, event.Type)
+			assert.NotZero(t, event.Time)
+			assert.Empty(t, exits)
+		},
+		"PublishDropsEventsForFullSubscribers": func(ctx context.Context, t *testing.T, bus *processEventBus) {
+			events, err := bus.subscribe(ctx, options.ProcessEventFilter{})
+			require.NoError(t, err)
+
+			for i := 0; i < ProcessEventBufferSize+1; i++ {
+				bus.publish(ProcessEvent{Type: options.ProcessCreated})
+			}
+			assert.Len(t, events, ProcessEventBufferSize)
+		},
+		"SubscribeFailsWithCanceledContext": func(ctx context.Context, t *testing.T, bus *processEventBus) {
+			cctx, cancel := context.WithCancel(ctx)
+			cancel()
+			_, err := bus.subscribe(cctx, options.ProcessEventFilter{})
+			assert.Error(t, err)
+		},
+		"WatchPublishesTimedOutEvent": func(ctx context.Context, t *testing.T, bus *processEventBus) {
+			events, err := bus.subscribe(ctx, options.ProcessEventFilter{Types: []options.ProcessEventType{options.ProcessExited, options.ProcessTimedOut}})
+			require.NoError(t, err)
+
+			opts := &options.Create{Args: []string{"sleep", "10"}, Timeout: time.Second}
+			proc, err := newBasicProcess(ctx, opts)
+			require.NoError(t, err)
+			bus.watch(ctx, proc, true)
+
+			for _, eventType := range []options.ProcessEventType{options.ProcessExited, options.ProcessTimedOut} {
+				select {
+				case event := <-events:
+					assert.Equal(t, eventType, event.Type)
+					assert.True(t, event.Info.Timeout)
+				case <-ctx.Done():
+					require.FailNow(t, "context is done before receiving event", eventType)
+				}
+			}
+		},
+		"WatchPublishesExitOfCompletedProcess": func(ctx context.Context, t *testing.T, bus *processEventBus) {
+			events, err := bus.subscribe(ctx, options.ProcessEventFilter{})
+			require.NoError(t, err)
+
+			proc, err := newBasicProcess(ctx, &options.Create{Args: []string{"true"}})
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+			bus.watch(ctx, proc, false)
+
+			assert.Equal(t, options.ProcessCreated, (<-events).Type)
+			assert.Equal(t, options.ProcessExited, (<-events).Type)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+			defer cancel()
+
+			testCase(ctx, t, &processEventBus{})
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package jasper
+
+import (
+	"context"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// ErrProcessHistoryNotSupported is an error indicating that the manager does
+// not keep a history of completed processes.
+var ErrProcessHistoryNotSupported = errors.New("process history is not supported")
+
+// ProcessHistory is a store of the information of completed processes, which
+// outlives the managers that created them. Implementations must be
+// thread-safe.
+type ProcessHistory interface {
+	// Put records the info of a completed process. If the process has
+	// already been recorded, its record is replaced.
+	Put(context.Context, ProcessInfo) error
+	// Find returns the records that match the query, starting with the most
+	// recently completed process.
+	Find(context.Context, options.HistoryQuery) ([]ProcessInfo, error)
+}
+
+// matchesHistoryQuery returns whether or not the process info matches the
+// tags, status and exit code criteria of the query. The query must already
+// be validated.
+func matchesHistoryQuery(info ProcessInfo, q options.HistoryQuery) bool {
+	switch q.Status {
+	case options.Successful:
+		if !info.Successful {
+			return false
+		}
+	case options.Failed:
+		if info.Successful {
+			return false
+		}
+	}
+
+	if q.ExitCode != nil && info.ExitCode != *q.ExitCode {
+		return false
+	}
+
+	return hasAllTags(info.Options.Tags, q.Tags)
+}
@@ -0,0 +1,166 @@
+package jasper
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	// historyRecordsBucket contains the BSON-encoded process records, keyed
+	// by the time that the process completed followed by the process ID so
+	// that the records are ordered by completion time.
+	historyRecordsBucket = []byte("records")
+	// historyIDsBucket maps each process ID to the key of its record.
+	historyIDsBucket = []byte("ids")
+)
+
+// BoltProcessHistory is a ProcessHistory that is persisted to a single file
+// on disk using an embedded BoltDB database.
+type BoltProcessHistory struct {
+	db *bolt.DB
+}
+
+// NewBoltProcessHistory opens the process history stored in the file at the
+// given path, creating it if it does not exist. Only one process history can
+// have the file open at a time. Callers are responsible for closing the
+// process history once it is no longer in use.
+func NewBoltProcessHistory(path string) (*BoltProcessHistory, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening process history file '%s'", path)
+	}
+
+	if err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{historyRecordsBucket, historyIDsBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return errors.Wrapf(err, "creating bucket '%s'", bucket)
+			}
+		}
+		return nil
+	}); err != nil {
+		_ = db.Close()
+		return nil, errors.Wrap(err, "initializing process history")
+	}
+
+	return &BoltProcessHistory{db: db}, nil
+}
+
+// Put records the info of a completed process in the history.
+func (h *BoltProcessHistory) Put(_ context.Context, info ProcessInfo) error {
+	if info.ID == "" {
+		return errors.New("cannot record process without an ID")
+	}
+	if !info.Complete {
+		return errors.Errorf("cannot record process '%s' that has not completed", info.ID)
+	}
+
+	doc, err := bson.Marshal(info)
+	if err != nil {
+		return errors.Wrapf(err, "marshalling info for process '%s'", info.ID)
+	}
+	key := historyRecordKey(info)
+
+	return h.db.Update(func(tx *bolt.Tx) error {
+		records := tx.Bucket(historyRecordsBucket)
+		ids := tx.Bucket(historyIDsBucket)
+
+		if oldKey := ids.Get([]byte(info.ID)); oldKey != nil {
+			if err := records.Delete(oldKey); err != nil {
+				return errors.Wrapf(err, "deleting existing record for process '%s'", info.ID)
+			}
+		}
+		if err := records.Put(key, doc); err != nil {
+			return errors.Wrapf(err, "recording process '%s'", info.ID)
+		}
+		return errors.Wrapf(ids.Put([]byte(info.ID), key), "indexing record for process '%s'", info.ID)
+	})
+}
+
+// Find returns the records in the history that match the query, starting
+// with the most recently completed process.
+func (h *BoltProcessHistory) Find(ctx context.Context, q options.HistoryQuery) ([]ProcessInfo, error) {
+	if err := q.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid query")
+	}
+
+	out := []ProcessInfo{}
+	err := h.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(historyRecordsBucket).Cursor()
+
+		var key, doc []byte
+		if q.CompletedBefore.IsZero() {
+			key, doc = cursor.Last()
+		} else {
+			// Seek to the first record that completed after the query's
+			// upper bound and step back from it.
+			key, doc = cursor.Seek(historyTimeKey(q.CompletedBefore.Add(time.Nanosecond)))
+			if key == nil {
+				key, doc = cursor.Last()
+			} else {
+				key, doc = cursor.Prev()
+			}
+		}
+
+		for ; key != nil; key, doc = cursor.Prev() {
+			if err := ctx.Err(); err != nil {
+				return errors.WithStack(err)
+			}
+			if !q.CompletedAfter.IsZero() && historyKeyTime(key).Before(q.CompletedAfter) {
+				break
+			}
+
+			var info ProcessInfo
+			if err := bson.Unmarshal(doc, &info); err != nil {
+				return errors.Wrap(err, "unmarshalling process record")
+			}
+			if !matchesHistoryQuery(info, q) {
+				continue
+			}
+
+			out = append(out, info)
+			if q.Limit > 0 && len(out) == q.Limit {
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "finding process records")
+	}
+
+	return out, nil
+}
+
+// Close closes the file that the history is stored in.
+func (h *BoltProcessHistory) Close() error {
+	return errors.Wrap(h.db.Close(), "closing process history file")
+}
+
+// historyRecordKey returns the key of the record for the process info.
+func historyRecordKey(info ProcessInfo) []byte {
+	return append(historyTimeKey(info.EndAt), info.ID...)
+}
+
+// historyTimeKey returns the prefix of the record keys for processes that
+// completed at the given time.
+func historyTimeKey(t time.Time) []byte {
+	var nanos uint64
+	if t.After(time.Unix(0, 0)) {
+		nanos = uint64(t.UnixNano())
+	}
+	key := make([]byte, 8, 8+36)
+	binary.BigEndian.PutUint64(key, nanos)
+	return key
+}
+
+// historyKeyTime returns the completion time encoded in the record key.
+func historyKeyTime(key []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key[:8])))
+}
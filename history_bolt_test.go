@@ -0,0 +1,176 @@
+package jasper
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltProcessHistory(t *testing.T) {
+	makeInfo := func(id string, endAt time.Time, exitCode int, tags ...string) ProcessInfo {
+		return ProcessInfo{
+			ID:         id,
+			Complete:   true,
+			Successful: exitCode == 0,
+			ExitCode:   exitCode,
+			StartAt:    endAt.Add(-time.Second),
+			EndAt:      endAt,
+			Options: options.Create{
+				Args: []string{"echo", id},
+				Tags: tags,
+			},
+		}
+	}
+	ids := func(infos []ProcessInfo) []string {
+		out := make([]string, 0, len(infos))
+		for _, info := range infos {
+			out = append(out, info.ID)
+		}
+		return out
+	}
+	now := time.Now().Truncate(time.Millisecond)
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory){
+		"PutFailsWithoutID": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			assert.Error(t, h.Put(ctx, makeInfo("", now, 0)))
+		},
+		"PutFailsForIncompleteProcess": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			info := makeInfo("foo", now, 0)
+			info.Complete = false
+			info.IsRunning = true
+			assert.Error(t, h.Put(ctx, info))
+		},
+		"FindReturnsNothingForEmptyHistory": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			infos, err := h.Find(ctx, options.HistoryQuery{})
+			require.NoError(t, err)
+			assert.Empty(t, infos)
+		},
+		"FindFailsWithInvalidQuery": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			_, err := h.Find(ctx, options.HistoryQuery{Status: options.Running})
+			assert.Error(t, err)
+		},
+		"FindReturnsMostRecentlyCompletedFirst": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			require.NoError(t, h.Put(ctx, makeInfo("second", now.Add(-time.Minute), 0)))
+			require.NoError(t, h.Put(ctx, makeInfo("third", now, 0)))
+			require.NoError(t, h.Put(ctx, makeInfo("first", now.Add(-time.Hour), 0)))
+
+			infos, err := h.Find(ctx, options.HistoryQuery{})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"third", "second", "first"}, ids(infos))
+			assert.Equal(t, []string{"echo", "third"}, infos[0].Options.Args)
+			assert.True(t, now.Equal(infos[0].EndAt))
+		},
+		"FindFiltersByTimeRange": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			for i, id := range []string{"0", "1", "2", "3", "4"} {
+				require.NoError(t, h.Put(ctx, makeInfo(id, now.Add(time.Duration(i)*time.Minute), 0)))
+			}
+
+			infos, err := h.Find(ctx, options.HistoryQuery{
+				CompletedAfter:  now.Add(time.Minute),
+				CompletedBefore: now.Add(3 * time.Minute),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"3", "2", "1"}, ids(infos))
+
+			infos, err = h.Find(ctx, options.HistoryQuery{CompletedAfter: now.Add(3 * time.Minute)})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"4", "3"}, ids(infos))
+
+			infos, err = h.Find(ctx, options.HistoryQuery{CompletedBefore: now.Add(90 * time.Second)})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"1", "0"}, ids(infos))
+
+			infos, err = h.Find(ctx, options.HistoryQuery{CompletedBefore: now.Add(time.Hour)})
+			require.NoError(t, err)
+			assert.Len(t, infos, 5)
+		},
+		"FindFiltersByTags": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			require.NoError(t, h.Put(ctx, makeInfo("foo", now, 0, "foo")))
+			require.NoError(t, h.Put(ctx, makeInfo("foobar", now.Add(time.Second), 0, "foo", "bar")))
+			require.NoError(t, h.Put(ctx, makeInfo("untagged", now.Add(2*time.Second), 0)))
+
+			infos, err := h.Find(ctx, options.HistoryQuery{Tags: []string{"foo"}})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"foobar", "foo"}, ids(infos))
+
+			infos, err = h.Find(ctx, options.HistoryQuery{Tags: []string{"foo", "bar"}})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"foobar"}, ids(infos))
+
+			infos, err = h.Find(ctx, options.HistoryQuery{Tags: []string{"baz"}})
+			require.NoError(t, err)
+			assert.Empty(t, infos)
+		},
+		"FindFiltersByStatusAndExitCode": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			require.NoError(t, h.Put(ctx, makeInfo("success", now, 0)))
+			require.NoError(t, h.Put(ctx, makeInfo("failure", now.Add(time.Second), 1)))
+			require.NoError(t, h.Put(ctx, makeInfo("other-failure", now.Add(2*time.Second), 2)))
+
+			infos, err := h.Find(ctx, options.HistoryQuery{Status: options.Successful})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"success"}, ids(infos))
+
+			infos, err = h.Find(ctx, options.HistoryQuery{Status: options.Failed})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"other-failure", "failure"}, ids(infos))
+
+			exitCode := 1
+			infos, err = h.Find(ctx, options.HistoryQuery{ExitCode: &exitCode})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"failure"}, ids(infos))
+		},
+		"FindRespectsLimit": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			for i, id := range []string{"0", "1", "2"} {
+				require.NoError(t, h.Put(ctx, makeInfo(id, now.Add(time.Duration(i)*time.Second), 0)))
+			}
+
+			infos, err := h.Find(ctx, options.HistoryQuery{Limit: 2})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"2", "1"}, ids(infos))
+		},
+		"PutReplacesExistingRecord": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			require.NoError(t, h.Put(ctx, makeInfo("foo", now, 1)))
+			require.NoError(t, h.Put(ctx, makeInfo("foo", now.Add(time.Minute), 0)))
+
+			infos, err := h.Find(ctx, options.HistoryQuery{})
+			require.NoError(t, err)
+			require.Len(t, infos, 1)
+			assert.True(t, infos[0].Successful)
+			assert.True(t, now.Add(time.Minute).Equal(infos[0].EndAt))
+		},
+		"RecordsPersistAfterReopening": func(ctx context.Context, t *testing.T, path string, h *BoltProcessHistory) {
+			require.NoError(t, h.Put(ctx, makeInfo("foo", now, 0)))
+			require.NoError(t, h.Close())
+
+			reopened, err := NewBoltProcessHistory(path)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, reopened.Close())
+			}()
+
+			infos, err := reopened.Find(ctx, options.HistoryQuery{})
+			require.NoError(t, err)
+			assert.Equal(t, []string{"foo"}, ids(infos))
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			path := filepath.Join(t.TempDir(), "history.db")
+			h, err := NewBoltProcessHistory(path)
+			require.NoError(t, err)
+			defer func() {
+				// The history may have already been closed by the test.
+				_ = h.Close()
+			}()
+
+			testCase(ctx, t, path, h)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package jasper
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// WriteStandardInput copies the input to the standard input of the given
+// Process proc while it runs. The process must have been created with
+// (options.Create).StandardInputStream set. For remote interfaces, this
+// function will not work; use (remote.Manager).WriteStdin() instead.
+func WriteStandardInput(ctx context.Context, proc Process, input io.Reader) error {
+	if proc == nil {
+		return errors.New("cannot write standard input to nil process")
+	}
+	if proc.Complete(ctx) {
+		return errors.New("cannot write standard input to a completed process")
+	}
+
+	opts := proc.Info(ctx).Options
+	return opts.WriteStandardInput(ctx, input)
+}
+
+// CloseStandardInput closes the standard input of the given Process proc so
+// that it reads EOF. The process must have been created with
+// (options.Create).StandardInputStream set. For remote interfaces, this
+// function will not work; use (remote.Manager).CloseStdin() instead.
+func CloseStandardInput(ctx context.Context, proc Process) error {
+	if proc == nil {
+		return errors.New("cannot close standard input of nil process")
+	}
+
+	opts := proc.Info(ctx).Options
+	return opts.CloseStandardInput()
+}
@@ -0,0 +1,115 @@
+package jasper
+
+import (
+	"context"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandardInputStream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for procType, makeProc := range map[string]ProcessConstructor{
+		"BasicProcess":    newBasicProcess,
+		"BlockingProcess": newBlockingProcess,
+	} {
+		t.Run(procType, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor){
+				"WriteFailsWithNilProcess": func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+					assert.Error(t, WriteStandardInput(ctx, nil, strings.NewReader("foo")))
+				},
+				"CloseFailsWithNilProcess": func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+					assert.Error(t, CloseStandardInput(ctx, nil))
+				},
+				"WriteFailsWithoutStream": func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+					opts.StandardInputStream = false
+					opts.Args = []string{"sleep", "10"}
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+					}()
+
+					assert.Error(t, WriteStandardInput(ctx, proc, strings.NewReader("foo")))
+					assert.Error(t, CloseStandardInput(ctx, proc))
+				},
+				"WritesToRunningProcess": func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+
+					require.NoError(t, WriteStandardInput(ctx, proc, strings.NewReader("foo\n")))
+					require.NoError(t, WriteStandardInput(ctx, proc, strings.NewReader("bar\n")))
+					assert.False(t, proc.Complete(ctx), "process should keep running until standard input is closed")
+					require.NoError(t, CloseStandardInput(ctx, proc))
+
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					logs, err := GetInMemoryLogStream(ctx, proc, 10)
+					require.NoError(t, err)
+					output := strings.Join(logs, "\n")
+					assert.Contains(t, output, "foo")
+					assert.Contains(t, output, "bar")
+				},
+				"WriteFailsAfterClose": func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+
+					require.NoError(t, CloseStandardInput(ctx, proc))
+					assert.NoError(t, CloseStandardInput(ctx, proc))
+					assert.Error(t, WriteStandardInput(ctx, proc, strings.NewReader("foo")))
+
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+				},
+				"WriteFailsAfterProcessCompletes": func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+
+					require.NoError(t, CloseStandardInput(ctx, proc))
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					assert.Error(t, WriteStandardInput(ctx, proc, strings.NewReader("foo")))
+				},
+				"RespawnedProcessGetsNewStream": func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+					require.NoError(t, CloseStandardInput(ctx, proc))
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					newProc, err := proc.Respawn(ctx)
+					require.NoError(t, err)
+					require.NoError(t, WriteStandardInput(ctx, newProc, strings.NewReader("foo\n")))
+					require.NoError(t, CloseStandardInput(ctx, newProc))
+					_, err = newProc.Wait(ctx)
+					require.NoError(t, err)
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					tctx, tcancel := context.WithTimeout(ctx, testutil.ProcessTestTimeout)
+					defer tcancel()
+
+					logger, err := NewInMemoryLogger(10)
+					require.NoError(t, err)
+					opts := &options.Create{
+						Args:                []string{"cat"},
+						StandardInputStream: true,
+						Output: options.Output{
+							Loggers: []*options.LoggerConfig{logger},
+						},
+					}
+					testCase(tctx, t, opts, makeProc)
+				})
+			}
+		})
+	}
+}
@@ -35,12 +35,21 @@ type Manager interface {
 	Register(context.Context, Process) error
 
 	List(context.Context, options.Filter) ([]Process, error)
+	// Query returns the processes that match the structured query.
+	Query(context.Context, options.ProcessQuery) ([]Process, error)
 	Group(context.Context, string) ([]Process, error)
 	Get(context.Context, string) (Process, error)
 	Clear(context.Context)
 	Close(context.Context) error
 
 	LoggingCache(context.Context) LoggingCache
+	// History returns the store of completed processes, or nil if the
+	// manager does not keep a process history.
+	History(context.Context) ProcessHistory
+	// Subscribe returns a channel that receives the lifecycle events of the
+	// manager's processes that match the filter. The channel is closed once
+	// the context is done.
+	Subscribe(context.Context, options.ProcessEventFilter) (<-chan ProcessEvent, error)
 	WriteFile(ctx context.Context, opts options.WriteFile) error
 }
 
@@ -72,6 +81,19 @@ type Process interface {
 	// the signal, not the state of the process signaled.
 	Signal(context.Context, syscall.Signal) error
 
+	// Stop stops the process by following the stop policy: it
+	// sends each step's signal to the policy's target in order,
+	// escalating to the next step if the target does not exit
+	// within the step's grace period. It returns an error if the
+	// target is still running after the last step. The step that
+	// ended the process is reported in (ProcessInfo).StoppedBy.
+	Stop(context.Context, options.StopPolicy) error
+
+	// Resize changes the window size of the pseudo-terminal that the
+	// process runs in. It returns an error if the process was not
+	// created with (options.Create).TTY set.
+	Resize(ctx context.Context, rows, cols uint16) error
+
 	// Wait blocks until the process exits or the context is
 	// canceled or is not properly defined. Wait will return the
 	// exit code as -1 if it was unable to return a true code due
@@ -83,6 +105,13 @@ type Process interface {
 	// and instead is returned as -1.
 	Wait(context.Context) (int, error)
 
+	// WaitReady blocks until the process is ready according to the
+	// probes in (options.Create).Readiness. It returns an error if the
+	// process exits or the readiness timeout elapses before all of the
+	// probes succeed, or if the context is done first. A process
+	// without readiness probes is ready as soon as it starts.
+	WaitReady(context.Context) error
+
 	// Respawn respawns a near-identical version of the process on
 	// which it is called. It will spawn a new process with the same
 	// options and return the new, "respawned" process.
@@ -135,4 +164,35 @@ type ProcessInfo struct {
 	Options    options.Create `json:"options" bson:"options"`
 	StartAt    time.Time      `json:"start_at,omitempty" bson:"start_at,omitempty"`
 	EndAt      time.Time      `json:"end_at,omitempty" bson:"end_at,omitempty"`
+	// Resources reports the resource usage of the process tree. This is only
+	// populated for local processes on supported platforms.
+	Resources *ProcessResources `json:"resources,omitempty" bson:"resources,omitempty"`
+	// StoppedBy reports the step of the stop policy that ended the process
+	// if it was stopped with Stop.
+	StoppedBy *StopResult `json:"stopped_by,omitempty" bson:"stopped_by,omitempty"`
+	// KilledPIDs are the PIDs of the descendants of the process that were
+	// killed along with it because (options.Create).KillTree is set.
+	// Descendants that were sent SIGTERM are only included once they exit.
+	KilledPIDs []int `json:"killed_pids,omitempty" bson:"killed_pids,omitempty"`
+	// RestartCount is the number of times that the manager has restarted the
+	// process because of (options.Create).Restart.
+	RestartCount int `json:"restart_count,omitempty" bson:"restart_count,omitempty"`
+	// PreviousIDs are the IDs of the processes that were restarted to create
+	// this process, from oldest to newest.
+	PreviousIDs []string `json:"previous_ids,omitempty" bson:"previous_ids,omitempty"`
+	// Ready is whether or not all of the process's readiness probes have
+	// succeeded. It is only set for processes created with
+	// (options.Create).Readiness.
+	Ready bool `json:"ready,omitempty" bson:"ready,omitempty"`
+	// ReadyAt is the time at which the process became ready.
+	ReadyAt time.Time `json:"ready_at,omitempty" bson:"ready_at,omitempty"`
+}
+
+// redactProcessInfo returns the info without the credentials in its options
+// so that they are not exposed to clients or persisted.
+func redactProcessInfo(info ProcessInfo) ProcessInfo {
+	if info.Options.Remote != nil {
+		info.Options.Remote = info.Options.Remote.Redacted()
+	}
+	return info
 }
@@ -38,6 +38,19 @@ type Executor interface {
 	// SetGroupLeader marks the local process as a group leader. This is a
 	// noop for remote executors and on non-unix systems.
 	SetGroupLeader()
+	// SetLimits sets resource limits that are applied to the local process
+	// when it starts. This is a noop for remote executors.
+	SetLimits(Limits)
+	// SetTTY makes the local process run in a pseudo-terminal with the given
+	// window size when it starts. All of the process' output is written to
+	// its standard output. This is a noop for remote executors.
+	SetTTY(rows, cols uint16)
+	// Resize changes the window size of the process' pseudo-terminal.
+	Resize(rows, cols uint16) error
+	// SetCancel sets the function that is called to kill the local process
+	// when its context is done instead of sending it SIGKILL. This is a noop
+	// for remote executors.
+	SetCancel(func() error)
 	// PID returns the local process ID of the process if it is running or
 	// complete. This is not guaranteed to return a valid value for remote
 	// executors and will return -1 if it could not be retrieved.
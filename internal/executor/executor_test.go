@@ -22,6 +22,7 @@ func executorTypes() map[string]executorConstructor {
 		"StandardExec": func(ctx context.Context, args []string) (Executor, error) {
 			return NewLocal(ctx, args), nil
 		},
+		"SSH": newTestSSH,
 	}
 }
 
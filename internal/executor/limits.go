@@ -0,0 +1,24 @@
+package executor
+
+// Limits are resource limits for a local process. Nil rlimits are inherited
+// from the parent process and zero cgroup quotas are unlimited.
+type Limits struct {
+	CPUSeconds   *uint64
+	AddressSpace *uint64
+	OpenFiles    *uint64
+	NumProcs     *uint64
+	CoreSize     *uint64
+
+	CgroupMemoryBytes int64
+	CgroupCPUs        float64
+}
+
+// hasCgroup returns whether or not the limits require a cgroup.
+func (l *Limits) hasCgroup() bool {
+	return l.CgroupMemoryBytes > 0 || l.CgroupCPUs > 0
+}
+
+// hasRlimits returns whether or not the limits include any rlimits.
+func (l *Limits) hasRlimits() bool {
+	return l.CPUSeconds != nil || l.AddressSpace != nil || l.OpenFiles != nil || l.NumProcs != nil || l.CoreSize != nil
+}
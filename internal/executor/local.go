@@ -3,15 +3,44 @@ package executor
 import (
 	"context"
 	"io"
+	"os"
 	"os/exec"
+	"sync"
 	"syscall"
+	"time"
 
+	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 )
 
 // local runs processes on a local machine via exec.
 type local struct {
-	cmd *exec.Cmd
+	cmd    *exec.Cmd
+	limits *Limits
+	cgroup limitsCgroup
+	tty    *localTTY
+}
+
+// ttyOutputDrainTimeout is how long to wait for the output written to a
+// process' pseudo-terminal to be copied after the process exits.
+const ttyOutputDrainTimeout = 2 * time.Second
+
+// localTTY is the pseudo-terminal of a local process.
+type localTTY struct {
+	// mu guards the window size and master, which can be changed
+	// concurrently by resizing the terminal.
+	mu   sync.Mutex
+	rows uint16
+	cols uint16
+	// stdout is the writer that receives the output written to the
+	// terminal.
+	stdout io.Writer
+	// master is the controlling side of the terminal. It is only set once
+	// the process has started.
+	master *os.File
+	// outputDone is closed once all the output written to the terminal has
+	// been copied to stdout.
+	outputDone chan struct{}
 }
 
 // NewLocal returns an Executor that creates processes locally.
@@ -68,6 +97,9 @@ func (e *local) SetStdout(stdout io.Writer) {
 }
 
 func (e *local) Stdout() io.Writer {
+	if e.tty != nil && e.tty.stdout != nil {
+		return e.tty.stdout
+	}
 	return e.cmd.Stdout
 }
 
@@ -80,14 +112,73 @@ func (e *local) Stderr() io.Writer {
 	return e.cmd.Stderr
 }
 
-// Start begins running the process.
+// SetLimits sets the resource limits for the process.
+func (e *local) SetLimits(limits Limits) {
+	e.limits = &limits
+}
+
+// SetTTY sets the process to run in a pseudo-terminal of the given size.
+func (e *local) SetTTY(rows, cols uint16) {
+	e.tty = &localTTY{rows: rows, cols: cols}
+}
+
+// SetCancel sets the function that kills the process when its context is
+// done.
+func (e *local) SetCancel(cancel func() error) {
+	e.cmd.Cancel = cancel
+}
+
+// Resize changes the window size of the process' pseudo-terminal.
+func (e *local) Resize(rows, cols uint16) error {
+	if e.tty == nil {
+		return errors.New("cannot resize a process that is not running in a pseudo-terminal")
+	}
+	return e.resizeTTY(rows, cols)
+}
+
+// Start begins running the process. If the process has resource limits, they
+// are applied as it starts.
 func (e *local) Start() error {
+	if e.tty != nil {
+		return e.startTTY(e.start)
+	}
+	return e.start()
+}
+
+func (e *local) start() error {
+	if e.limits != nil {
+		return e.startWithLimits()
+	}
 	return e.cmd.Start()
 }
 
-// Wait returns the result for waiting for the process to finish.
+// Wait returns the result for waiting for the process to finish. If the
+// process is running in a pseudo-terminal, this also waits for its remaining
+// output to be copied.
 func (e *local) Wait() error {
-	return e.cmd.Wait()
+	err := e.cmd.Wait()
+	if e.tty != nil && e.tty.outputDone != nil {
+		e.waitTTYOutput()
+	}
+	return err
+}
+
+// waitTTYOutput waits for the output written to the pseudo-terminal to be
+// copied. Background descendants of the process can keep the terminal open
+// after the process exits, so if the output is not done within
+// ttyOutputDrainTimeout, the terminal is closed to stop copying it.
+func (e *local) waitTTYOutput() {
+	timer := time.NewTimer(ttyOutputDrainTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-e.tty.outputDone:
+		return
+	case <-timer.C:
+	}
+
+	grip.Debug(context.Background(), errors.Wrap(e.closeTTY(), "closing pseudo-terminal held open by descendant processes"))
+	<-e.tty.outputDone
 }
 
 // Signal sends a signal to the process.
@@ -135,7 +226,11 @@ func (e *local) SignalInfo() (sig syscall.Signal, signaled bool) {
 	return status.Signal(), status.Signaled()
 }
 
-// Close is a no-op.
+// Close cleans up any resources used to enforce the process' resource limits
+// and closes its pseudo-terminal, if any.
 func (e *local) Close() error {
-	return nil
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(e.cleanupLimits())
+	catcher.Add(e.closeTTY())
+	return catcher.Resolve()
 }
@@ -0,0 +1,17 @@
+//go:build !linux
+
+package executor
+
+import "github.com/pkg/errors"
+
+// limitsCgroup is unused on platforms that do not support resource limits.
+type limitsCgroup interface{}
+
+// startWithLimits returns an error because resource limits are not supported
+// on this platform.
+func (e *local) startWithLimits() error {
+	return errors.New("resource limits are not supported on this platform")
+}
+
+// cleanupLimits is a noop on platforms that do not support resource limits.
+func (e *local) cleanupLimits() error { return nil }
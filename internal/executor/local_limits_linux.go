@@ -0,0 +1,252 @@
+package executor
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"syscall"
+
+	"github.com/containerd/cgroups/v3"
+	"github.com/containerd/cgroups/v3/cgroup1"
+	"github.com/containerd/cgroups/v3/cgroup2"
+	"github.com/google/uuid"
+	"github.com/mongodb/grip"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// cgroupV2Mountpoint is the mount point of the unified cgroup hierarchy.
+	cgroupV2Mountpoint = "/sys/fs/cgroup"
+	// cgroupCPUPeriod is the period in microseconds over which the cgroup
+	// CPU quota is enforced.
+	cgroupCPUPeriod uint64 = 100000
+)
+
+// limitsCgroup is a cgroup that enforces the cgroup quotas for a single
+// process.
+type limitsCgroup interface {
+	// prepare configures the command to start in the cgroup, if possible.
+	prepare(cmd *exec.Cmd) error
+	// add moves the started process into the cgroup, if it was not already
+	// placed there by prepare.
+	add(pid int) error
+	delete() error
+}
+
+// startWithLimits starts the process and applies its resource limits. If the
+// limits cannot be applied, the process is killed.
+func (e *local) startWithLimits() error {
+	if e.limits.hasCgroup() {
+		cgroup, err := newLimitsCgroup(e.limits)
+		if err != nil {
+			return errors.Wrap(err, "creating cgroup for process limits")
+		}
+		e.cgroup = cgroup
+		if err := cgroup.prepare(e.cmd); err != nil {
+			return errors.Wrap(err, "preparing process to start in cgroup")
+		}
+	}
+
+	hasRlimits := e.limits.hasRlimits()
+	if hasRlimits {
+		// The process is traced so that it stops before it executes any
+		// instructions, which ensures that its rlimits apply from the
+		// start. Ptrace requests must be made from the thread that started
+		// the process.
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		if e.cmd.SysProcAttr == nil {
+			e.cmd.SysProcAttr = &syscall.SysProcAttr{}
+		}
+		e.cmd.SysProcAttr.Ptrace = true
+	}
+
+	if err := e.cmd.Start(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(e.applyLimits())
+	if hasRlimits {
+		catcher.Wrap(resumeTracedProcess(e.cmd.Process.Pid), "resuming process after applying limits")
+	}
+	if catcher.HasErrors() {
+		catcher.Wrap(e.cmd.Process.Kill(), "killing process after failing to apply limits")
+		// The process will not be waited on by the caller if it fails to
+		// start, so it must be reaped here.
+		_ = e.cmd.Wait()
+		return catcher.Resolve()
+	}
+
+	return nil
+}
+
+// applyLimits sets the rlimits of the started process and adds it to its
+// cgroup.
+func (e *local) applyLimits() error {
+	pid := e.cmd.Process.Pid
+	catcher := grip.NewBasicCatcher()
+	for _, rlimit := range []struct {
+		name     string
+		resource int
+		value    *uint64
+	}{
+		{name: "CPU", resource: unix.RLIMIT_CPU, value: e.limits.CPUSeconds},
+		{name: "address space", resource: unix.RLIMIT_AS, value: e.limits.AddressSpace},
+		{name: "open files", resource: unix.RLIMIT_NOFILE, value: e.limits.OpenFiles},
+		{name: "number of processes", resource: unix.RLIMIT_NPROC, value: e.limits.NumProcs},
+		{name: "core size", resource: unix.RLIMIT_CORE, value: e.limits.CoreSize},
+	} {
+		if rlimit.value == nil {
+			continue
+		}
+		limit := &unix.Rlimit{Cur: *rlimit.value, Max: *rlimit.value}
+		catcher.Wrapf(ignoreExited(unix.Prlimit(pid, rlimit.resource, limit, nil)), "setting %s limit", rlimit.name)
+	}
+
+	if e.cgroup != nil {
+		catcher.Wrap(ignoreExited(e.cgroup.add(pid)), "adding process to cgroup")
+	}
+
+	return catcher.Resolve()
+}
+
+// resumeTracedProcess waits for the traced process to stop once it has
+// executed its program and then detaches from it so that it resumes running.
+func resumeTracedProcess(pid int) error {
+	var info unix.Siginfo
+	// The process is not reaped if it has already exited so that it can still
+	// be waited on.
+	if err := unix.Waitid(unix.P_PID, pid, &info, unix.WSTOPPED|unix.WEXITED|unix.WNOWAIT, nil); err != nil {
+		return errors.Wrap(err, "waiting for process to stop")
+	}
+	return ignoreExited(unix.PtraceDetach(pid))
+}
+
+// ignoreExited ignores errors caused by the process having already exited,
+// in which case there is nothing left to limit.
+func ignoreExited(err error) error {
+	if errors.Is(err, unix.ESRCH) {
+		return nil
+	}
+	return err
+}
+
+// cleanupLimits deletes the process' cgroup, if any.
+func (e *local) cleanupLimits() error {
+	if e.cgroup == nil {
+		return nil
+	}
+	if err := e.cgroup.delete(); err != nil {
+		return errors.Wrap(err, "deleting cgroup for process limits")
+	}
+	e.cgroup = nil
+	return nil
+}
+
+// newLimitsCgroup creates a new cgroup that enforces the cgroup quotas in the
+// limits.
+func newLimitsCgroup(limits *Limits) (limitsCgroup, error) {
+	name := "/jasper-limits-" + uuid.New().String()
+
+	var memory, quota *int64
+	if limits.CgroupMemoryBytes > 0 {
+		memory = &limits.CgroupMemoryBytes
+	}
+	if limits.CgroupCPUs > 0 {
+		cpuQuota := int64(limits.CgroupCPUs * float64(cgroupCPUPeriod))
+		quota = &cpuQuota
+	}
+	period := cgroupCPUPeriod
+
+	if cgroups.Mode() == cgroups.Unified {
+		resources := &cgroup2.Resources{}
+		if memory != nil {
+			resources.Memory = &cgroup2.Memory{Max: memory}
+		}
+		if quota != nil {
+			resources.CPU = &cgroup2.CPU{Max: cgroup2.NewCPUMax(quota, &period)}
+		}
+		manager, err := cgroup2.NewManager(cgroupV2Mountpoint, name, resources)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating cgroup v2")
+		}
+		return &limitsCgroupV2{manager: manager, path: filepath.Join(cgroupV2Mountpoint, name)}, nil
+	}
+
+	resources := &specs.LinuxResources{}
+	if memory != nil {
+		resources.Memory = &specs.LinuxMemory{Limit: memory}
+	}
+	if quota != nil {
+		resources.CPU = &specs.LinuxCPU{Quota: quota, Period: &period}
+	}
+	cgroup, err := cgroup1.New(cgroup1.StaticPath(name), resources)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating cgroup v1")
+	}
+	return &limitsCgroupV1{cgroup: cgroup}, nil
+}
+
+// limitsCgroupV2 is a limitsCgroup in the unified hierarchy. The process is
+// started directly in the cgroup, so its quotas apply from the first
+// instruction.
+type limitsCgroupV2 struct {
+	manager *cgroup2.Manager
+	path    string
+	dir     *os.File
+}
+
+func (c *limitsCgroupV2) prepare(cmd *exec.Cmd) error {
+	dir, err := os.Open(c.path)
+	if err != nil {
+		return errors.Wrap(err, "opening cgroup directory")
+	}
+	c.dir = dir
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(dir.Fd())
+	return nil
+}
+
+func (c *limitsCgroupV2) add(int) error {
+	return c.closeDir()
+}
+
+func (c *limitsCgroupV2) closeDir() error {
+	if c.dir == nil {
+		return nil
+	}
+	err := c.dir.Close()
+	c.dir = nil
+	return err
+}
+
+func (c *limitsCgroupV2) delete() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(c.closeDir())
+	catcher.Add(c.manager.Delete())
+	return catcher.Resolve()
+}
+
+// limitsCgroupV1 is a limitsCgroup in the legacy hierarchy. The process can
+// only be added to the cgroup once it has started.
+type limitsCgroupV1 struct {
+	cgroup cgroup1.Cgroup
+}
+
+func (c *limitsCgroupV1) prepare(*exec.Cmd) error { return nil }
+
+func (c *limitsCgroupV1) add(pid int) error {
+	return c.cgroup.Add(cgroup1.Process{Pid: pid})
+}
+
+func (c *limitsCgroupV1) delete() error {
+	return c.cgroup.Delete()
+}
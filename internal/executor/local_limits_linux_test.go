@@ -0,0 +1,70 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/containerd/cgroups/v3"
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalLimits(t *testing.T) {
+	for name, test := range map[string]func(ctx context.Context, t *testing.T){
+		"RlimitsAreAppliedToProcess": func(ctx context.Context, t *testing.T) {
+			exec := NewLocal(ctx, []string{"sh", "-c", "ulimit -n; ulimit -c"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			openFiles := uint64(64)
+			coreSize := uint64(0)
+			exec.SetLimits(Limits{OpenFiles: &openFiles, CoreSize: &coreSize})
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			exec.SetStdout(stdout)
+
+			require.NoError(t, exec.Start())
+			require.NoError(t, exec.Wait())
+			assert.Equal(t, []string{"64", "0"}, strings.Fields(stdout.String()))
+		},
+		"StartFailsIfRlimitCannotBeApplied": func(ctx context.Context, t *testing.T) {
+			if os.Geteuid() == 0 {
+				t.Skip("admin privileges allow raising the hard limit")
+			}
+			exec := NewLocal(ctx, []string{"sleep", "1"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			openFiles := ^uint64(0) - 1
+			exec.SetLimits(Limits{OpenFiles: &openFiles})
+
+			assert.Error(t, exec.Start())
+		},
+		"ProcessIsPlacedInCgroup": func(ctx context.Context, t *testing.T) {
+			if os.Geteuid() != 0 {
+				t.Skip("cannot create cgroups without admin privileges")
+			}
+			exec := NewLocal(ctx, []string{"cat", "/proc/self/cgroup"})
+			exec.SetLimits(Limits{CgroupMemoryBytes: 512 * 1024 * 1024, CgroupCPUs: 0.5})
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			exec.SetStdout(stdout)
+
+			require.NoError(t, exec.Start())
+			require.NoError(t, exec.Wait())
+			require.NoError(t, exec.Close())
+			if cgroups.Mode() == cgroups.Unified {
+				assert.Contains(t, stdout.String(), "jasper-limits-")
+			}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ExecutorTestTimeout)
+			defer cancel()
+			test(ctx, t)
+		})
+	}
+}
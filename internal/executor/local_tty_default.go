@@ -0,0 +1,20 @@
+//go:build !unix
+
+package executor
+
+import "github.com/pkg/errors"
+
+// startTTY returns an error because pseudo-terminals are not supported on
+// this platform.
+func (e *local) startTTY(func() error) error {
+	return errors.New("pseudo-terminals are not supported on this platform")
+}
+
+// resizeTTY returns an error because pseudo-terminals are not supported on
+// this platform.
+func (e *local) resizeTTY(uint16, uint16) error {
+	return errors.New("pseudo-terminals are not supported on this platform")
+}
+
+// closeTTY is a noop on platforms that do not support pseudo-terminals.
+func (e *local) closeTTY() error { return nil }
@@ -0,0 +1,155 @@
+//go:build unix
+
+package executor
+
+import (
+	"io"
+	"os"
+	"syscall"
+
+	"github.com/creack/pty"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// ttyEOF is the default end-of-file character for terminals (Ctrl-D).
+const ttyEOF = 0x04
+
+// startTTY starts the process using the given start function with a new
+// pseudo-terminal as its controlling terminal and standard streams. The
+// output written to the terminal is copied to the process' standard output
+// and the process' standard input, if any, is copied into the terminal.
+func (e *local) startTTY(start func() error) error {
+	e.tty.mu.Lock()
+	defer e.tty.mu.Unlock()
+
+	master, slave, err := pty.Open()
+	if err != nil {
+		return errors.Wrap(err, "opening pseudo-terminal")
+	}
+	// The parent does not need the child's side of the terminal once the
+	// child has started.
+	defer slave.Close()
+
+	// Descendants of the process can keep the terminal open after it exits,
+	// so closing the terminal must interrupt copying its output.
+	if master, err = makePollable(master); err != nil {
+		return errors.Wrap(err, "making pseudo-terminal pollable")
+	}
+
+	if err = setTTYSize(master, e.tty.rows, e.tty.cols); err != nil {
+		_ = master.Close()
+		return errors.Wrap(err, "setting pseudo-terminal size")
+	}
+
+	stdin := e.cmd.Stdin
+	e.tty.stdout = e.cmd.Stdout
+	e.cmd.Stdin = slave
+	e.cmd.Stdout = slave
+	e.cmd.Stderr = slave
+
+	if e.cmd.SysProcAttr == nil {
+		e.cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	e.cmd.SysProcAttr.Setsid = true
+	e.cmd.SysProcAttr.Setctty = true
+	e.cmd.SysProcAttr.Ctty = 0
+	// A session leader already leads its own process group, so it cannot
+	// create a new one.
+	e.cmd.SysProcAttr.Setpgid = false
+
+	if err = start(); err != nil {
+		_ = master.Close()
+		return errors.WithStack(err)
+	}
+
+	e.tty.master = master
+	e.tty.outputDone = make(chan struct{})
+	stdout := e.tty.stdout
+	if stdout == nil {
+		stdout = io.Discard
+	}
+	go func() {
+		defer close(e.tty.outputDone)
+		// Reading from the terminal fails once every process that has it
+		// open has exited.
+		_, _ = io.Copy(stdout, master)
+	}()
+
+	if stdin != nil {
+		go func() {
+			if _, err := io.Copy(master, stdin); err != nil {
+				return
+			}
+			// The process only reads EOF from the terminal once it receives
+			// the end-of-file character.
+			_, _ = master.Write([]byte{ttyEOF})
+		}()
+	}
+
+	return nil
+}
+
+// resizeTTY changes the window size of the pseudo-terminal. If the process
+// has not started yet, the size is applied when it starts.
+func (e *local) resizeTTY(rows, cols uint16) error {
+	e.tty.mu.Lock()
+	defer e.tty.mu.Unlock()
+
+	e.tty.rows = rows
+	e.tty.cols = cols
+	if e.tty.master == nil {
+		return nil
+	}
+	return errors.Wrap(setTTYSize(e.tty.master, rows, cols), "setting pseudo-terminal size")
+}
+
+// setTTYSize sets the window size of the pseudo-terminal. Unlike pty.Setsize,
+// this does not put the terminal into blocking mode.
+func setTTYSize(master *os.File, rows, cols uint16) error {
+	conn, err := master.SyscallConn()
+	if err != nil {
+		return errors.Wrap(err, "getting raw pseudo-terminal")
+	}
+	var ioctlErr error
+	if err = conn.Control(func(fd uintptr) {
+		ioctlErr = unix.IoctlSetWinsize(int(fd), unix.TIOCSWINSZ, &unix.Winsize{Row: rows, Col: cols})
+	}); err != nil {
+		return errors.Wrap(err, "controlling raw pseudo-terminal")
+	}
+	return errors.WithStack(ioctlErr)
+}
+
+// makePollable returns a non-blocking duplicate of the file, which the
+// runtime polls so that closing it interrupts pending reads, and closes the
+// original file.
+func makePollable(f *os.File) (*os.File, error) {
+	defer f.Close()
+
+	fd, err := unix.FcntlInt(f.Fd(), unix.F_DUPFD_CLOEXEC, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "duplicating file descriptor")
+	}
+	if err = unix.SetNonblock(fd, true); err != nil {
+		_ = unix.Close(fd)
+		return nil, errors.Wrap(err, "setting file descriptor to non-blocking")
+	}
+	return os.NewFile(uintptr(fd), f.Name()), nil
+}
+
+// closeTTY closes the pseudo-terminal, if any.
+func (e *local) closeTTY() error {
+	if e.tty == nil {
+		return nil
+	}
+
+	e.tty.mu.Lock()
+	defer e.tty.mu.Unlock()
+
+	if e.tty.master == nil {
+		return nil
+	}
+	err := e.tty.master.Close()
+	e.tty.master = nil
+	return errors.Wrap(err, "closing pseudo-terminal")
+}
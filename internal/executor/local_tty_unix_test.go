@@ -0,0 +1,166 @@
+//go:build unix
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalTTY(t *testing.T) {
+	for name, test := range map[string]func(ctx context.Context, t *testing.T){
+		"ProcessRunsInTerminalWithWindowSize": func(ctx context.Context, t *testing.T) {
+			exec := NewLocal(ctx, []string{"sh", "-c", "test -t 0 && test -t 1 && test -t 2 && stty size"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			exec.SetTTY(30, 100)
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			exec.SetStdout(stdout)
+
+			require.NoError(t, exec.Start())
+			require.NoError(t, exec.Wait())
+			assert.Equal(t, "30 100", strings.TrimSpace(stdout.String()))
+			assert.Equal(t, stdout, exec.Stdout())
+		},
+		"StandardErrorIsWrittenToStandardOutput": func(ctx context.Context, t *testing.T) {
+			exec := NewLocal(ctx, []string{"sh", "-c", "echo foo 1>&2"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			exec.SetTTY(24, 80)
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			stderr := utility.MakeSafeBuffer(bytes.Buffer{})
+			exec.SetStdout(stdout)
+			exec.SetStderr(stderr)
+
+			require.NoError(t, exec.Start())
+			require.NoError(t, exec.Wait())
+			assert.Contains(t, stdout.String(), "foo")
+			assert.Empty(t, stderr.String())
+		},
+		"StandardInputIsWrittenToTerminal": func(ctx context.Context, t *testing.T) {
+			exec := NewLocal(ctx, []string{"cat"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			exec.SetTTY(24, 80)
+			exec.SetStdin(bytes.NewBufferString("foo\n"))
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			exec.SetStdout(stdout)
+
+			require.NoError(t, exec.Start())
+			require.NoError(t, exec.Wait())
+			assert.Contains(t, stdout.String(), "foo")
+		},
+		"ResizeChangesWindowSizeOfRunningProcess": func(ctx context.Context, t *testing.T) {
+			stdinReader, stdinWriter, err := os.Pipe()
+			require.NoError(t, err)
+			defer stdinReader.Close()
+			defer stdinWriter.Close()
+
+			exec := NewLocal(ctx, []string{"sh", "-c", "read line; stty size"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			exec.SetTTY(24, 80)
+			exec.SetStdin(stdinReader)
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			exec.SetStdout(stdout)
+
+			require.NoError(t, exec.Start())
+			require.NoError(t, exec.Resize(50, 132))
+			_, err = stdinWriter.Write([]byte("\n"))
+			require.NoError(t, err)
+			require.NoError(t, stdinWriter.Close())
+			require.NoError(t, exec.Wait())
+			assert.Contains(t, stdout.String(), "50 132")
+		},
+		"ConcurrentResizesSucceed": func(ctx context.Context, t *testing.T) {
+			exec := NewLocal(ctx, []string{"sleep", "1"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			exec.SetTTY(24, 80)
+
+			require.NoError(t, exec.Start())
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(size uint16) {
+					defer wg.Done()
+					assert.NoError(t, exec.Resize(size, size))
+				}(uint16(24 + i))
+			}
+			wg.Wait()
+			require.NoError(t, exec.Signal(syscall.SIGKILL))
+			assert.Error(t, exec.Wait())
+		},
+		"ResizeFailsWithoutTerminal": func(ctx context.Context, t *testing.T) {
+			exec := NewLocal(ctx, []string{"sleep", "1"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+
+			require.NoError(t, exec.Start())
+			assert.Error(t, exec.Resize(50, 132))
+			require.NoError(t, exec.Wait())
+		},
+		"WaitReturnsWhenDescendantKeepsTerminalOpen": func(ctx context.Context, t *testing.T) {
+			// The descendant ignores the hangup signal that it receives when
+			// the process exits, so it keeps the terminal open.
+			exec := NewLocal(ctx, []string{"sh", "-c", "trap '' HUP; sleep 30 & echo $!"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			exec.SetTTY(24, 80)
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			exec.SetStdout(stdout)
+
+			require.NoError(t, exec.Start())
+			waitDone := make(chan error, 1)
+			go func() {
+				waitDone <- exec.Wait()
+			}()
+			select {
+			case err := <-waitDone:
+				require.NoError(t, err)
+			case <-ctx.Done():
+				require.FailNow(t, "wait did not return while a descendant held the terminal open")
+			}
+
+			pid, err := strconv.Atoi(strings.TrimSpace(stdout.String()))
+			require.NoError(t, err)
+			assert.NoError(t, syscall.Kill(pid, syscall.SIGKILL))
+		},
+		"GroupLeaderCanRunInTerminal": func(ctx context.Context, t *testing.T) {
+			exec := NewLocal(ctx, []string{"true"})
+			defer func() {
+				assert.NoError(t, exec.Close())
+			}()
+			exec.SetGroupLeader()
+			exec.SetTTY(24, 80)
+
+			require.NoError(t, exec.Start())
+			require.NoError(t, exec.Wait())
+			assert.True(t, exec.Success())
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ExecutorTestTimeout)
+			defer cancel()
+			test(ctx, t)
+		})
+	}
+}
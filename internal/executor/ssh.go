@@ -0,0 +1,435 @@
+package executor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHDestination describes a remote host and how to connect to it with the
+// native SSH client.
+type SSHDestination struct {
+	// Address is the host and port of the remote SSH server.
+	Address string
+	// Config is the configuration used to connect and authenticate to the
+	// remote host.
+	Config *ssh.ClientConfig
+	// AgentSocket, if set, is the path to the socket of an SSH agent whose
+	// keys are used to authenticate in addition to the authentication
+	// methods in Config.
+	AgentSocket string
+	// ID uniquely identifies the address and configuration. Executors with
+	// the same destination ID share the same connection to the remote host.
+	ID string
+}
+
+// Validate checks that the destination contains enough information to connect
+// to the remote host.
+func (d *SSHDestination) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(d.Address == "", "must specify an address")
+	catcher.NewWhen(d.Config == nil, "must specify a client configuration")
+	catcher.NewWhen(d.ID == "", "must specify an ID")
+	return catcher.Resolve()
+}
+
+// sshPIDMarker prefixes the line on which the remote shell writes its PID
+// before it runs the process.
+const sshPIDMarker = "__jasper_pid__:"
+
+// execSSH runs remote processes using the native SSH client.
+type execSSH struct {
+	ctx    context.Context
+	dest   SSHDestination
+	pool   *sshClientPool
+	args   []string
+	dir    string
+	env    []string
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	client      *pooledSSHClient
+	releaseOnce sync.Once
+	session     *ssh.Session
+	pid         int
+	outputDone  chan struct{}
+	waitDone    chan struct{}
+	waited      bool
+	waitErr     error
+	exitCode    int
+	signal      syscall.Signal
+	signaled    bool
+}
+
+// NewSSH returns an Executor that creates processes on the destination using
+// the native SSH client. Processes on the same destination share a single
+// connection to the remote host. As with the SSH binary, the arguments are
+// joined into a command line that is interpreted by the remote shell, which
+// must be POSIX compatible.
+func NewSSH(ctx context.Context, dest SSHDestination, args []string) Executor {
+	return &execSSH{
+		ctx:      ctx,
+		dest:     dest,
+		pool:     defaultSSHClientPool,
+		args:     args,
+		pid:      -1,
+		exitCode: -1,
+		signal:   syscall.Signal(-1),
+	}
+}
+
+// Args returns the arguments to the process.
+func (e *execSSH) Args() []string {
+	return e.args
+}
+
+// SetEnv sets the remote process environment.
+func (e *execSSH) SetEnv(env []string) {
+	e.env = env
+}
+
+// Env returns the remote process environment.
+func (e *execSSH) Env() []string {
+	return e.env
+}
+
+// SetDir sets the remote process working directory.
+func (e *execSSH) SetDir(dir string) {
+	e.dir = dir
+}
+
+// Dir returns the remote process working directory.
+func (e *execSSH) Dir() string {
+	return e.dir
+}
+
+// SetStdin sets the remote process standard input.
+func (e *execSSH) SetStdin(stdin io.Reader) {
+	e.stdin = stdin
+}
+
+// SetStdout sets the remote process standard output.
+func (e *execSSH) SetStdout(stdout io.Writer) {
+	e.stdout = stdout
+}
+
+// Stdout returns the remote process standard output.
+func (e *execSSH) Stdout() io.Writer {
+	return e.stdout
+}
+
+// SetStderr sets the remote process standard error.
+func (e *execSSH) SetStderr(stderr io.Writer) {
+	e.stderr = stderr
+}
+
+// Stderr returns the remote process standard error.
+func (e *execSSH) Stderr() io.Writer {
+	return e.stderr
+}
+
+// Start begins running the remote process in a new session on the
+// destination's shared connection. It returns once the remote process has
+// started and its PID is known.
+func (e *execSSH) Start() error {
+	if e.session != nil {
+		return errors.New("process has already started")
+	}
+	if err := e.ctx.Err(); err != nil {
+		return errors.Wrap(err, "starting remote process")
+	}
+
+	session, err := e.newSession()
+	if err != nil {
+		return errors.Wrap(err, "creating SSH session")
+	}
+	session.Stdin = e.stdin
+	session.Stderr = e.stderr
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "getting standard output")
+		catcher.Add(closeSSHSession(session))
+		e.release()
+		return catcher.Resolve()
+	}
+	if err = session.Start(e.command()); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "starting remote process")
+		catcher.Add(closeSSHSession(session))
+		e.release()
+		return catcher.Resolve()
+	}
+	e.session = session
+	e.waitDone = make(chan struct{})
+	go e.killOnContextDone()
+
+	// The remote shell writes its PID before it runs the process, so wait
+	// for it before forwarding the rest of the output.
+	output := bufio.NewReader(stdout)
+	e.pid = e.readPID(output)
+	e.outputDone = make(chan struct{})
+	go func() {
+		defer close(e.outputDone)
+		w := e.stdout
+		if w == nil {
+			w = io.Discard
+		}
+		_, _ = io.Copy(w, output)
+	}()
+
+	return nil
+}
+
+// newSession opens a session on the destination's shared connection. If the
+// shared connection is broken, it is replaced by a new connection.
+func (e *execSSH) newSession() (*ssh.Session, error) {
+	for attempt := 0; ; attempt++ {
+		client, err := e.pool.get(e.ctx, e.dest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "connecting to '%s'", e.dest.Address)
+		}
+		session, err := client.NewSession()
+		if err == nil {
+			e.client = client
+			return session, nil
+		}
+		e.pool.evict(client)
+		if attempt > 0 {
+			return nil, errors.WithStack(err)
+		}
+	}
+}
+
+// command returns the command that the remote shell runs. The remote shell
+// first writes its PID and then replaces itself with a shell that runs the
+// process, so the PID is the PID of the process or its parent shell.
+func (e *execSSH) command() string {
+	var cmd []string
+	if e.dir != "" {
+		cmd = append(cmd, "cd", shellQuote(e.dir), "&&")
+	}
+	if len(e.env) != 0 {
+		cmd = append(cmd, "export")
+		for _, kv := range e.env {
+			key, val, _ := strings.Cut(kv, "=")
+			cmd = append(cmd, key+"="+shellQuote(val))
+		}
+		cmd = append(cmd, "&&")
+	}
+	cmd = append(cmd, e.args...)
+
+	return fmt.Sprintf("echo %s$$ && exec sh -c %s", sshPIDMarker, shellQuote(strings.Join(cmd, " ")))
+}
+
+// readPID reads the remote PID from the output. Any output that the remote
+// shell writes before the PID, such as from its startup files, is forwarded
+// to standard output. It returns -1 if the output ends before the PID.
+func (e *execSSH) readPID(output *bufio.Reader) int {
+	for {
+		line, err := output.ReadBytes('\n')
+		if pid, ok := bytes.CutPrefix(bytes.TrimSpace(line), []byte(sshPIDMarker)); ok {
+			if n, err := strconv.Atoi(string(pid)); err == nil {
+				return n
+			}
+			return -1
+		}
+		if e.stdout != nil && len(line) != 0 {
+			_, _ = e.stdout.Write(line)
+		}
+		if err != nil {
+			return -1
+		}
+	}
+}
+
+// killOnContextDone kills the remote process if the context is done before
+// the process exits.
+func (e *execSSH) killOnContextDone() {
+	select {
+	case <-e.ctx.Done():
+		// Not all SSH servers support signals, so closing the session
+		// ensures that Wait returns.
+		_ = e.session.Signal(ssh.SIGKILL)
+		_ = e.session.Close()
+	case <-e.waitDone:
+	}
+}
+
+// Wait waits for the remote process to finish and records its exit status.
+func (e *execSSH) Wait() error {
+	if e.session == nil {
+		return errors.New("cannot wait on an unstarted process")
+	}
+	if e.waited {
+		return e.waitErr
+	}
+
+	<-e.outputDone
+	err := e.session.Wait()
+	close(e.waitDone)
+	e.waited = true
+	e.setExitStatus(err)
+	e.release()
+	e.waitErr = err
+
+	return err
+}
+
+// setExitStatus records the exit status of the remote process from the
+// result of waiting for the session.
+func (e *execSSH) setExitStatus(err error) {
+	var exitErr *ssh.ExitError
+	switch {
+	case err == nil:
+		e.exitCode = 0
+	case errors.As(err, &exitErr) && exitErr.Signal() != "":
+		e.signal, e.signaled = syscallSignal(ssh.Signal(exitErr.Signal()))
+	case errors.As(err, &exitErr):
+		e.exitCode = exitErr.ExitStatus()
+	case e.ctx.Err() != nil:
+		// The session was closed because the context is done before the
+		// remote process reported how it exited, so it is treated as
+		// killed.
+		e.signal = syscall.SIGKILL
+		e.signaled = true
+	}
+}
+
+// Signal sends a signal to the remote process. The remote SSH server must
+// support signal requests, which servers such as OpenSSH deliver to the
+// process group of the session.
+func (e *execSSH) Signal(sig syscall.Signal) error {
+	if e.session == nil {
+		return errors.New("cannot signal an unstarted process")
+	}
+	if e.waited {
+		return errors.New("cannot signal a completed process")
+	}
+	sshSig, ok := sshSignal(sig)
+	if !ok {
+		return errors.Errorf("signal '%s' cannot be sent over SSH", sig)
+	}
+	return errors.Wrap(e.session.Signal(sshSig), "sending signal to remote process")
+}
+
+// SetGroupLeader is a noop for SSH processes.
+func (e *execSSH) SetGroupLeader() {}
+
+// SetLimits is a noop for SSH processes.
+func (e *execSSH) SetLimits(Limits) {}
+
+// SetTTY is a noop for SSH processes.
+func (e *execSSH) SetTTY(uint16, uint16) {}
+
+// SetCancel is a noop for SSH processes.
+func (e *execSSH) SetCancel(func() error) {}
+
+// Resize returns an error because SSH processes do not run in a
+// pseudo-terminal.
+func (e *execSSH) Resize(uint16, uint16) error {
+	return errors.New("cannot resize SSH process because it does not run in a pseudo-terminal")
+}
+
+// PID returns the PID of the remote process, or -1 if it could not be
+// retrieved.
+func (e *execSSH) PID() int {
+	return e.pid
+}
+
+// ExitCode returns the exit code of the remote process, or -1 if the process
+// is not finished, was killed by a signal or did not report its exit status.
+func (e *execSSH) ExitCode() int {
+	return e.exitCode
+}
+
+// Success returns whether or not the remote process ran successfully.
+func (e *execSSH) Success() bool {
+	return e.waited && e.exitCode == 0 && !e.signaled
+}
+
+// SignalInfo returns the signal that killed the remote process, if any.
+func (e *execSSH) SignalInfo() (sig syscall.Signal, signaled bool) {
+	return e.signal, e.signaled
+}
+
+// Close closes the session and releases the shared connection.
+func (e *execSSH) Close() error {
+	defer e.release()
+	if e.session == nil {
+		return nil
+	}
+	return closeSSHSession(e.session)
+}
+
+// release releases the executor's reference to the shared connection.
+func (e *execSSH) release() {
+	e.releaseOnce.Do(func() {
+		if e.client != nil {
+			e.pool.release(e.client)
+		}
+	})
+}
+
+// closeSSHSession closes the session, ignoring the error if it is already
+// closed.
+func closeSSHSession(session *ssh.Session) error {
+	if err := session.Close(); err != nil && err != io.EOF {
+		return errors.Wrap(err, "closing SSH session")
+	}
+	return nil
+}
+
+// shellQuote quotes the string so that a POSIX shell interprets it as a
+// single word.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// sshSignals maps the signals that can be sent over SSH on all platforms to
+// their SSH names.
+var sshSignals = map[syscall.Signal]ssh.Signal{
+	syscall.SIGABRT: ssh.SIGABRT,
+	syscall.SIGALRM: ssh.SIGALRM,
+	syscall.SIGFPE:  ssh.SIGFPE,
+	syscall.SIGHUP:  ssh.SIGHUP,
+	syscall.SIGILL:  ssh.SIGILL,
+	syscall.SIGINT:  ssh.SIGINT,
+	syscall.SIGKILL: ssh.SIGKILL,
+	syscall.SIGPIPE: ssh.SIGPIPE,
+	syscall.SIGQUIT: ssh.SIGQUIT,
+	syscall.SIGSEGV: ssh.SIGSEGV,
+	syscall.SIGTERM: ssh.SIGTERM,
+}
+
+// sshSignal returns the SSH name of the signal.
+func sshSignal(sig syscall.Signal) (ssh.Signal, bool) {
+	if sshSig, ok := sshSignals[sig]; ok {
+		return sshSig, true
+	}
+	sshSig, ok := platformSSHSignals[sig]
+	return sshSig, ok
+}
+
+// syscallSignal returns the signal with the given SSH name.
+func syscallSignal(sshSig ssh.Signal) (syscall.Signal, bool) {
+	for _, signals := range []map[syscall.Signal]ssh.Signal{sshSignals, platformSSHSignals} {
+		for sig, name := range signals {
+			if name == sshSig {
+				return sig, true
+			}
+		}
+	}
+	return syscall.Signal(-1), false
+}
@@ -122,6 +122,21 @@ func (e *execSSHBinary) Signal(sig syscall.Signal) error {
 // SetGroupLeader is a noop for SSH processes.
 func (e *execSSHBinary) SetGroupLeader() {}
 
+// SetLimits is a noop for SSH processes.
+func (e *execSSHBinary) SetLimits(Limits) {}
+
+// SetTTY is a noop for SSH processes.
+func (e *execSSHBinary) SetTTY(uint16, uint16) {}
+
+// SetCancel is a noop for SSH processes.
+func (e *execSSHBinary) SetCancel(func() error) {}
+
+// Resize returns an error because SSH processes do not run in a
+// pseudo-terminal.
+func (e *execSSHBinary) Resize(uint16, uint16) error {
+	return errors.New("cannot resize SSH process because it does not run in a pseudo-terminal")
+}
+
 // PID returns the PID of the local SSH binary process.
 func (e *execSSHBinary) PID() int {
 	if e.cmd == nil || e.cmd.Process == nil {
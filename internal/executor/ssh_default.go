@@ -0,0 +1,13 @@
+//go:build !unix
+
+package executor
+
+import (
+	"syscall"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// platformSSHSignals is empty because the other signals that can be sent over
+// SSH are not defined on non-unix systems.
+var platformSSHSignals = map[syscall.Signal]ssh.Signal{}
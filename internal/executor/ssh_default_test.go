@@ -0,0 +1,17 @@
+//go:build !unix
+
+package executor
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setTestSSHProcessGroup is a noop on platforms without process groups.
+func setTestSSHProcessGroup(*exec.Cmd) {}
+
+// signalTestSSHProcessGroup signals the command on platforms without process
+// groups.
+func signalTestSSHProcessGroup(cmd *exec.Cmd, sig syscall.Signal) error {
+	return cmd.Process.Signal(sig)
+}
@@ -0,0 +1,204 @@
+package executor
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// DefaultSSHIdleTimeout is how long a shared SSH connection stays open after
+// the last process using it is closed.
+const DefaultSSHIdleTimeout = time.Minute
+
+// defaultSSHClientPool is the pool of connections shared by all SSH
+// executors.
+var defaultSSHClientPool = newSSHClientPool(DefaultSSHIdleTimeout)
+
+// sshClientPool shares SSH connections between the processes that run on the
+// same destination. A connection is closed once it has not been used by any
+// process for the idle timeout.
+type sshClientPool struct {
+	mu      sync.Mutex
+	clients map[string]*pooledSSHClient
+	// dialing serializes connecting to each destination so that processes
+	// started at the same time share a single connection.
+	dialing     map[string]*sync.Mutex
+	idleTimeout time.Duration
+}
+
+// pooledSSHClient is a shared SSH connection.
+type pooledSSHClient struct {
+	*ssh.Client
+	id        string
+	refs      int
+	idleTimer *time.Timer
+	closed    bool
+}
+
+func newSSHClientPool(idleTimeout time.Duration) *sshClientPool {
+	return &sshClientPool{
+		clients:     map[string]*pooledSSHClient{},
+		dialing:     map[string]*sync.Mutex{},
+		idleTimeout: idleTimeout,
+	}
+}
+
+// get returns the shared connection to the destination, connecting to it if
+// there is none. The caller must release the connection once it is done with
+// it.
+func (p *sshClientPool) get(ctx context.Context, dest SSHDestination) (*pooledSSHClient, error) {
+	if err := dest.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid SSH destination")
+	}
+
+	dialMu := p.dialLock(dest.ID)
+	dialMu.Lock()
+	defer dialMu.Unlock()
+
+	if client := p.acquire(dest.ID); client != nil {
+		return client, nil
+	}
+
+	sshClient, err := dialSSH(ctx, dest)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client := &pooledSSHClient{Client: sshClient, id: dest.ID, refs: 1}
+	p.clients[dest.ID] = client
+	go func() {
+		// Stop sharing the connection once it is closed by either side.
+		_ = sshClient.Wait()
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.removeLocked(client)
+	}()
+
+	return client, nil
+}
+
+// dialLock returns the lock that must be held to connect to the destination
+// with the given ID.
+func (p *sshClientPool) dialLock(id string) *sync.Mutex {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.dialing[id]; !ok {
+		p.dialing[id] = &sync.Mutex{}
+	}
+	return p.dialing[id]
+}
+
+// acquire returns the open shared connection with the given ID, if any.
+func (p *sshClientPool) acquire(id string) *pooledSSHClient {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.acquireLocked(id)
+}
+
+func (p *sshClientPool) acquireLocked(id string) *pooledSSHClient {
+	client, ok := p.clients[id]
+	if !ok || client.closed {
+		return nil
+	}
+	client.refs++
+	if client.idleTimer != nil {
+		client.idleTimer.Stop()
+		client.idleTimer = nil
+	}
+	return client
+}
+
+// release releases a reference to the shared connection. Once it has no
+// references, it is closed after the idle timeout.
+func (p *sshClientPool) release(client *pooledSSHClient) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client.refs--
+	if client.refs > 0 || client.closed {
+		return
+	}
+	client.idleTimer = time.AfterFunc(p.idleTimeout, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if client.refs > 0 {
+			return
+		}
+		p.removeLocked(client)
+	})
+}
+
+// evict stops sharing the connection and closes it, such as when it is
+// broken. Processes that already use the connection are not affected.
+func (p *sshClientPool) evict(client *pooledSSHClient) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	client.refs--
+	p.removeLocked(client)
+}
+
+// removeLocked stops sharing the connection and closes it if it is not used
+// by any process.
+func (p *sshClientPool) removeLocked(client *pooledSSHClient) {
+	if p.clients[client.id] == client {
+		delete(p.clients, client.id)
+	}
+	if !client.closed {
+		client.closed = true
+		go func() { _ = client.Close() }()
+	}
+}
+
+// dialSSH connects and authenticates to the destination.
+func dialSSH(ctx context.Context, dest SSHDestination) (*ssh.Client, error) {
+	config := *dest.Config
+	if dest.AgentSocket != "" {
+		agentConn, err := net.Dial("unix", dest.AgentSocket)
+		if err != nil {
+			return nil, errors.Wrapf(err, "connecting to SSH agent at '%s'", dest.AgentSocket)
+		}
+		// The agent is only needed to authenticate.
+		defer agentConn.Close()
+		config.Auth = append(append([]ssh.AuthMethod{}, config.Auth...), ssh.PublicKeysCallback(agent.NewClient(agentConn).Signers))
+	}
+
+	dialer := net.Dialer{Timeout: config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", dest.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dialing '%s'", dest.Address)
+	}
+
+	// The SSH handshake does not accept a context, so it is bounded by the
+	// context's deadline and the connection timeout.
+	deadline, ok := ctx.Deadline()
+	if config.Timeout > 0 && (!ok || time.Now().Add(config.Timeout).Before(deadline)) {
+		deadline, ok = time.Now().Add(config.Timeout), true
+	}
+	if ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			grip.Debug(ctx, errors.Wrap(conn.Close(), "closing connection"))
+			return nil, errors.Wrap(err, "setting handshake deadline")
+		}
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, dest.Address, &config)
+	if err != nil {
+		grip.Debug(ctx, errors.Wrap(conn.Close(), "closing connection"))
+		return nil, errors.Wrap(err, "establishing SSH connection")
+	}
+	if err = conn.SetDeadline(time.Time{}); err != nil {
+		grip.Debug(ctx, errors.Wrap(sshConn.Close(), "closing SSH connection"))
+		return nil, errors.Wrap(err, "clearing handshake deadline")
+	}
+
+	return ssh.NewClient(sshConn, chans, reqs), nil
+}
@@ -0,0 +1,415 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// testSSHServer is an in-process SSH server that runs the commands it
+// receives as local processes.
+type testSSHServer struct {
+	addr   string
+	config *ssh.ServerConfig
+	// conns is the number of connections that the server has accepted.
+	conns atomic.Int32
+}
+
+// startTestSSHServer starts an SSH server that accepts the user "user" with
+// the password "password" or any of the authorized keys. The server stops
+// when the context is done.
+func startTestSSHServer(ctx context.Context, authorizedKeys ...ssh.PublicKey) (*testSSHServer, error) {
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating host key")
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating host key signer")
+	}
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(meta ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if meta.User() == "user" && string(password) == "password" {
+				return nil, nil
+			}
+			return nil, errors.New("invalid password")
+		},
+		PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			for _, authorized := range authorizedKeys {
+				if meta.User() == "user" && bytes.Equal(key.Marshal(), authorized.Marshal()) {
+					return nil, nil
+				}
+			}
+			return nil, errors.New("unauthorized key")
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errors.Wrap(err, "listening")
+	}
+	s := &testSSHServer{addr: lis.Addr().String(), config: config}
+
+	go func() {
+		<-ctx.Done()
+		_ = lis.Close()
+	}()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			s.conns.Add(1)
+			go s.handleConn(ctx, conn)
+		}
+	}()
+
+	return s, nil
+}
+
+func (s *testSSHServer) handleConn(ctx context.Context, conn net.Conn) {
+	serverConn, chans, reqs, err := ssh.NewServerConn(conn, s.config)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	go func() {
+		<-ctx.Done()
+		_ = serverConn.Close()
+	}()
+
+	for newChan := range chans {
+		if newChan.ChannelType() != "session" {
+			_ = newChan.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+		ch, chReqs, err := newChan.Accept()
+		if err != nil {
+			continue
+		}
+		go handleTestSSHSession(ch, chReqs)
+	}
+}
+
+// handleTestSSHSession runs the command that the client requests in the
+// session and reports how it exits.
+func handleTestSSHSession(ch ssh.Channel, reqs <-chan *ssh.Request) {
+	var cmd *exec.Cmd
+	for req := range reqs {
+		switch req.Type {
+		case "exec":
+			var payload struct{ Command string }
+			if err := ssh.Unmarshal(req.Payload, &payload); err != nil || cmd != nil {
+				_ = req.Reply(false, nil)
+				continue
+			}
+			cmd = exec.Command("sh", "-c", payload.Command)
+			setTestSSHProcessGroup(cmd)
+			cmd.Stdout = ch
+			cmd.Stderr = ch.Stderr()
+			stdin, err := cmd.StdinPipe()
+			if err != nil {
+				_ = req.Reply(false, nil)
+				continue
+			}
+			if err := cmd.Start(); err != nil {
+				_ = req.Reply(false, nil)
+				continue
+			}
+			_ = req.Reply(true, nil)
+
+			go func() {
+				_, _ = io.Copy(stdin, ch)
+				_ = stdin.Close()
+			}()
+			go func(cmd *exec.Cmd) {
+				_ = cmd.Wait()
+				status := cmd.ProcessState.Sys().(syscall.WaitStatus)
+				if sig, ok := sshSignal(status.Signal()); ok && status.Signaled() {
+					_, _ = ch.SendRequest("exit-signal", false, ssh.Marshal(struct {
+						Signal     string
+						CoreDumped bool
+						Error      string
+						Lang       string
+					}{Signal: string(sig)}))
+				} else {
+					_, _ = ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{Status: uint32(status.ExitStatus())}))
+				}
+				_ = ch.Close()
+			}(cmd)
+		case "signal":
+			var payload struct{ Signal string }
+			if err := ssh.Unmarshal(req.Payload, &payload); err == nil && cmd != nil && cmd.Process != nil {
+				if sig, ok := syscallSignal(ssh.Signal(payload.Signal)); ok {
+					_ = signalTestSSHProcessGroup(cmd, sig)
+				}
+			}
+			if req.WantReply {
+				_ = req.Reply(true, nil)
+			}
+		default:
+			_ = req.Reply(false, nil)
+		}
+	}
+}
+
+// destination returns the destination of the server for a client that
+// authenticates with the given methods.
+func (s *testSSHServer) destination(auth ...ssh.AuthMethod) SSHDestination {
+	return SSHDestination{
+		Address: s.addr,
+		Config: &ssh.ClientConfig{
+			User:            "user",
+			Auth:            auth,
+			HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+			Timeout:         time.Second,
+		},
+		ID: s.addr,
+	}
+}
+
+var unquotedShellWord = regexp.MustCompile(`^[A-Za-z0-9_./=-]+$`)
+
+// newTestSSH returns an SSH executor that runs the process with the given
+// arguments on a new test SSH server. The SSH executor passes the arguments
+// to the remote shell as they are, like the SSH binary, so they are quoted to
+// run the same process as the local executor.
+func newTestSSH(ctx context.Context, args []string) (Executor, error) {
+	s, err := startTestSSHServer(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	quotedArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		if !unquotedShellWord.MatchString(arg) {
+			arg = shellQuote(arg)
+		}
+		quotedArgs = append(quotedArgs, arg)
+	}
+
+	return NewSSH(ctx, s.destination(ssh.Password("password")), quotedArgs), nil
+}
+
+// runTestSSH runs the process on the destination and returns its standard
+// output.
+func runTestSSH(ctx context.Context, pool *sshClientPool, dest SSHDestination, args ...string) (string, error) {
+	e := NewSSH(ctx, dest, args).(*execSSH)
+	e.pool = pool
+
+	stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+	e.SetStdout(stdout)
+	catcher := grip.NewBasicCatcher()
+	if catcher.Add(e.Start()); !catcher.HasErrors() {
+		catcher.Add(e.Wait())
+	}
+	catcher.Add(e.Close())
+	return stdout.String(), catcher.Resolve()
+}
+
+// isPooled returns whether or not the pool has a connection to the
+// destination.
+func isPooled(pool *sshClientPool, dest SSHDestination) bool {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	_, ok := pool.clients[dest.ID]
+	return ok
+}
+
+func TestSSH(t *testing.T) {
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool){
+		"ProcessesShareConnection": func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool) {
+			dest := s.destination(ssh.Password("password"))
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					out, err := runTestSSH(ctx, pool, dest, "echo", "foo")
+					assert.NoError(t, err)
+					assert.Equal(t, "foo\n", out)
+				}()
+			}
+			wg.Wait()
+			out, err := runTestSSH(ctx, pool, dest, "echo", "bar")
+			require.NoError(t, err)
+			assert.Equal(t, "bar\n", out)
+			assert.EqualValues(t, 1, s.conns.Load())
+		},
+		"IdleConnectionIsClosed": func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool) {
+			pool.idleTimeout = 10 * time.Millisecond
+			dest := s.destination(ssh.Password("password"))
+			_, err := runTestSSH(ctx, pool, dest, "true")
+			require.NoError(t, err)
+			assert.Eventually(t, func() bool {
+				return !isPooled(pool, dest)
+			}, time.Second, 10*time.Millisecond)
+
+			_, err = runTestSSH(ctx, pool, dest, "true")
+			require.NoError(t, err)
+			assert.EqualValues(t, 2, s.conns.Load())
+		},
+		"BrokenConnectionIsReplaced": func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool) {
+			dest := s.destination(ssh.Password("password"))
+			_, err := runTestSSH(ctx, pool, dest, "true")
+			require.NoError(t, err)
+			client := pool.acquire(dest.ID)
+			require.NotNil(t, client)
+			pool.release(client)
+			require.NoError(t, client.Close())
+
+			_, err = runTestSSH(ctx, pool, dest, "true")
+			require.NoError(t, err)
+			assert.EqualValues(t, 2, s.conns.Load())
+		},
+		"AuthenticatesWithKey": func(ctx context.Context, t *testing.T, _ *testSSHServer, pool *sshClientPool) {
+			signer := newTestSSHSigner(t)
+			s, err := startTestSSHServer(ctx, signer.PublicKey())
+			require.NoError(t, err)
+			out, err := runTestSSH(ctx, pool, s.destination(ssh.PublicKeys(signer)), "echo", "foo")
+			require.NoError(t, err)
+			assert.Equal(t, "foo\n", out)
+		},
+		"AuthenticatesWithAgent": func(ctx context.Context, t *testing.T, _ *testSSHServer, pool *sshClientPool) {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			require.NoError(t, err)
+			keyring := agent.NewKeyring()
+			require.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: key}))
+			signers, err := keyring.Signers()
+			require.NoError(t, err)
+			s, err := startTestSSHServer(ctx, signers[0].PublicKey())
+			require.NoError(t, err)
+
+			lis, err := net.Listen("unix", filepath.Join(t.TempDir(), "agent.sock"))
+			require.NoError(t, err)
+			defer lis.Close()
+			go func() {
+				for {
+					conn, err := lis.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						defer conn.Close()
+						_ = agent.ServeAgent(keyring, conn)
+					}()
+				}
+			}()
+
+			dest := s.destination()
+			dest.AgentSocket = lis.Addr().String()
+			out, err := runTestSSH(ctx, pool, dest, "echo", "foo")
+			require.NoError(t, err)
+			assert.Equal(t, "foo\n", out)
+		},
+		"FailsWithInvalidCredentials": func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool) {
+			e := NewSSH(ctx, s.destination(ssh.Password("foo")), []string{"true"}).(*execSSH)
+			e.pool = pool
+			assert.Error(t, e.Start())
+			assert.NoError(t, e.Close())
+		},
+		"RunsInWorkingDirectoryWithEnvironment": func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool) {
+			dir := filepath.Join(t.TempDir(), "it's a dir")
+			require.NoError(t, os.Mkdir(dir, 0755))
+			e := NewSSH(ctx, s.destination(ssh.Password("password")), []string{"pwd", "&&", "echo", "$FOO"}).(*execSSH)
+			e.pool = pool
+			defer func() {
+				assert.NoError(t, e.Close())
+			}()
+			e.SetDir(dir)
+			e.SetEnv([]string{"FOO=bar 'baz'"})
+
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			e.SetStdout(stdout)
+			require.NoError(t, e.Start())
+			require.NoError(t, e.Wait())
+			assert.Equal(t, []string{dir, "bar 'baz'"}, strings.Split(strings.TrimSpace(stdout.String()), "\n"))
+		},
+		"PIDIsRemotePID": func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool) {
+			e := NewSSH(ctx, s.destination(ssh.Password("password")), []string{"echo", "$$"}).(*execSSH)
+			e.pool = pool
+			defer func() {
+				assert.NoError(t, e.Close())
+			}()
+
+			stdout := utility.MakeSafeBuffer(bytes.Buffer{})
+			e.SetStdout(stdout)
+			require.NoError(t, e.Start())
+			require.NoError(t, e.Wait())
+			assert.Positive(t, e.PID())
+			assert.Equal(t, strconv.Itoa(e.PID()), strings.TrimSpace(stdout.String()))
+		},
+		"ForwardsSignals": func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool) {
+			e := NewSSH(ctx, s.destination(ssh.Password("password")), []string{"sleep", "10"}).(*execSSH)
+			e.pool = pool
+			defer func() {
+				assert.NoError(t, e.Close())
+			}()
+
+			require.NoError(t, e.Start())
+			require.NoError(t, e.Signal(syscall.SIGTERM))
+			assert.Error(t, e.Wait())
+			sig, signaled := e.SignalInfo()
+			assert.True(t, signaled)
+			assert.Equal(t, syscall.SIGTERM, sig)
+			assert.Equal(t, -1, e.ExitCode())
+		},
+		"ReportsExitCode": func(ctx context.Context, t *testing.T, s *testSSHServer, pool *sshClientPool) {
+			e := NewSSH(ctx, s.destination(ssh.Password("password")), []string{"exit", "42"}).(*execSSH)
+			e.pool = pool
+			defer func() {
+				assert.NoError(t, e.Close())
+			}()
+
+			require.NoError(t, e.Start())
+			assert.Error(t, e.Wait())
+			assert.Equal(t, 42, e.ExitCode())
+			_, signaled := e.SignalInfo()
+			assert.False(t, signaled)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ExecutorTestTimeout)
+			defer cancel()
+
+			s, err := startTestSSHServer(ctx)
+			require.NoError(t, err)
+			testCase(ctx, t, s, newSSHClientPool(DefaultSSHIdleTimeout))
+		})
+	}
+}
+
+func newTestSSHSigner(t *testing.T) ssh.Signer {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+	return signer
+}
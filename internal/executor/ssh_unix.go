@@ -0,0 +1,16 @@
+//go:build unix
+
+package executor
+
+import (
+	"syscall"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// platformSSHSignals maps the signals that can only be sent over SSH on unix
+// systems to their SSH names.
+var platformSSHSignals = map[syscall.Signal]ssh.Signal{
+	syscall.SIGUSR1: ssh.SIGUSR1,
+	syscall.SIGUSR2: ssh.SIGUSR2,
+}
@@ -0,0 +1,20 @@
+//go:build unix
+
+package executor
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setTestSSHProcessGroup runs the command in its own process group, like an
+// SSH server runs each session.
+func setTestSSHProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// signalTestSSHProcessGroup signals the command's process group, like an SSH
+// server signals a session.
+func signalTestSSHProcessGroup(cmd *exec.Cmd, sig syscall.Signal) error {
+	return syscall.Kill(-cmd.Process.Pid, sig)
+}
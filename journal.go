@@ -0,0 +1,20 @@
+package jasper
+
+import (
+	"context"
+)
+
+// ProcessJournal is a persistent record of the processes that are running
+// under a manager. It allows a new manager to recover the processes that were
+// started by a previous one, for example after the service running the
+// manager restarts. Implementations must be thread-safe.
+type ProcessJournal interface {
+	// Put records the info of a running process. If the process has already
+	// been recorded, its record is replaced.
+	Put(context.Context, ProcessInfo) error
+	// Remove deletes the record of the process with the given ID. It is not
+	// an error to remove a process that is not recorded.
+	Remove(context.Context, string) error
+	// List returns the records of all processes in the journal.
+	List(context.Context) ([]ProcessInfo, error)
+}
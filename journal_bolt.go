@@ -0,0 +1,94 @@
+package jasper
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// journalProcessesBucket contains the BSON-encoded records of running
+// processes, keyed by process ID.
+var journalProcessesBucket = []byte("processes")
+
+// BoltProcessJournal is a ProcessJournal that is persisted to a single file on
+// disk using an embedded BoltDB database.
+type BoltProcessJournal struct {
+	db *bolt.DB
+}
+
+// NewBoltProcessJournal opens the process journal stored in the file at the
+// given path, creating it if it does not exist. Only one process journal can
+// have the file open at a time. Callers are responsible for closing the
+// process journal once it is no longer in use.
+func NewBoltProcessJournal(path string) (*BoltProcessJournal, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening process journal file '%s'", path)
+	}
+
+	if err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(journalProcessesBucket)
+		return errors.Wrapf(err, "creating bucket '%s'", journalProcessesBucket)
+	}); err != nil {
+		_ = db.Close()
+		return nil, errors.Wrap(err, "initializing process journal")
+	}
+
+	return &BoltProcessJournal{db: db}, nil
+}
+
+// Put records the info of a running process in the journal.
+func (j *BoltProcessJournal) Put(_ context.Context, info ProcessInfo) error {
+	if info.ID == "" {
+		return errors.New("cannot record process without an ID")
+	}
+
+	doc, err := bson.Marshal(info)
+	if err != nil {
+		return errors.Wrapf(err, "marshalling info for process '%s'", info.ID)
+	}
+
+	return j.db.Update(func(tx *bolt.Tx) error {
+		return errors.Wrapf(tx.Bucket(journalProcessesBucket).Put([]byte(info.ID), doc), "recording process '%s'", info.ID)
+	})
+}
+
+// Remove deletes the record of the process with the given ID from the
+// journal.
+func (j *BoltProcessJournal) Remove(_ context.Context, id string) error {
+	return j.db.Update(func(tx *bolt.Tx) error {
+		return errors.Wrapf(tx.Bucket(journalProcessesBucket).Delete([]byte(id)), "removing process '%s'", id)
+	})
+}
+
+// List returns the records of all processes in the journal.
+func (j *BoltProcessJournal) List(ctx context.Context) ([]ProcessInfo, error) {
+	out := []ProcessInfo{}
+	err := j.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(journalProcessesBucket).ForEach(func(_, doc []byte) error {
+			if err := ctx.Err(); err != nil {
+				return errors.WithStack(err)
+			}
+
+			var info ProcessInfo
+			if err := bson.Unmarshal(doc, &info); err != nil {
+				return errors.Wrap(err, "unmarshalling process record")
+			}
+			out = append(out, info)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing process records")
+	}
+
+	return out, nil
+}
+
+// Close closes the file that the journal is stored in.
+func (j *BoltProcessJournal) Close() error {
+	return errors.Wrap(j.db.Close(), "closing process journal file")
+}
@@ -0,0 +1,100 @@
+package jasper
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltProcessJournal(t *testing.T) {
+	makeInfo := func(id string, tags ...string) ProcessInfo {
+		return ProcessInfo{
+			ID:        id,
+			PID:       1234,
+			IsRunning: true,
+			Options: options.Create{
+				Args:        []string{"sleep", "10"},
+				Environment: map[string]string{ManagerEnvironID: "manager"},
+				Tags:        tags,
+			},
+		}
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, path string, j *BoltProcessJournal){
+		"PutFailsWithoutID": func(ctx context.Context, t *testing.T, path string, j *BoltProcessJournal) {
+			assert.Error(t, j.Put(ctx, makeInfo("")))
+		},
+		"ListReturnsNothingForEmptyJournal": func(ctx context.Context, t *testing.T, path string, j *BoltProcessJournal) {
+			infos, err := j.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, infos)
+		},
+		"ListReturnsRecordedProcesses": func(ctx context.Context, t *testing.T, path string, j *BoltProcessJournal) {
+			require.NoError(t, j.Put(ctx, makeInfo("foo", "tag")))
+			require.NoError(t, j.Put(ctx, makeInfo("bar")))
+
+			infos, err := j.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, infos, 2)
+			for _, info := range infos {
+				if info.ID == "foo" {
+					assert.Equal(t, []string{"tag"}, info.Options.Tags)
+				}
+				assert.Equal(t, 1234, info.PID)
+				assert.Equal(t, "manager", info.Options.Environment[ManagerEnvironID])
+			}
+		},
+		"PutReplacesExistingRecord": func(ctx context.Context, t *testing.T, path string, j *BoltProcessJournal) {
+			require.NoError(t, j.Put(ctx, makeInfo("foo", "tag")))
+			require.NoError(t, j.Put(ctx, makeInfo("foo", "tag", "other-tag")))
+
+			infos, err := j.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, infos, 1)
+			assert.Equal(t, []string{"tag", "other-tag"}, infos[0].Options.Tags)
+		},
+		"RemoveDeletesRecord": func(ctx context.Context, t *testing.T, path string, j *BoltProcessJournal) {
+			require.NoError(t, j.Put(ctx, makeInfo("foo")))
+			require.NoError(t, j.Remove(ctx, "foo"))
+			assert.NoError(t, j.Remove(ctx, "foo"))
+
+			infos, err := j.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, infos)
+		},
+		"RecordsPersistAfterReopening": func(ctx context.Context, t *testing.T, path string, j *BoltProcessJournal) {
+			require.NoError(t, j.Put(ctx, makeInfo("foo")))
+			require.NoError(t, j.Close())
+
+			reopened, err := NewBoltProcessJournal(path)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, reopened.Close())
+			}()
+
+			infos, err := reopened.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, infos, 1)
+			assert.Equal(t, "foo", infos[0].ID)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			path := filepath.Join(t.TempDir(), "journal.db")
+			j, err := NewBoltProcessJournal(path)
+			require.NoError(t, err)
+			defer func() {
+				// The journal may have already been closed by the test.
+				_ = j.Close()
+			}()
+
+			testCase(ctx, t, path, j)
+		})
+	}
+}
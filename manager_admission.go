@@ -0,0 +1,320 @@
+package jasper
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// AdmissionManager is a Manager that limits the number of processes that can
+// run at the same time. Processes that would exceed the limits wait in a queue
+// to be created instead of failing.
+type AdmissionManager interface {
+	Manager
+	// AdmissionStats returns the current state of the admission queue.
+	AdmissionStats() AdmissionStats
+}
+
+// AdmissionStats describes the processes that are running or waiting to run
+// in an admission manager.
+type AdmissionStats struct {
+	// Running is the number of admitted processes that have not yet
+	// completed.
+	Running int `bson:"running" json:"running" yaml:"running"`
+	// RunningByTag is the number of admitted processes that have not yet
+	// completed for each limited tag.
+	RunningByTag map[string]int `bson:"running_by_tag,omitempty" json:"running_by_tag,omitempty" yaml:"running_by_tag,omitempty"`
+	// Queued is the number of processes that are waiting to be admitted.
+	Queued int `bson:"queued" json:"queued" yaml:"queued"`
+	// OldestQueued is how long the process that has been waiting the
+	// longest has been in the queue.
+	OldestQueued time.Duration `bson:"oldest_queued,omitempty" json:"oldest_queued,omitempty" yaml:"oldest_queued,omitempty"`
+	// Admitted is the total number of processes that have been admitted.
+	Admitted int `bson:"admitted" json:"admitted" yaml:"admitted"`
+	// Abandoned is the total number of processes whose context was done
+	// before they could be admitted.
+	Abandoned int `bson:"abandoned" json:"abandoned" yaml:"abandoned"`
+	// TotalWait is the total time that admitted processes spent waiting in
+	// the queue.
+	TotalWait time.Duration `bson:"total_wait" json:"total_wait" yaml:"total_wait"`
+	// MaxWait is the longest time that an admitted process spent waiting in
+	// the queue.
+	MaxWait time.Duration `bson:"max_wait" json:"max_wait" yaml:"max_wait"`
+}
+
+type admissionManager struct {
+	Manager
+	limits options.Admission
+
+	mu           sync.Mutex
+	closed       bool
+	queue        []*admissionWaiter
+	running      int
+	runningByTag map[string]int
+	admitted     int
+	abandoned    int
+	totalWait    time.Duration
+	maxWait      time.Duration
+}
+
+// admissionWaiter is a single call to CreateProcess that is waiting to be
+// admitted.
+type admissionWaiter struct {
+	priority   int
+	tags       []string
+	enqueuedAt time.Time
+	ready      chan struct{}
+	admitted   bool
+	err        error
+}
+
+// MakeAdmissionManager wraps the given manager so that it runs at most the
+// given number of processes at the same time. If creating a process would
+// exceed the limits, CreateProcess waits until enough of the running
+// processes have completed or its context is done. Waiting processes are
+// admitted in order of their options' AdmissionPriority, and then in the
+// order that they were created. A process that is only held back by the
+// limit of one of its tags does not hold back processes without that tag.
+//
+// Only processes created through the returned manager count towards the
+// limits; processes that are registered with it do not. The returned manager
+// is thread-safe if the given manager is thread-safe.
+func MakeAdmissionManager(mngr Manager, limits options.Admission) (AdmissionManager, error) {
+	if err := limits.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid admission limits")
+	}
+
+	return &admissionManager{
+		Manager:      mngr,
+		limits:       *limits.Copy(),
+		runningByTag: map[string]int{},
+	}, nil
+}
+
+func (m *admissionManager) CreateProcess(ctx context.Context, opts *options.Create) (Process, error) {
+	if opts == nil {
+		return nil, errors.New("must specify process options")
+	}
+
+	release, err := m.admit(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "waiting to create process")
+	}
+
+	proc, err := m.Manager.CreateProcess(ctx, opts)
+	if err != nil {
+		release()
+		return nil, errors.WithStack(err)
+	}
+
+	if err := proc.RegisterTrigger(ctx, func(ProcessInfo) { release() }); err != nil {
+		// The trigger cannot be registered if the process has already
+		// completed. Otherwise, release the slot now rather than hold it
+		// forever.
+		grip.WarningWhen(ctx, !proc.Info(ctx).Complete, message.WrapError(err, message.Fields{
+			"message": "could not register trigger to release admitted process, so it no longer counts towards the limits",
+			"process": proc.ID(),
+			"manager": m.ID(),
+		}))
+		release()
+	}
+
+	return proc, nil
+}
+
+func (m *admissionManager) CreateCommand(ctx context.Context) *Command {
+	return NewCommand().ProcConstructor(m.CreateProcess)
+}
+
+// Close fails all processes that are waiting to be admitted before closing
+// the wrapped manager.
+func (m *admissionManager) Close(ctx context.Context) error {
+	m.mu.Lock()
+	m.closed = true
+	for _, w := range m.queue {
+		w.err = errors.New("manager is closed")
+		close(w.ready)
+	}
+	m.queue = nil
+	m.mu.Unlock()
+
+	return m.Manager.Close(ctx)
+}
+
+func (m *admissionManager) AdmissionStats() AdmissionStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stats := AdmissionStats{
+		Running:   m.running,
+		Queued:    len(m.queue),
+		Admitted:  m.admitted,
+		Abandoned: m.abandoned,
+		TotalWait: m.totalWait,
+		MaxWait:   m.maxWait,
+	}
+	for tag, running := range m.runningByTag {
+		if running == 0 {
+			continue
+		}
+		if stats.RunningByTag == nil {
+			stats.RunningByTag = map[string]int{}
+		}
+		stats.RunningByTag[tag] = running
+	}
+	for _, w := range m.queue {
+		if waited := time.Since(w.enqueuedAt); waited > stats.OldestQueued {
+			stats.OldestQueued = waited
+		}
+	}
+
+	return stats
+}
+
+// admit blocks until the process can run within the limits or the context is
+// done. If it is admitted, it returns a function that must be called once
+// the process completes to release its slot.
+func (m *admissionManager) admit(ctx context.Context, opts *options.Create) (func(), error) {
+	w := &admissionWaiter{
+		priority:   opts.AdmissionPriority,
+		enqueuedAt: time.Now(),
+		ready:      make(chan struct{}),
+	}
+	seen := map[string]bool{}
+	for _, tag := range opts.Tags {
+		if _, ok := m.limits.TagLimits[tag]; ok && !seen[tag] {
+			w.tags = append(w.tags, tag)
+			seen[tag] = true
+		}
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil, errors.New("manager is closed")
+	}
+	m.enqueue(w)
+	m.dispatch()
+	if !w.admitted {
+		grip.Debug(ctx, message.Fields{
+			"message":  "process is waiting to be admitted",
+			"args":     opts.Args,
+			"priority": w.priority,
+			"queued":   len(m.queue),
+			"running":  m.running,
+			"manager":  m.ID(),
+		})
+	}
+	m.mu.Unlock()
+
+	select {
+	case <-w.ready:
+	case <-ctx.Done():
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if w.admitted {
+			// The process was admitted at the same time that the context
+			// finished, so give its slot to the next waiting process.
+			m.releaseSlot(w)
+		} else if w.err == nil {
+			m.remove(w)
+			m.abandoned++
+		}
+		return nil, errors.Wrap(ctx.Err(), "process was not admitted before the context was done")
+	}
+
+	if w.err != nil {
+		return nil, w.err
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			m.releaseSlot(w)
+		})
+	}, nil
+}
+
+// enqueue inserts the waiter into the queue, which is sorted by descending
+// priority and then ascending arrival order. The caller must hold the lock.
+func (m *admissionManager) enqueue(w *admissionWaiter) {
+	idx := sort.Search(len(m.queue), func(i int) bool {
+		return m.queue[i].priority < w.priority
+	})
+	m.queue = append(m.queue, nil)
+	copy(m.queue[idx+1:], m.queue[idx:])
+	m.queue[idx] = w
+}
+
+// remove removes the waiter from the queue. The caller must hold the lock.
+func (m *admissionManager) remove(w *admissionWaiter) {
+	for idx := range m.queue {
+		if m.queue[idx] == w {
+			m.queue = append(m.queue[:idx], m.queue[idx+1:]...)
+			return
+		}
+	}
+}
+
+// dispatch admits the waiters in queue order that fit within the limits.
+// Once the maximum number of running processes is reached, no later waiters
+// are admitted, but a waiter that exceeds only a tag limit does not prevent
+// later waiters from being admitted. The caller must hold the lock.
+func (m *admissionManager) dispatch() {
+	remaining := m.queue[:0]
+	for _, w := range m.queue {
+		if !m.fits(w) {
+			remaining = append(remaining, w)
+			continue
+		}
+
+		m.running++
+		for _, tag := range w.tags {
+			m.runningByTag[tag]++
+		}
+		waited := time.Since(w.enqueuedAt)
+		m.admitted++
+		m.totalWait += waited
+		if waited > m.maxWait {
+			m.maxWait = waited
+		}
+		w.admitted = true
+		close(w.ready)
+	}
+	for idx := len(remaining); idx < len(m.queue); idx++ {
+		m.queue[idx] = nil
+	}
+	m.queue = remaining
+}
+
+// fits returns whether or not admitting the waiter keeps the running
+// processes within the limits. The caller must hold the lock.
+func (m *admissionManager) fits(w *admissionWaiter) bool {
+	if m.limits.MaxRunning > 0 && m.running >= m.limits.MaxRunning {
+		return false
+	}
+	for _, tag := range w.tags {
+		if m.runningByTag[tag] >= m.limits.TagLimits[tag] {
+			return false
+		}
+	}
+	return true
+}
+
+// releaseSlot frees the slot held by an admitted waiter and admits any
+// waiters that now fit. The caller must hold the lock.
+func (m *admissionManager) releaseSlot(w *admissionWaiter) {
+	m.running--
+	for _, tag := range w.tags {
+		m.runningByTag[tag]--
+	}
+	m.dispatch()
+}
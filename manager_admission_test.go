@@ -0,0 +1,221 @@
+package jasper
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	testoptions "github.com/mongodb/jasper/testutil/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmissionManager(t *testing.T) {
+	t.Run("InvalidLimitsError", func(t *testing.T) {
+		mngr, err := NewSynchronizedManager(false)
+		require.NoError(t, err)
+		_, err = MakeAdmissionManager(mngr, options.Admission{})
+		assert.Error(t, err)
+	})
+
+	type createResult struct {
+		proc Process
+		err  error
+	}
+	// createAsync creates the process in the background.
+	createAsync := func(ctx context.Context, mngr Manager, opts *options.Create) <-chan createResult {
+		out := make(chan createResult, 1)
+		go func() {
+			proc, err := mngr.CreateProcess(ctx, opts)
+			out <- createResult{proc: proc, err: err}
+		}()
+		return out
+	}
+	// waitForQueued waits until the given number of processes are waiting
+	// to be admitted.
+	waitForQueued := func(t *testing.T, mngr AdmissionManager, queued int) {
+		require.Eventually(t, func() bool {
+			return mngr.AdmissionStats().Queued == queued
+		}, testutil.TestTimeout, 10*time.Millisecond)
+	}
+	kill := func(ctx context.Context, t *testing.T, proc Process) {
+		require.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+		_, _ = proc.Wait(ctx)
+	}
+	taggedSleep := func(tags ...string) *options.Create {
+		opts := testoptions.SleepCreateOpts(10)
+		opts.Tags = tags
+		return opts
+	}
+
+	for testName, testCase := range map[string]struct {
+		limits options.Admission
+		test   func(ctx context.Context, t *testing.T, mngr AdmissionManager)
+	}{
+		"ProcessesWithinLimitStartImmediately": {
+			limits: options.Admission{MaxRunning: 2},
+			test: func(ctx context.Context, t *testing.T, mngr AdmissionManager) {
+				for i := 0; i < 2; i++ {
+					_, err := mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(10))
+					require.NoError(t, err)
+				}
+				stats := mngr.AdmissionStats()
+				assert.Equal(t, 2, stats.Running)
+				assert.Equal(t, 2, stats.Admitted)
+				assert.Zero(t, stats.Queued)
+			},
+		},
+		"OverLimitProcessWaitsForRunningProcessToComplete": {
+			limits: options.Admission{MaxRunning: 1},
+			test: func(ctx context.Context, t *testing.T, mngr AdmissionManager) {
+				running, err := mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(10))
+				require.NoError(t, err)
+
+				waiting := createAsync(ctx, mngr, testoptions.TrueCreateOpts())
+				waitForQueued(t, mngr, 1)
+				assert.Equal(t, 1, mngr.AdmissionStats().Running)
+				assert.Positive(t, mngr.AdmissionStats().OldestQueued)
+
+				kill(ctx, t, running)
+				res := <-waiting
+				require.NoError(t, res.err)
+				_, err = res.proc.Wait(ctx)
+				require.NoError(t, err)
+
+				require.Eventually(t, func() bool {
+					return mngr.AdmissionStats().Running == 0
+				}, testutil.TestTimeout, 10*time.Millisecond)
+				stats := mngr.AdmissionStats()
+				assert.Equal(t, 2, stats.Admitted)
+				assert.Positive(t, stats.MaxWait)
+				assert.GreaterOrEqual(t, stats.TotalWait, stats.MaxWait)
+			},
+		},
+		"OverLimitProcessFailsOnceContextIsDone": {
+			limits: options.Admission{MaxRunning: 1},
+			test: func(ctx context.Context, t *testing.T, mngr AdmissionManager) {
+				_, err := mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(10))
+				require.NoError(t, err)
+
+				tctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+				defer cancel()
+				proc, err := mngr.CreateProcess(tctx, testoptions.TrueCreateOpts())
+				assert.Error(t, err)
+				assert.Nil(t, proc)
+
+				stats := mngr.AdmissionStats()
+				assert.Zero(t, stats.Queued)
+				assert.Equal(t, 1, stats.Abandoned)
+				assert.Equal(t, 1, stats.Running)
+			},
+		},
+		"HigherPriorityProcessIsAdmittedFirst": {
+			limits: options.Admission{MaxRunning: 1},
+			test: func(ctx context.Context, t *testing.T, mngr AdmissionManager) {
+				running, err := mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(10))
+				require.NoError(t, err)
+
+				low := createAsync(ctx, mngr, testoptions.SleepCreateOpts(10))
+				waitForQueued(t, mngr, 1)
+				highOpts := testoptions.SleepCreateOpts(10)
+				highOpts.AdmissionPriority = 1
+				high := createAsync(ctx, mngr, highOpts)
+				waitForQueued(t, mngr, 2)
+
+				kill(ctx, t, running)
+				res := <-high
+				require.NoError(t, res.err)
+				select {
+				case <-low:
+					assert.Fail(t, "lower priority process should not be admitted while the higher priority process is running")
+				case <-time.After(100 * time.Millisecond):
+				}
+				assert.Equal(t, 1, mngr.AdmissionStats().Queued)
+
+				kill(ctx, t, res.proc)
+				res = <-low
+				require.NoError(t, res.err)
+			},
+		},
+		"TagLimitDoesNotHoldBackOtherProcesses": {
+			limits: options.Admission{TagLimits: map[string]int{"compile": 1}},
+			test: func(ctx context.Context, t *testing.T, mngr AdmissionManager) {
+				running, err := mngr.CreateProcess(ctx, taggedSleep("compile"))
+				require.NoError(t, err)
+				assert.Equal(t, map[string]int{"compile": 1}, mngr.AdmissionStats().RunningByTag)
+
+				waiting := createAsync(ctx, mngr, taggedSleep("compile", "test"))
+				waitForQueued(t, mngr, 1)
+
+				_, err = mngr.CreateProcess(ctx, taggedSleep("test"))
+				require.NoError(t, err)
+				_, err = mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(10))
+				require.NoError(t, err)
+				assert.Equal(t, 1, mngr.AdmissionStats().Queued)
+
+				kill(ctx, t, running)
+				res := <-waiting
+				require.NoError(t, res.err)
+			},
+		},
+		"FailedCreateReleasesSlot": {
+			limits: options.Admission{MaxRunning: 1},
+			test: func(ctx context.Context, t *testing.T, mngr AdmissionManager) {
+				_, err := mngr.CreateProcess(ctx, &options.Create{})
+				require.Error(t, err)
+				assert.Zero(t, mngr.AdmissionStats().Running)
+			},
+		},
+		"CloseFailsWaitingProcesses": {
+			limits: options.Admission{MaxRunning: 1},
+			test: func(ctx context.Context, t *testing.T, mngr AdmissionManager) {
+				_, err := mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(10))
+				require.NoError(t, err)
+				waiting := createAsync(ctx, mngr, testoptions.TrueCreateOpts())
+				waitForQueued(t, mngr, 1)
+
+				require.NoError(t, mngr.Close(ctx))
+				res := <-waiting
+				assert.Error(t, res.err)
+				assert.Zero(t, mngr.AdmissionStats().Queued)
+
+				_, err = mngr.CreateProcess(ctx, testoptions.TrueCreateOpts())
+				assert.Error(t, err)
+			},
+		},
+		"CommandWaitsForAdmission": {
+			limits: options.Admission{MaxRunning: 1},
+			test: func(ctx context.Context, t *testing.T, mngr AdmissionManager) {
+				running, err := mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(10))
+				require.NoError(t, err)
+
+				done := make(chan error, 1)
+				go func() {
+					done <- mngr.CreateCommand(ctx).Append("true").Run(ctx)
+				}()
+				waitForQueued(t, mngr, 1)
+
+				kill(ctx, t, running)
+				require.NoError(t, <-done)
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			base, err := NewSynchronizedManager(false)
+			require.NoError(t, err)
+			mngr, err := MakeAdmissionManager(base, testCase.limits)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, mngr.Close(ctx))
+			}()
+
+			testCase.test(ctx, t, mngr)
+		})
+	}
+}
@@ -17,6 +17,7 @@ type basicProcessManager struct {
 	procs   map[string]Process
 	tracker ProcessTracker
 	loggers LoggingCache
+	events  processEventBus
 }
 
 // newBasicProcessManager returns a manager which is not thread safe for
@@ -77,12 +78,19 @@ func (m *basicProcessManager) CreateProcess(ctx context.Context, opts *options.C
 	}
 
 	m.procs[proc.ID()] = proc
+	m.events.watch(ctx, proc, true)
 
 	return proc, nil
 }
 
 func (m *basicProcessManager) LoggingCache(_ context.Context) LoggingCache { return m.loggers }
 
+func (m *basicProcessManager) History(_ context.Context) ProcessHistory { return nil }
+
+func (m *basicProcessManager) Subscribe(ctx context.Context, f options.ProcessEventFilter) (<-chan ProcessEvent, error) {
+	return m.events.subscribe(ctx, f)
+}
+
 func (m *basicProcessManager) CreateCommand(ctx context.Context) *Command {
 	return NewCommand().ProcConstructor(m.CreateProcess)
 }
@@ -114,6 +122,7 @@ func (m *basicProcessManager) Register(ctx context.Context, proc Process) error
 	}
 
 	m.procs[id] = proc
+	m.events.watch(ctx, proc, false)
 	return nil
 }
 
@@ -132,24 +141,29 @@ func (m *basicProcessManager) List(ctx context.Context, f options.Filter) ([]Pro
 		cctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
 		info := proc.Info(cctx)
 		cancel()
-		switch {
-		case f == options.Running:
-			if info.IsRunning {
-				out = append(out, proc)
-			}
-		case f == options.Terminated:
-			if !info.IsRunning {
-				out = append(out, proc)
-			}
-		case f == options.Successful:
-			if info.Successful {
-				out = append(out, proc)
-			}
-		case f == options.Failed:
-			if info.Complete && !info.Successful {
-				out = append(out, proc)
-			}
-		case f == options.All:
+		if matchesFilter(info, f) {
+			out = append(out, proc)
+		}
+	}
+
+	return out, nil
+}
+
+func (m *basicProcessManager) Query(ctx context.Context, q options.ProcessQuery) ([]Process, error) {
+	if err := q.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid query")
+	}
+
+	out := []Process{}
+	for _, proc := range m.procs {
+		if ctx.Err() != nil {
+			return nil, errors.WithStack(ctx.Err())
+		}
+
+		cctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		info := proc.Info(cctx)
+		cancel()
+		if MatchesProcessQuery(info, q) {
 			out = append(out, proc)
 		}
 	}
@@ -170,6 +184,7 @@ func (m *basicProcessManager) Clear(ctx context.Context) {
 	for procID, proc := range m.procs {
 		if proc.Complete(ctx) {
 			delete(m.procs, procID)
+			closeOutputCapture(ctx, proc)
 			grip.Warning(ctx, message.WrapError(m.loggers.Remove(procID), message.Fields{
 				"message": "problem clearing caching logger for process",
 				"process": proc.ID(),
@@ -192,6 +207,17 @@ func (m *basicProcessManager) Close(ctx context.Context) error {
 	defer cancel()
 
 	if m.tracker != nil {
+		// The tracker kills the processes directly, so the processes that
+		// kill their whole tree are killed first to record the descendants
+		// that they kill.
+		var killTreeProcs []Process
+		for _, proc := range procs {
+			if proc.Info(ctx).Options.KillTree {
+				killTreeProcs = append(killTreeProcs, proc)
+			}
+		}
+		grip.Warning(ctx, errors.Wrap(KillAll(termCtx, killTreeProcs), "killing process trees"))
+
 		if err := m.tracker.Cleanup(); err != nil {
 			grip.Warning(ctx, errors.Wrap(err, "cleaning up tracked processes"))
 		} else {
@@ -0,0 +1,104 @@
+package jasper
+
+import (
+	"context"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+type historyManager struct {
+	Manager
+	history ProcessHistory
+}
+
+// MakeHistoryManager wraps the given manager so that the info of the processes
+// that it creates or registers is recorded in the process history once they
+// complete. Processes are therefore still recorded after the manager has
+// cleared them or the service running the manager has restarted. The returned
+// manager is thread-safe if the given manager is thread-safe.
+func MakeHistoryManager(mngr Manager, history ProcessHistory) Manager {
+	return &historyManager{
+		Manager: mngr,
+		history: history,
+	}
+}
+
+func (m *historyManager) CreateProcess(ctx context.Context, opts *options.Create) (Process, error) {
+	proc, err := m.Manager.CreateProcess(ctx, opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	m.recordOnCompletion(ctx, proc)
+
+	return proc, nil
+}
+
+func (m *historyManager) CreateCommand(ctx context.Context) *Command {
+	return NewCommand().ProcConstructor(m.CreateProcess)
+}
+
+func (m *historyManager) Register(ctx context.Context, proc Process) error {
+	if err := m.Manager.Register(ctx, proc); err != nil {
+		return errors.WithStack(err)
+	}
+
+	m.recordOnCompletion(ctx, proc)
+
+	return nil
+}
+
+// Clear records all completed processes before clearing them from the
+// manager.
+func (m *historyManager) Clear(ctx context.Context) {
+	procs, err := m.Manager.List(ctx, options.Terminated)
+	if err != nil {
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not list completed processes to record before clearing them",
+			"manager": m.ID(),
+		}))
+	}
+	for _, proc := range procs {
+		if info := proc.Info(ctx); info.Complete {
+			m.record(ctx, info)
+		}
+	}
+
+	m.Manager.Clear(ctx)
+}
+
+func (m *historyManager) History(context.Context) ProcessHistory {
+	return m.history
+}
+
+// recordOnCompletion records the process in the history once it completes.
+func (m *historyManager) recordOnCompletion(ctx context.Context, proc Process) {
+	if err := proc.RegisterTrigger(ctx, func(info ProcessInfo) {
+		// The context may already be done by the time the process
+		// completes.
+		m.record(context.Background(), info)
+	}); err != nil {
+		// The trigger cannot be registered if the process has already
+		// completed.
+		if info := proc.Info(ctx); info.Complete {
+			m.record(ctx, info)
+			return
+		}
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not register trigger to record process in history",
+			"process": proc.ID(),
+			"manager": m.ID(),
+		}))
+	}
+}
+
+func (m *historyManager) record(ctx context.Context, info ProcessInfo) {
+	grip.Warning(ctx, message.WrapError(m.history.Put(ctx, redactProcessInfo(info)), message.Fields{
+		"message": "could not record process in history",
+		"process": info.ID,
+		"manager": m.ID(),
+	}))
+}
@@ -0,0 +1,154 @@
+package jasper
+
+import (
+	"context"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	testoptions "github.com/mongodb/jasper/testutil/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryManager(t *testing.T) {
+	for mngrName, makeMngr := range map[string]func(t *testing.T) Manager{
+		"Basic": func(t *testing.T) Manager {
+			mngr, err := newBasicProcessManager(map[string]Process{}, false)
+			require.NoError(t, err)
+			return mngr
+		},
+		"Synchronized": func(t *testing.T) Manager {
+			mngr, err := NewSynchronizedManager(false)
+			require.NoError(t, err)
+			return mngr
+		},
+		"SelfClearing": func(t *testing.T) Manager {
+			mngr, err := NewSelfClearingProcessManager(2, false)
+			require.NoError(t, err)
+			return mngr
+		},
+	} {
+		t.Run(mngrName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory){
+				"HistoryReturnsStore": func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory) {
+					assert.Equal(t, history, mngr.History(ctx))
+				},
+				"CompletedProcessIsRecorded": func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory) {
+					opts := testoptions.FalseCreateOpts()
+					opts.Tags = []string{"foo"}
+					proc, err := mngr.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.Error(t, err)
+
+					infos, err := history.Find(ctx, options.HistoryQuery{Tags: []string{"foo"}})
+					require.NoError(t, err)
+					require.Len(t, infos, 1)
+					assert.Equal(t, proc.ID(), infos[0].ID)
+					assert.True(t, infos[0].Complete)
+					assert.False(t, infos[0].Successful)
+					assert.Equal(t, opts.Args, infos[0].Options.Args)
+				},
+				"RemoteCredentialsAreNotRecorded": func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory) {
+					opts := &options.Create{
+						Args: []string{"true"},
+						Remote: &options.Remote{
+							Host:          "localhost",
+							Args:          []string{"-o", "BatchMode=yes", "-p", "1"},
+							Key:           "key",
+							KeyPassphrase: "passphrase",
+							Password:      "password",
+						},
+					}
+					proc, err := mngr.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					_, _ = proc.Wait(ctx)
+
+					infos, err := history.Find(ctx, options.HistoryQuery{})
+					require.NoError(t, err)
+					require.Len(t, infos, 1)
+					require.NotNil(t, infos[0].Options.Remote)
+					assert.Equal(t, "localhost", infos[0].Options.Remote.Host)
+					assert.Empty(t, infos[0].Options.Remote.Key)
+					assert.Empty(t, infos[0].Options.Remote.KeyPassphrase)
+					assert.Empty(t, infos[0].Options.Remote.Password)
+				},
+				"RunningProcessIsNotRecorded": func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory) {
+					proc, err := mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(10))
+					require.NoError(t, err)
+
+					infos, err := history.Find(ctx, options.HistoryQuery{})
+					require.NoError(t, err)
+					assert.Empty(t, infos)
+
+					require.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+					_, _ = proc.Wait(ctx)
+				},
+				"ProcessIsRecordedAfterClear": func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory) {
+					proc, err := mngr.CreateProcess(ctx, testoptions.TrueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					mngr.Clear(ctx)
+					procs, err := mngr.List(ctx, options.All)
+					require.NoError(t, err)
+					assert.Empty(t, procs)
+
+					infos, err := history.Find(ctx, options.HistoryQuery{})
+					require.NoError(t, err)
+					require.Len(t, infos, 1)
+					assert.Equal(t, proc.ID(), infos[0].ID)
+				},
+				"CompletedRegisteredProcessIsRecorded": func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory) {
+					proc, err := newBasicProcess(ctx, testoptions.TrueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					require.NoError(t, mngr.Register(ctx, proc))
+
+					infos, err := history.Find(ctx, options.HistoryQuery{})
+					require.NoError(t, err)
+					require.Len(t, infos, 1)
+					assert.Equal(t, proc.ID(), infos[0].ID)
+				},
+				"CommandProcessesAreRecorded": func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory) {
+					require.NoError(t, mngr.CreateCommand(ctx).Extend([][]string{{"true"}, {"true"}}).Run(ctx))
+
+					infos, err := history.Find(ctx, options.HistoryQuery{Status: options.Successful})
+					require.NoError(t, err)
+					assert.Len(t, infos, 2)
+				},
+				"ProcessesEvictedBySelfClearingAreRecorded": func(ctx context.Context, t *testing.T, mngr Manager, history ProcessHistory) {
+					for i := 0; i < 5; i++ {
+						proc, err := mngr.CreateProcess(ctx, testoptions.TrueCreateOpts())
+						require.NoError(t, err)
+						_, err = proc.Wait(ctx)
+						require.NoError(t, err)
+					}
+
+					infos, err := history.Find(ctx, options.HistoryQuery{})
+					require.NoError(t, err)
+					assert.Len(t, infos, 5)
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+					defer cancel()
+
+					history, err := NewBoltProcessHistory(filepath.Join(t.TempDir(), "history.db"))
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, history.Close())
+					}()
+
+					testCase(ctx, t, MakeHistoryManager(makeMngr(t), history), history)
+				})
+			}
+		})
+	}
+}
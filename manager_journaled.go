@@ -0,0 +1,289 @@
+package jasper
+
+import (
+	"context"
+	"os"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+type journaledManager struct {
+	Manager
+	journal ProcessJournal
+}
+
+// MakeJournaledManager wraps the given manager so that the processes that it
+// creates or registers are recorded in the process journal for as long as
+// they run, along with their tags.
+//
+// Before returning, it recovers the processes that previous managers
+// recorded in the journal, for example before the service running the
+// manager restarted. Processes that are still running are found by the
+// ManagerEnvironID set in their environment and are registered with the
+// given manager as adopted processes, which keep their original IDs and tags.
+// Since adopted processes are not children of the current process, their
+// state is determined by polling, so their exit codes are unknown and they
+// cannot be respawned. Recovery is only supported on Linux.
+func MakeJournaledManager(ctx context.Context, mngr Manager, journal ProcessJournal) (Manager, error) {
+	m := &journaledManager{
+		Manager: mngr,
+		journal: journal,
+	}
+	if err := m.recover(ctx); err != nil {
+		return nil, errors.Wrap(err, "recovering processes from journal")
+	}
+
+	return m, nil
+}
+
+func (m *journaledManager) CreateProcess(ctx context.Context, opts *options.Create) (Process, error) {
+	proc, err := m.Manager.CreateProcess(ctx, opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	m.record(ctx, proc)
+
+	return m.wrap(proc), nil
+}
+
+func (m *journaledManager) CreateCommand(ctx context.Context) *Command {
+	return NewCommand().ProcConstructor(m.CreateProcess)
+}
+
+func (m *journaledManager) Register(ctx context.Context, proc Process) error {
+	if err := m.Manager.Register(ctx, proc); err != nil {
+		return errors.WithStack(err)
+	}
+
+	m.record(ctx, proc)
+
+	return nil
+}
+
+func (m *journaledManager) List(ctx context.Context, f options.Filter) ([]Process, error) {
+	procs, err := m.Manager.List(ctx, f)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return m.wrapAll(procs), nil
+}
+
+func (m *journaledManager) Query(ctx context.Context, q options.ProcessQuery) ([]Process, error) {
+	procs, err := m.Manager.Query(ctx, q)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return m.wrapAll(procs), nil
+}
+
+func (m *journaledManager) Group(ctx context.Context, name string) ([]Process, error) {
+	procs, err := m.Manager.Group(ctx, name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return m.wrapAll(procs), nil
+}
+
+func (m *journaledManager) Get(ctx context.Context, id string) (Process, error) {
+	proc, err := m.Manager.Get(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return m.wrap(proc), nil
+}
+
+// record adds the process to the journal while it runs.
+func (m *journaledManager) record(ctx context.Context, proc Process) {
+	info := proc.Info(ctx)
+	if info.Complete {
+		return
+	}
+
+	grip.Warning(ctx, message.WrapError(m.journal.Put(ctx, redactProcessInfo(info)), message.Fields{
+		"message": "could not record process in journal",
+		"process": proc.ID(),
+		"manager": m.ID(),
+	}))
+
+	if err := proc.RegisterTrigger(ctx, func(info ProcessInfo) {
+		// The context may already be done by the time the process
+		// completes.
+		m.remove(context.Background(), info.ID)
+	}); err != nil {
+		// The trigger cannot be registered if the process has already
+		// completed.
+		if proc.Complete(ctx) {
+			m.remove(ctx, proc.ID())
+			return
+		}
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not register trigger to remove process from journal",
+			"process": proc.ID(),
+			"manager": m.ID(),
+		}))
+	}
+}
+
+func (m *journaledManager) remove(ctx context.Context, id string) {
+	grip.Warning(ctx, message.WrapError(m.journal.Remove(ctx, id), message.Fields{
+		"message": "could not remove process from journal",
+		"process": id,
+		"manager": m.ID(),
+	}))
+}
+
+func (m *journaledManager) wrap(proc Process) Process {
+	return &journaledProcess{Process: proc, journal: m.journal}
+}
+
+func (m *journaledManager) wrapAll(procs []Process) []Process {
+	out := make([]Process, 0, len(procs))
+	for _, proc := range procs {
+		out = append(out, m.wrap(proc))
+	}
+	return out
+}
+
+// recover adopts the running processes that are recorded in the journal and
+// removes the records of the processes that have exited.
+func (m *journaledManager) recover(ctx context.Context) error {
+	records, err := m.journal.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "listing journaled processes")
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	managerIDs := map[string]bool{}
+	journaled := map[string]ProcessInfo{}
+	for _, info := range records {
+		if managerID := info.Options.Environment[ManagerEnvironID]; managerID != "" {
+			managerIDs[managerID] = true
+		}
+		journaled[info.ID] = info
+	}
+
+	procs, err := findManagedProcesses(managerIDs)
+	if err != nil {
+		return errors.Wrap(err, "finding running processes")
+	}
+	// Descendants of a managed process inherit its environment, so group
+	// them by process ID to find the process that was actually started by
+	// the manager.
+	running := map[string][]managedProcess{}
+	for _, proc := range procs {
+		id := proc.env[EnvironID]
+		running[id] = append(running[id], proc)
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for id := range journaled {
+		if _, ok := running[id]; !ok {
+			catcher.Wrapf(m.journal.Remove(ctx, id), "removing exited process '%s' from journal", id)
+		}
+	}
+
+	for id, candidates := range running {
+		info, ok := journaled[id]
+		root := findRootManagedProcess(candidates, info.PID)
+		if !ok {
+			info = ProcessInfo{
+				ID: id,
+				Options: options.Create{
+					Args: root.args,
+					Environment: map[string]string{
+						EnvironID:        id,
+						ManagerEnvironID: root.env[ManagerEnvironID],
+					},
+				},
+			}
+			info.StartAt, _ = processStartTime(root.startTicks)
+		}
+		info.PID = root.pid
+		if info.Host == "" {
+			info.Host, _ = os.Hostname()
+		}
+
+		proc := newAdoptedProcess(info, root.startTicks)
+		if err := m.Manager.Register(ctx, proc); err != nil {
+			catcher.Wrapf(err, "registering adopted process '%s'", id)
+			continue
+		}
+		m.record(ctx, proc)
+
+		grip.Info(ctx, message.Fields{
+			"message": "adopted running process",
+			"process": id,
+			"pid":     root.pid,
+			"manager": m.ID(),
+		})
+	}
+
+	return catcher.Resolve()
+}
+
+// findRootManagedProcess returns the process among the candidates with the
+// same process ID that was started by the manager. This is the process with
+// the journaled PID, if any, or otherwise the process whose parent is not
+// also a candidate.
+func findRootManagedProcess(candidates []managedProcess, journaledPID int) managedProcess {
+	pids := map[int]bool{}
+	for _, proc := range candidates {
+		if proc.pid == journaledPID {
+			return proc
+		}
+		pids[proc.pid] = true
+	}
+	for _, proc := range candidates {
+		if !pids[proc.ppid] {
+			return proc
+		}
+	}
+	return candidates[0]
+}
+
+// journaledProcess is a process that updates its record in the journal when
+// its tags change.
+type journaledProcess struct {
+	Process
+	journal ProcessJournal
+}
+
+func (p *journaledProcess) Tag(t string) {
+	p.Process.Tag(t)
+	p.update()
+}
+
+func (p *journaledProcess) ResetTags() {
+	p.Process.ResetTags()
+	p.update()
+}
+
+func (p *journaledProcess) update() {
+	ctx := context.Background()
+	info := p.Process.Info(ctx)
+	if info.Complete {
+		return
+	}
+
+	grip.Warning(ctx, message.WrapError(p.journal.Put(ctx, redactProcessInfo(info)), message.Fields{
+		"message": "could not update process tags in journal",
+		"process": info.ID,
+	}))
+}
+
+func (p *journaledProcess) outputCapture() *outputCapture {
+	if cp, ok := p.Process.(capturingProcess); ok {
+		return cp.outputCapture()
+	}
+	return nil
+}
+
+func (p *journaledProcess) setRestartLineage(lineage restartLineage) {
+	setRestartLineage(p.Process, lineage)
+}
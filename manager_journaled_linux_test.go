@@ -0,0 +1,228 @@
+package jasper
+
+import (
+	"context"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	testoptions "github.com/mongodb/jasper/testutil/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournaledManagerRecovery(t *testing.T) {
+	// makeManager simulates a (re)start of the service by creating a new
+	// manager around the same journal.
+	makeManager := func(ctx context.Context, t *testing.T, journal ProcessJournal) Manager {
+		basicMngr, err := newBasicProcessManager(map[string]Process{}, false)
+		require.NoError(t, err)
+		mngr, err := MakeJournaledManager(ctx, basicMngr, journal)
+		require.NoError(t, err)
+		return mngr
+	}
+	createProc := func(ctx context.Context, t *testing.T, mngr Manager, opts *options.Create) Process {
+		proc, err := mngr.CreateProcess(ctx, opts)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			// The process may exit before it is signaled.
+			_ = proc.Signal(ctx, syscall.SIGKILL)
+			_, _ = proc.Wait(ctx)
+		})
+		return proc
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, journal ProcessJournal){
+		"EmptyJournalRecoversNothing": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			mngr := makeManager(ctx, t, journal)
+			procs, err := mngr.List(ctx, options.All)
+			require.NoError(t, err)
+			assert.Empty(t, procs)
+		},
+		"RunningProcessesAreJournaled": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			mngr := makeManager(ctx, t, journal)
+			proc := createProc(ctx, t, mngr, testoptions.SleepCreateOpts(10))
+			proc.Tag("foo")
+
+			infos, err := journal.List(ctx)
+			require.NoError(t, err)
+			require.Len(t, infos, 1)
+			assert.Equal(t, proc.ID(), infos[0].ID)
+			assert.Equal(t, []string{"foo"}, infos[0].Options.Tags)
+
+			require.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+			_, err = proc.Wait(ctx)
+			require.Error(t, err)
+
+			infos, err = journal.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, infos)
+		},
+		"RunningProcessIsAdoptedWithTags": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			oldMngr := makeManager(ctx, t, journal)
+			opts := testoptions.SleepCreateOpts(10)
+			opts.Tags = []string{"foo"}
+			proc := createProc(ctx, t, oldMngr, opts)
+			oldProc, err := oldMngr.Get(ctx, proc.ID())
+			require.NoError(t, err)
+			oldProc.Tag("bar")
+
+			mngr := makeManager(ctx, t, journal)
+			adopted, err := mngr.Get(ctx, proc.ID())
+			require.NoError(t, err)
+			assert.True(t, adopted.Running(ctx))
+			assert.ElementsMatch(t, []string{"foo", "bar"}, adopted.GetTags())
+			info := adopted.Info(ctx)
+			assert.Equal(t, proc.Info(ctx).PID, info.PID)
+			assert.Equal(t, opts.Args, info.Options.Args)
+			assert.Equal(t, oldMngr.ID(), info.Options.Environment[ManagerEnvironID])
+
+			procs, err := mngr.Group(ctx, "bar")
+			require.NoError(t, err)
+			assert.Len(t, procs, 1)
+		},
+		"AdoptedProcessCanBeSignaledAndWaitedOn": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			oldMngr := makeManager(ctx, t, journal)
+			proc := createProc(ctx, t, oldMngr, testoptions.SleepCreateOpts(10))
+
+			mngr := makeManager(ctx, t, journal)
+			adopted, err := mngr.Get(ctx, proc.ID())
+			require.NoError(t, err)
+
+			triggered := make(chan ProcessInfo, 1)
+			require.NoError(t, adopted.RegisterTrigger(ctx, func(info ProcessInfo) {
+				triggered <- info
+			}))
+
+			require.NoError(t, adopted.Signal(ctx, syscall.SIGKILL))
+			exitCode, err := adopted.Wait(ctx)
+			assert.Error(t, err)
+			assert.Equal(t, -1, exitCode)
+			assert.True(t, adopted.Complete(ctx))
+			assert.Error(t, adopted.Signal(ctx, syscall.SIGKILL))
+
+			select {
+			case info := <-triggered:
+				assert.True(t, info.Complete)
+				assert.False(t, info.EndAt.IsZero())
+			case <-ctx.Done():
+				assert.Fail(t, "trigger did not run before context was done")
+			}
+
+			infos, err := journal.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, infos)
+		},
+		"AdoptedProcessCannotBeRespawnedOrResized": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			oldMngr := makeManager(ctx, t, journal)
+			proc := createProc(ctx, t, oldMngr, testoptions.SleepCreateOpts(10))
+
+			mngr := makeManager(ctx, t, journal)
+			adopted, err := mngr.Get(ctx, proc.ID())
+			require.NoError(t, err)
+
+			_, err = adopted.Respawn(ctx)
+			assert.Error(t, err)
+			assert.Error(t, adopted.Resize(ctx, 50, 132))
+		},
+		"ExitedProcessIsRemovedFromJournal": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			require.NoError(t, journal.Put(ctx, ProcessInfo{
+				ID:        "foo",
+				PID:       -1,
+				IsRunning: true,
+				Options: options.Create{
+					Args:        []string{"sleep", "10"},
+					Environment: map[string]string{ManagerEnvironID: "bar"},
+				},
+			}))
+
+			mngr := makeManager(ctx, t, journal)
+			procs, err := mngr.List(ctx, options.All)
+			require.NoError(t, err)
+			assert.Empty(t, procs)
+
+			infos, err := journal.List(ctx)
+			require.NoError(t, err)
+			assert.Empty(t, infos)
+		},
+		"UnjournaledProcessOfPreviousManagerIsAdopted": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			oldMngr := makeManager(ctx, t, journal)
+			journaledProc := createProc(ctx, t, oldMngr, testoptions.SleepCreateOpts(10))
+			unjournaledProc := createProc(ctx, t, oldMngr, testoptions.SleepCreateOpts(20))
+			require.NoError(t, journal.Remove(ctx, unjournaledProc.ID()))
+
+			mngr := makeManager(ctx, t, journal)
+			procs, err := mngr.List(ctx, options.All)
+			require.NoError(t, err)
+			assert.Len(t, procs, 2)
+
+			_, err = mngr.Get(ctx, journaledProc.ID())
+			require.NoError(t, err)
+			adopted, err := mngr.Get(ctx, unjournaledProc.ID())
+			require.NoError(t, err)
+			info := adopted.Info(ctx)
+			assert.Equal(t, unjournaledProc.Info(ctx).PID, info.PID)
+			assert.Equal(t, []string{"sleep", "20"}, info.Options.Args)
+			assert.WithinDuration(t, unjournaledProc.Info(ctx).StartAt, info.StartAt, 2*time.Second)
+
+			infos, err := journal.List(ctx)
+			require.NoError(t, err)
+			assert.Len(t, infos, 2)
+		},
+		"DescendantsAreNotAdoptedSeparately": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			oldMngr := makeManager(ctx, t, journal)
+			proc := createProc(ctx, t, oldMngr, &options.Create{
+				Args: []string{"sh", "-c", "sleep 10; true"},
+			})
+			require.Eventually(t, func() bool {
+				return len(listChildren(proc.Info(ctx).PID)) > 0
+			}, testutil.TestTimeout, 10*time.Millisecond)
+			// Without a journal record, the process must be identified from
+			// the process tree.
+			require.NoError(t, journal.Remove(ctx, proc.ID()))
+			// Keep a record of the previous manager in the journal.
+			createProc(ctx, t, oldMngr, testoptions.SleepCreateOpts(10))
+
+			mngr := makeManager(ctx, t, journal)
+			adopted, err := mngr.Get(ctx, proc.ID())
+			require.NoError(t, err)
+			assert.Equal(t, proc.Info(ctx).PID, adopted.Info(ctx).PID)
+
+			procs, err := mngr.List(ctx, options.All)
+			require.NoError(t, err)
+			assert.Len(t, procs, 2)
+		},
+		"RecoveredManagerJournalsNewProcesses": func(ctx context.Context, t *testing.T, journal ProcessJournal) {
+			oldMngr := makeManager(ctx, t, journal)
+			createProc(ctx, t, oldMngr, testoptions.SleepCreateOpts(10))
+
+			mngr := makeManager(ctx, t, journal)
+			proc := createProc(ctx, t, mngr, testoptions.SleepCreateOpts(10))
+
+			infos, err := journal.List(ctx)
+			require.NoError(t, err)
+			ids := []string{}
+			for _, info := range infos {
+				ids = append(ids, info.ID)
+			}
+			assert.Len(t, ids, 2)
+			assert.Contains(t, ids, proc.ID())
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+			defer cancel()
+
+			journal, err := NewBoltProcessJournal(filepath.Join(t.TempDir(), "journal.db"))
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, journal.Close())
+			}()
+
+			testCase(ctx, t, journal)
+		})
+	}
+}
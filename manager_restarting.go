@@ -0,0 +1,79 @@
+package jasper
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+type restartingManager struct {
+	Manager
+	mu       sync.RWMutex
+	restarts processRestarter
+}
+
+// MakeRestartingManager wraps the given manager so that the processes that it
+// creates are restarted according to the restart policies in their options
+// until they are signaled or the manager is closed. Restarted processes are
+// created through the wrapped manager, so it should wrap every manager that
+// must also see the restarted processes, such as a journaled, history or
+// admission manager. Synchronized managers that it wraps do not also restart
+// the processes. The returned manager is thread-safe if the given manager is
+// thread-safe.
+func MakeRestartingManager(mngr Manager) Manager {
+	return &restartingManager{Manager: mngr}
+}
+
+func (m *restartingManager) CreateProcess(ctx context.Context, opts *options.Create) (Process, error) {
+	return m.createProcess(ctx, opts, restartLineage{})
+}
+
+func (m *restartingManager) CreateCommand(ctx context.Context) *Command {
+	return NewCommand().ProcConstructor(m.CreateProcess)
+}
+
+func (m *restartingManager) createProcess(ctx context.Context, opts *options.Create, lineage restartLineage) (Process, error) {
+	ctx, supervise := superviseRestarts(ctx, opts)
+	if lineage.count > 0 {
+		ctx = withRestartLineage(ctx, lineage)
+	}
+	proc, err := m.Manager.CreateProcess(ctx, opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if lineage.count > 0 {
+		setRestartLineage(proc, lineage)
+	}
+	if supervise {
+		m.restarts.supervise(ctx, proc, lineage, m.restartProcess)
+	}
+
+	return proc, nil
+}
+
+// restartProcess creates a process to restart a previous process unless the
+// manager is closed.
+func (m *restartingManager) restartProcess(ctx context.Context, opts *options.Create, lineage restartLineage) (Process, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.restarts.isClosed() {
+		return nil, nil
+	}
+
+	return m.createProcess(ctx, opts, lineage)
+}
+
+// Close stops restarting processes and waits for pending restarts before
+// closing the wrapped manager.
+func (m *restartingManager) Close(ctx context.Context) error {
+	m.restarts.close()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return errors.WithStack(m.Manager.Close(ctx))
+}
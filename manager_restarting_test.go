@@ -0,0 +1,122 @@
+package jasper
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestartingManager(t *testing.T) {
+	restartOpts := func() *options.Create {
+		return &options.Create{
+			Args: []string{"sh", "-c", "sleep 0.5; exit 1"},
+			Restart: &options.Restart{
+				Condition:  options.RestartOnFailure,
+				MaxRetries: 2,
+				Backoff:    10 * time.Millisecond,
+				MaxBackoff: 20 * time.Millisecond,
+			},
+		}
+	}
+	// waitForProcs waits until the manager has the given number of processes
+	// and all of them have completed.
+	waitForProcs := func(ctx context.Context, t *testing.T, mngr Manager, numProcs int) {
+		require.Eventually(t, func() bool {
+			procs, err := mngr.List(ctx, options.All)
+			require.NoError(t, err)
+			if len(procs) != numProcs {
+				return false
+			}
+			for _, proc := range procs {
+				if !proc.Info(ctx).Complete {
+					return false
+				}
+			}
+			return true
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("RestartedProcessesAreJournaledAndRecordedInHistory", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+		defer cancel()
+
+		journal, err := NewBoltProcessJournal(filepath.Join(t.TempDir(), "journal.db"))
+		require.NoError(t, err)
+		defer func() {
+			assert.NoError(t, journal.Close())
+		}()
+		history, err := NewBoltProcessHistory(filepath.Join(t.TempDir(), "history.db"))
+		require.NoError(t, err)
+		defer func() {
+			assert.NoError(t, history.Close())
+		}()
+
+		syncMngr, err := NewSynchronizedManager(false)
+		require.NoError(t, err)
+		journaledMngr, err := MakeJournaledManager(ctx, syncMngr, journal)
+		require.NoError(t, err)
+		mngr := MakeRestartingManager(MakeHistoryManager(journaledMngr, history))
+		defer func() {
+			assert.NoError(t, mngr.Close(ctx))
+		}()
+
+		proc, err := mngr.CreateProcess(ctx, restartOpts())
+		require.NoError(t, err)
+
+		var restarted ProcessInfo
+		require.Eventually(t, func() bool {
+			procs, err := mngr.List(ctx, options.Running)
+			require.NoError(t, err)
+			for _, p := range procs {
+				if info := p.Info(ctx); info.RestartCount == 1 {
+					restarted = info
+					return true
+				}
+			}
+			return false
+		}, 10*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{proc.ID()}, restarted.PreviousIDs)
+		infos, err := journal.List(ctx)
+		require.NoError(t, err)
+		var ids []string
+		for _, info := range infos {
+			ids = append(ids, info.ID)
+		}
+		assert.Contains(t, ids, restarted.ID)
+
+		waitForProcs(ctx, t, mngr, 3)
+		require.Eventually(t, func() bool {
+			infos, err := history.Find(ctx, options.HistoryQuery{})
+			require.NoError(t, err)
+			return len(infos) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+		infos, err = journal.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, infos)
+	})
+	t.Run("RestartedProcessesAreAdmitted", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+		defer cancel()
+
+		syncMngr, err := NewSynchronizedManager(false)
+		require.NoError(t, err)
+		admissionMngr, err := MakeAdmissionManager(syncMngr, options.Admission{MaxRunning: 1})
+		require.NoError(t, err)
+		mngr := MakeRestartingManager(admissionMngr)
+		defer func() {
+			assert.NoError(t, mngr.Close(ctx))
+		}()
+
+		_, err = mngr.CreateProcess(ctx, restartOpts())
+		require.NoError(t, err)
+
+		waitForProcs(ctx, t, mngr, 3)
+		assert.Equal(t, 3, admissionMngr.AdmissionStats().Admitted)
+	})
+}
@@ -25,24 +25,58 @@ func NewSynchronizedManager(trackProcs bool) (Manager, error) {
 }
 
 type synchronizedProcessManager struct {
-	mu      sync.RWMutex
-	manager Manager
+	mu       sync.RWMutex
+	manager  Manager
+	restarts processRestarter
 }
 
 func (m *synchronizedProcessManager) ID() string {
 	return m.manager.ID()
 }
 
+// CreateProcess creates a process with the wrapped manager. If the options
+// have a restart policy, the process is restarted according to it until the
+// process is signaled or the manager is closed, unless a manager that wraps
+// this one already restarts it.
 func (m *synchronizedProcessManager) CreateProcess(ctx context.Context, opts *options.Create) (Process, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	return m.createProcess(ctx, opts, restartLineage{})
+}
+
+func (m *synchronizedProcessManager) createProcess(ctx context.Context, opts *options.Create, lineage restartLineage) (Process, error) {
+	ctx, supervise := superviseRestarts(ctx, opts)
+	if lineage.count > 0 {
+		ctx = withRestartLineage(ctx, lineage)
+	}
 	proc, err := m.manager.CreateProcess(ctx, opts)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	proc = makeSynchronizedProcess(proc)
 
-	return makeSynchronizedProcess(proc), nil
+	if lineage.count > 0 {
+		setRestartLineage(proc, lineage)
+	}
+	if supervise {
+		m.restarts.supervise(ctx, proc, lineage, m.restartProcess)
+	}
+
+	return proc, nil
+}
+
+// restartProcess creates a process to restart a previous process unless the
+// manager is closed.
+func (m *synchronizedProcessManager) restartProcess(ctx context.Context, opts *options.Create, lineage restartLineage) (Process, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.restarts.isClosed() {
+		return nil, nil
+	}
+
+	return m.createProcess(ctx, opts, lineage)
 }
 
 func (m *synchronizedProcessManager) CreateCommand(ctx context.Context) *Command {
@@ -75,6 +109,26 @@ func (m *synchronizedProcessManager) List(ctx context.Context, f options.Filter)
 	return syncedProcs, errors.WithStack(err)
 }
 
+func (m *synchronizedProcessManager) Query(ctx context.Context, q options.ProcessQuery) ([]Process, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	procs, err := m.manager.Query(ctx, q)
+	var syncedProcs []Process
+	for _, proc := range procs {
+		syncedProcs = append(syncedProcs, &synchronizedProcess{proc: proc})
+	}
+	return syncedProcs, errors.WithStack(err)
+}
+
+func (m *synchronizedProcessManager) Subscribe(ctx context.Context, f options.ProcessEventFilter) (<-chan ProcessEvent, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	events, err := m.manager.Subscribe(ctx, f)
+	return events, errors.WithStack(err)
+}
+
 func (m *synchronizedProcessManager) Get(ctx context.Context, id string) (Process, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -93,7 +147,10 @@ func (m *synchronizedProcessManager) Clear(ctx context.Context) {
 	m.manager.Clear(ctx)
 }
 
+// Close stops restarting processes before closing the wrapped manager.
 func (m *synchronizedProcessManager) Close(ctx context.Context) error {
+	m.restarts.close()
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -119,6 +176,13 @@ func (m *synchronizedProcessManager) LoggingCache(ctx context.Context) LoggingCa
 	return m.manager.LoggingCache(ctx)
 }
 
+func (m *synchronizedProcessManager) History(ctx context.Context) ProcessHistory {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.manager.History(ctx)
+}
+
 func (m *synchronizedProcessManager) WriteFile(ctx context.Context, opts options.WriteFile) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -2,6 +2,7 @@ package jasper
 
 import (
 	"context"
+	"path/filepath"
 	"runtime"
 	"testing"
 
@@ -41,6 +42,18 @@ func TestManagerImplementations(t *testing.T) {
 			require.NoError(t, err)
 			return NewRemoteManager(m, nil)
 		},
+		"JournaledManager": func(ctx context.Context, t *testing.T) Manager {
+			journal, err := NewBoltProcessJournal(filepath.Join(t.TempDir(), "journal.db"))
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				assert.NoError(t, journal.Close())
+			})
+			synchronizedManager, err := NewSynchronizedManager(false)
+			require.NoError(t, err)
+			journaledManager, err := MakeJournaledManager(ctx, synchronizedManager, journal)
+			require.NoError(t, err)
+			return journaledManager
+		},
 	} {
 		testCases := append(ManagerTests(), []ManagerTestCase{
 			{
@@ -136,6 +149,23 @@ func TestManagerImplementations(t *testing.T) {
 					assert.Error(t, err)
 				},
 			},
+			{
+				Name: "GetCapturedOutputFromManagedProcess",
+				Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+					opts := modifyOpts(&options.Create{Args: []string{"echo", "foo"}})
+					opts.Output.Capture = &options.OutputCapture{}
+					proc, err := mngr.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					proc, err = mngr.Get(ctx, proc.ID())
+					require.NoError(t, err)
+					out, err := GetCapturedOutput(ctx, proc, options.OutputQuery{})
+					require.NoError(t, err)
+					assert.Equal(t, "foo\n", string(out.Data))
+				},
+			},
 			{
 				Name: "ManagerCallsOptionsCloseByDefault",
 				Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
@@ -15,16 +15,20 @@ type Manager struct {
 	FailCreate      bool
 	FailRegister    bool
 	FailList        bool
+	FailQuery       bool
 	FailGroup       bool
 	FailGet         bool
 	FailClose       bool
 	NilLoggingCache bool
 	FailWriteFile   bool
+	FailSubscribe   bool
 	Create          func(*options.Create) Process
 	CreateConfig    Process
 	ManagerID       string
 	Procs           []jasper.Process
 	LoggingCacheVal jasper.LoggingCache
+	HistoryVal      jasper.ProcessHistory
+	Events          []jasper.ProcessEvent
 
 	// WriteFile input
 	WriteFileOptions options.WriteFile
@@ -79,6 +83,36 @@ func (m *Manager) LoggingCache(ctx context.Context) jasper.LoggingCache {
 	return m.LoggingCacheVal
 }
 
+// History returns the implementation's process history.
+func (m *Manager) History(ctx context.Context) jasper.ProcessHistory {
+	return m.HistoryVal
+}
+
+// Subscribe returns a channel that receives the events in Events that match
+// the given filter. The channel is closed once the context is done. If
+// FailSubscribe is set, it returns an error.
+func (m *Manager) Subscribe(ctx context.Context, f options.ProcessEventFilter) (<-chan jasper.ProcessEvent, error) {
+	if m.FailSubscribe {
+		return nil, mockFail()
+	}
+	if err := f.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid filter")
+	}
+
+	events := make(chan jasper.ProcessEvent, len(m.Events))
+	for _, event := range m.Events {
+		if jasper.MatchesProcessEventFilter(event, f) {
+			events <- event
+		}
+	}
+	go func() {
+		<-ctx.Done()
+		close(events)
+	}()
+
+	return events, nil
+}
+
 // Register adds the process to Procs. If FailRegister is set, it returns an
 // error.
 func (m *Manager) Register(ctx context.Context, proc jasper.Process) error {
@@ -129,6 +163,26 @@ func (m *Manager) List(ctx context.Context, f options.Filter) ([]jasper.Process,
 	return filteredProcs, nil
 }
 
+// Query returns all processes that match the given query. If FailQuery is
+// set, it returns an error.
+func (m *Manager) Query(ctx context.Context, q options.ProcessQuery) ([]jasper.Process, error) {
+	if m.FailQuery {
+		return nil, mockFail()
+	}
+	if err := q.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid query")
+	}
+
+	matchingProcs := []jasper.Process{}
+	for _, proc := range m.Procs {
+		if jasper.MatchesProcessQuery(proc.Info(ctx), q) {
+			matchingProcs = append(matchingProcs, proc)
+		}
+	}
+
+	return matchingProcs, nil
+}
+
 // Group returns all processses that have the given tag. If FailGroup is set, it
 // returns an error.
 func (m *Manager) Group(ctx context.Context, tag string) ([]jasper.Process, error) {
@@ -5,6 +5,7 @@ import (
 	"syscall"
 
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 )
 
 // Process implements the Process interface with exported fields to
@@ -15,7 +16,10 @@ type Process struct {
 	FailRegisterSignalTrigger   bool
 	FailRegisterSignalTriggerID bool
 	FailSignal                  bool
+	FailResize                  bool
+	FailStop                    bool
 	FailWait                    bool
+	FailWaitReady               bool
 	WaitExitCode                int
 
 	ProcInfo         jasper.ProcessInfo
@@ -23,6 +27,8 @@ type Process struct {
 	SignalTriggers   jasper.SignalTriggerSequence
 	SignalTriggerIDs []jasper.SignalTriggerID
 	Signals          []syscall.Signal
+	Resizes          []options.TTY
+	StopPolicies     []options.StopPolicy
 	Tags             []string
 }
 
@@ -73,6 +79,30 @@ func (p *Process) Signal(ctx context.Context, sig syscall.Signal) error {
 	return nil
 }
 
+// Stop records the stop policies used to stop the process in StopPolicies. If
+// FailStop is set, it returns an error.
+func (p *Process) Stop(ctx context.Context, policy options.StopPolicy) error {
+	if p.FailStop {
+		return mockFail()
+	}
+
+	p.StopPolicies = append(p.StopPolicies, policy)
+
+	return nil
+}
+
+// Resize records the window sizes of the resizes in Resizes. If FailResize is
+// set, it returns an error.
+func (p *Process) Resize(ctx context.Context, rows, cols uint16) error {
+	if p.FailResize {
+		return mockFail()
+	}
+
+	p.Resizes = append(p.Resizes, options.TTY{Rows: rows, Cols: cols})
+
+	return nil
+}
+
 // Wait returns the ExitCode set by the user in ProcInfo. If FailWait is set, it
 // returns exit code -1 and an error.
 func (p *Process) Wait(ctx context.Context) (int, error) {
@@ -83,6 +113,15 @@ func (p *Process) Wait(ctx context.Context) (int, error) {
 	return p.ProcInfo.ExitCode, nil
 }
 
+// WaitReady returns an error if FailWaitReady is set.
+func (p *Process) WaitReady(ctx context.Context) error {
+	if p.FailWaitReady {
+		return mockFail()
+	}
+
+	return nil
+}
+
 // Respawn creates a new Process, which has a copy of all the fields in the
 // current Process. If FailRespawn is set, it returns an error.
 func (p *Process) Respawn(ctx context.Context) (jasper.Process, error) {
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"io"
 
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/options"
@@ -16,7 +17,11 @@ type RemoteManager struct {
 	FailDownloadFile       bool
 	FailDownloadMongoDB    bool
 	FailGetLogStream       bool
+	FailGetCapturedOutput  bool
+	FailFollowLogs         bool
 	FailGetBuildloggerURLs bool
+	FailWriteStdin         bool
+	FailCloseStdin         bool
 	FailSignalEvent        bool
 	FailSendMessages       bool
 
@@ -34,6 +39,21 @@ type RemoteManager struct {
 	LogStreamCount int
 	jasper.LogStream
 
+	// GetCapturedOutput input/output
+	CapturedOutputID    string
+	CapturedOutputQuery options.OutputQuery
+	CapturedOutput      *jasper.CapturedOutput
+
+	// FollowLogs input/output
+	FollowLogsID     string
+	FollowLogsOffset int
+	LogChunks        []jasper.LogChunk
+
+	// WriteStdin/CloseStdin input
+	StdinID     string
+	StdinData   []byte
+	StdinClosed bool
+
 	// GetBuildloggerURLs output
 	BuildloggerURLs []string
 
@@ -111,6 +131,69 @@ func (c *RemoteManager) GetLogStream(ctx context.Context, id string, count int)
 	return c.LogStream, nil
 }
 
+// GetCapturedOutput stores the given process ID and query and returns
+// CapturedOutput. If FailGetCapturedOutput is set, it returns an error.
+func (c *RemoteManager) GetCapturedOutput(ctx context.Context, id string, query options.OutputQuery) (*jasper.CapturedOutput, error) {
+	if c.FailGetCapturedOutput {
+		return nil, mockFail()
+	}
+
+	c.CapturedOutputID = id
+	c.CapturedOutputQuery = query
+
+	return c.CapturedOutput, nil
+}
+
+// FollowLogs stores the given log stream ID and offset and passes each of
+// the LogChunks to the handler. If FailFollowLogs is set, it returns an error.
+func (c *RemoteManager) FollowLogs(ctx context.Context, id string, offset int, handler func(jasper.LogChunk) error) error {
+	if c.FailFollowLogs {
+		return mockFail()
+	}
+
+	c.FollowLogsID = id
+	c.FollowLogsOffset = offset
+
+	for _, chunk := range c.LogChunks {
+		if err := handler(chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteStdin stores the given process ID and appends the input to StdinData.
+// If FailWriteStdin is set, it returns an error.
+func (c *RemoteManager) WriteStdin(ctx context.Context, id string, input io.Reader) error {
+	if c.FailWriteStdin {
+		return mockFail()
+	}
+
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+
+	c.StdinID = id
+	c.StdinData = append(c.StdinData, data...)
+
+	return nil
+}
+
+// CloseStdin stores the given process ID and marks standard input as closed.
+// If FailCloseStdin is set, it returns an error.
+func (c *RemoteManager) CloseStdin(ctx context.Context, id string) error {
+	if c.FailCloseStdin {
+		return mockFail()
+	}
+
+	c.StdinID = id
+	c.StdinClosed = true
+
+	return nil
+}
+
 // SignalEvent stores the given event name. If FailSignalEvent is set, it
 // returns an error.
 func (c *RemoteManager) SignalEvent(ctx context.Context, name string) error {
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -54,3 +55,31 @@ func getPIDFromLog(line string) (int, bool) {
 func logContainsOOMKill(line string) bool {
 	return strings.Contains(line, "low swap")
 }
+
+// getOOMKillFromLog returns the PID of the process that the OOM kill in the
+// given log line killed and the time at which it was killed.
+func getOOMKillFromLog(line string) (int, time.Time, bool) {
+	pid, hasPID := getPIDFromLog(line)
+	if !hasPID {
+		return 0, time.Time{}, false
+	}
+	at, hasTime := getTimeFromLog(line)
+	if !hasTime {
+		return 0, time.Time{}, false
+	}
+	return pid, at, true
+}
+
+// logTimeLayout is the layout of the timestamp at the start of log lines.
+const logTimeLayout = "2006-01-02 15:04:05.000000-0700"
+
+func getTimeFromLog(line string) (time.Time, bool) {
+	if len(line) < len(logTimeLayout) {
+		return time.Time{}, false
+	}
+	at, err := time.Parse(logTimeLayout, line[:len(logTimeLayout)])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return at, true
+}
@@ -2,6 +2,7 @@ package jasper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -12,3 +13,11 @@ func TestGetPIDFromLog(t *testing.T) {
 	assert.True(t, hasPID)
 	assert.Equal(t, 29670, pid)
 }
+
+func TestGetOOMKillFromLog(t *testing.T) {
+	log := "2018-10-03 21:55:21.478932+0000 0x16b Default 0x0 0 kernel: low swap: killing largest compressed process with pid 29670 (mongod) and size 1 MB"
+	pid, at, ok := getOOMKillFromLog(log)
+	assert.True(t, ok)
+	assert.Equal(t, 29670, pid)
+	assert.True(t, time.Date(2018, 10, 3, 21, 55, 21, 478932000, time.UTC).Equal(at))
+}
@@ -4,6 +4,7 @@ package jasper
 
 import (
 	"context"
+	"time"
 )
 
 // These are placeholder implementations for platforms that don't support the
@@ -16,3 +17,7 @@ func (o *oomTrackerImpl) Clear(ctx context.Context) error {
 func (o *oomTrackerImpl) Check(ctx context.Context) error {
 	return nil
 }
+
+func getOOMKillFromLog(string) (int, time.Time, bool) {
+	return 0, time.Time{}, false
+}
@@ -2,10 +2,12 @@ package jasper
 
 import (
 	"context"
+	"os"
 	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -56,3 +58,53 @@ func getPIDFromDmesg(line string) (int, bool) {
 	}
 	return pid, true
 }
+
+// getOOMKillFromLog returns the PID of the process that the OOM kill in the
+// given dmesg line killed and the time at which it was killed.
+func getOOMKillFromLog(line string) (int, time.Time, bool) {
+	pid, hasPID := getPIDFromDmesg(line)
+	if !hasPID {
+		return 0, time.Time{}, false
+	}
+	at, hasTime := getTimeFromDmesg(line)
+	if !hasTime {
+		return 0, time.Time{}, false
+	}
+	return pid, at, true
+}
+
+// getTimeFromDmesg returns the time of the dmesg line from its timestamp,
+// which is the time since the system booted.
+func getTimeFromDmesg(line string) (time.Time, bool) {
+	r := regexp.MustCompile(`^\[\s*(\d+\.\d+)\]`)
+	matches := r.FindStringSubmatch(line)
+	if len(matches) != 2 {
+		return time.Time{}, false
+	}
+	sinceBoot, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	bootTime, err := getBootTime()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return bootTime.Add(time.Duration(sinceBoot * float64(time.Second))), true
+}
+
+// getBootTime returns the time at which the system booted.
+func getBootTime() (time.Time, error) {
+	uptime, err := os.ReadFile("/proc/uptime")
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "reading uptime")
+	}
+	fields := strings.Fields(string(uptime))
+	if len(fields) == 0 {
+		return time.Time{}, errors.New("uptime is empty")
+	}
+	secs, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "parsing uptime")
+	}
+	return time.Now().Add(-time.Duration(secs * float64(time.Second))), nil
+}
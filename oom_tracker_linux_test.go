@@ -1,9 +1,12 @@
 package jasper
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDmesgContainsOOMKill(t *testing.T) {
@@ -19,3 +22,36 @@ func TestDmesgContainsOOMKill(t *testing.T) {
 	assert.True(t, hasPID)
 	assert.Equal(t, 9823, pid)
 }
+
+func TestGetOOMKillFromLog(t *testing.T) {
+	bootTime, err := getBootTime()
+	require.NoError(t, err)
+
+	pid, at, ok := getOOMKillFromLog("[11686.043647] Killed process 2603 (flasherav) total-vm:1498536kB, anon-rss:721784kB, file-rss:4228kB")
+	require.True(t, ok)
+	assert.Equal(t, 2603, pid)
+	assert.WithinDuration(t, bootTime.Add(11686043647*time.Microsecond), at, 100*time.Millisecond)
+
+	_, _, ok = getOOMKillFromLog("Killed process 9823, UID 0, (FlowCon.fresher) total-vm:3098244kB, anon-rss:1157280kB, file-rss:36kB")
+	assert.False(t, ok, "line without a timestamp should not be an OOM kill with a known time")
+}
+
+func TestWasOOMKilled(t *testing.T) {
+	bootTime, err := getBootTime()
+	require.NoError(t, err)
+	dmesgLine := func(at time.Time, pid int) string {
+		return fmt.Sprintf("[%12.6f] Killed process %d (foo) total-vm:1498536kB", at.Sub(bootTime).Seconds(), pid)
+	}
+
+	info := ProcessInfo{
+		PID:     1234,
+		StartAt: time.Now().Add(-time.Minute),
+		EndAt:   time.Now(),
+	}
+	killedAt := info.EndAt.Add(-time.Second)
+
+	assert.True(t, wasOOMKilled(info, []string{dmesgLine(killedAt, info.PID)}))
+	assert.False(t, wasOOMKilled(info, []string{dmesgLine(killedAt, info.PID+1)}), "other process was killed")
+	assert.False(t, wasOOMKilled(info, []string{dmesgLine(info.StartAt.Add(-time.Minute), info.PID)}), "previous process with the same PID was killed")
+	assert.False(t, wasOOMKilled(info, nil), "no process was killed")
+}
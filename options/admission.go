@@ -0,0 +1,42 @@
+package options
+
+import (
+	"github.com/mongodb/grip"
+)
+
+// Admission describes the limits on the number of processes that a manager
+// can run at the same time. Processes that would exceed a limit wait to be
+// started until they are within the limits.
+type Admission struct {
+	// MaxRunning is the maximum number of processes that can run at the same
+	// time. If it is 0, there is no limit.
+	MaxRunning int `bson:"max_running,omitempty" json:"max_running,omitempty" yaml:"max_running,omitempty"`
+	// TagLimits are the maximum number of processes with a given tag that can
+	// run at the same time. A process with multiple limited tags must be
+	// within the limit of each of them.
+	TagLimits map[string]int `bson:"tag_limits,omitempty" json:"tag_limits,omitempty" yaml:"tag_limits,omitempty"`
+}
+
+// Validate ensures that the admission limits are valid.
+func (a *Admission) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(a.MaxRunning < 0, "maximum running processes cannot be negative")
+	catcher.NewWhen(a.MaxRunning == 0 && len(a.TagLimits) == 0, "must specify at least one limit")
+	for tag, limit := range a.TagLimits {
+		catcher.NewWhen(tag == "", "tag limit must specify a tag")
+		catcher.ErrorfWhen(limit <= 0, "limit for tag '%s' must be positive", tag)
+	}
+	return catcher.Resolve()
+}
+
+// Copy returns a copy of the admission limits.
+func (a *Admission) Copy() *Admission {
+	copied := *a
+	if a.TagLimits != nil {
+		copied.TagLimits = make(map[string]int, len(a.TagLimits))
+		for tag, limit := range a.TagLimits {
+			copied.TagLimits[tag] = limit
+		}
+	}
+	return &copied
+}
@@ -0,0 +1,39 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdmission(t *testing.T) {
+	for name, admission := range map[string]Admission{
+		"MaxRunning":            {MaxRunning: 2},
+		"TagLimits":             {TagLimits: map[string]int{"compile": 1}},
+		"MaxRunningAndTagLimit": {MaxRunning: 4, TagLimits: map[string]int{"compile": 2}},
+	} {
+		t.Run(name+"Validates", func(t *testing.T) {
+			assert.NoError(t, admission.Validate())
+		})
+	}
+	for name, admission := range map[string]Admission{
+		"NoLimits":           {},
+		"NegativeMaxRunning": {MaxRunning: -1},
+		"ZeroTagLimit":       {MaxRunning: 1, TagLimits: map[string]int{"compile": 0}},
+		"NegativeTagLimit":   {TagLimits: map[string]int{"compile": -1}},
+		"EmptyTag":           {TagLimits: map[string]int{"": 1}},
+	} {
+		t.Run(name+"DoesNotValidate", func(t *testing.T) {
+			assert.Error(t, admission.Validate())
+		})
+	}
+	t.Run("CopyDoesNotShareTagLimits", func(t *testing.T) {
+		a := Admission{MaxRunning: 2, TagLimits: map[string]int{"compile": 1}}
+		copied := a.Copy()
+		require.Equal(t, a, *copied)
+
+		copied.TagLimits["compile"] = 2
+		assert.Equal(t, 1, a.TagLimits["compile"])
+	})
+}
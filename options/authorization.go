@@ -0,0 +1,235 @@
+package options
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// Role is a set of remote Manager operations that a client is allowed to
+// perform. Each role allows all the operations of the roles before it.
+type Role string
+
+const (
+	// RoleReadOnly allows clients to inspect the manager and its processes,
+	// such as listing processes and reading their logs.
+	RoleReadOnly Role = "read-only"
+	// RoleProcessOperator additionally allows clients to create processes
+	// and to control existing ones, such as signaling them or writing to
+	// their standard input.
+	RoleProcessOperator Role = "process-operator"
+	// RoleAdmin additionally allows clients to operate on the manager and
+	// the host, such as closing the manager, writing files and downloading
+	// files.
+	RoleAdmin Role = "admin"
+)
+
+// rank returns the position of the role in the hierarchy of roles, or -1 if
+// the role is invalid.
+func (r Role) rank() int {
+	switch r {
+	case RoleReadOnly:
+		return 0
+	case RoleProcessOperator:
+		return 1
+	case RoleAdmin:
+		return 2
+	default:
+		return -1
+	}
+}
+
+// Validate checks that the role is one of the defined roles.
+func (r Role) Validate() error {
+	if r.rank() < 0 {
+		return errors.Errorf("unrecognized role '%s'", r)
+	}
+	return nil
+}
+
+// Includes returns whether the role allows all the operations that the other
+// role allows.
+func (r Role) Includes(other Role) bool {
+	return r.rank() >= 0 && r.rank() >= other.rank()
+}
+
+// Authorization describes which remote Manager operations each client is
+// allowed to perform. Clients are identified by the common name of their TLS
+// certificate or by the ID of the token or API key they authenticated with.
+type Authorization struct {
+	// Clients maps client identities to their access.
+	Clients map[string]ClientAccess `bson:"clients,omitempty" json:"clients,omitempty" yaml:"clients,omitempty"`
+	// DefaultAccess, if set, is the access of clients that are not in
+	// Clients, including clients that are not identified. Otherwise, such
+	// clients cannot perform any operations.
+	DefaultAccess *ClientAccess `bson:"default_access,omitempty" json:"default_access,omitempty" yaml:"default_access,omitempty"`
+}
+
+// NewAuthorizationFromFile reads the JSON-encoded authorization from the file
+// at the given path.
+func NewAuthorizationFromFile(path string) (*Authorization, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading authorization file")
+	}
+
+	authz := Authorization{}
+	if err := json.Unmarshal(contents, &authz); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling JSON contents of authorization file")
+	}
+	if err := authz.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid authorization from file")
+	}
+
+	return &authz, nil
+}
+
+// Validate checks that each client's access is valid.
+func (a *Authorization) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(len(a.Clients) == 0 && a.DefaultAccess == nil, "must specify access for at least one client")
+	for id, access := range a.Clients {
+		catcher.NewWhen(id == "", "client identity cannot be empty")
+		catcher.Wrapf(access.Validate(), "invalid access for client '%s'", id)
+	}
+	if a.DefaultAccess != nil {
+		catcher.Wrap(a.DefaultAccess.Validate(), "invalid default access")
+	}
+	return catcher.Resolve()
+}
+
+// Access returns the access of the client with the given identity. It returns
+// an error if the client has no access.
+func (a *Authorization) Access(identity string) (*ClientAccess, error) {
+	if access, ok := a.Clients[identity]; ok {
+		return &access, nil
+	}
+	if a.DefaultAccess != nil {
+		return a.DefaultAccess, nil
+	}
+	if identity == "" {
+		return nil, errors.New("unidentified client is not authorized")
+	}
+	return nil, errors.Errorf("client '%s' is not authorized", identity)
+}
+
+// ClientAccess describes the operations that a client is allowed to perform.
+type ClientAccess struct {
+	// Role is the set of operations that the client can perform.
+	Role Role `bson:"role" json:"role" yaml:"role"`
+	// AllowedPaths, if set, are the absolute paths of the directories in
+	// which the client can write or download files. Otherwise, the client
+	// can write files anywhere that its role allows. Paths are compared
+	// lexically, so a symbolic link in an allowed directory can still point
+	// outside of it.
+	AllowedPaths []string `bson:"allowed_paths,omitempty" json:"allowed_paths,omitempty" yaml:"allowed_paths,omitempty"`
+	// AllowedArgs, if set, are the arguments with which the client can
+	// create processes. A process can be created if its arguments begin with
+	// all the elements of any of the allowed arguments, where each element
+	// is matched as a filepath.Match pattern. For example, [["git",
+	// "status"], ["/usr/bin/*"]] allows the client to run "git status" with
+	// any additional arguments and any executable in /usr/bin. The arguments
+	// of the processes created by triggers must be allowed as well.
+	// Otherwise, the client can create any process that its role allows.
+	AllowedArgs [][]string `bson:"allowed_args,omitempty" json:"allowed_args,omitempty" yaml:"allowed_args,omitempty"`
+}
+
+// Validate checks that the role is valid, that the allowed paths are absolute
+// and that the allowed arguments are valid patterns.
+func (a *ClientAccess) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(a.Role.Validate())
+	for _, path := range a.AllowedPaths {
+		catcher.ErrorfWhen(!filepath.IsAbs(path), "allowed path '%s' must be an absolute path", path)
+	}
+	for _, args := range a.AllowedArgs {
+		catcher.NewWhen(len(args) == 0, "allowed arguments cannot be empty")
+		for _, pattern := range args {
+			_, err := filepath.Match(pattern, "")
+			catcher.Wrapf(err, "invalid allowed argument pattern '%s'", pattern)
+		}
+	}
+	return catcher.Resolve()
+}
+
+// Authorize returns an error if the client's role does not include the
+// required role.
+func (a *ClientAccess) Authorize(required Role) error {
+	if !a.Role.Includes(required) {
+		return errors.Errorf("role '%s' does not allow operations that require role '%s'", a.Role, required)
+	}
+	return nil
+}
+
+// AuthorizePath returns an error if the client is not allowed to write to the
+// given path.
+func (a *ClientAccess) AuthorizePath(path string) error {
+	if len(a.AllowedPaths) == 0 {
+		return nil
+	}
+	if !filepath.IsAbs(path) {
+		return errors.Errorf("path '%s' must be an absolute path", path)
+	}
+
+	path = filepath.Clean(path)
+	for _, allowed := range a.AllowedPaths {
+		rel, err := filepath.Rel(filepath.Clean(allowed), path)
+		if err != nil {
+			continue
+		}
+		if rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil
+		}
+	}
+
+	return errors.Errorf("path '%s' is not in an allowed directory", path)
+}
+
+// AuthorizeArgs returns an error if the client is not allowed to create a
+// process with the given arguments.
+func (a *ClientAccess) AuthorizeArgs(args []string) error {
+	if len(a.AllowedArgs) == 0 {
+		return nil
+	}
+
+	for _, allowed := range a.AllowedArgs {
+		if matchArgs(allowed, args) {
+			return nil
+		}
+	}
+
+	return errors.Errorf("arguments %q are not allowed", args)
+}
+
+// AuthorizeCreate returns an error if the client is not allowed to create a
+// process with the given options, including the processes that its triggers
+// create.
+func (a *ClientAccess) AuthorizeCreate(opts *Create) error {
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(a.AuthorizeArgs(opts.Args))
+	for _, triggers := range [][]*Create{opts.OnSuccess, opts.OnFailure, opts.OnTimeout} {
+		for _, trigger := range triggers {
+			if trigger != nil {
+				catcher.Wrap(a.AuthorizeCreate(trigger), "trigger")
+			}
+		}
+	}
+	return catcher.Resolve()
+}
+
+// matchArgs returns whether the arguments begin with the patterns.
+func matchArgs(patterns, args []string) bool {
+	if len(args) < len(patterns) {
+		return false
+	}
+	for i, pattern := range patterns {
+		if ok, err := filepath.Match(pattern, args[i]); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
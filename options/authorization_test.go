@@ -0,0 +1,191 @@
+package options
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRole(t *testing.T) {
+	t.Run("RolesIncludeLesserRoles", func(t *testing.T) {
+		assert.True(t, RoleAdmin.Includes(RoleProcessOperator))
+		assert.True(t, RoleAdmin.Includes(RoleReadOnly))
+		assert.True(t, RoleProcessOperator.Includes(RoleReadOnly))
+		assert.True(t, RoleReadOnly.Includes(RoleReadOnly))
+		assert.False(t, RoleReadOnly.Includes(RoleProcessOperator))
+		assert.False(t, RoleProcessOperator.Includes(RoleAdmin))
+	})
+	t.Run("InvalidRoleIncludesNothing", func(t *testing.T) {
+		assert.Error(t, Role("foo").Validate())
+		assert.False(t, Role("foo").Includes(RoleReadOnly))
+		assert.False(t, Role("").Includes(Role("foo")))
+	})
+}
+
+func TestAuthorization(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		for testName, testCase := range map[string]struct {
+			authz   Authorization
+			isValid bool
+		}{
+			"SucceedsWithClient": {
+				authz:   Authorization{Clients: map[string]ClientAccess{"foo": {Role: RoleReadOnly}}},
+				isValid: true,
+			},
+			"SucceedsWithDefaultAccess": {
+				authz:   Authorization{DefaultAccess: &ClientAccess{Role: RoleAdmin}},
+				isValid: true,
+			},
+			"FailsWithoutAccess": {},
+			"FailsWithInvalidRole": {
+				authz: Authorization{Clients: map[string]ClientAccess{"foo": {Role: "bar"}}},
+			},
+			"FailsWithEmptyIdentity": {
+				authz: Authorization{Clients: map[string]ClientAccess{"": {Role: RoleAdmin}}},
+			},
+			"FailsWithRelativeAllowedPath": {
+				authz: Authorization{DefaultAccess: &ClientAccess{Role: RoleAdmin, AllowedPaths: []string{"foo"}}},
+			},
+			"FailsWithEmptyAllowedArgs": {
+				authz: Authorization{DefaultAccess: &ClientAccess{Role: RoleAdmin, AllowedArgs: [][]string{{}}}},
+			},
+			"FailsWithInvalidAllowedArgsPattern": {
+				authz: Authorization{DefaultAccess: &ClientAccess{Role: RoleAdmin, AllowedArgs: [][]string{{"["}}}},
+			},
+		} {
+			t.Run(testName, func(t *testing.T) {
+				err := testCase.authz.Validate()
+				if testCase.isValid {
+					assert.NoError(t, err)
+				} else {
+					assert.Error(t, err)
+				}
+			})
+		}
+	})
+	t.Run("Access", func(t *testing.T) {
+		authz := Authorization{Clients: map[string]ClientAccess{"foo": {Role: RoleAdmin}}}
+		access, err := authz.Access("foo")
+		require.NoError(t, err)
+		assert.Equal(t, RoleAdmin, access.Role)
+
+		_, err = authz.Access("bar")
+		assert.Error(t, err)
+		_, err = authz.Access("")
+		assert.Error(t, err)
+
+		authz.DefaultAccess = &ClientAccess{Role: RoleReadOnly}
+		access, err = authz.Access("")
+		require.NoError(t, err)
+		assert.Equal(t, RoleReadOnly, access.Role)
+	})
+	t.Run("NewAuthorizationFromFile", func(t *testing.T) {
+		writeFile := func(t *testing.T, contents string) string {
+			path := filepath.Join(t.TempDir(), "authorization.json")
+			require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+			return path
+		}
+
+		authz, err := NewAuthorizationFromFile(writeFile(t, `{"clients": {"foo": {"role": "admin", "allowed_args": [["echo"]]}}, "default_access": {"role": "read-only"}}`))
+		require.NoError(t, err)
+		assert.Equal(t, ClientAccess{Role: RoleAdmin, AllowedArgs: [][]string{{"echo"}}}, authz.Clients["foo"])
+		require.NotZero(t, authz.DefaultAccess)
+		assert.Equal(t, RoleReadOnly, authz.DefaultAccess.Role)
+
+		_, err = NewAuthorizationFromFile(writeFile(t, `{"clients": {"foo": {"role": "foo"}}}`))
+		assert.Error(t, err)
+		_, err = NewAuthorizationFromFile(writeFile(t, "foo"))
+		assert.Error(t, err)
+		_, err = NewAuthorizationFromFile(filepath.Join(t.TempDir(), "nonexistent.json"))
+		assert.Error(t, err)
+	})
+}
+
+func TestClientAccess(t *testing.T) {
+	t.Run("Authorize", func(t *testing.T) {
+		access := ClientAccess{Role: RoleProcessOperator}
+		assert.NoError(t, access.Authorize(RoleReadOnly))
+		assert.NoError(t, access.Authorize(RoleProcessOperator))
+		assert.Error(t, access.Authorize(RoleAdmin))
+	})
+	t.Run("AuthorizePath", func(t *testing.T) {
+		assert.NoError(t, (&ClientAccess{Role: RoleAdmin}).AuthorizePath("foo"))
+
+		access := ClientAccess{Role: RoleAdmin, AllowedPaths: []string{"/foo/bar", "/baz/"}}
+		for path, allowed := range map[string]bool{
+			"/foo/bar":           true,
+			"/foo/bar/bat":       true,
+			"/baz/qux/file":      true,
+			"/foo/barbat":        false,
+			"/foo":               false,
+			"/foo/bar/../bat":    false,
+			"/foo/bar/../../baz": true,
+			"foo/bar/bat":        false,
+		} {
+			if allowed {
+				assert.NoError(t, access.AuthorizePath(path), path)
+			} else {
+				assert.Error(t, access.AuthorizePath(path), path)
+			}
+		}
+	})
+	t.Run("AuthorizeArgs", func(t *testing.T) {
+		assert.NoError(t, (&ClientAccess{Role: RoleAdmin}).AuthorizeArgs([]string{"foo"}))
+
+		access := ClientAccess{Role: RoleAdmin, AllowedArgs: [][]string{{"git", "status"}, {"/usr/bin/*"}}}
+		for _, testCase := range []struct {
+			args    []string
+			allowed bool
+		}{
+			{args: []string{"git", "status"}, allowed: true},
+			{args: []string{"git", "status", "--long"}, allowed: true},
+			{args: []string{"git", "push"}},
+			{args: []string{"git"}},
+			{args: []string{"/usr/bin/echo", "foo"}, allowed: true},
+			{args: []string{"/usr/bin/sub/echo"}},
+			{args: []string{}},
+		} {
+			if testCase.allowed {
+				assert.NoError(t, access.AuthorizeArgs(testCase.args), testCase.args)
+			} else {
+				assert.Error(t, access.AuthorizeArgs(testCase.args), testCase.args)
+			}
+		}
+	})
+	t.Run("AuthorizeCreate", func(t *testing.T) {
+		access := ClientAccess{Role: RoleProcessOperator, AllowedArgs: [][]string{{"echo"}}}
+		allowed := func() *Create { return &Create{Args: []string{"echo", "foo"}} }
+		disallowed := func() *Create { return &Create{Args: []string{"rm", "foo"}} }
+
+		assert.NoError(t, access.AuthorizeCreate(allowed()))
+		assert.Error(t, access.AuthorizeCreate(disallowed()))
+		assert.NoError(t, (&ClientAccess{Role: RoleProcessOperator}).AuthorizeCreate(&Create{
+			Args:      []string{"rm", "foo"},
+			OnSuccess: []*Create{disallowed()},
+		}))
+
+		for triggerName, addTrigger := range map[string]func(opts, trigger *Create){
+			"OnSuccess": func(opts, trigger *Create) { opts.OnSuccess = append(opts.OnSuccess, trigger) },
+			"OnFailure": func(opts, trigger *Create) { opts.OnFailure = append(opts.OnFailure, trigger) },
+			"OnTimeout": func(opts, trigger *Create) { opts.OnTimeout = append(opts.OnTimeout, trigger) },
+		} {
+			t.Run(triggerName, func(t *testing.T) {
+				opts := allowed()
+				addTrigger(opts, allowed())
+				assert.NoError(t, access.AuthorizeCreate(opts))
+
+				addTrigger(opts, disallowed())
+				assert.Error(t, access.AuthorizeCreate(opts))
+
+				nested := allowed()
+				trigger := allowed()
+				addTrigger(trigger, disallowed())
+				addTrigger(nested, trigger)
+				assert.Error(t, access.AuthorizeCreate(nested))
+			})
+		}
+	})
+}
@@ -2,6 +2,8 @@ package options
 
 import (
 	"context"
+	"math"
+	"time"
 
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/level"
@@ -23,6 +25,11 @@ type Command struct {
 	RunBackground   bool            `json:"run_background,omitempty"`
 	Sudo            bool            `json:"sudo,omitempty"`
 	SudoUser        string          `json:"sudo_user,omitempty"`
+	Retry           *CommandRetry   `json:"retry,omitempty"`
+	OutputTail      int             `json:"output_tail,omitempty"`
+	Graph           *CommandGraph   `json:"graph,omitempty"`
+	MaxParallel     int             `json:"max_parallel,omitempty"`
+	FailFast        bool            `json:"fail_fast,omitempty"`
 	Prerequisite    func() bool     `json:"-"`
 	PostHook        CommandPostHook `json:"-"`
 	PreHook         CommandPreHook  `json:"-"`
@@ -38,10 +45,98 @@ func (opts *Command) Validate() error {
 	}
 	catcher.Add(opts.Process.Validate())
 	catcher.NewWhen(opts.Priority != 0 && !opts.Priority.IsValid(), "invalid priority")
-	catcher.NewWhen(len(opts.Commands) == 0, "must specify at least one command")
+	catcher.NewWhen(len(opts.Commands) == 0 && opts.Graph == nil, "must specify at least one command")
+	catcher.NewWhen(len(opts.Commands) != 0 && opts.Graph != nil, "cannot specify both commands and a graph of steps")
+	catcher.NewWhen(opts.OutputTail < 0, "output tail cannot be negative")
+	catcher.NewWhen(opts.MaxParallel < 0, "max parallel cannot be negative")
+	if opts.Retry != nil {
+		catcher.Wrap(opts.Retry.Validate(), "invalid retry options")
+	}
+	if opts.Graph != nil {
+		catcher.Wrap(opts.Graph.Validate(), "invalid graph")
+	}
+	return catcher.Resolve()
+}
+
+// CommandRetry describes how to retry a subcommand of a jasper.Command that
+// fails. Each attempt creates a new process with the same options. Retries
+// only apply to commands that are not run in the background, and readers set
+// as standard input are not replayed between attempts.
+type CommandRetry struct {
+	// MaxAttempts is the maximum number of times each subcommand is run,
+	// including the first attempt. If it is 0 or 1, subcommands are not
+	// retried.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// Backoff is the delay before the first retry. The delay doubles with each
+	// subsequent retry up to MaxBackoff. If unset, subcommands are retried
+	// immediately.
+	Backoff time.Duration `json:"backoff,omitempty"`
+	// MaxBackoff is the maximum delay between retries. If unset, the delay is
+	// not capped.
+	MaxBackoff time.Duration `json:"max_backoff,omitempty"`
+	// ExitCodes are the exit codes that a failed subcommand is retried on. If
+	// unset, a subcommand that fails with any exit code is retried.
+	ExitCodes []int `json:"exit_codes,omitempty"`
+	// OnTimeout determines whether or not a subcommand that fails because it
+	// exceeded its process timeout is retried.
+	OnTimeout bool `json:"on_timeout,omitempty"`
+}
+
+// Validate ensures that the retry options are valid.
+func (r *CommandRetry) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(r.MaxAttempts < 0, "max attempts cannot be negative")
+	catcher.NewWhen(r.Backoff < 0, "backoff cannot be negative")
+	catcher.NewWhen(r.MaxBackoff < 0, "max backoff cannot be negative")
+	catcher.NewWhen(r.MaxBackoff != 0 && r.MaxBackoff < r.Backoff, "max backoff cannot be less than the backoff")
 	return catcher.Resolve()
 }
 
+// ShouldRetry returns whether or not a subcommand whose given attempt failed
+// with the exit code should be run again.
+func (r *CommandRetry) ShouldRetry(attempt, exitCode int, timedOut bool) bool {
+	if attempt >= r.MaxAttempts {
+		return false
+	}
+	if timedOut {
+		return r.OnTimeout
+	}
+	if len(r.ExitCodes) == 0 {
+		return true
+	}
+	for _, code := range r.ExitCodes {
+		if code == exitCode {
+			return true
+		}
+	}
+	return false
+}
+
+// Delay returns how long to wait before the retry that follows the given
+// attempt.
+func (r *CommandRetry) Delay(attempt int) time.Duration {
+	delay := r.Backoff
+	for i := 1; i < attempt && delay < math.MaxInt64/2; i++ {
+		if r.MaxBackoff != 0 && delay >= r.MaxBackoff {
+			break
+		}
+		delay *= 2
+	}
+	if r.MaxBackoff != 0 && delay > r.MaxBackoff {
+		return r.MaxBackoff
+	}
+	return delay
+}
+
+// Copy returns a copy of the retry options.
+func (r *CommandRetry) Copy() *CommandRetry {
+	copied := *r
+	if r.ExitCodes != nil {
+		copied.ExitCodes = append([]int{}, r.ExitCodes...)
+	}
+	return &copied
+}
+
 // CommandPreHook describes a common function type to run before
 // sub-commands in a command object and can modify the state of the
 // command.
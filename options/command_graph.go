@@ -0,0 +1,143 @@
+package options
+
+import (
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// GraphFailurePolicy determines what happens to the remaining steps of a
+// command graph once a step fails.
+type GraphFailurePolicy string
+
+const (
+	// GraphFailureSkipDependents skips the steps that depend directly or
+	// indirectly on the failed step. Steps that do not depend on it still run.
+	GraphFailureSkipDependents GraphFailurePolicy = "skip-dependents"
+	// GraphFailureRunDependents runs the steps that depend on the failed step
+	// as if it had succeeded.
+	GraphFailureRunDependents GraphFailurePolicy = "run-dependents"
+	// GraphFailureAbort skips all steps that have not yet started. Steps that
+	// are already running are allowed to finish.
+	GraphFailureAbort GraphFailurePolicy = "abort"
+)
+
+// Validate ensures that the failure policy is valid.
+func (p GraphFailurePolicy) Validate() error {
+	switch p {
+	case GraphFailureSkipDependents, GraphFailureRunDependents, GraphFailureAbort:
+		return nil
+	default:
+		return errors.Errorf("'%s' is not a valid graph failure policy", p)
+	}
+}
+
+// CommandGraph describes a set of named steps of a jasper.Command that run as
+// a dependency graph: each step runs once all of the steps it depends on have
+// finished, and steps that do not depend on each other run concurrently.
+type CommandGraph struct {
+	// Steps are the steps of the graph. Steps that are ready to run at the
+	// same time are started in the order that they are listed.
+	Steps []CommandStep `json:"steps"`
+	// MaxConcurrency is the maximum number of steps that can run at the same
+	// time. If it is 0, there is no limit.
+	MaxConcurrency int `json:"max_concurrency,omitempty"`
+	// OnFailure determines what happens to the remaining steps once a step
+	// fails. If unset, it defaults to GraphFailureSkipDependents.
+	OnFailure GraphFailurePolicy `json:"on_failure,omitempty"`
+}
+
+// CommandStep is a single named step of a command graph.
+type CommandStep struct {
+	// Name uniquely identifies the step within the graph.
+	Name string   `json:"name"`
+	Args []string `json:"args"`
+	// DependsOn are the names of the steps that must finish before this step
+	// runs.
+	DependsOn []string `json:"depends_on,omitempty"`
+}
+
+// Validate ensures that the graph is valid and acyclic and sets the default
+// failure policy if it is unset.
+func (g *CommandGraph) Validate() error {
+	if g.OnFailure == "" {
+		g.OnFailure = GraphFailureSkipDependents
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(len(g.Steps) == 0, "must specify at least one step")
+	catcher.NewWhen(g.MaxConcurrency < 0, "max concurrency cannot be negative")
+	catcher.Add(g.OnFailure.Validate())
+
+	steps := make(map[string]CommandStep, len(g.Steps))
+	for _, step := range g.Steps {
+		if step.Name == "" {
+			catcher.New("step must have a name")
+			continue
+		}
+		if _, ok := steps[step.Name]; ok {
+			catcher.Errorf("step name '%s' is not unique", step.Name)
+			continue
+		}
+		steps[step.Name] = step
+		catcher.ErrorfWhen(len(step.Args) == 0, "step '%s' must specify at least one argument", step.Name)
+	}
+	for _, step := range g.Steps {
+		for _, dep := range step.DependsOn {
+			_, ok := steps[dep]
+			catcher.ErrorfWhen(!ok, "step '%s' depends on nonexistent step '%s'", step.Name, dep)
+		}
+	}
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+
+	return errors.Wrap(g.checkAcyclic(steps), "invalid step dependencies")
+}
+
+// checkAcyclic returns an error if the steps' dependencies contain a cycle.
+func (g *CommandGraph) checkAcyclic(steps map[string]CommandStep) error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(steps))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return errors.Errorf("step '%s' depends on itself", name)
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		for _, dep := range steps[name].DependsOn {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+
+	for _, step := range g.Steps {
+		if err := visit(step.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Copy returns a copy of the graph.
+func (g *CommandGraph) Copy() *CommandGraph {
+	copied := *g
+	if g.Steps != nil {
+		copied.Steps = make([]CommandStep, 0, len(g.Steps))
+		for _, step := range g.Steps {
+			step.Args = append([]string(nil), step.Args...)
+			step.DependsOn = append([]string(nil), step.DependsOn...)
+			copied.Steps = append(copied.Steps, step)
+		}
+	}
+	return &copied
+}
@@ -0,0 +1,60 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandGraph(t *testing.T) {
+	t.Run("ValidateSetsDefaultFailurePolicy", func(t *testing.T) {
+		g := CommandGraph{Steps: []CommandStep{
+			{Name: "a", Args: []string{"true"}},
+			{Name: "b", Args: []string{"true"}, DependsOn: []string{"a"}},
+		}}
+		require.NoError(t, g.Validate())
+		assert.Equal(t, GraphFailureSkipDependents, g.OnFailure)
+	})
+	for testName, g := range map[string]CommandGraph{
+		"NoSteps":                {},
+		"NegativeMaxConcurrency": {Steps: []CommandStep{{Name: "a", Args: []string{"true"}}}, MaxConcurrency: -1},
+		"InvalidFailurePolicy":   {Steps: []CommandStep{{Name: "a", Args: []string{"true"}}}, OnFailure: "foo"},
+		"StepWithoutName":        {Steps: []CommandStep{{Args: []string{"true"}}}},
+		"StepWithoutArgs":        {Steps: []CommandStep{{Name: "a"}}},
+		"DuplicateStepNames": {Steps: []CommandStep{
+			{Name: "a", Args: []string{"true"}},
+			{Name: "a", Args: []string{"false"}},
+		}},
+		"NonexistentDependency": {Steps: []CommandStep{
+			{Name: "a", Args: []string{"true"}, DependsOn: []string{"b"}},
+		}},
+		"StepDependsOnItself": {Steps: []CommandStep{
+			{Name: "a", Args: []string{"true"}, DependsOn: []string{"a"}},
+		}},
+		"DependencyCycle": {Steps: []CommandStep{
+			{Name: "a", Args: []string{"true"}, DependsOn: []string{"c"}},
+			{Name: "b", Args: []string{"true"}, DependsOn: []string{"a"}},
+			{Name: "c", Args: []string{"true"}, DependsOn: []string{"b"}},
+		}},
+	} {
+		t.Run(testName+"DoesNotValidate", func(t *testing.T) {
+			assert.Error(t, g.Validate())
+		})
+	}
+	t.Run("CopyDoesNotShareSteps", func(t *testing.T) {
+		g := CommandGraph{Steps: []CommandStep{
+			{Name: "a", Args: []string{"true"}},
+			{Name: "b", Args: []string{"true"}, DependsOn: []string{"a"}},
+		}}
+		copied := g.Copy()
+		require.Equal(t, g, *copied)
+
+		copied.Steps[0].Name = "c"
+		copied.Steps[1].Args[0] = "false"
+		copied.Steps[1].DependsOn[0] = "c"
+		assert.Equal(t, "a", g.Steps[0].Name)
+		assert.Equal(t, []string{"true"}, g.Steps[1].Args)
+		assert.Equal(t, []string{"a"}, g.Steps[1].DependsOn)
+	})
+}
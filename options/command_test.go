@@ -2,6 +2,7 @@ package options
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/level"
@@ -58,6 +59,84 @@ func TestCommand(t *testing.T) {
 			}
 			assert.NoError(t, opts.Validate())
 		})
+		t.Run("NegativeMaxParallelCausesError", func(t *testing.T) {
+			opts := &Command{
+				Commands:    [][]string{{""}},
+				MaxParallel: -1,
+			}
+			assert.Error(t, opts.Validate())
+		})
+		t.Run("NegativeOutputTailCausesError", func(t *testing.T) {
+			opts := &Command{
+				Commands:   [][]string{{""}},
+				OutputTail: -1,
+			}
+			assert.Error(t, opts.Validate())
+		})
+		t.Run("GraphWithoutCommandsIsValid", func(t *testing.T) {
+			opts := &Command{
+				Graph: &CommandGraph{Steps: []CommandStep{{Name: "a", Args: []string{"true"}}}},
+			}
+			assert.NoError(t, opts.Validate())
+		})
+		t.Run("CommandsAndGraphCauseError", func(t *testing.T) {
+			opts := &Command{
+				Commands: [][]string{{"true"}},
+				Graph:    &CommandGraph{Steps: []CommandStep{{Name: "a", Args: []string{"true"}}}},
+			}
+			assert.Error(t, opts.Validate())
+		})
+		t.Run("InvalidGraphCausesError", func(t *testing.T) {
+			opts := &Command{Graph: &CommandGraph{}}
+			assert.Error(t, opts.Validate())
+		})
+		t.Run("InvalidRetryCausesError", func(t *testing.T) {
+			opts := &Command{
+				Commands: [][]string{{""}},
+				Retry:    &CommandRetry{MaxAttempts: -1},
+			}
+			assert.Error(t, opts.Validate())
+		})
+	})
+	t.Run("Retry", func(t *testing.T) {
+		t.Run("MaxBackoffLessThanBackoffIsInvalid", func(t *testing.T) {
+			r := CommandRetry{MaxAttempts: 2, Backoff: time.Minute, MaxBackoff: time.Second}
+			assert.Error(t, r.Validate())
+		})
+		t.Run("ShouldRetryStopsAtMaxAttempts", func(t *testing.T) {
+			r := CommandRetry{MaxAttempts: 2}
+			assert.True(t, r.ShouldRetry(1, 1, false))
+			assert.False(t, r.ShouldRetry(2, 1, false))
+			assert.False(t, (&CommandRetry{}).ShouldRetry(1, 1, false))
+		})
+		t.Run("ShouldRetryOnlyListedExitCodes", func(t *testing.T) {
+			r := CommandRetry{MaxAttempts: 2, ExitCodes: []int{2, 3}}
+			assert.True(t, r.ShouldRetry(1, 3, false))
+			assert.False(t, r.ShouldRetry(1, 1, false))
+		})
+		t.Run("ShouldRetryTimeoutOnlyIfOnTimeout", func(t *testing.T) {
+			r := CommandRetry{MaxAttempts: 2}
+			assert.False(t, r.ShouldRetry(1, -1, true))
+			r.OnTimeout = true
+			assert.True(t, r.ShouldRetry(1, -1, true))
+		})
+		t.Run("DelayBacksOffExponentiallyUpToMax", func(t *testing.T) {
+			r := CommandRetry{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+			assert.Equal(t, time.Second, r.Delay(1))
+			assert.Equal(t, 2*time.Second, r.Delay(2))
+			assert.Equal(t, 4*time.Second, r.Delay(3))
+			assert.Equal(t, 5*time.Second, r.Delay(4))
+			assert.Equal(t, 5*time.Second, r.Delay(100))
+		})
+		t.Run("DelayWithoutBackoffIsZero", func(t *testing.T) {
+			assert.Zero(t, (&CommandRetry{}).Delay(10))
+		})
+		t.Run("CopyDoesNotShareExitCodes", func(t *testing.T) {
+			r := CommandRetry{ExitCodes: []int{1}}
+			copied := r.Copy()
+			copied.ExitCodes[0] = 2
+			assert.Equal(t, []int{1}, r.ExitCodes)
+		})
 	})
 	t.Run("LoggingPreHook", func(t *testing.T) {
 		sender, err := send.NewInternalLogger("pre-hook", send.LevelInfo{Default: level.Debug, Threshold: level.Debug})
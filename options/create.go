@@ -8,6 +8,7 @@ import (
 	"hash"
 	"io"
 	"os"
+	"runtime"
 	"sort"
 	"time"
 
@@ -66,11 +67,45 @@ type Create struct {
 	// interfaces, StandardInputBytes should be set instead of StandardInput.
 	StandardInput      io.Reader `bson:"-" json:"-" yaml:"-"`
 	StandardInputBytes []byte    `bson:"stdin_bytes" json:"stdin_bytes" yaml:"stdin_bytes"`
+	// StandardInputStream keeps the process's standard input open once it
+	// starts so that input can be written to it while it runs using
+	// WriteStandardInput. The process reads EOF once CloseStandardInput is
+	// called. This cannot be combined with StandardInput or
+	// StandardInputBytes.
+	StandardInputStream bool `bson:"stdin_stream,omitempty" json:"stdin_stream,omitempty" yaml:"stdin_stream,omitempty"`
 	// GroupLeader sets the child process as a group leader so its pgid is set to its pid.
 	// This is a noop for remote executors and on non-unix systems.
 	GroupLeader bool `bson:"group_leader" json:"group_leader" yaml:"group_leader"`
-
-	closers []func() error
+	// ResourceSampleInterval is the duration between samples of the resource
+	// usage of a local process tree. If unset, the process implementation
+	// uses its default interval. This is a noop for remote executors.
+	ResourceSampleInterval time.Duration `bson:"resource_sample_interval,omitempty" json:"resource_sample_interval,omitempty" yaml:"resource_sample_interval,omitempty"`
+	// Limits sets resource limits for the process. This is a noop for remote
+	// executors.
+	Limits *Limits `bson:"limits,omitempty" json:"limits,omitempty" yaml:"limits,omitempty"`
+	// TTY, if set, runs the process in a pseudo-terminal with the given
+	// window size. This is a noop for remote executors.
+	TTY *TTY `bson:"tty,omitempty" json:"tty,omitempty" yaml:"tty,omitempty"`
+	// KillTree makes signals apply to the whole process tree: whenever the
+	// process is signaled or killed because it timed out, all of its
+	// descendants are sent the same signal. This is only supported on Linux
+	// and is a noop for remote executors.
+	KillTree bool `bson:"kill_tree,omitempty" json:"kill_tree,omitempty" yaml:"kill_tree,omitempty"`
+	// Restart, if set, is the policy for restarting the process once it
+	// exits. It is only enforced for processes created by a thread-safe
+	// manager (e.g. one made with NewSynchronizedManager).
+	Restart *Restart `bson:"restart,omitempty" json:"restart,omitempty" yaml:"restart,omitempty"`
+	// Readiness, if set, describes the probes that determine when the
+	// process is ready after it starts. See (jasper.Process).WaitReady.
+	Readiness *Readiness `bson:"readiness,omitempty" json:"readiness,omitempty" yaml:"readiness,omitempty"`
+	// AdmissionPriority is the priority of the process while it waits to be
+	// started by a manager that limits the number of running processes (see
+	// jasper.MakeAdmissionManager). Processes with a higher priority are
+	// started first.
+	AdmissionPriority int `bson:"admission_priority,omitempty" json:"admission_priority,omitempty" yaml:"admission_priority,omitempty"`
+
+	closers     []func() error
+	stdinWriter *os.File
 }
 
 // MakeCreation takes a command string and returns an equivalent
@@ -103,6 +138,9 @@ func (opts *Create) Validate() error {
 	catcher.NewWhen(opts.Timeout < 0, "timeout cannot be negative")
 	catcher.NewWhen(opts.Timeout > 0 && opts.Timeout < time.Second, "timeout must be greater than one second if specified")
 	catcher.NewWhen(opts.TimeoutSecs < 0, "timeout seconds cannot be negative")
+	catcher.NewWhen(opts.ResourceSampleInterval < 0, "resource sample interval cannot be negative")
+	catcher.NewWhen(opts.StandardInputStream && (opts.StandardInput != nil || len(opts.StandardInputBytes) != 0), "cannot specify both standard input and a standard input stream")
+	catcher.NewWhen(opts.KillTree && opts.isLocal() && runtime.GOOS != "linux", "killing the process tree is only supported on Linux")
 
 	if opts.Timeout > 0 && opts.TimeoutSecs > 0 {
 		catcher.ErrorfWhen(time.Duration(opts.TimeoutSecs)*time.Second != opts.Timeout,
@@ -129,6 +167,22 @@ func (opts *Create) Validate() error {
 		catcher.Wrap(opts.Remote.Validate(), "invalid SSH options")
 	}
 
+	if opts.Limits != nil {
+		catcher.Wrap(opts.Limits.Validate(), "invalid resource limits")
+	}
+
+	if opts.TTY != nil {
+		catcher.Wrap(opts.TTY.Validate(), "invalid pseudo-terminal options")
+	}
+
+	if opts.Restart != nil {
+		catcher.Wrap(opts.Restart.Validate(), "invalid restart policy")
+	}
+
+	if opts.Readiness != nil {
+		catcher.Wrap(opts.Readiness.Validate(), "invalid readiness options")
+	}
+
 	if catcher.HasErrors() {
 		return catcher.Resolve()
 	}
@@ -254,7 +308,20 @@ func (opts *Create) Resolve(ctx context.Context) (exe executor.Executor, t time.
 	}
 	cmd.SetStderr(stderr)
 
-	if opts.StandardInput != nil {
+	if opts.StandardInputStream {
+		stdinReader, stdinWriter, err := os.Pipe()
+		if err != nil {
+			return nil, time.Time{}, errors.Wrap(err, "creating standard input pipe")
+		}
+		cmd.SetStdin(stdinReader)
+		opts.stdinWriter = stdinWriter
+		opts.closers = append(opts.closers, func() error {
+			catcher := grip.NewBasicCatcher()
+			catcher.Wrap(opts.CloseStandardInput(), "closing standard input stream")
+			catcher.Wrap(stdinReader.Close(), "closing standard input reader")
+			return catcher.Resolve()
+		})
+	} else if opts.StandardInput != nil {
 		cmd.SetStdin(opts.StandardInput)
 	}
 
@@ -267,10 +334,25 @@ func (opts *Create) Resolve(ctx context.Context) (exe executor.Executor, t time.
 		cmd.SetGroupLeader()
 	}
 
+	if opts.Limits != nil {
+		cmd.SetLimits(opts.Limits.resolve())
+	}
+
+	if opts.TTY != nil {
+		cmd.SetTTY(opts.TTY.Rows, opts.TTY.Cols)
+	}
+
 	return cmd, deadline, nil
 }
 
 func (opts *Create) resolveExecutor(ctx context.Context) (executor.Executor, error) {
+	if opts.Remote != nil && opts.Remote.UseSSHLibrary {
+		dest, err := opts.Remote.resolveSSHDestination()
+		if err != nil {
+			return nil, errors.Wrap(err, "resolving SSH destination")
+		}
+		return executor.NewSSH(ctx, *dest, opts.Args), nil
+	}
 	if opts.Remote != nil {
 		return executor.NewSSHBinary(ctx, opts.Remote.String(), opts.Remote.Args, opts.Args), nil
 	}
@@ -320,6 +402,52 @@ func (opts *Create) RegisterCloser(fn func() error) {
 	opts.closers = append(opts.closers, fn)
 }
 
+// WriteStandardInput copies the input to the standard input of the process
+// created with these options until the input is exhausted or the context is
+// done. The process must have been created with StandardInputStream set.
+func (opts *Create) WriteStandardInput(ctx context.Context, input io.Reader) error {
+	if opts.stdinWriter == nil {
+		return errors.New("process was not created with a standard input stream")
+	}
+
+	if err := opts.stdinWriter.SetWriteDeadline(time.Time{}); err == nil {
+		stop := context.AfterFunc(ctx, func() {
+			_ = opts.stdinWriter.SetWriteDeadline(time.Now())
+		})
+		defer stop()
+	} else if !errors.Is(err, os.ErrNoDeadline) && !errors.Is(err, os.ErrClosed) {
+		return errors.Wrap(err, "resetting standard input write deadline")
+	}
+
+	if _, err := io.Copy(opts.stdinWriter, input); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if errors.Is(err, os.ErrClosed) {
+			return errors.New("standard input stream is already closed")
+		}
+		return errors.Wrap(err, "writing to standard input")
+	}
+
+	return nil
+}
+
+// CloseStandardInput closes the standard input of the process created with
+// these options so that the process reads EOF. This is a no-op if the standard
+// input stream is already closed. The process must have been created with
+// StandardInputStream set.
+func (opts *Create) CloseStandardInput() error {
+	if opts.stdinWriter == nil {
+		return errors.New("process was not created with a standard input stream")
+	}
+
+	if err := opts.stdinWriter.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		return errors.Wrap(err, "closing standard input")
+	}
+
+	return nil
+}
+
 // Copy returns a copy of the options for only the exported fields. Unexported
 // fields are cleared.
 func (opts *Create) Copy() *Create {
@@ -366,9 +494,26 @@ func (opts *Create) Copy() *Create {
 		optsCopy.Remote = opts.Remote.Copy()
 	}
 
+	if opts.Limits != nil {
+		optsCopy.Limits = opts.Limits.Copy()
+	}
+
+	if opts.TTY != nil {
+		optsCopy.TTY = opts.TTY.Copy()
+	}
+
+	if opts.Restart != nil {
+		optsCopy.Restart = opts.Restart.Copy()
+	}
+
+	if opts.Readiness != nil {
+		optsCopy.Readiness = opts.Readiness.Copy()
+	}
+
 	optsCopy.Output = *opts.Output.Copy()
 
 	optsCopy.closers = nil
+	optsCopy.stdinWriter = nil
 
 	return &optsCopy
 }
@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
@@ -283,6 +284,118 @@ func TestCreate(t *testing.T) {
 			assert.Error(t, err)
 			assert.Nil(t, cmd)
 		},
+		"ValidLimitsShouldValidate": func(t *testing.T, opts *Create) {
+			openFiles := uint64(64)
+			opts.Limits = &Limits{
+				OpenFiles: &openFiles,
+				Cgroup:    &CgroupLimits{MemoryBytes: 1024 * 1024 * 1024},
+			}
+			assert.NoError(t, opts.Validate())
+		},
+		"NegativeCgroupLimitsShouldNotValidate": func(t *testing.T, opts *Create) {
+			opts.Limits = &Limits{Cgroup: &CgroupLimits{MemoryBytes: -1, CPUs: 1}}
+			assert.Error(t, opts.Validate())
+		},
+		"EmptyCgroupLimitsShouldNotValidate": func(t *testing.T, opts *Create) {
+			opts.Limits = &Limits{Cgroup: &CgroupLimits{}}
+			assert.Error(t, opts.Validate())
+		},
+		"CopyDoesNotShareLimits": func(t *testing.T, opts *Create) {
+			coreSize := uint64(0)
+			opts.Limits = &Limits{CoreSize: &coreSize, Cgroup: &CgroupLimits{CPUs: 0.5}}
+			optsCopy := opts.Copy()
+			require.NotNil(t, optsCopy.Limits)
+			assert.Equal(t, opts.Limits, optsCopy.Limits)
+
+			*optsCopy.Limits.CoreSize = 1
+			optsCopy.Limits.Cgroup.CPUs = 2
+			assert.Zero(t, *opts.Limits.CoreSize)
+			assert.Equal(t, 0.5, opts.Limits.Cgroup.CPUs)
+		},
+		"TTYWithoutWindowSizeUsesDefaultSize": func(t *testing.T, opts *Create) {
+			opts.TTY = &TTY{}
+			require.NoError(t, opts.Validate())
+			assert.EqualValues(t, DefaultTTYRows, opts.TTY.Rows)
+			assert.EqualValues(t, DefaultTTYCols, opts.TTY.Cols)
+		},
+		"TTYWithWindowSizeKeepsSize": func(t *testing.T, opts *Create) {
+			opts.TTY = &TTY{Rows: 50, Cols: 132}
+			require.NoError(t, opts.Validate())
+			assert.EqualValues(t, 50, opts.TTY.Rows)
+			assert.EqualValues(t, 132, opts.TTY.Cols)
+		},
+		"CopyDoesNotShareTTY": func(t *testing.T, opts *Create) {
+			opts.TTY = &TTY{Rows: 50, Cols: 132}
+			optsCopy := opts.Copy()
+			require.NotNil(t, optsCopy.TTY)
+			assert.Equal(t, opts.TTY, optsCopy.TTY)
+
+			optsCopy.TTY.Rows = 10
+			assert.EqualValues(t, 50, opts.TTY.Rows)
+		},
+		"RestartWithoutConditionDefaultsToNever": func(t *testing.T, opts *Create) {
+			opts.Restart = &Restart{}
+			require.NoError(t, opts.Validate())
+			assert.Equal(t, RestartNever, opts.Restart.Condition)
+			assert.Equal(t, DefaultRestartBackoff, opts.Restart.Backoff)
+			assert.Equal(t, DefaultRestartMaxBackoff, opts.Restart.MaxBackoff)
+		},
+		"InvalidRestartShouldNotValidate": func(t *testing.T, opts *Create) {
+			opts.Restart = &Restart{Condition: "sometimes"}
+			assert.Error(t, opts.Validate())
+		},
+		"CopyDoesNotShareRestart": func(t *testing.T, opts *Create) {
+			opts.Restart = &Restart{Condition: RestartAlways}
+			optsCopy := opts.Copy()
+			require.NotNil(t, optsCopy.Restart)
+			assert.Equal(t, opts.Restart, optsCopy.Restart)
+
+			optsCopy.Restart.Condition = RestartNever
+			assert.Equal(t, RestartAlways, opts.Restart.Condition)
+		},
+		"StandardInputStreamWithStandardInputBytesShouldNotValidate": func(t *testing.T, opts *Create) {
+			opts.StandardInputStream = true
+			opts.StandardInputBytes = []byte("foo")
+			assert.Error(t, opts.Validate())
+		},
+		"StandardInputStreamWithStandardInputShouldNotValidate": func(t *testing.T, opts *Create) {
+			opts.StandardInputStream = true
+			opts.StandardInput = strings.NewReader("foo")
+			assert.Error(t, opts.Validate())
+		},
+		"KillTreeValidatesOnlyOnLinux": func(t *testing.T, opts *Create) {
+			opts.KillTree = true
+			if runtime.GOOS == "linux" {
+				assert.NoError(t, opts.Validate())
+			} else {
+				assert.Error(t, opts.Validate())
+			}
+		},
+		"WriteStandardInputFailsWithoutStream": func(t *testing.T, opts *Create) {
+			cmd, _, err := opts.Resolve(ctx)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, opts.Close())
+				assert.NoError(t, cmd.Close())
+			}()
+
+			assert.Error(t, opts.WriteStandardInput(ctx, strings.NewReader("foo")))
+			assert.Error(t, opts.CloseStandardInput())
+		},
+		"ResolveOpensStandardInputStream": func(t *testing.T, opts *Create) {
+			opts.StandardInputStream = true
+			cmd, _, err := opts.Resolve(ctx)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, cmd.Close())
+			}()
+
+			assert.NoError(t, opts.CloseStandardInput())
+			assert.NoError(t, opts.CloseStandardInput(), "closing should be idempotent")
+			assert.Error(t, opts.WriteStandardInput(ctx, strings.NewReader("foo")))
+			assert.NoError(t, opts.Close())
+			assert.Nil(t, opts.Copy().stdinWriter)
+		},
 		"ResolveSucceedsWithValidLoggingConfiguration": func(t *testing.T, opts *Create) {
 			b, err := json.Marshal(&SplunkLoggerOptions{
 				Splunk: send.SplunkConnectionInfo{
@@ -0,0 +1,60 @@
+package options
+
+import (
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// ProcessEventType is the type of a lifecycle event of a process.
+type ProcessEventType string
+
+const (
+	// ProcessCreated is the event for a process that is added to a manager,
+	// either by creating it or by registering it.
+	ProcessCreated ProcessEventType = "created"
+	// ProcessStarted is the event for a process that a manager has started.
+	ProcessStarted ProcessEventType = "started"
+	// ProcessSignaled is the event for a process that is about to be sent a
+	// signal.
+	ProcessSignaled ProcessEventType = "signaled"
+	// ProcessExited is the event for a process that has exited for any
+	// reason.
+	ProcessExited ProcessEventType = "exited"
+	// ProcessTimedOut is the event for a process that was killed because it
+	// exceeded its timeout. It follows the process's ProcessExited event.
+	ProcessTimedOut ProcessEventType = "timed_out"
+	// ProcessOOMKilled is the event for a process that was killed by the
+	// kernel's out-of-memory killer. It follows the process's ProcessExited
+	// event.
+	ProcessOOMKilled ProcessEventType = "oom_killed"
+)
+
+// Validate ensures that the event type is valid.
+func (t ProcessEventType) Validate() error {
+	switch t {
+	case ProcessCreated, ProcessStarted, ProcessSignaled, ProcessExited, ProcessTimedOut, ProcessOOMKilled:
+		return nil
+	default:
+		return errors.Errorf("'%s' is not a valid process event type", t)
+	}
+}
+
+// ProcessEventFilter represents a filter for the lifecycle events of
+// processes. Events must satisfy every criterion that is set to match the
+// filter.
+type ProcessEventFilter struct {
+	// Tags matches events for processes that have all of the given tags.
+	Tags []string `bson:"tags,omitempty" json:"tags,omitempty" yaml:"tags,omitempty"`
+	// Types matches events that have any of the given types. If unset, events
+	// of all types match.
+	Types []ProcessEventType `bson:"types,omitempty" json:"types,omitempty" yaml:"types,omitempty"`
+}
+
+// Validate ensures that the filter only contains valid event types.
+func (f *ProcessEventFilter) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	for _, t := range f.Types {
+		catcher.Add(t.Validate())
+	}
+	return catcher.Resolve()
+}
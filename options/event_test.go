@@ -0,0 +1,25 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessEventFilter(t *testing.T) {
+	t.Run("EmptyFilterValidates", func(t *testing.T) {
+		f := ProcessEventFilter{}
+		assert.NoError(t, f.Validate())
+	})
+	t.Run("AllTypesValidate", func(t *testing.T) {
+		f := ProcessEventFilter{
+			Tags:  []string{"foo"},
+			Types: []ProcessEventType{ProcessCreated, ProcessStarted, ProcessSignaled, ProcessExited, ProcessTimedOut, ProcessOOMKilled},
+		}
+		assert.NoError(t, f.Validate())
+	})
+	t.Run("InvalidTypeDoesNotValidate", func(t *testing.T) {
+		f := ProcessEventFilter{Types: []ProcessEventType{ProcessExited, "foo"}}
+		assert.Error(t, f.Validate())
+	})
+}
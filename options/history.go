@@ -0,0 +1,45 @@
+package options
+
+import (
+	"time"
+
+	"github.com/mongodb/grip"
+)
+
+// HistoryQuery represents a query for the records of completed processes in a
+// process history. Records must satisfy every criterion that is set to match
+// the query.
+type HistoryQuery struct {
+	// Tags matches processes that have all of the given tags.
+	Tags []string `bson:"tags,omitempty" json:"tags,omitempty" yaml:"tags,omitempty"`
+	// CompletedAfter matches processes that completed at or after the given
+	// time.
+	CompletedAfter time.Time `bson:"completed_after,omitempty" json:"completed_after,omitempty" yaml:"completed_after,omitempty"`
+	// CompletedBefore matches processes that completed at or before the given
+	// time.
+	CompletedBefore time.Time `bson:"completed_before,omitempty" json:"completed_before,omitempty" yaml:"completed_before,omitempty"`
+	// Status matches processes by their outcome. It must be one of All,
+	// Successful or Failed. If unset, it defaults to All.
+	Status Filter `bson:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty"`
+	// ExitCode, if set, matches processes that exited with the given exit
+	// code.
+	ExitCode *int `bson:"exit_code,omitempty" json:"exit_code,omitempty" yaml:"exit_code,omitempty"`
+	// Limit is the maximum number of records to return. Records are returned
+	// starting with the most recently completed process. If unset, all
+	// matching records are returned.
+	Limit int `bson:"limit,omitempty" json:"limit,omitempty" yaml:"limit,omitempty"`
+}
+
+// Validate ensures that the query is valid and sets the default status if it
+// is unset.
+func (q *HistoryQuery) Validate() error {
+	if q.Status == "" {
+		q.Status = All
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.ErrorfWhen(q.Status != All && q.Status != Successful && q.Status != Failed, "'%s' is not a valid status for completed processes", q.Status)
+	catcher.NewWhen(q.Limit < 0, "limit cannot be negative")
+	catcher.NewWhen(!q.CompletedAfter.IsZero() && !q.CompletedBefore.IsZero() && q.CompletedAfter.After(q.CompletedBefore), "completed after time cannot be after completed before time")
+	return catcher.Resolve()
+}
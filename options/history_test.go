@@ -0,0 +1,42 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryQuery(t *testing.T) {
+	t.Run("EmptyQueryDefaultsToAllStatuses", func(t *testing.T) {
+		q := HistoryQuery{}
+		assert.NoError(t, q.Validate())
+		assert.Equal(t, All, q.Status)
+	})
+	t.Run("CompletedStatusesValidate", func(t *testing.T) {
+		for _, f := range []Filter{All, Successful, Failed} {
+			q := HistoryQuery{Status: f}
+			assert.NoError(t, q.Validate())
+		}
+	})
+	t.Run("OtherStatusesDoNotValidate", func(t *testing.T) {
+		for _, f := range []Filter{Running, Terminated, "foo"} {
+			q := HistoryQuery{Status: f}
+			assert.Error(t, q.Validate())
+		}
+	})
+	t.Run("NegativeLimitDoesNotValidate", func(t *testing.T) {
+		q := HistoryQuery{Limit: -1}
+		assert.Error(t, q.Validate())
+	})
+	t.Run("TimeRangeValidates", func(t *testing.T) {
+		now := time.Now()
+		q := HistoryQuery{CompletedAfter: now.Add(-time.Hour), CompletedBefore: now}
+		assert.NoError(t, q.Validate())
+	})
+	t.Run("InvertedTimeRangeDoesNotValidate", func(t *testing.T) {
+		now := time.Now()
+		q := HistoryQuery{CompletedAfter: now, CompletedBefore: now.Add(-time.Hour)}
+		assert.Error(t, q.Validate())
+	})
+}
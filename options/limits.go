@@ -0,0 +1,93 @@
+package options
+
+import (
+	"github.com/mongodb/grip"
+	"github.com/mongodb/jasper/internal/executor"
+)
+
+// Limits represent resource limits applied to a single local process,
+// independently of the limits of the process that creates it. Unset limits
+// are inherited from the creating process. Limits are currently only
+// supported for local processes on Linux.
+type Limits struct {
+	// CPUSeconds is the maximum amount of CPU time the process can use in
+	// seconds (RLIMIT_CPU).
+	CPUSeconds *uint64 `bson:"cpu_seconds,omitempty" json:"cpu_seconds,omitempty" yaml:"cpu_seconds,omitempty"`
+	// AddressSpace is the maximum size of the process' virtual memory in
+	// bytes (RLIMIT_AS).
+	AddressSpace *uint64 `bson:"address_space,omitempty" json:"address_space,omitempty" yaml:"address_space,omitempty"`
+	// OpenFiles is the maximum number of file descriptors the process can
+	// have open (RLIMIT_NOFILE).
+	OpenFiles *uint64 `bson:"open_files,omitempty" json:"open_files,omitempty" yaml:"open_files,omitempty"`
+	// NumProcs is the maximum number of processes that the process' user can
+	// have (RLIMIT_NPROC).
+	NumProcs *uint64 `bson:"num_procs,omitempty" json:"num_procs,omitempty" yaml:"num_procs,omitempty"`
+	// CoreSize is the maximum size of a core file in bytes (RLIMIT_CORE).
+	// Setting it to 0 disables core dumps.
+	CoreSize *uint64 `bson:"core_size,omitempty" json:"core_size,omitempty" yaml:"core_size,omitempty"`
+	// Cgroup, if set, places the process and its descendants in a dedicated
+	// cgroup with the given quotas. This requires admin privileges.
+	Cgroup *CgroupLimits `bson:"cgroup,omitempty" json:"cgroup,omitempty" yaml:"cgroup,omitempty"`
+}
+
+// CgroupLimits represent the quotas of a cgroup for a single process and its
+// descendants.
+type CgroupLimits struct {
+	// MemoryBytes is the maximum amount of memory in bytes.
+	MemoryBytes int64 `bson:"memory_bytes,omitempty" json:"memory_bytes,omitempty" yaml:"memory_bytes,omitempty"`
+	// CPUs is the maximum number of CPUs worth of time that can be used
+	// (e.g. 0.5 allows the use of half of a CPU).
+	CPUs float64 `bson:"cpus,omitempty" json:"cpus,omitempty" yaml:"cpus,omitempty"`
+}
+
+// Validate checks that the limits are valid.
+func (l *Limits) Validate() error {
+	if l.Cgroup == nil {
+		return nil
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(l.Cgroup.MemoryBytes < 0, "cgroup memory limit cannot be negative")
+	catcher.NewWhen(l.Cgroup.CPUs < 0, "cgroup CPU limit cannot be negative")
+	catcher.NewWhen(l.Cgroup.MemoryBytes == 0 && l.Cgroup.CPUs == 0, "must specify at least one cgroup limit")
+	return catcher.Resolve()
+}
+
+// Copy returns a copy of the limits.
+func (l *Limits) Copy() *Limits {
+	copyLimit := func(val *uint64) *uint64 {
+		if val == nil {
+			return nil
+		}
+		valCopy := *val
+		return &valCopy
+	}
+
+	limitsCopy := Limits{
+		CPUSeconds:   copyLimit(l.CPUSeconds),
+		AddressSpace: copyLimit(l.AddressSpace),
+		OpenFiles:    copyLimit(l.OpenFiles),
+		NumProcs:     copyLimit(l.NumProcs),
+		CoreSize:     copyLimit(l.CoreSize),
+	}
+	if l.Cgroup != nil {
+		cgroupCopy := *l.Cgroup
+		limitsCopy.Cgroup = &cgroupCopy
+	}
+	return &limitsCopy
+}
+
+func (l *Limits) resolve() executor.Limits {
+	limits := executor.Limits{
+		CPUSeconds:   l.CPUSeconds,
+		AddressSpace: l.AddressSpace,
+		OpenFiles:    l.OpenFiles,
+		NumProcs:     l.NumProcs,
+		CoreSize:     l.CoreSize,
+	}
+	if l.Cgroup != nil {
+		limits.CgroupMemoryBytes = l.Cgroup.MemoryBytes
+		limits.CgroupCPUs = l.Cgroup.CPUs
+	}
+	return limits
+}
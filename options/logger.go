@@ -3,10 +3,12 @@ package options
 import (
 	"context"
 	"encoding/json"
+	"sync"
 	"time"
 
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/send"
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
@@ -342,19 +344,35 @@ func (opts *BufferOptions) Validate() error {
 type SafeSender struct {
 	baseSender send.Sender
 	send.Sender
+	// inMemoryLog is set if the base sender is an in-memory logger's sender.
+	inMemoryLog *InMemoryLog
 }
 
 // NewSafeSender returns a grip send.Sender with the given base options. It
 // overwrites the underlying Close method in order to ensure that both the base
 // sender and buffered sender are closed correctly.
 func NewSafeSender(baseSender send.Sender, opts BaseOptions) (send.Sender, error) {
+	sender, err := newSafeSender(baseSender, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+	return sender, nil
+}
+
+// newSafeSender is the same as NewSafeSender, except that messages are sent
+// to the base sender through the in-memory log, if one is given.
+func newSafeSender(baseSender send.Sender, opts BaseOptions, log *InMemoryLog) (*SafeSender, error) {
 	if err := opts.Validate(); err != nil {
 		return nil, errors.Wrap(err, "invalid options")
 	}
 
-	sender := &SafeSender{}
+	sender := &SafeSender{inMemoryLog: log}
 	if opts.Buffer.Buffered {
-		s, err := send.NewBufferedSender(context.Background(), baseSender, send.BufferedSenderOptions{FlushInterval: opts.Buffer.Duration, BufferSize: opts.Buffer.MaxSize})
+		bufferedBaseSender := baseSender
+		if log != nil {
+			bufferedBaseSender = &inMemoryLogSender{Sender: baseSender, log: log}
+		}
+		s, err := send.NewBufferedSender(context.Background(), bufferedBaseSender, send.BufferedSenderOptions{FlushInterval: opts.Buffer.Duration, BufferSize: opts.Buffer.MaxSize})
 		if err != nil {
 			return nil, errors.Wrap(err, "creating buffered sender")
 		}
@@ -375,6 +393,23 @@ func NewSafeSender(baseSender send.Sender, opts BaseOptions) (send.Sender, error
 	return sender, nil
 }
 
+// Send sends the message to the underlying grip send.Sender.
+func (s *SafeSender) Send(ctx context.Context, msg message.Composer) {
+	if s.inMemoryLog != nil && s.baseSender == nil {
+		// The in-memory log sends the message to the base sender itself so
+		// that it can count the messages.
+		s.inMemoryLog.send(ctx, msg)
+		return
+	}
+	s.Sender.Send(ctx, msg)
+}
+
+// InMemoryLog returns the in-memory log if the base sender is an in-memory
+// logger's sender. Otherwise, it returns nil.
+func (s *SafeSender) InMemoryLog() *InMemoryLog {
+	return s.inMemoryLog
+}
+
 // GetSender returns the underlying base grip send.Sender.
 func (s *SafeSender) GetSender() send.Sender {
 	if s.baseSender != nil {
@@ -399,3 +434,91 @@ func (s *SafeSender) Close() error {
 
 	return catcher.Resolve()
 }
+
+// InMemoryLog tracks the messages sent to an in-memory logger's grip
+// send.InMemorySender. The sender only buffers the most recent messages, so the
+// log also counts the total number of messages that the sender has received so
+// that readers can follow the log from a given offset.
+type InMemoryLog struct {
+	sender *send.InMemorySender
+	mu     sync.RWMutex
+	total  int
+	notify chan struct{}
+}
+
+func newInMemoryLog(sender *send.InMemorySender) *InMemoryLog {
+	return &InMemoryLog{
+		sender: sender,
+		notify: make(chan struct{}),
+	}
+}
+
+// Sender returns the in-memory sender that buffers the log's messages.
+func (l *InMemoryLog) Sender() *send.InMemorySender {
+	return l.sender
+}
+
+// send sends the given message to the in-memory sender and notifies any
+// readers waiting for new messages.
+func (l *InMemoryLog) send(ctx context.Context, msg message.Composer) {
+	if !l.sender.Level().ShouldLog(msg) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sender.Send(ctx, msg)
+	l.total++
+	close(l.notify)
+	l.notify = make(chan struct{})
+}
+
+// GetSince returns the buffered messages starting at the given offset, which
+// is the number of messages that were received before the first message to
+// return. If some of the messages after the offset have already been dropped
+// from the buffer, it returns all the buffered messages instead. It returns
+// the messages, the offset of the first returned message, the offset from
+// which to continue reading, and a channel that is closed once more messages
+// are received.
+func (l *InMemoryLog) GetSince(offset int) (msgs []message.Composer, start int, next int, wait <-chan struct{}) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= l.total {
+		return nil, offset, offset, l.notify
+	}
+
+	buffered := l.sender.Get()
+	start = l.total - len(buffered)
+	if offset > start {
+		buffered = buffered[offset-start:]
+		start = offset
+	}
+
+	msgs = make([]message.Composer, len(buffered))
+	copy(msgs, buffered)
+
+	return msgs, start, l.total, l.notify
+}
+
+// Total returns the total number of messages that the sender has received.
+func (l *InMemoryLog) Total() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.total
+}
+
+// inMemoryLogSender is a grip send.Sender that sends messages to an
+// InMemoryLog.
+type inMemoryLogSender struct {
+	send.Sender
+	log *InMemoryLog
+}
+
+func (s *inMemoryLogSender) Send(ctx context.Context, msg message.Composer) {
+	s.log.send(ctx, msg)
+}
@@ -182,12 +182,12 @@ func (opts *InMemoryLoggerOptions) Configure() (send.Sender, error) {
 		return nil, errors.Wrap(err, "invalid config")
 	}
 
-	sender, err := send.NewInMemorySender(DefaultLogName, opts.Base.Level, opts.InMemoryCap)
+	baseSender, err := send.NewInMemorySender(DefaultLogName, opts.Base.Level, opts.InMemoryCap)
 	if err != nil {
 		return nil, errors.Wrap(err, "creating base in-memory logger")
 	}
 
-	sender, err = NewSafeSender(sender, opts.Base)
+	sender, err := newSafeSender(baseSender, opts.Base, newInMemoryLog(baseSender.(*send.InMemorySender)))
 	if err != nil {
 		return nil, errors.Wrap(err, "creating safe in-memory logger")
 	}
@@ -1,10 +1,14 @@
 package options
 
 import (
+	"context"
 	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/send"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -419,3 +423,113 @@ func TestLoggerConfigMarshalJSON(t *testing.T) {
 		assert.Equal(t, config.info.Config, roundTripped.info.Config)
 	})
 }
+
+func TestInMemoryLog(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newLog := func(t *testing.T, capacity int) *InMemoryLog {
+		sender, err := send.NewInMemorySender("test", send.LevelInfo{Default: level.Info, Threshold: level.Info}, capacity)
+		require.NoError(t, err)
+		return newInMemoryLog(sender.(*send.InMemorySender))
+	}
+	sendMessages := func(sender *InMemoryLog, msgs ...string) {
+		for _, msg := range msgs {
+			sender.send(ctx, message.NewDefaultMessage(level.Info, msg))
+		}
+	}
+	msgStrings := func(msgs []message.Composer) []string {
+		strs := make([]string, 0, len(msgs))
+		for _, msg := range msgs {
+			strs = append(strs, msg.String())
+		}
+		return strs
+	}
+
+	t.Run("GetSinceReturnsMessagesAfterOffset", func(t *testing.T) {
+		sender := newLog(t, 10)
+		sendMessages(sender, "foo", "bar", "bat")
+
+		msgs, start, next, _ := sender.GetSince(1)
+		assert.Equal(t, []string{"bar", "bat"}, msgStrings(msgs))
+		assert.Equal(t, 1, start)
+		assert.Equal(t, 3, next)
+		assert.Equal(t, 3, sender.Total())
+	})
+	t.Run("GetSinceReturnsOldestBufferedMessagesIfTruncated", func(t *testing.T) {
+		sender := newLog(t, 2)
+		sendMessages(sender, "foo", "bar", "bat", "baz")
+
+		msgs, start, next, _ := sender.GetSince(1)
+		assert.Equal(t, []string{"bat", "baz"}, msgStrings(msgs))
+		assert.Equal(t, 2, start)
+		assert.Equal(t, 4, next)
+	})
+	t.Run("GetSinceWaitsForNewMessagesAtEnd", func(t *testing.T) {
+		sender := newLog(t, 10)
+		sendMessages(sender, "foo")
+
+		msgs, start, next, wait := sender.GetSince(1)
+		assert.Empty(t, msgs)
+		assert.Equal(t, 1, start)
+		assert.Equal(t, 1, next)
+		select {
+		case <-wait:
+			assert.Fail(t, "should not be notified before new messages are sent")
+		default:
+		}
+
+		sendMessages(sender, "bar")
+		select {
+		case <-wait:
+		default:
+			assert.Fail(t, "should be notified after new messages are sent")
+		}
+
+		msgs, _, next, _ = sender.GetSince(next)
+		assert.Equal(t, []string{"bar"}, msgStrings(msgs))
+		assert.Equal(t, 2, next)
+	})
+	t.Run("ConfiguredLoggerKeepsGripInMemorySender", func(t *testing.T) {
+		for testName, buffer := range map[string]BufferOptions{
+			"Unbuffered": {},
+			"Buffered":   {Buffered: true, Duration: time.Hour, MaxSize: 100},
+		} {
+			t.Run(testName, func(t *testing.T) {
+				opts := &InMemoryLoggerOptions{
+					InMemoryCap: 10,
+					Base:        BaseOptions{Format: LogFormatPlain, Buffer: buffer},
+				}
+				sender, err := opts.Configure()
+				require.NoError(t, err)
+				safeSender, ok := sender.(*SafeSender)
+				require.True(t, ok)
+				inMemorySender, ok := safeSender.GetSender().(*send.InMemorySender)
+				require.True(t, ok)
+				log := safeSender.InMemoryLog()
+				require.NotNil(t, log)
+				assert.Equal(t, inMemorySender, log.Sender())
+
+				sender.Send(ctx, message.NewDefaultMessage(level.Info, "foo"))
+				sender.Send(ctx, message.NewDefaultMessage(level.Info, "bar"))
+				require.NoError(t, sender.Close())
+
+				// Buffered messages are sent to the base sender in groups,
+				// so the offsets count the messages in the base sender.
+				msgs, start, next, _ := log.GetSince(0)
+				assert.Equal(t, msgStrings(inMemorySender.Get()), msgStrings(msgs))
+				assert.Zero(t, start)
+				assert.Equal(t, len(inMemorySender.Get()), next)
+				logs, err := inMemorySender.GetString()
+				require.NoError(t, err)
+				assert.Contains(t, strings.Join(logs, "\n"), "foo")
+				assert.Contains(t, strings.Join(logs, "\n"), "bar")
+			})
+		}
+	})
+	t.Run("MessagesBelowThresholdAreNotCounted", func(t *testing.T) {
+		sender := newLog(t, 10)
+		sender.send(ctx, message.NewDefaultMessage(level.Debug, "foo"))
+		assert.Zero(t, sender.Total())
+	})
+}
@@ -23,6 +23,10 @@ type Output struct {
 	// to. They are closed and cleaned up when the process exits. If this
 	// behavior is not desired, use Output instead of Loggers.
 	Loggers []*LoggerConfig `bson:"loggers" json:"loggers,omitempty" yaml:"loggers"`
+	// Capture, if set, captures the process' standard output and standard
+	// error in memory so that it can be retrieved while the process runs and
+	// after it completes.
+	Capture *OutputCapture `bson:"capture,omitempty" json:"capture,omitempty" yaml:"capture,omitempty"`
 
 	outputSender *send.WriterSender
 	errorSender  *send.WriterSender
@@ -99,6 +103,10 @@ func (o *Output) Validate() error {
 		catcher.Wrap(l.validate(), "invalid logger")
 	}
 
+	if o.Capture != nil {
+		catcher.Wrap(o.Capture.Validate(), "invalid output capture")
+	}
+
 	return catcher.Resolve()
 }
 
@@ -213,6 +221,11 @@ func (o *Output) Copy() *Output {
 		_ = copy(optsCopy.Loggers, o.Loggers)
 	}
 
+	if o.Capture != nil {
+		capture := *o.Capture
+		optsCopy.Capture = &capture
+	}
+
 	return &optsCopy
 }
 
@@ -0,0 +1,105 @@
+package options
+
+import (
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// DefaultOutputCaptureSize is the maximum number of bytes of each output
+// stream that are kept in memory if it is not set.
+const DefaultOutputCaptureSize = 1024 * 1024
+
+// OutputCapture describes how to capture the standard output and standard
+// error of a process so that it can be retrieved later (see
+// jasper.GetCapturedOutput).
+type OutputCapture struct {
+	// MaxBytes is the maximum number of bytes of each stream that are kept in
+	// memory. Once it is exceeded, the oldest output is discarded. If unset,
+	// it defaults to DefaultOutputCaptureSize.
+	MaxBytes int `bson:"max_bytes,omitempty" json:"max_bytes,omitempty" yaml:"max_bytes,omitempty"`
+	// SpillToFile writes the output that no longer fits in memory to a
+	// temporary file rather than discarding it, so that all of the output
+	// remains available. The file is removed once the process is cleared
+	// from its manager.
+	SpillToFile bool `bson:"spill_to_file,omitempty" json:"spill_to_file,omitempty" yaml:"spill_to_file,omitempty"`
+}
+
+// Validate ensures that the capture options are valid and sets the default
+// size if it is unset.
+func (c *OutputCapture) Validate() error {
+	if c.MaxBytes == 0 {
+		c.MaxBytes = DefaultOutputCaptureSize
+	}
+	if c.MaxBytes < 0 {
+		return errors.New("maximum capture size cannot be negative")
+	}
+	return nil
+}
+
+// OutputStream identifies one of the standard output streams of a process.
+type OutputStream string
+
+const (
+	// OutputStreamStdout is the process' standard output.
+	OutputStreamStdout OutputStream = "stdout"
+	// OutputStreamStderr is the process' standard error.
+	OutputStreamStderr OutputStream = "stderr"
+)
+
+// Validate ensures that the output stream is valid.
+func (s OutputStream) Validate() error {
+	switch s {
+	case OutputStreamStdout, OutputStreamStderr:
+		return nil
+	default:
+		return errors.Errorf("'%s' is not a valid output stream", s)
+	}
+}
+
+// OutputQuery describes which part of a process' captured output to get.
+// Output can either be selected by byte offset or by line range, but not
+// both.
+type OutputQuery struct {
+	// Stream is the output stream to get. If unset, it defaults to
+	// OutputStreamStdout.
+	Stream OutputStream `bson:"stream,omitempty" json:"stream,omitempty" yaml:"stream,omitempty"`
+	// Offset is the byte offset in the stream to start from. If it is
+	// negative, it is relative to the end of the stream (e.g. -1024 gets the
+	// last 1 KiB).
+	Offset int64 `bson:"offset,omitempty" json:"offset,omitempty" yaml:"offset,omitempty"`
+	// Limit is the maximum number of bytes to get. If it is 0, there is no
+	// limit.
+	Limit int64 `bson:"limit,omitempty" json:"limit,omitempty" yaml:"limit,omitempty"`
+	// Lines, if set, selects a range of lines instead of bytes.
+	Lines *LineRange `bson:"lines,omitempty" json:"lines,omitempty" yaml:"lines,omitempty"`
+}
+
+// LineRange is a range of lines of an output stream. A line includes its
+// trailing newline, and the final line of the stream does not need to end in
+// a newline.
+type LineRange struct {
+	// Start is the number of the first line to get, counting from 0. If it
+	// is negative, it is relative to the end of the stream (e.g. -10 gets the
+	// last 10 lines).
+	Start int64 `bson:"start,omitempty" json:"start,omitempty" yaml:"start,omitempty"`
+	// Count is the maximum number of lines to get. If it is 0, all lines from
+	// Start to the end of the stream are returned.
+	Count int64 `bson:"count,omitempty" json:"count,omitempty" yaml:"count,omitempty"`
+}
+
+// Validate ensures that the query is valid and sets the default stream if it
+// is unset.
+func (q *OutputQuery) Validate() error {
+	if q.Stream == "" {
+		q.Stream = OutputStreamStdout
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(q.Stream.Validate())
+	catcher.NewWhen(q.Limit < 0, "limit cannot be negative")
+	if q.Lines != nil {
+		catcher.NewWhen(q.Offset != 0 || q.Limit != 0, "cannot select output by both bytes and lines")
+		catcher.NewWhen(q.Lines.Count < 0, "line count cannot be negative")
+	}
+	return catcher.Resolve()
+}
@@ -0,0 +1,55 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputCapture(t *testing.T) {
+	t.Run("ValidateSetsDefaultSize", func(t *testing.T) {
+		c := OutputCapture{}
+		require.NoError(t, c.Validate())
+		assert.Equal(t, DefaultOutputCaptureSize, c.MaxBytes)
+	})
+	t.Run("NegativeSizeDoesNotValidate", func(t *testing.T) {
+		c := OutputCapture{MaxBytes: -1}
+		assert.Error(t, c.Validate())
+	})
+	t.Run("OutputCopyDoesNotShareCapture", func(t *testing.T) {
+		o := Output{Capture: &OutputCapture{MaxBytes: 10}}
+		copied := o.Copy()
+		require.NotNil(t, copied.Capture)
+		copied.Capture.MaxBytes = 20
+		assert.Equal(t, 10, o.Capture.MaxBytes)
+	})
+}
+
+func TestOutputQuery(t *testing.T) {
+	t.Run("ValidateSetsDefaultStream", func(t *testing.T) {
+		q := OutputQuery{}
+		require.NoError(t, q.Validate())
+		assert.Equal(t, OutputStreamStdout, q.Stream)
+	})
+	for name, q := range map[string]OutputQuery{
+		"ByteRange":  {Stream: OutputStreamStderr, Offset: -100, Limit: 10},
+		"LastLines":  {Lines: &LineRange{Start: -10}},
+		"LineRange":  {Lines: &LineRange{Start: 5, Count: 2}},
+		"FullStream": {Stream: OutputStreamStdout},
+	} {
+		t.Run(name+"Validates", func(t *testing.T) {
+			assert.NoError(t, q.Validate())
+		})
+	}
+	for name, q := range map[string]OutputQuery{
+		"InvalidStream":     {Stream: "foo"},
+		"NegativeLimit":     {Limit: -1},
+		"BytesAndLines":     {Offset: 10, Lines: &LineRange{Start: 1}},
+		"NegativeLineCount": {Lines: &LineRange{Count: -1}},
+	} {
+		t.Run(name+"DoesNotValidate", func(t *testing.T) {
+			assert.Error(t, q.Validate())
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package options
+
+import (
+	"time"
+
+	"github.com/mongodb/grip"
+)
+
+// ProcessQuery represents a structured query for the processes in a manager.
+// Processes must satisfy every criterion that is set to match the query.
+type ProcessQuery struct {
+	// Status matches processes by their state. If unset, it defaults to All.
+	Status Filter `bson:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty"`
+	// Tags matches processes that have all of the tags in at least one of
+	// the tag sets.
+	Tags [][]string `bson:"tags,omitempty" json:"tags,omitempty" yaml:"tags,omitempty"`
+	// MinExitCode, if set, matches completed processes that exited with an
+	// exit code greater than or equal to the given exit code.
+	MinExitCode *int `bson:"min_exit_code,omitempty" json:"min_exit_code,omitempty" yaml:"min_exit_code,omitempty"`
+	// MaxExitCode, if set, matches completed processes that exited with an
+	// exit code less than or equal to the given exit code.
+	MaxExitCode *int `bson:"max_exit_code,omitempty" json:"max_exit_code,omitempty" yaml:"max_exit_code,omitempty"`
+	// StartedAfter matches processes that started at or after the given
+	// time.
+	StartedAfter time.Time `bson:"started_after,omitempty" json:"started_after,omitempty" yaml:"started_after,omitempty"`
+	// StartedBefore matches processes that started at or before the given
+	// time.
+	StartedBefore time.Time `bson:"started_before,omitempty" json:"started_before,omitempty" yaml:"started_before,omitempty"`
+	// Args matches processes whose space-separated arguments contain the
+	// given substring.
+	Args string `bson:"args,omitempty" json:"args,omitempty" yaml:"args,omitempty"`
+	// WorkingDirectory matches processes whose working directory contains the
+	// given substring.
+	WorkingDirectory string `bson:"working_directory,omitempty" json:"working_directory,omitempty" yaml:"working_directory,omitempty"`
+	// Host matches processes that run on the given host.
+	Host string `bson:"host,omitempty" json:"host,omitempty" yaml:"host,omitempty"`
+}
+
+// Validate ensures that the query is valid and sets the default status if it
+// is unset.
+func (q *ProcessQuery) Validate() error {
+	if q.Status == "" {
+		q.Status = All
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(q.Status.Validate(), "invalid status")
+	for _, tags := range q.Tags {
+		catcher.NewWhen(len(tags) == 0, "tag sets cannot be empty")
+	}
+	catcher.NewWhen(q.MinExitCode != nil && q.MaxExitCode != nil && *q.MinExitCode > *q.MaxExitCode, "minimum exit code cannot be greater than maximum exit code")
+	catcher.NewWhen(!q.StartedAfter.IsZero() && !q.StartedBefore.IsZero() && q.StartedAfter.After(q.StartedBefore), "started after time cannot be after started before time")
+	return catcher.Resolve()
+}
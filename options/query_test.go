@@ -0,0 +1,53 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessQuery(t *testing.T) {
+	t.Run("EmptyQueryDefaultsToAllStatuses", func(t *testing.T) {
+		q := ProcessQuery{}
+		assert.NoError(t, q.Validate())
+		assert.Equal(t, All, q.Status)
+	})
+	t.Run("AllStatusesValidate", func(t *testing.T) {
+		for _, f := range []Filter{All, Running, Terminated, Successful, Failed} {
+			q := ProcessQuery{Status: f}
+			assert.NoError(t, q.Validate())
+		}
+	})
+	t.Run("InvalidStatusDoesNotValidate", func(t *testing.T) {
+		q := ProcessQuery{Status: "foo"}
+		assert.Error(t, q.Validate())
+	})
+	t.Run("TagSetsValidate", func(t *testing.T) {
+		q := ProcessQuery{Tags: [][]string{{"foo", "bar"}, {"bat"}}}
+		assert.NoError(t, q.Validate())
+	})
+	t.Run("EmptyTagSetDoesNotValidate", func(t *testing.T) {
+		q := ProcessQuery{Tags: [][]string{{"foo"}, {}}}
+		assert.Error(t, q.Validate())
+	})
+	t.Run("ExitCodeRangeValidates", func(t *testing.T) {
+		q := ProcessQuery{MinExitCode: utility.ToIntPtr(1), MaxExitCode: utility.ToIntPtr(1)}
+		assert.NoError(t, q.Validate())
+	})
+	t.Run("InvertedExitCodeRangeDoesNotValidate", func(t *testing.T) {
+		q := ProcessQuery{MinExitCode: utility.ToIntPtr(2), MaxExitCode: utility.ToIntPtr(1)}
+		assert.Error(t, q.Validate())
+	})
+	t.Run("TimeRangeValidates", func(t *testing.T) {
+		now := time.Now()
+		q := ProcessQuery{StartedAfter: now.Add(-time.Hour), StartedBefore: now}
+		assert.NoError(t, q.Validate())
+	})
+	t.Run("InvertedTimeRangeDoesNotValidate", func(t *testing.T) {
+		now := time.Now()
+		q := ProcessQuery{StartedAfter: now, StartedBefore: now.Add(-time.Hour)}
+		assert.Error(t, q.Validate())
+	})
+}
@@ -0,0 +1,167 @@
+package options
+
+import (
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultReadinessInterval is the interval between attempts to run the
+	// readiness probes if it is not set.
+	DefaultReadinessInterval = 250 * time.Millisecond
+	// DefaultReadinessProbeTimeout is the maximum amount of time that a
+	// single attempt of a TCP, HTTP or command probe can take.
+	DefaultReadinessProbeTimeout = 5 * time.Second
+)
+
+// Readiness describes how to check that a started process is ready (e.g. that
+// a server is accepting connections). The process is ready once all of the
+// probes succeed.
+type Readiness struct {
+	// Probes are the probes that must all succeed for the process to be ready.
+	Probes []ReadinessProbe `bson:"probes" json:"probes" yaml:"probes"`
+	// Interval is the interval between attempts to run the probes that have
+	// not yet succeeded. If unset, it defaults to DefaultReadinessInterval.
+	Interval time.Duration `bson:"interval,omitempty" json:"interval,omitempty" yaml:"interval,omitempty"`
+	// Timeout is how long after the process starts the probes are attempted.
+	// If the process is not ready once it elapses, it is considered to have
+	// failed to become ready. If unset, the probes are attempted until the
+	// process exits.
+	Timeout time.Duration `bson:"timeout,omitempty" json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// ReadinessProbe is a single check of whether a process is ready. Exactly one
+// kind of probe must be set.
+type ReadinessProbe struct {
+	// TCP succeeds once a TCP connection can be made to the address.
+	TCP *TCPProbe `bson:"tcp,omitempty" json:"tcp,omitempty" yaml:"tcp,omitempty"`
+	// HTTP succeeds once a GET request to the URL returns the expected status.
+	HTTP *HTTPProbe `bson:"http,omitempty" json:"http,omitempty" yaml:"http,omitempty"`
+	// Log succeeds once a line of the process' output matches the pattern.
+	Log *LogProbe `bson:"log,omitempty" json:"log,omitempty" yaml:"log,omitempty"`
+	// File succeeds once the file exists.
+	File *FileProbe `bson:"file,omitempty" json:"file,omitempty" yaml:"file,omitempty"`
+	// Command succeeds once the command exits with exit code 0.
+	Command *CommandProbe `bson:"command,omitempty" json:"command,omitempty" yaml:"command,omitempty"`
+}
+
+// TCPProbe checks that a TCP connection can be made to an address.
+type TCPProbe struct {
+	// Address is the address to connect to in the form "host:port".
+	Address string `bson:"address" json:"address" yaml:"address"`
+}
+
+// HTTPProbe checks that a GET request to a URL returns the expected status.
+type HTTPProbe struct {
+	URL string `bson:"url" json:"url" yaml:"url"`
+	// Status is the expected status code of the response. If unset, any 2xx
+	// status code succeeds.
+	Status int `bson:"status,omitempty" json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// LogProbe checks that a line of the process' standard output or standard
+// error matches a regular expression.
+type LogProbe struct {
+	Pattern string `bson:"pattern" json:"pattern" yaml:"pattern"`
+}
+
+// FileProbe checks that a file exists.
+type FileProbe struct {
+	Path string `bson:"path" json:"path" yaml:"path"`
+}
+
+// CommandProbe checks that a command exits successfully. The command runs on
+// the local host.
+type CommandProbe struct {
+	Args []string `bson:"args" json:"args" yaml:"args"`
+}
+
+// Validate ensures that the readiness options are valid and sets the default
+// interval if it is unset.
+func (r *Readiness) Validate() error {
+	if r.Interval == 0 {
+		r.Interval = DefaultReadinessInterval
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(len(r.Probes) == 0, "must specify at least one readiness probe")
+	catcher.NewWhen(r.Interval < 0, "readiness interval cannot be negative")
+	catcher.NewWhen(r.Timeout < 0, "readiness timeout cannot be negative")
+	for i, probe := range r.Probes {
+		catcher.Wrapf(probe.Validate(), "invalid readiness probe %d", i)
+	}
+	return catcher.Resolve()
+}
+
+// Validate ensures that exactly one kind of probe is set and that it is valid.
+func (p *ReadinessProbe) Validate() error {
+	var numSet int
+	for _, set := range []bool{p.TCP != nil, p.HTTP != nil, p.Log != nil, p.File != nil, p.Command != nil} {
+		if set {
+			numSet++
+		}
+	}
+	if numSet != 1 {
+		return errors.New("must specify exactly one kind of probe")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	switch {
+	case p.TCP != nil:
+		catcher.NewWhen(p.TCP.Address == "", "TCP probe must specify an address")
+	case p.HTTP != nil:
+		if _, err := url.ParseRequestURI(p.HTTP.URL); err != nil {
+			catcher.Wrapf(err, "invalid HTTP probe URL '%s'", p.HTTP.URL)
+		}
+		catcher.NewWhen(p.HTTP.Status < 0, "HTTP probe status cannot be negative")
+	case p.Log != nil:
+		if _, err := regexp.Compile(p.Log.Pattern); err != nil {
+			catcher.Wrapf(err, "invalid log probe pattern '%s'", p.Log.Pattern)
+		}
+		catcher.NewWhen(p.Log.Pattern == "", "log probe must specify a pattern")
+	case p.File != nil:
+		catcher.NewWhen(p.File.Path == "", "file probe must specify a path")
+	case p.Command != nil:
+		catcher.NewWhen(len(p.Command.Args) == 0, "command probe must specify at least one argument")
+	}
+	return catcher.Resolve()
+}
+
+// Copy returns a copy of the readiness options.
+func (r *Readiness) Copy() *Readiness {
+	copied := *r
+	if r.Probes != nil {
+		copied.Probes = make([]ReadinessProbe, 0, len(r.Probes))
+		for _, probe := range r.Probes {
+			copied.Probes = append(copied.Probes, probe.copy())
+		}
+	}
+	return &copied
+}
+
+func (p ReadinessProbe) copy() ReadinessProbe {
+	if p.TCP != nil {
+		tcp := *p.TCP
+		p.TCP = &tcp
+	}
+	if p.HTTP != nil {
+		http := *p.HTTP
+		p.HTTP = &http
+	}
+	if p.Log != nil {
+		log := *p.Log
+		p.Log = &log
+	}
+	if p.File != nil {
+		file := *p.File
+		p.File = &file
+	}
+	if p.Command != nil {
+		p.Command = &CommandProbe{Args: append([]string{}, p.Command.Args...)}
+	}
+	return p
+}
@@ -0,0 +1,65 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadiness(t *testing.T) {
+	t.Run("ValidateSetsDefaultInterval", func(t *testing.T) {
+		r := Readiness{Probes: []ReadinessProbe{{File: &FileProbe{Path: "foo"}}}}
+		require.NoError(t, r.Validate())
+		assert.Equal(t, DefaultReadinessInterval, r.Interval)
+	})
+	t.Run("NoProbesDoesNotValidate", func(t *testing.T) {
+		r := Readiness{}
+		assert.Error(t, r.Validate())
+	})
+	t.Run("NegativeTimeoutDoesNotValidate", func(t *testing.T) {
+		r := Readiness{
+			Probes:  []ReadinessProbe{{File: &FileProbe{Path: "foo"}}},
+			Timeout: -time.Second,
+		}
+		assert.Error(t, r.Validate())
+	})
+	t.Run("ProbeWithMultipleKindsDoesNotValidate", func(t *testing.T) {
+		r := Readiness{Probes: []ReadinessProbe{{
+			File: &FileProbe{Path: "foo"},
+			TCP:  &TCPProbe{Address: "localhost:27017"},
+		}}}
+		assert.Error(t, r.Validate())
+	})
+	t.Run("EmptyProbeDoesNotValidate", func(t *testing.T) {
+		r := Readiness{Probes: []ReadinessProbe{{}}}
+		assert.Error(t, r.Validate())
+	})
+	for name, probe := range map[string]ReadinessProbe{
+		"TCPProbeWithoutAddress":      {TCP: &TCPProbe{}},
+		"HTTPProbeWithInvalidURL":     {HTTP: &HTTPProbe{URL: "not a url"}},
+		"HTTPProbeWithNegativeStatus": {HTTP: &HTTPProbe{URL: "http://localhost:8080", Status: -1}},
+		"LogProbeWithoutPattern":      {Log: &LogProbe{}},
+		"LogProbeWithInvalidPattern":  {Log: &LogProbe{Pattern: "("}},
+		"FileProbeWithoutPath":        {File: &FileProbe{}},
+		"CommandProbeWithoutArgs":     {Command: &CommandProbe{}},
+	} {
+		t.Run(name+"DoesNotValidate", func(t *testing.T) {
+			assert.Error(t, probe.Validate())
+		})
+	}
+	t.Run("CopyDoesNotShareProbes", func(t *testing.T) {
+		r := Readiness{Probes: []ReadinessProbe{
+			{HTTP: &HTTPProbe{URL: "http://localhost:8080"}},
+			{Command: &CommandProbe{Args: []string{"true"}}},
+		}}
+		copied := r.Copy()
+		require.Equal(t, r, *copied)
+
+		copied.Probes[0].HTTP.URL = "http://localhost:9090"
+		copied.Probes[1].Command.Args[0] = "false"
+		assert.Equal(t, "http://localhost:8080", r.Probes[0].HTTP.URL)
+		assert.Equal(t, []string{"true"}, r.Probes[1].Command.Args)
+	})
+}
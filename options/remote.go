@@ -1,9 +1,27 @@
 package options
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/mongodb/grip"
+	"github.com/mongodb/jasper/internal/executor"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// Constants representing the defaults of the native SSH client.
+const (
+	DefaultSSHPort           = 22
+	DefaultSSHConnectTimeout = 30 * time.Second
 )
 
 // RemoteConfig represents the arguments to connect to a remote host.
@@ -17,6 +35,32 @@ type Remote struct {
 
 	// Additional args to the SSH binary.
 	Args []string `bson:"args,omitempty" json:"args,omitempty"`
+
+	// UseSSHLibrary runs processes using the native SSH client instead of
+	// the SSH binary. The options below only apply to the native SSH client.
+	UseSSHLibrary bool `bson:"use_ssh_library,omitempty" json:"use_ssh_library,omitempty"`
+	// Port is the port of the remote SSH server. Defaults to 22.
+	Port int `bson:"port,omitempty" json:"port,omitempty"`
+	// Key is a PEM-encoded private key to authenticate with.
+	Key string `bson:"key,omitempty" json:"key,omitempty"`
+	// KeyFile is the path to a PEM-encoded private key to authenticate with.
+	KeyFile string `bson:"key_file,omitempty" json:"key_file,omitempty"`
+	// KeyPassphrase decrypts the private key, if it is encrypted.
+	KeyPassphrase string `bson:"key_passphrase,omitempty" json:"key_passphrase,omitempty"`
+	// Password is the password to authenticate with.
+	Password string `bson:"password,omitempty" json:"password,omitempty"`
+	// UseAgent authenticates with the keys of the SSH agent listening on the
+	// socket given by the SSH_AUTH_SOCK environment variable.
+	UseAgent bool `bson:"use_agent,omitempty" json:"use_agent,omitempty"`
+	// KnownHostsFile is the path to the file containing the keys used to
+	// verify the remote host. Defaults to ~/.ssh/known_hosts.
+	KnownHostsFile string `bson:"known_hosts_file,omitempty" json:"known_hosts_file,omitempty"`
+	// InsecureSkipHostKeyVerification disables verifying the remote host's
+	// key. This should only be used for testing.
+	InsecureSkipHostKeyVerification bool `bson:"insecure_skip_host_key_verification,omitempty" json:"insecure_skip_host_key_verification,omitempty"`
+	// ConnectTimeout is the maximum time to connect and authenticate to the
+	// remote host. Defaults to 30 seconds.
+	ConnectTimeout time.Duration `bson:"connect_timeout,omitempty" json:"connect_timeout,omitempty"`
 }
 
 // Copy returns a copy of the options for only the exported fields.
@@ -25,6 +69,17 @@ func (opts *Remote) Copy() *Remote {
 	return &optsCopy
 }
 
+// Redacted returns a copy of the options without the private key, its
+// passphrase or the password, so that the options can be exposed without
+// revealing the credentials.
+func (opts *Remote) Redacted() *Remote {
+	optsCopy := opts.Copy()
+	optsCopy.Key = ""
+	optsCopy.KeyPassphrase = ""
+	optsCopy.Password = ""
+	return optsCopy
+}
+
 // Validate ensures that enough information is provided to connect to a remote
 // host.
 func (opts *Remote) Validate() error {
@@ -33,6 +88,14 @@ func (opts *Remote) Validate() error {
 		catcher.New("host cannot be empty")
 	}
 
+	if opts.UseSSHLibrary {
+		catcher.NewWhen(opts.Port < 0 || opts.Port > 65535, "port must be between 0 and 65535")
+		catcher.NewWhen(opts.Key != "" && opts.KeyFile != "", "cannot specify both a key and a key file")
+		catcher.NewWhen(opts.Key == "" && opts.KeyFile == "" && opts.Password == "" && !opts.UseAgent, "must specify at least one authentication method")
+		catcher.NewWhen(opts.KnownHostsFile != "" && opts.InsecureSkipHostKeyVerification, "cannot specify a known hosts file when skipping host key verification")
+		catcher.NewWhen(opts.ConnectTimeout < 0, "connect timeout cannot be negative")
+	}
+
 	return catcher.Resolve()
 }
 
@@ -43,3 +106,112 @@ func (opts *Remote) String() string {
 
 	return fmt.Sprintf("%s@%s", opts.User, opts.Host)
 }
+
+// resolveSSHDestination returns the destination used by the native SSH
+// client. Remote users default to the current user.
+func (opts *Remote) resolveSSHDestination() (*executor.SSHDestination, error) {
+	username := opts.User
+	if username == "" {
+		u, err := user.Current()
+		if err != nil {
+			return nil, errors.Wrap(err, "getting current user")
+		}
+		username = u.Username
+	}
+
+	port := opts.Port
+	if port == 0 {
+		port = DefaultSSHPort
+	}
+	timeout := opts.ConnectTimeout
+	if timeout == 0 {
+		timeout = DefaultSSHConnectTimeout
+	}
+
+	config := &ssh.ClientConfig{
+		User:    username,
+		Timeout: timeout,
+	}
+
+	if opts.Key != "" || opts.KeyFile != "" {
+		key := []byte(opts.Key)
+		if opts.KeyFile != "" {
+			var err error
+			if key, err = os.ReadFile(opts.KeyFile); err != nil {
+				return nil, errors.Wrapf(err, "reading key file '%s'", opts.KeyFile)
+			}
+		}
+		signer, err := parseSSHKey(key, opts.KeyPassphrase)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing private key")
+		}
+		config.Auth = append(config.Auth, ssh.PublicKeys(signer))
+	}
+	if opts.Password != "" {
+		config.Auth = append(config.Auth, ssh.Password(opts.Password))
+	}
+
+	var agentSocket string
+	if opts.UseAgent {
+		agentSocket = os.Getenv("SSH_AUTH_SOCK")
+		if agentSocket == "" {
+			return nil, errors.New("cannot use SSH agent because SSH_AUTH_SOCK is not set")
+		}
+	}
+
+	knownHostsFile := opts.KnownHostsFile
+	if opts.InsecureSkipHostKeyVerification {
+		config.HostKeyCallback = ssh.InsecureIgnoreHostKey()
+	} else {
+		if knownHostsFile == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return nil, errors.Wrap(err, "getting home directory for known hosts file")
+			}
+			knownHostsFile = filepath.Join(home, ".ssh", "known_hosts")
+		}
+		callback, err := knownhosts.New(knownHostsFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading known hosts file '%s'", knownHostsFile)
+		}
+		config.HostKeyCallback = callback
+	}
+
+	addr := net.JoinHostPort(opts.Host, strconv.Itoa(port))
+
+	// Processes only share a connection if they connect in exactly the same
+	// way, so a connection is never reused with different credentials or
+	// host key verification.
+	id := sha256.New()
+	for _, field := range []string{
+		username,
+		addr,
+		opts.Key,
+		opts.KeyFile,
+		opts.KeyPassphrase,
+		opts.Password,
+		agentSocket,
+		knownHostsFile,
+		strconv.FormatBool(opts.InsecureSkipHostKeyVerification),
+		timeout.String(),
+	} {
+		_, _ = id.Write([]byte(field))
+		_, _ = id.Write([]byte{0})
+	}
+
+	return &executor.SSHDestination{
+		Address:     addr,
+		Config:      config,
+		AgentSocket: agentSocket,
+		ID:          hex.EncodeToString(id.Sum(nil)),
+	}, nil
+}
+
+// parseSSHKey parses the PEM-encoded private key, decrypting it with the
+// passphrase if it is non-empty.
+func parseSSHKey(key []byte, passphrase string) (ssh.Signer, error) {
+	if passphrase != "" {
+		return ssh.ParsePrivateKeyWithPassphrase(key, []byte(passphrase))
+	}
+	return ssh.ParsePrivateKey(key)
+}
@@ -0,0 +1,138 @@
+package options
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mongodb/jasper/internal/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestRemote(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		for testName, testCase := range map[string]struct {
+			opts  Remote
+			valid bool
+		}{
+			"SSHBinaryOnlyRequiresHost":                      {opts: Remote{Host: "foo"}, valid: true},
+			"EmptyHostDoesNotValidate":                       {opts: Remote{}},
+			"SSHLibraryWithPassword":                         {opts: Remote{Host: "foo", UseSSHLibrary: true, Password: "bar"}, valid: true},
+			"SSHLibraryWithAgent":                            {opts: Remote{Host: "foo", UseSSHLibrary: true, UseAgent: true}, valid: true},
+			"SSHLibraryWithoutAuthenticationDoesNotValidate": {opts: Remote{Host: "foo", UseSSHLibrary: true}},
+			"SSHLibraryWithKeyAndKeyFileDoesNotValidate":     {opts: Remote{Host: "foo", UseSSHLibrary: true, Key: "bar", KeyFile: "baz"}},
+			"SSHLibraryWithInvalidPortDoesNotValidate":       {opts: Remote{Host: "foo", UseSSHLibrary: true, Password: "bar", Port: 65536}},
+			"SSHLibraryWithNegativeTimeoutDoesNotValidate":   {opts: Remote{Host: "foo", UseSSHLibrary: true, Password: "bar", ConnectTimeout: -1}},
+			"SSHLibraryWithKnownHostsAndInsecureDoesNotValidate": {
+				opts: Remote{Host: "foo", UseSSHLibrary: true, Password: "bar", KnownHostsFile: "baz", InsecureSkipHostKeyVerification: true},
+			},
+		} {
+			t.Run(testName, func(t *testing.T) {
+				if testCase.valid {
+					assert.NoError(t, testCase.opts.Validate())
+				} else {
+					assert.Error(t, testCase.opts.Validate())
+				}
+			})
+		}
+	})
+	t.Run("RedactedRemovesCredentials", func(t *testing.T) {
+		opts := Remote{Host: "foo", User: "bar", UseSSHLibrary: true, Key: "key", KeyFile: "key_file", KeyPassphrase: "passphrase", Password: "password"}
+		redacted := opts.Redacted()
+		assert.Equal(t, Remote{Host: "foo", User: "bar", UseSSHLibrary: true, KeyFile: "key_file"}, *redacted)
+		assert.Equal(t, "password", opts.Password)
+	})
+	t.Run("ResolveSSHDestination", func(t *testing.T) {
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		block, err := ssh.MarshalPrivateKey(key, "")
+		require.NoError(t, err)
+		encryptedBlock, err := ssh.MarshalPrivateKeyWithPassphrase(key, "", []byte("passphrase"))
+		require.NoError(t, err)
+		sshPub, err := ssh.NewPublicKey(pub)
+		require.NoError(t, err)
+
+		knownHostsFile := filepath.Join(t.TempDir(), "known_hosts")
+		require.NoError(t, os.WriteFile(knownHostsFile, []byte(knownhosts.Line([]string{"[foo]:2222"}, sshPub)+"\n"), 0600))
+		fooAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2222}
+
+		resolve := func(t *testing.T, opts Remote) *executor.SSHDestination {
+			dest, err := opts.resolveSSHDestination()
+			require.NoError(t, err)
+			require.NoError(t, dest.Validate())
+			return dest
+		}
+
+		t.Run("DefaultsPortAndTimeout", func(t *testing.T) {
+			dest := resolve(t, Remote{Host: "foo", User: "bar", Password: "baz", InsecureSkipHostKeyVerification: true})
+			assert.Equal(t, "foo:22", dest.Address)
+			assert.Equal(t, "bar", dest.Config.User)
+			assert.Equal(t, DefaultSSHConnectTimeout, dest.Config.Timeout)
+			assert.Len(t, dest.Config.Auth, 1)
+		})
+		t.Run("ParsesKey", func(t *testing.T) {
+			dest := resolve(t, Remote{Host: "foo", Key: string(pem.EncodeToMemory(block)), InsecureSkipHostKeyVerification: true})
+			assert.Len(t, dest.Config.Auth, 1)
+		})
+		t.Run("ParsesEncryptedKeyFile", func(t *testing.T) {
+			keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+			require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(encryptedBlock), 0600))
+			dest := resolve(t, Remote{Host: "foo", KeyFile: keyFile, KeyPassphrase: "passphrase", Password: "bar", InsecureSkipHostKeyVerification: true})
+			assert.Len(t, dest.Config.Auth, 2)
+
+			_, err := (&Remote{Host: "foo", KeyFile: keyFile, KeyPassphrase: "wrong", InsecureSkipHostKeyVerification: true}).resolveSSHDestination()
+			assert.Error(t, err)
+		})
+		t.Run("InvalidKeyErrors", func(t *testing.T) {
+			_, err := (&Remote{Host: "foo", Key: "bar", InsecureSkipHostKeyVerification: true}).resolveSSHDestination()
+			assert.Error(t, err)
+		})
+		t.Run("AgentRequiresSocket", func(t *testing.T) {
+			t.Setenv("SSH_AUTH_SOCK", "")
+			_, err := (&Remote{Host: "foo", UseAgent: true, InsecureSkipHostKeyVerification: true}).resolveSSHDestination()
+			assert.Error(t, err)
+
+			t.Setenv("SSH_AUTH_SOCK", "/foo/agent.sock")
+			dest := resolve(t, Remote{Host: "foo", UseAgent: true, InsecureSkipHostKeyVerification: true})
+			assert.Equal(t, "/foo/agent.sock", dest.AgentSocket)
+		})
+		t.Run("VerifiesHostKeyWithKnownHostsFile", func(t *testing.T) {
+			dest := resolve(t, Remote{Host: "foo", Port: 2222, Password: "bar", KnownHostsFile: knownHostsFile})
+			assert.NoError(t, dest.Config.HostKeyCallback("foo:2222", fooAddr, sshPub))
+
+			otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+			require.NoError(t, err)
+			otherSSHPub, err := ssh.NewPublicKey(otherPub)
+			require.NoError(t, err)
+			assert.Error(t, dest.Config.HostKeyCallback("foo:2222", fooAddr, otherSSHPub))
+		})
+		t.Run("MissingKnownHostsFileErrors", func(t *testing.T) {
+			_, err := (&Remote{Host: "foo", Password: "bar", KnownHostsFile: filepath.Join(t.TempDir(), "known_hosts")}).resolveSSHDestination()
+			assert.Error(t, err)
+		})
+		t.Run("IDDependsOnConnection", func(t *testing.T) {
+			opts := Remote{Host: "foo", User: "bar", Password: "baz", InsecureSkipHostKeyVerification: true}
+			id := resolve(t, opts).ID
+			assert.Equal(t, id, resolve(t, opts).ID)
+
+			for _, modify := range []func(*Remote){
+				func(r *Remote) { r.Host = "qux" },
+				func(r *Remote) { r.User = "qux" },
+				func(r *Remote) { r.Port = 2222 },
+				func(r *Remote) { r.Password = "qux" },
+				func(r *Remote) { r.InsecureSkipHostKeyVerification, r.KnownHostsFile = false, knownHostsFile },
+			} {
+				modified := opts
+				modify(&modified)
+				assert.NotEqual(t, id, resolve(t, modified).ID)
+			}
+		})
+	})
+}
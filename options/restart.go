@@ -0,0 +1,116 @@
+package options
+
+import (
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultRestartBackoff is the delay before the first restart of a
+	// process if the restart policy does not set one.
+	DefaultRestartBackoff = time.Second
+	// DefaultRestartMaxBackoff is the maximum delay between restarts of a
+	// process if the restart policy does not set one.
+	DefaultRestartMaxBackoff = time.Minute
+)
+
+// RestartCondition is the condition under which a process is restarted once
+// it exits.
+type RestartCondition string
+
+const (
+	// RestartNever never restarts the process.
+	RestartNever RestartCondition = "never"
+	// RestartOnFailure restarts the process only if it exits unsuccessfully.
+	RestartOnFailure RestartCondition = "on-failure"
+	// RestartAlways restarts the process whenever it exits.
+	RestartAlways RestartCondition = "always"
+)
+
+// Validate ensures that the restart condition is valid.
+func (c RestartCondition) Validate() error {
+	switch c {
+	case RestartNever, RestartOnFailure, RestartAlways:
+		return nil
+	default:
+		return errors.Errorf("'%s' is not a valid restart condition", c)
+	}
+}
+
+// Restart is a policy for automatically restarting a process once it exits.
+// Each restart creates a new process with the same options, so the restarted
+// process has a new ID.
+type Restart struct {
+	// Condition is the condition under which the process is restarted. If
+	// unset, it defaults to RestartNever.
+	Condition RestartCondition `bson:"condition,omitempty" json:"condition,omitempty" yaml:"condition,omitempty"`
+	// MaxRetries is the maximum number of consecutive restarts. If it is 0,
+	// there is no limit.
+	MaxRetries int `bson:"max_retries,omitempty" json:"max_retries,omitempty" yaml:"max_retries,omitempty"`
+	// Backoff is the delay before the first consecutive restart. The delay
+	// doubles with each consecutive restart up to MaxBackoff. If unset, it
+	// defaults to DefaultRestartBackoff.
+	Backoff time.Duration `bson:"backoff,omitempty" json:"backoff,omitempty" yaml:"backoff,omitempty"`
+	// MaxBackoff is the maximum delay between restarts. If unset, it defaults
+	// to DefaultRestartMaxBackoff.
+	MaxBackoff time.Duration `bson:"max_backoff,omitempty" json:"max_backoff,omitempty" yaml:"max_backoff,omitempty"`
+	// ResetWindow is how long a process must run before its consecutive
+	// restarts and backoff are reset. If it is 0, they are never reset.
+	ResetWindow time.Duration `bson:"reset_window,omitempty" json:"reset_window,omitempty" yaml:"reset_window,omitempty"`
+}
+
+// Validate ensures that the restart policy is valid and sets the defaults for
+// unset fields.
+func (r *Restart) Validate() error {
+	if r.Condition == "" {
+		r.Condition = RestartNever
+	}
+	if r.Backoff == 0 {
+		r.Backoff = DefaultRestartBackoff
+	}
+	if r.MaxBackoff == 0 {
+		r.MaxBackoff = DefaultRestartMaxBackoff
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(r.Condition.Validate())
+	catcher.NewWhen(r.MaxRetries < 0, "max retries cannot be negative")
+	catcher.NewWhen(r.Backoff < 0, "backoff cannot be negative")
+	catcher.NewWhen(r.MaxBackoff < r.Backoff, "max backoff cannot be less than the backoff")
+	catcher.NewWhen(r.ResetWindow < 0, "reset window cannot be negative")
+	return catcher.Resolve()
+}
+
+// ShouldRestart returns whether or not a process that exited with the given
+// outcome should be restarted, ignoring the number of retries.
+func (r *Restart) ShouldRestart(successful bool) bool {
+	switch r.Condition {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return !successful
+	default:
+		return false
+	}
+}
+
+// Delay returns how long to wait before restarting the process when it has
+// already been restarted the given number of consecutive times.
+func (r *Restart) Delay(retries int) time.Duration {
+	delay := r.Backoff
+	for i := 0; i < retries && delay < r.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > r.MaxBackoff {
+		return r.MaxBackoff
+	}
+	return delay
+}
+
+// Copy returns a copy of the restart policy.
+func (r *Restart) Copy() *Restart {
+	copied := *r
+	return &copied
+}
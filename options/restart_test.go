@@ -0,0 +1,46 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestart(t *testing.T) {
+	t.Run("ShouldRestart", func(t *testing.T) {
+		for condition, expected := range map[RestartCondition]struct{ successful, failed bool }{
+			RestartNever:     {successful: false, failed: false},
+			RestartOnFailure: {successful: false, failed: true},
+			RestartAlways:    {successful: true, failed: true},
+		} {
+			t.Run(string(condition), func(t *testing.T) {
+				r := Restart{Condition: condition}
+				assert.Equal(t, expected.successful, r.ShouldRestart(true))
+				assert.Equal(t, expected.failed, r.ShouldRestart(false))
+			})
+		}
+	})
+	t.Run("DelayBacksOffExponentiallyUpToMax", func(t *testing.T) {
+		r := Restart{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+		require.NoError(t, r.Validate())
+		assert.Equal(t, time.Second, r.Delay(0))
+		assert.Equal(t, 2*time.Second, r.Delay(1))
+		assert.Equal(t, 4*time.Second, r.Delay(2))
+		assert.Equal(t, 5*time.Second, r.Delay(3))
+		assert.Equal(t, 5*time.Second, r.Delay(100))
+	})
+	t.Run("NegativeMaxRetriesDoesNotValidate", func(t *testing.T) {
+		r := Restart{Condition: RestartAlways, MaxRetries: -1}
+		assert.Error(t, r.Validate())
+	})
+	t.Run("MaxBackoffLessThanBackoffDoesNotValidate", func(t *testing.T) {
+		r := Restart{Condition: RestartAlways, Backoff: time.Minute, MaxBackoff: time.Second}
+		assert.Error(t, r.Validate())
+	})
+	t.Run("NegativeResetWindowDoesNotValidate", func(t *testing.T) {
+		r := Restart{Condition: RestartAlways, ResetWindow: -time.Second}
+		assert.Error(t, r.Validate())
+	})
+}
@@ -0,0 +1,86 @@
+package options
+
+import (
+	"syscall"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// DefaultStopGracePeriod is the grace period of each step of the default stop
+// policy.
+const DefaultStopGracePeriod = 10 * time.Second
+
+// StopTarget is the set of processes that a stop policy signals.
+type StopTarget string
+
+const (
+	// StopTargetProcess signals only the process itself.
+	StopTargetProcess StopTarget = "process"
+	// StopTargetGroup signals every process in the process group of the
+	// process. The process must be the leader of its process group (i.e. it
+	// must be created with GroupLeader set).
+	StopTargetGroup StopTarget = "group"
+	// StopTargetTree signals the process and all of its descendants.
+	StopTargetTree StopTarget = "tree"
+)
+
+// Validate ensures that the stop target is valid.
+func (t StopTarget) Validate() error {
+	switch t {
+	case StopTargetProcess, StopTargetGroup, StopTargetTree:
+		return nil
+	default:
+		return errors.Errorf("'%s' is not a valid stop target", t)
+	}
+}
+
+// StopStep is a single step of a stop policy.
+type StopStep struct {
+	// Signal is the signal to send to the processes in the stop target.
+	Signal syscall.Signal `bson:"signal" json:"signal" yaml:"signal"`
+	// GracePeriod is how long to wait for the processes in the stop target to
+	// exit after sending the signal before moving on to the next step.
+	GracePeriod time.Duration `bson:"grace_period" json:"grace_period" yaml:"grace_period"`
+}
+
+// StopPolicy describes how to stop a process: each step's signal is sent in
+// order until the processes in the target exit, escalating to the next step
+// whenever a step's grace period expires.
+type StopPolicy struct {
+	// Steps are the steps to take in order. If unset, it defaults to sending
+	// SIGTERM and then SIGKILL, each with the DefaultStopGracePeriod.
+	Steps []StopStep `bson:"steps,omitempty" json:"steps,omitempty" yaml:"steps,omitempty"`
+	// Target is the set of processes to signal. If unset, it defaults to
+	// StopTargetProcess.
+	Target StopTarget `bson:"target,omitempty" json:"target,omitempty" yaml:"target,omitempty"`
+}
+
+// DefaultStopSteps returns the steps of the default stop policy, which sends
+// SIGTERM and then escalates to SIGKILL.
+func DefaultStopSteps() []StopStep {
+	return []StopStep{
+		{Signal: syscall.SIGTERM, GracePeriod: DefaultStopGracePeriod},
+		{Signal: syscall.SIGKILL, GracePeriod: DefaultStopGracePeriod},
+	}
+}
+
+// Validate ensures that the stop policy is valid and sets the default steps
+// and target if they are unset.
+func (p *StopPolicy) Validate() error {
+	if len(p.Steps) == 0 {
+		p.Steps = DefaultStopSteps()
+	}
+	if p.Target == "" {
+		p.Target = StopTargetProcess
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Add(p.Target.Validate())
+	for i, step := range p.Steps {
+		catcher.ErrorfWhen(step.Signal <= 0, "step %d must have a positive signal", i)
+		catcher.ErrorfWhen(step.GracePeriod <= 0, "step %d must have a positive grace period", i)
+	}
+	return catcher.Resolve()
+}
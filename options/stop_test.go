@@ -0,0 +1,43 @@
+package options
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopPolicy(t *testing.T) {
+	t.Run("EmptyPolicySetsDefaults", func(t *testing.T) {
+		p := StopPolicy{}
+		require.NoError(t, p.Validate())
+		assert.Equal(t, DefaultStopSteps(), p.Steps)
+		assert.Equal(t, StopTargetProcess, p.Target)
+	})
+	t.Run("CustomStepsAndTargetValidate", func(t *testing.T) {
+		p := StopPolicy{
+			Steps: []StopStep{
+				{Signal: syscall.SIGINT, GracePeriod: time.Second},
+				{Signal: syscall.SIGKILL, GracePeriod: time.Second},
+			},
+			Target: StopTargetTree,
+		}
+		require.NoError(t, p.Validate())
+		assert.Len(t, p.Steps, 2)
+		assert.Equal(t, StopTargetTree, p.Target)
+	})
+	t.Run("InvalidTargetDoesNotValidate", func(t *testing.T) {
+		p := StopPolicy{Target: "foo"}
+		assert.Error(t, p.Validate())
+	})
+	t.Run("StepWithoutSignalDoesNotValidate", func(t *testing.T) {
+		p := StopPolicy{Steps: []StopStep{{GracePeriod: time.Second}}}
+		assert.Error(t, p.Validate())
+	})
+	t.Run("StepWithoutGracePeriodDoesNotValidate", func(t *testing.T) {
+		p := StopPolicy{Steps: []StopStep{{Signal: syscall.SIGTERM}}}
+		assert.Error(t, p.Validate())
+	})
+}
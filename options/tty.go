@@ -0,0 +1,39 @@
+package options
+
+const (
+	// DefaultTTYRows is the default number of rows in a pseudo-terminal.
+	DefaultTTYRows = 24
+	// DefaultTTYCols is the default number of columns in a pseudo-terminal.
+	DefaultTTYCols = 80
+)
+
+// TTY represents the pseudo-terminal that a local process runs in. When a
+// process runs in a pseudo-terminal, its standard output and standard error
+// are combined and written to the standard output writers and loggers.
+// Pseudo-terminals are currently only supported for local processes on unix
+// systems.
+type TTY struct {
+	// Rows is the height of the terminal window in characters. If unset,
+	// it defaults to DefaultTTYRows.
+	Rows uint16 `bson:"rows,omitempty" json:"rows,omitempty" yaml:"rows,omitempty"`
+	// Cols is the width of the terminal window in characters. If unset, it
+	// defaults to DefaultTTYCols.
+	Cols uint16 `bson:"cols,omitempty" json:"cols,omitempty" yaml:"cols,omitempty"`
+}
+
+// Validate sets the default window size for any unset dimensions.
+func (t *TTY) Validate() error {
+	if t.Rows == 0 {
+		t.Rows = DefaultTTYRows
+	}
+	if t.Cols == 0 {
+		t.Cols = DefaultTTYCols
+	}
+	return nil
+}
+
+// Copy returns a copy of the pseudo-terminal options.
+func (t *TTY) Copy() *TTY {
+	ttyCopy := *t
+	return &ttyCopy
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/send"
 	"github.com/mongodb/jasper/options"
 	"github.com/pkg/errors"
@@ -34,6 +35,21 @@ type LogStream struct {
 	Done bool     `bson:"done" json:"done"`
 }
 
+// LogChunk represents a batch of output lines pushed while following the
+// in-memory output logs of a process.
+type LogChunk struct {
+	Logs []string `bson:"logs,omitempty" json:"logs,omitempty"`
+	// Offset is the number of lines that were logged before the first line in
+	// Logs. If it is greater than the offset that was requested, the lines in
+	// between were dropped from the in-memory buffer before they were read.
+	Offset int `bson:"offset" json:"offset"`
+	// NextOffset is the offset from which to resume following the logs.
+	NextOffset int `bson:"next_offset" json:"next_offset"`
+	// Done indicates that the process has completed and all of its logs have
+	// been read.
+	Done bool `bson:"done" json:"done"`
+}
+
 // GetInMemoryLogStream gets at most count logs from the in-memory output logs
 // for the given Process proc. If the process has not been called with
 // Process.Wait(), this is not guaranteed to produce all the logs. This function
@@ -41,20 +57,86 @@ type LogStream struct {
 // output. It returns io.EOF if the stream is done. For remote interfaces, this
 // function will not work; use (remote.Manager).GetLogStream() instead.
 func GetInMemoryLogStream(ctx context.Context, proc Process, count int) ([]string, error) {
-	if proc == nil {
-		return nil, errors.New("cannot get output logs from nil process")
+	inMemorySender, err := getInMemorySender(ctx, proc)
+	if err != nil {
+		return nil, err
 	}
-	for _, logger := range proc.Info(ctx).Options.Output.Loggers {
-		if logger.Type() != options.LogInMemory {
-			continue
+
+	msgs, _, err := inMemorySender.GetCount(count)
+	if err != nil {
+		if err != io.EOF {
+			err = errors.Wrap(err, "getting logs from in-memory stream")
 		}
+		return nil, err
+	}
 
-		// This is fine because logger.sender is already set.
-		sender, err := logger.Resolve()
-		if err != nil {
+	return formatInMemoryLogs(inMemorySender, msgs)
+}
+
+// FollowInMemoryLogs pushes the in-memory output logs for the given Process
+// proc to the handler as they are written, starting at the given offset. It
+// returns once the process has completed and all of its logs have been
+// handled, the context is done, or the handler returns an error. The last
+// chunk passed to the handler when the process completes is marked as done.
+// This function assumes that there is exactly one in-memory logger attached
+// to this process's output. For remote interfaces, this function will not
+// work; use (remote.Manager).FollowLogs() instead.
+func FollowInMemoryLogs(ctx context.Context, proc Process, offset int, handler func(LogChunk) error) error {
+	log, err := getInMemoryLog(ctx, proc)
+	if err != nil {
+		return err
+	}
+
+	complete := make(chan struct{})
+	waitCtx, waitCancel := context.WithCancel(ctx)
+	defer waitCancel()
+	go func() {
+		defer close(complete)
+		_, _ = proc.Wait(waitCtx)
+	}()
+
+	var isComplete bool
+	for {
+		msgs, start, next, wait := log.GetSince(offset)
+		if len(msgs) != 0 {
+			logs, err := formatInMemoryLogs(log.Sender(), msgs)
+			if err != nil {
+				return err
+			}
+			if err := handler(LogChunk{Logs: logs, Offset: start, NextOffset: next}); err != nil {
+				return err
+			}
+			offset = next
 			continue
 		}
 
+		if isComplete {
+			return handler(LogChunk{Offset: offset, NextOffset: offset, Done: true})
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wait:
+		case <-complete:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// Check for new logs one last time before finishing, since the
+			// process may have logged output before it completed.
+			isComplete = true
+		}
+	}
+}
+
+// getInMemorySender returns the in-memory sender for the given Process proc's
+// output logs.
+func getInMemorySender(ctx context.Context, proc Process) (*send.InMemorySender, error) {
+	senders, err := getInMemoryLoggerSenders(ctx, proc)
+	if err != nil {
+		return nil, err
+	}
+	for _, sender := range senders {
 		safeSender, ok := sender.(*options.SafeSender)
 		if ok {
 			sender = safeSender.GetSender()
@@ -64,24 +146,62 @@ func GetInMemoryLogStream(ctx context.Context, proc Process, count int) ([]strin
 			continue
 		}
 
-		msgs, _, err := inMemorySender.GetCount(count)
-		if err != nil {
-			if err != io.EOF {
-				err = errors.Wrap(err, "getting logs from in-memory stream")
-			}
-			return nil, err
-		}
+		return inMemorySender, nil
+	}
+	return nil, errors.New("could not find in-memory output logs")
+}
 
-		strs := make([]string, 0, len(msgs))
-		for _, msg := range msgs {
-			str, err := inMemorySender.Formatter()(msg)
-			if err != nil {
-				return nil, err
-			}
-			strs = append(strs, str)
+// getInMemoryLog returns the in-memory log for the given Process proc's output
+// logs.
+func getInMemoryLog(ctx context.Context, proc Process) (*options.InMemoryLog, error) {
+	senders, err := getInMemoryLoggerSenders(ctx, proc)
+	if err != nil {
+		return nil, err
+	}
+	for _, sender := range senders {
+		safeSender, ok := sender.(*options.SafeSender)
+		if !ok || safeSender.InMemoryLog() == nil {
+			continue
 		}
 
-		return strs, nil
+		return safeSender.InMemoryLog(), nil
 	}
 	return nil, errors.New("could not find in-memory output logs")
 }
+
+// getInMemoryLoggerSenders returns the senders for the given Process proc's
+// in-memory output loggers.
+func getInMemoryLoggerSenders(ctx context.Context, proc Process) ([]send.Sender, error) {
+	if proc == nil {
+		return nil, errors.New("cannot get output logs from nil process")
+	}
+	var senders []send.Sender
+	for _, logger := range proc.Info(ctx).Options.Output.Loggers {
+		if logger.Type() != options.LogInMemory {
+			continue
+		}
+
+		// This is fine because logger.sender is already set.
+		sender, err := logger.Resolve()
+		if err != nil {
+			continue
+		}
+		senders = append(senders, sender)
+	}
+	return senders, nil
+}
+
+// formatInMemoryLogs formats the messages using the in-memory sender's
+// formatter.
+func formatInMemoryLogs(sender send.Sender, msgs []message.Composer) ([]string, error) {
+	strs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		str, err := sender.Formatter()(msg)
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, str)
+	}
+
+	return strs, nil
+}
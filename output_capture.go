@@ -0,0 +1,469 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/internal/executor"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// CapturedOutput is part of an output stream captured from a process (see
+// (options.Output).Capture).
+type CapturedOutput struct {
+	Stream options.OutputStream `bson:"stream" json:"stream"`
+	// Data is the requested output.
+	Data []byte `bson:"data" json:"data"`
+	// Offset is the byte offset in the stream of the first byte of Data.
+	Offset int64 `bson:"offset" json:"offset"`
+	// Size is the total number of bytes that have been written to the
+	// stream.
+	Size int64 `bson:"size" json:"size"`
+	// Truncated indicates that some of the requested output is no longer
+	// available because it was discarded.
+	Truncated bool `bson:"truncated" json:"truncated"`
+}
+
+// GetCapturedOutput gets part of the captured output of the given Process
+// proc, which must have been created with (options.Output).Capture set. The
+// output can be retrieved both while the process is running and after it
+// completes. For remote interfaces, this function will not work; use
+// (remote.Manager).GetCapturedOutput() instead.
+func GetCapturedOutput(ctx context.Context, proc Process, query options.OutputQuery) (*CapturedOutput, error) {
+	if proc == nil {
+		return nil, errors.New("cannot get captured output from nil process")
+	}
+	if err := query.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid output query")
+	}
+
+	cp, ok := proc.(capturingProcess)
+	if !ok {
+		return nil, errors.New("process does not support output capture")
+	}
+	capture := cp.outputCapture()
+	if capture == nil {
+		return nil, errors.New("output capture is not enabled for the process")
+	}
+
+	return capture.stream(query.Stream).get(query)
+}
+
+// capturingProcess is a process that can capture its output.
+type capturingProcess interface {
+	// outputCapture returns the process' output capture, or nil if its output
+	// is not captured.
+	outputCapture() *outputCapture
+}
+
+// closeOutputCapture releases the resources used to capture the process'
+// output, if any.
+func closeOutputCapture(ctx context.Context, proc Process) {
+	cp, ok := proc.(capturingProcess)
+	if !ok {
+		return
+	}
+	grip.Warning(ctx, message.WrapError(cp.outputCapture().close(), message.Fields{
+		"message": "problem closing output capture",
+		"process": proc.ID(),
+	}))
+}
+
+// outputCapture captures the standard output and standard error of a
+// process. A nil outputCapture belongs to a process whose output is not
+// captured.
+type outputCapture struct {
+	stdout *captureStream
+	stderr *captureStream
+}
+
+// newOutputCapture returns an output capture for the capture options, or nil
+// if there are none.
+func newOutputCapture(opts *options.OutputCapture) (*outputCapture, error) {
+	if opts == nil {
+		return nil, nil
+	}
+
+	stdout, err := newCaptureStream(*opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "capturing standard output")
+	}
+	stderr, err := newCaptureStream(*opts)
+	if err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Wrap(err, "capturing standard error")
+		catcher.Wrap(stdout.close(), "closing standard output capture")
+		return nil, catcher.Resolve()
+	}
+
+	return &outputCapture{stdout: stdout, stderr: stderr}, nil
+}
+
+// wrapOutput makes the process' standard output and standard error also
+// written to the capture. It must be called before the process starts.
+func (c *outputCapture) wrapOutput(exec executor.Executor) {
+	if c == nil {
+		return
+	}
+
+	exec.SetStdout(teeCapture(exec.Stdout(), c.stdout))
+	exec.SetStderr(teeCapture(exec.Stderr(), c.stderr))
+}
+
+func teeCapture(w io.Writer, stream *captureStream) io.Writer {
+	if w == nil {
+		return stream
+	}
+	return io.MultiWriter(w, stream)
+}
+
+func (c *outputCapture) stream(stream options.OutputStream) *captureStream {
+	if stream == options.OutputStreamStderr {
+		return c.stderr
+	}
+	return c.stdout
+}
+
+// close releases the resources used by the capture. The captured output is
+// no longer available once it is closed.
+func (c *outputCapture) close() error {
+	if c == nil {
+		return nil
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(c.stdout.close(), "closing standard output capture")
+	catcher.Wrap(c.stderr.close(), "closing standard error capture")
+	return catcher.Resolve()
+}
+
+// captureScanSize is the size of the chunks read when scanning the captured
+// output for lines.
+const captureScanSize = 32 * 1024
+
+// captureStream captures a single output stream in a ring buffer of a fixed
+// size. Output that no longer fits in the buffer is either discarded or, if
+// spilling is enabled, written to a temporary file. It is thread-safe.
+type captureStream struct {
+	mu  sync.Mutex
+	max int
+	// buf holds the output from start to size. The byte at offset o is at
+	// index o % max.
+	buf []byte
+	// start is the offset of the oldest byte in buf.
+	start int64
+	// size is the total number of bytes written.
+	size int64
+	// droppedLines is the number of complete lines before start.
+	droppedLines int64
+	// lineAligned is whether or not the output before start ends with a
+	// complete line.
+	lineAligned bool
+
+	// spill, if set, holds the output from offset 0 to start.
+	spill     *os.File
+	spillPath string
+	closed    bool
+}
+
+func newCaptureStream(opts options.OutputCapture) (*captureStream, error) {
+	s := &captureStream{
+		max:         opts.MaxBytes,
+		lineAligned: true,
+	}
+	if !opts.SpillToFile {
+		return s, nil
+	}
+
+	file, err := os.CreateTemp("", "jasper-output-")
+	if err != nil {
+		return nil, errors.Wrap(err, "creating spill file")
+	}
+	s.spill = file
+	// Remove the file right away so that it is cleaned up once it is closed,
+	// even if the process is never cleared. If it cannot be removed while it
+	// is open (e.g. on Windows), remove it once it is closed.
+	if err := os.Remove(file.Name()); err != nil {
+		s.spillPath = file.Name()
+	}
+
+	return s, nil
+}
+
+func (s *captureStream) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := len(p)
+	if s.closed {
+		return n, nil
+	}
+
+	if len(p) > s.max {
+		// Only the end of the output fits in the buffer, so everything
+		// before it is dropped.
+		s.evict(s.size - s.start)
+		head := p[:len(p)-s.max]
+		s.drop(head, s.size)
+		s.size += int64(len(head))
+		s.start = s.size
+		p = p[len(head):]
+	}
+	if over := s.size - s.start + int64(len(p)) - int64(s.max); over > 0 {
+		s.evict(over)
+	}
+
+	if int(s.size) == len(s.buf) && len(s.buf)+len(p) <= s.max {
+		// The buffer only grows as large as it needs to until it is full.
+		s.buf = append(s.buf, p...)
+		s.size += int64(len(p))
+		return n, nil
+	}
+	if len(s.buf) < s.max {
+		s.buf = append(s.buf, make([]byte, s.max-len(s.buf))...)
+	}
+	for len(p) > 0 {
+		copied := copy(s.buf[s.size%int64(s.max):], p)
+		p = p[copied:]
+		s.size += int64(copied)
+	}
+
+	return n, nil
+}
+
+// evict removes the oldest n bytes from the buffer.
+func (s *captureStream) evict(n int64) {
+	if n <= 0 {
+		return
+	}
+
+	evicted := make([]byte, n)
+	s.readBuffer(evicted, s.start)
+	s.drop(evicted, s.start)
+	s.start += n
+}
+
+// drop records that the output at the given offset is no longer in the
+// buffer and writes it to the spill file if there is one.
+func (s *captureStream) drop(p []byte, offset int64) {
+	if len(p) == 0 {
+		return
+	}
+
+	s.droppedLines += int64(bytes.Count(p, []byte{'\n'}))
+	s.lineAligned = p[len(p)-1] == '\n'
+
+	if s.spill == nil {
+		return
+	}
+	if _, err := s.spill.WriteAt(p, offset); err != nil {
+		// Stop spilling rather than keep an incomplete copy of the output.
+		grip.Warning(context.Background(), message.WrapError(err, message.Fields{
+			"message": "could not spill captured output to file, discarding it instead",
+			"file":    s.spill.Name(),
+		}))
+		grip.Warning(context.Background(), s.closeSpill())
+	}
+}
+
+// readBuffer reads len(p) bytes at the given offset from the buffer.
+func (s *captureStream) readBuffer(p []byte, offset int64) {
+	for len(p) > 0 {
+		copied := copy(p, s.buf[offset%int64(s.max):])
+		p = p[copied:]
+		offset += int64(copied)
+	}
+}
+
+// available returns the offset of the oldest byte that can still be read.
+func (s *captureStream) available() int64 {
+	if s.spill != nil {
+		return 0
+	}
+	return s.start
+}
+
+// readAt reads len(p) bytes at the given offset, which must be available.
+func (s *captureStream) readAt(p []byte, offset int64) error {
+	if offset < s.start {
+		spilled := p
+		if int64(len(spilled)) > s.start-offset {
+			spilled = spilled[:s.start-offset]
+		}
+		if _, err := s.spill.ReadAt(spilled, offset); err != nil {
+			return errors.Wrap(err, "reading spill file")
+		}
+		p = p[len(spilled):]
+		offset += int64(len(spilled))
+	}
+	s.readBuffer(p, offset)
+	return nil
+}
+
+// skipLines returns the offset just past the nth newline at or after the
+// given offset, or the end of the stream if there are fewer than n.
+func (s *captureStream) skipLines(from, n int64) (int64, error) {
+	chunk := make([]byte, captureScanSize)
+	for pos := from; n > 0 && pos < s.size; {
+		size := min(s.size-pos, int64(len(chunk)))
+		if err := s.readAt(chunk[:size], pos); err != nil {
+			return 0, err
+		}
+		for i, b := range chunk[:size] {
+			if b != '\n' {
+				continue
+			}
+			n--
+			if n == 0 {
+				return pos + int64(i) + 1, nil
+			}
+		}
+		pos += size
+	}
+	return s.size, nil
+}
+
+// lastLines returns the offset of the start of the nth line from the end of
+// the stream and whether or not some of those lines are no longer available.
+func (s *captureStream) lastLines(n int64) (int64, bool, error) {
+	avail := s.available()
+	end := s.size
+	if end > avail {
+		// The newline that ends the last line does not start another line.
+		last := make([]byte, 1)
+		if err := s.readAt(last, end-1); err != nil {
+			return 0, false, err
+		}
+		if last[0] == '\n' {
+			end--
+		}
+	}
+
+	chunk := make([]byte, captureScanSize)
+	for pos := end; pos > avail; {
+		size := min(pos-avail, int64(len(chunk)))
+		if err := s.readAt(chunk[:size], pos-size); err != nil {
+			return 0, false, err
+		}
+		for i := size - 1; i >= 0; i-- {
+			if chunk[i] != '\n' {
+				continue
+			}
+			n--
+			if n == 0 {
+				return pos - size + i + 1, false, nil
+			}
+		}
+		pos -= size
+	}
+	return avail, avail > 0, nil
+}
+
+// get returns the part of the stream selected by the query.
+func (s *captureStream) get(query options.OutputQuery) (*CapturedOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, errors.New("output capture is closed")
+	}
+
+	avail := s.available()
+	var from, end int64
+	var truncated bool
+	var err error
+	switch lines := query.Lines; {
+	case lines == nil:
+		from = query.Offset
+		if from < 0 {
+			from = max(s.size+from, 0)
+		}
+		from = min(from, s.size)
+		end = s.size
+		if query.Limit > 0 {
+			end = min(from+query.Limit, s.size)
+		}
+		if from < avail {
+			from = avail
+			end = max(end, from)
+			truncated = true
+		}
+	case lines.Start < 0:
+		from, truncated, err = s.lastLines(-lines.Start)
+		if err != nil {
+			return nil, err
+		}
+		end = s.size
+		if lines.Count > 0 {
+			if end, err = s.skipLines(from, lines.Count); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		linesBefore := int64(0)
+		if avail > 0 {
+			linesBefore = s.droppedLines
+		}
+		from = avail
+		if lines.Start > linesBefore {
+			if from, err = s.skipLines(avail, lines.Start-linesBefore); err != nil {
+				return nil, err
+			}
+		}
+		truncated = lines.Start < linesBefore || (lines.Start == linesBefore && avail > 0 && !s.lineAligned)
+
+		end = s.size
+		if lines.Count > 0 {
+			if remaining := lines.Start + lines.Count - linesBefore; remaining <= 0 {
+				end = from
+			} else if end, err = s.skipLines(avail, remaining); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	out := &CapturedOutput{
+		Stream:    query.Stream,
+		Data:      make([]byte, end-from),
+		Offset:    from,
+		Size:      s.size,
+		Truncated: truncated,
+	}
+	if err := s.readAt(out.Data, from); err != nil {
+		return nil, errors.Wrap(err, "reading captured output")
+	}
+
+	return out, nil
+}
+
+// closeSpill closes and removes the spill file. The output that it held is
+// discarded.
+func (s *captureStream) closeSpill() error {
+	if s.spill == nil {
+		return nil
+	}
+
+	catcher := grip.NewBasicCatcher()
+	catcher.Wrap(s.spill.Close(), "closing spill file")
+	if s.spillPath != "" {
+		catcher.Wrap(os.Remove(s.spillPath), "removing spill file")
+	}
+	s.spill = nil
+	s.spillPath = ""
+	return catcher.Resolve()
+}
+
+func (s *captureStream) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	s.buf = nil
+	return s.closeSpill()
+}
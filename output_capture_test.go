@@ -0,0 +1,285 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureStream(t *testing.T) {
+	// writeLines writes the lines "0\n" through "<n-1>\n" in separate writes.
+	writeLines := func(t *testing.T, s *captureStream, n int) {
+		for i := 0; i < n; i++ {
+			_, err := fmt.Fprintf(s, "%d\n", i)
+			require.NoError(t, err)
+		}
+	}
+	get := func(t *testing.T, s *captureStream, query options.OutputQuery) *CapturedOutput {
+		require.NoError(t, query.Validate())
+		out, err := s.get(query)
+		require.NoError(t, err)
+		return out
+	}
+
+	for testName, testCase := range map[string]struct {
+		opts options.OutputCapture
+		test func(t *testing.T, s *captureStream)
+	}{
+		"GetsAllOutputThatFits": {
+			opts: options.OutputCapture{MaxBytes: 64},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 3)
+				out := get(t, s, options.OutputQuery{})
+				assert.Equal(t, "0\n1\n2\n", string(out.Data))
+				assert.Zero(t, out.Offset)
+				assert.EqualValues(t, 6, out.Size)
+				assert.False(t, out.Truncated)
+			},
+		},
+		"DiscardsOldestOutput": {
+			opts: options.OutputCapture{MaxBytes: 8},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 10)
+				out := get(t, s, options.OutputQuery{})
+				assert.Equal(t, "6\n7\n8\n9\n", string(out.Data))
+				assert.EqualValues(t, 12, out.Offset)
+				assert.EqualValues(t, 20, out.Size)
+				assert.True(t, out.Truncated)
+			},
+		},
+		"WriteLargerThanBufferKeepsEnd": {
+			opts: options.OutputCapture{MaxBytes: 4},
+			test: func(t *testing.T, s *captureStream) {
+				_, err := s.Write([]byte("ab"))
+				require.NoError(t, err)
+				_, err = s.Write([]byte("cdefghij"))
+				require.NoError(t, err)
+				out := get(t, s, options.OutputQuery{})
+				assert.Equal(t, "ghij", string(out.Data))
+				assert.EqualValues(t, 6, out.Offset)
+			},
+		},
+		"GetsByteRange": {
+			opts: options.OutputCapture{MaxBytes: 64},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 5)
+				out := get(t, s, options.OutputQuery{Offset: 2, Limit: 4})
+				assert.Equal(t, "1\n2\n", string(out.Data))
+				assert.EqualValues(t, 2, out.Offset)
+			},
+		},
+		"NegativeOffsetIsRelativeToEnd": {
+			opts: options.OutputCapture{MaxBytes: 64},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 5)
+				out := get(t, s, options.OutputQuery{Offset: -4})
+				assert.Equal(t, "3\n4\n", string(out.Data))
+				assert.EqualValues(t, 6, out.Offset)
+			},
+		},
+		"OffsetPastEndIsEmpty": {
+			opts: options.OutputCapture{MaxBytes: 64},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 2)
+				out := get(t, s, options.OutputQuery{Offset: 100})
+				assert.Empty(t, out.Data)
+				assert.EqualValues(t, 4, out.Offset)
+			},
+		},
+		"DiscardedByteRangeIsTruncated": {
+			opts: options.OutputCapture{MaxBytes: 8},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 10)
+				out := get(t, s, options.OutputQuery{Offset: 10, Limit: 4})
+				assert.Equal(t, "6\n", string(out.Data))
+				assert.EqualValues(t, 12, out.Offset)
+				assert.True(t, out.Truncated)
+			},
+		},
+		"GetsLineRange": {
+			opts: options.OutputCapture{MaxBytes: 64},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 5)
+				out := get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: 1, Count: 2}})
+				assert.Equal(t, "1\n2\n", string(out.Data))
+				assert.EqualValues(t, 2, out.Offset)
+				assert.False(t, out.Truncated)
+			},
+		},
+		"GetsLastLines": {
+			opts: options.OutputCapture{MaxBytes: 64},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 5)
+				out := get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: -2}})
+				assert.Equal(t, "3\n4\n", string(out.Data))
+				assert.False(t, out.Truncated)
+			},
+		},
+		"LastLinesIncludesPartialLine": {
+			opts: options.OutputCapture{MaxBytes: 64},
+			test: func(t *testing.T, s *captureStream) {
+				_, err := s.Write([]byte("a\nb\nprompt> "))
+				require.NoError(t, err)
+				out := get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: -2, Count: 1}})
+				assert.Equal(t, "b\n", string(out.Data))
+			},
+		},
+		"MoreLastLinesThanWrittenGetsAll": {
+			opts: options.OutputCapture{MaxBytes: 64},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 2)
+				out := get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: -10}})
+				assert.Equal(t, "0\n1\n", string(out.Data))
+				assert.False(t, out.Truncated)
+			},
+		},
+		"LineNumbersCountDiscardedLines": {
+			opts: options.OutputCapture{MaxBytes: 8},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 10)
+				out := get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: 7, Count: 2}})
+				assert.Equal(t, "7\n8\n", string(out.Data))
+				assert.False(t, out.Truncated)
+
+				out = get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: 4, Count: 3}})
+				assert.Equal(t, "6\n", string(out.Data))
+				assert.True(t, out.Truncated)
+
+				out = get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: 0, Count: 2}})
+				assert.Empty(t, out.Data)
+				assert.True(t, out.Truncated)
+
+				out = get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: -10}})
+				assert.Equal(t, "6\n7\n8\n9\n", string(out.Data))
+				assert.True(t, out.Truncated)
+			},
+		},
+		"PartiallyDiscardedLineIsTruncated": {
+			opts: options.OutputCapture{MaxBytes: 4},
+			test: func(t *testing.T, s *captureStream) {
+				_, err := s.Write([]byte("abcdef\ng\n"))
+				require.NoError(t, err)
+				out := get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: 0, Count: 1}})
+				assert.Equal(t, "f\n", string(out.Data))
+				assert.True(t, out.Truncated)
+			},
+		},
+		"SpillFileKeepsAllOutput": {
+			opts: options.OutputCapture{MaxBytes: 8, SpillToFile: true},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 100)
+				_, err := s.Write([]byte(strings.Repeat("x", 20)))
+				require.NoError(t, err)
+
+				out := get(t, s, options.OutputQuery{Limit: 4})
+				assert.Equal(t, "0\n1\n", string(out.Data))
+				assert.False(t, out.Truncated)
+
+				out = get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: 42, Count: 1}})
+				assert.Equal(t, "42\n", string(out.Data))
+
+				out = get(t, s, options.OutputQuery{Lines: &options.LineRange{Start: -2}})
+				assert.Equal(t, "99\n"+strings.Repeat("x", 20), string(out.Data))
+
+				out = get(t, s, options.OutputQuery{})
+				assert.Len(t, out.Data, int(out.Size))
+				assert.Zero(t, out.Offset)
+			},
+		},
+		"ClosedStreamErrors": {
+			opts: options.OutputCapture{MaxBytes: 8, SpillToFile: true},
+			test: func(t *testing.T, s *captureStream) {
+				writeLines(t, s, 10)
+				require.NoError(t, s.close())
+				_, err := s.get(options.OutputQuery{Stream: options.OutputStreamStdout})
+				assert.Error(t, err)
+			},
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			s, err := newCaptureStream(testCase.opts)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, s.close())
+			}()
+
+			testCase.test(t, s)
+		})
+	}
+}
+
+func TestGetCapturedOutput(t *testing.T) {
+	for procType, makeProc := range map[string]ProcessConstructor{
+		"Basic":    newBasicProcess,
+		"Blocking": newBlockingProcess,
+		"Synchronized": func(ctx context.Context, opts *options.Create) (Process, error) {
+			proc, err := newBasicProcess(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			return makeSynchronizedProcess(proc), nil
+		},
+	} {
+		t.Run(procType, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			t.Run("CapturesEachStream", func(t *testing.T) {
+				opts := &options.Create{Args: []string{"sh", "-c", "echo out; echo err >&2"}}
+				opts.Output.Capture = &options.OutputCapture{}
+				proc, err := makeProc(ctx, opts)
+				require.NoError(t, err)
+				_, err = proc.Wait(ctx)
+				require.NoError(t, err)
+
+				out, err := GetCapturedOutput(ctx, proc, options.OutputQuery{})
+				require.NoError(t, err)
+				assert.Equal(t, options.OutputStreamStdout, out.Stream)
+				assert.Equal(t, "out\n", string(out.Data))
+
+				out, err = GetCapturedOutput(ctx, proc, options.OutputQuery{Stream: options.OutputStreamStderr})
+				require.NoError(t, err)
+				assert.Equal(t, "err\n", string(out.Data))
+			})
+			t.Run("WithoutCaptureErrors", func(t *testing.T) {
+				proc, err := makeProc(ctx, &options.Create{Args: []string{"echo", "foo"}})
+				require.NoError(t, err)
+				_, err = GetCapturedOutput(ctx, proc, options.OutputQuery{})
+				assert.Error(t, err)
+			})
+			t.Run("InvalidQueryErrors", func(t *testing.T) {
+				opts := &options.Create{Args: []string{"echo", "foo"}}
+				opts.Output.Capture = &options.OutputCapture{}
+				proc, err := makeProc(ctx, opts)
+				require.NoError(t, err)
+				_, err = GetCapturedOutput(ctx, proc, options.OutputQuery{Stream: "foo"})
+				assert.Error(t, err)
+			})
+		})
+	}
+	t.Run("ClearingProcessClosesCapture", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+		defer cancel()
+
+		mngr, err := NewSynchronizedManager(false)
+		require.NoError(t, err)
+		opts := &options.Create{Args: []string{"echo", "foo"}}
+		opts.Output.Capture = &options.OutputCapture{SpillToFile: true}
+		proc, err := mngr.CreateProcess(ctx, opts)
+		require.NoError(t, err)
+		_, err = proc.Wait(ctx)
+		require.NoError(t, err)
+
+		_, err = GetCapturedOutput(ctx, proc, options.OutputQuery{})
+		require.NoError(t, err)
+		mngr.Clear(ctx)
+		_, err = GetCapturedOutput(ctx, proc, options.OutputQuery{})
+		assert.Error(t, err)
+	})
+}
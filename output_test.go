@@ -2,12 +2,15 @@ package jasper
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/testutil"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -117,3 +120,141 @@ func TestGetInMemoryLogStream(t *testing.T) {
 		})
 	}
 }
+
+func TestFollowInMemoryLogs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	addInMemoryLogger := func(t *testing.T, opts *options.Create) {
+		config := &options.LoggerConfig{}
+		require.NoError(t, config.Set(&options.InMemoryLoggerOptions{
+			InMemoryCap: 100,
+			Base: options.BaseOptions{
+				Format: options.LogFormatPlain,
+			},
+		}))
+		opts.Output.Loggers = []*options.LoggerConfig{config}
+	}
+
+	for procType, makeProc := range map[string]ProcessConstructor{
+		"BasicProcess":    newBasicProcess,
+		"BlockingProcess": newBlockingProcess,
+	} {
+		t.Run(procType, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, makeProc ProcessConstructor){
+				"FailsWithNilProcess": func(ctx context.Context, t *testing.T, makeProc ProcessConstructor) {
+					assert.Error(t, FollowInMemoryLogs(ctx, nil, 0, func(LogChunk) error { return nil }))
+				},
+				"FailsWithoutInMemoryLogger": func(ctx context.Context, t *testing.T, makeProc ProcessConstructor) {
+					proc, err := makeProc(ctx, &options.Create{Args: []string{"echo", "foo"}})
+					require.NoError(t, err)
+
+					assert.Error(t, FollowInMemoryLogs(ctx, proc, 0, func(LogChunk) error { return nil }))
+				},
+				"PushesLogsUntilProcessCompletes": func(ctx context.Context, t *testing.T, makeProc ProcessConstructor) {
+					// Output is only flushed to the loggers once enough of it
+					// is buffered, so the lines must be long enough to be
+					// logged before the process completes.
+					first := strings.Repeat("a", 100)
+					second := strings.Repeat("b", 100)
+					opts := &options.Create{Args: []string{"sh", "-c", fmt.Sprintf("echo %s; sleep 1; echo %s", first, second)}}
+					addInMemoryLogger(t, opts)
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+
+					var chunks []LogChunk
+					require.NoError(t, FollowInMemoryLogs(ctx, proc, 0, func(chunk LogChunk) error {
+						if len(chunks) == 0 {
+							assert.False(t, proc.Complete(ctx), "first chunk should be pushed while the process is running")
+						}
+						chunks = append(chunks, chunk)
+						return nil
+					}))
+					require.True(t, len(chunks) >= 2)
+
+					var logs []string
+					for i, chunk := range chunks {
+						logs = append(logs, chunk.Logs...)
+						if i > 0 {
+							assert.Equal(t, chunks[i-1].NextOffset, chunk.Offset)
+						}
+					}
+					assert.Contains(t, logs, first)
+					assert.Contains(t, logs, second)
+
+					last := chunks[len(chunks)-1]
+					assert.True(t, last.Done)
+					assert.Empty(t, last.Logs)
+					assert.Equal(t, len(logs), last.NextOffset)
+					for _, chunk := range chunks[:len(chunks)-1] {
+						assert.False(t, chunk.Done)
+					}
+				},
+				"ResumesFromOffset": func(ctx context.Context, t *testing.T, makeProc ProcessConstructor) {
+					lines := []string{strings.Repeat("a", 100), strings.Repeat("b", 100), strings.Repeat("c", 100)}
+					opts := &options.Create{Args: []string{"sh", "-c", fmt.Sprintf("echo %s; echo %s; echo %s", lines[0], lines[1], lines[2])}}
+					addInMemoryLogger(t, opts)
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					var allLogs []string
+					require.NoError(t, FollowInMemoryLogs(ctx, proc, 0, func(chunk LogChunk) error {
+						allLogs = append(allLogs, chunk.Logs...)
+						return nil
+					}))
+					offset := 0
+					for i, log := range allLogs {
+						if log == lines[1] {
+							offset = i
+						}
+					}
+					require.NotZero(t, offset)
+
+					var logs []string
+					require.NoError(t, FollowInMemoryLogs(ctx, proc, offset, func(chunk LogChunk) error {
+						if len(chunk.Logs) != 0 {
+							assert.Equal(t, offset, chunk.Offset)
+						}
+						logs = append(logs, chunk.Logs...)
+						return nil
+					}))
+					assert.Equal(t, allLogs[offset:], logs)
+					assert.NotContains(t, logs, lines[0])
+					assert.Contains(t, logs, lines[2])
+				},
+				"ReturnsHandlerError": func(ctx context.Context, t *testing.T, makeProc ProcessConstructor) {
+					opts := &options.Create{Args: []string{"echo", "foo"}}
+					addInMemoryLogger(t, opts)
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+
+					handlerErr := errors.New("handler error")
+					assert.Equal(t, handlerErr, FollowInMemoryLogs(ctx, proc, 0, func(LogChunk) error {
+						return handlerErr
+					}))
+				},
+				"StopsWhenContextIsDone": func(ctx context.Context, t *testing.T, makeProc ProcessConstructor) {
+					opts := &options.Create{Args: []string{"sleep", "10"}}
+					addInMemoryLogger(t, opts)
+					proc, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+
+					followCtx, followCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+					defer followCancel()
+					err = FollowInMemoryLogs(followCtx, proc, 0, func(LogChunk) error { return nil })
+					assert.Equal(t, context.DeadlineExceeded, err)
+					assert.False(t, proc.Complete(ctx))
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					tctx, tcancel := context.WithTimeout(ctx, testutil.ProcessTestTimeout)
+					defer tcancel()
+
+					testCase(tctx, t, makeProc)
+				})
+			}
+		})
+	}
+}
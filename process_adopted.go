@@ -0,0 +1,227 @@
+package jasper
+
+import (
+	"context"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// adoptedProcessPollInterval is the interval between checks for whether an
+// adopted process is still running.
+const adoptedProcessPollInterval = 100 * time.Millisecond
+
+// managedProcess is a process on the host that was started by a Jasper
+// manager, as identified by its environment variables.
+type managedProcess struct {
+	pid        int
+	ppid       int
+	startTicks uint64
+	args       []string
+	env        map[string]string
+}
+
+// adoptedProcess is a process that was started by a previous manager and
+// adopted by the current one, typically after the service running the
+// manager restarted. Since the process is not a child of the current process,
+// its state is determined by polling its PID and its exit code is unknown.
+type adoptedProcess struct {
+	info           ProcessInfo
+	startTicks     uint64
+	tags           map[string]struct{}
+	triggers       ProcessTriggerSequence
+	signalTriggers SignalTriggerSequence
+	stopResult     *StopResult
+	waitProcessed  chan struct{}
+	sync.RWMutex
+}
+
+// newAdoptedProcess adopts the running process described by the given info
+// that started at the given time in clock ticks since boot.
+func newAdoptedProcess(info ProcessInfo, startTicks uint64) *adoptedProcess {
+	p := &adoptedProcess{
+		info:          info,
+		startTicks:    startTicks,
+		tags:          make(map[string]struct{}),
+		waitProcessed: make(chan struct{}),
+	}
+	p.info.IsRunning = true
+	p.info.Complete = false
+	for _, t := range info.Options.Tags {
+		p.tags[t] = struct{}{}
+	}
+
+	go p.poll()
+
+	return p
+}
+
+// poll waits for the process to exit and then marks it as completed.
+func (p *adoptedProcess) poll() {
+	ticker := time.NewTicker(adoptedProcessPollInterval)
+	defer ticker.Stop()
+
+	for isProcessAlive(p.info.PID, p.startTicks) {
+		<-ticker.C
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	defer close(p.waitProcessed)
+	p.info.EndAt = time.Now()
+	p.info.IsRunning = false
+	p.info.Complete = true
+	p.info.ExitCode = -1
+	p.info.StoppedBy = p.stopResult
+	p.triggers.Run(p.info)
+}
+
+func (p *adoptedProcess) ID() string {
+	return p.info.ID
+}
+
+func (p *adoptedProcess) Info(_ context.Context) ProcessInfo {
+	p.RLock()
+	defer p.RUnlock()
+	return p.info
+}
+
+func (p *adoptedProcess) Complete(ctx context.Context) bool {
+	return !p.Running(ctx)
+}
+
+func (p *adoptedProcess) Running(_ context.Context) bool {
+	p.RLock()
+	defer p.RUnlock()
+	return p.info.IsRunning
+}
+
+func (p *adoptedProcess) Signal(_ context.Context, sig syscall.Signal) error {
+	p.RLock()
+	defer p.RUnlock()
+
+	if p.info.Complete || !isProcessAlive(p.info.PID, p.startTicks) {
+		return errors.New("cannot signal a process that has already exited")
+	}
+
+	if skipSignal := p.signalTriggers.Run(p.info, sig); skipSignal {
+		return nil
+	}
+
+	proc, err := os.FindProcess(p.info.PID)
+	if err != nil {
+		return errors.Wrapf(err, "finding process '%s'", p.info.ID)
+	}
+	sig = makeCompatible(sig)
+	return errors.Wrapf(proc.Signal(sig), "sending signal '%s' to process '%s'", sig, p.info.ID)
+}
+
+func (p *adoptedProcess) Stop(ctx context.Context, policy options.StopPolicy) error {
+	return stopProcess(ctx, p, policy, func(result *StopResult) {
+		p.Lock()
+		defer p.Unlock()
+		p.stopResult = result
+	})
+}
+
+func (p *adoptedProcess) Resize(context.Context, uint16, uint16) error {
+	return errors.New("cannot resize an adopted process")
+}
+
+func (p *adoptedProcess) Respawn(context.Context) (Process, error) {
+	return nil, errors.New("cannot respawn an adopted process")
+}
+
+// Wait waits for the process to exit. Since the exit code of an adopted
+// process is unknown, it always returns an error once the process exits.
+func (p *adoptedProcess) Wait(ctx context.Context) (int, error) {
+	select {
+	case <-ctx.Done():
+		return -1, ctx.Err()
+	case <-p.waitProcessed:
+	}
+
+	return -1, errors.Errorf("exit code of adopted process '%s' is unknown", p.info.ID)
+}
+
+// WaitReady returns immediately since the readiness probes of an adopted
+// process are not checked.
+func (p *adoptedProcess) WaitReady(context.Context) error {
+	return nil
+}
+
+func (p *adoptedProcess) RegisterTrigger(_ context.Context, trigger ProcessTrigger) error {
+	if trigger == nil {
+		return errors.New("cannot register nil trigger")
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if p.info.Complete {
+		return errors.New("cannot register trigger after process has already exited")
+	}
+
+	p.triggers = append(p.triggers, trigger)
+
+	return nil
+}
+
+func (p *adoptedProcess) RegisterSignalTrigger(_ context.Context, trigger SignalTrigger) error {
+	if trigger == nil {
+		return errors.New("cannot register nil trigger")
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if p.info.Complete {
+		return errors.New("cannot register signal trigger after process has already exited")
+	}
+
+	p.signalTriggers = append(p.signalTriggers, trigger)
+
+	return nil
+}
+
+func (p *adoptedProcess) RegisterSignalTriggerID(ctx context.Context, id SignalTriggerID) error {
+	makeTrigger, ok := GetSignalTriggerFactory(id)
+	if !ok {
+		return errors.Errorf("could not find signal trigger '%s'", id)
+	}
+	return errors.Wrap(p.RegisterSignalTrigger(ctx, makeTrigger()), "register signal trigger")
+}
+
+func (p *adoptedProcess) Tag(t string) {
+	p.Lock()
+	defer p.Unlock()
+
+	if _, ok := p.tags[t]; ok {
+		return
+	}
+	p.tags[t] = struct{}{}
+	p.info.Options.Tags = append(p.info.Options.Tags, t)
+}
+
+func (p *adoptedProcess) ResetTags() {
+	p.Lock()
+	defer p.Unlock()
+
+	p.tags = make(map[string]struct{})
+	p.info.Options.Tags = []string{}
+}
+
+func (p *adoptedProcess) GetTags() []string {
+	p.RLock()
+	defer p.RUnlock()
+
+	out := []string{}
+	for t := range p.tags {
+		out = append(out, t)
+	}
+	return out
+}
@@ -23,7 +23,13 @@ type basicProcess struct {
 	tags           map[string]struct{}
 	triggers       ProcessTriggerSequence
 	signalTriggers SignalTriggerSequence
+	stopResult     *StopResult
+	treeKiller     *processTreeKiller
+	restarts       restartLineage
+	readiness      *readinessChecker
+	capture        *outputCapture
 	waitProcessed  chan struct{}
+	resources      *resourceSampler
 	sync.RWMutex
 }
 
@@ -40,6 +46,7 @@ func newBasicProcess(ctx context.Context, opts *options.Create) (Process, error)
 		id:            id,
 		exec:          exec,
 		tags:          make(map[string]struct{}),
+		restarts:      restartLineageFromContext(ctx),
 		waitProcessed: make(chan struct{}),
 	}
 
@@ -47,11 +54,31 @@ func newBasicProcess(ctx context.Context, opts *options.Create) (Process, error)
 		p.tags[t] = struct{}{}
 	}
 
+	if opts.KillTree && opts.Remote == nil {
+		p.treeKiller = newProcessTreeKiller()
+		exec.SetCancel(func() error {
+			return p.treeKiller.signal(exec, syscall.SIGKILL)
+		})
+	}
+
+	if p.capture, err = newOutputCapture(opts.Output.Capture); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(err)
+		catcher.Wrap(opts.Close(), "closing options")
+		catcher.Wrap(exec.Close(), "closing executor")
+		return nil, errors.Wrap(catcher.Resolve(), "setting up output capture")
+	}
+	p.capture.wrapOutput(exec)
+
+	p.readiness = newReadinessChecker(opts.Readiness)
+	p.readiness.wrapOutput(exec)
+
 	if err = p.RegisterTrigger(ctx, makeOptionsCloseTrigger()); err != nil {
 		catcher := grip.NewBasicCatcher()
 		catcher.Add(err)
 		catcher.Wrap(opts.Close(), "closing options")
 		catcher.Wrap(exec.Close(), "closing executor")
+		catcher.Wrap(p.capture.close(), "closing output capture")
 		return nil, errors.Wrap(catcher.Resolve(), "registering options close trigger")
 	}
 
@@ -60,6 +87,7 @@ func newBasicProcess(ctx context.Context, opts *options.Create) (Process, error)
 		catcher.Add(err)
 		catcher.Wrap(opts.Close(), "closing options")
 		catcher.Wrap(exec.Close(), "closing executor")
+		catcher.Wrap(p.capture.close(), "closing output capture")
 		return nil, errors.Wrap(catcher.Resolve(), "starting process execution")
 	}
 
@@ -74,7 +102,13 @@ func newBasicProcess(ctx context.Context, opts *options.Create) (Process, error)
 	p.info.IsRunning = true
 	p.info.PID = exec.PID()
 
+	if opts.Remote == nil {
+		p.resources = newResourceSampler(p.info.PID)
+		go p.resources.run(ctx, opts.ResourceSampleInterval, p.waitProcessed)
+	}
+
 	go p.transition(ctx, deadline)
+	go p.readiness.run(ctx, p.waitProcessed)
 
 	return p, nil
 }
@@ -98,6 +132,11 @@ func (p *basicProcess) transition(ctx context.Context, deadline time.Time) {
 		p.info.EndAt = finishTime
 		p.info.IsRunning = false
 		p.info.Complete = true
+		p.info.Resources = p.resources.get()
+		p.info.StoppedBy = p.stopResult
+		p.info.KilledPIDs = p.treeKiller.killedPIDs()
+		p.restarts.apply(&p.info)
+		p.readiness.apply(&p.info)
 		if sig, signaled := p.exec.SignalInfo(); signaled {
 			p.info.ExitCode = int(sig)
 			if !deadline.IsZero() {
@@ -123,7 +162,15 @@ func (p *basicProcess) Info(_ context.Context) ProcessInfo {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.info
+	info := p.info
+	if !info.Complete {
+		info.Resources = p.resources.get()
+	}
+	// Descendants that were sent SIGTERM can exit after the process does.
+	info.KilledPIDs = p.treeKiller.killedPIDs()
+	p.restarts.apply(&info)
+	p.readiness.apply(&info)
+	return redactProcessInfo(info)
 }
 
 func (p *basicProcess) Complete(ctx context.Context) bool {
@@ -146,11 +193,42 @@ func (p *basicProcess) Signal(_ context.Context, sig syscall.Signal) error {
 
 	if skipSignal := p.signalTriggers.Run(p.info, sig); !skipSignal {
 		sig = makeCompatible(sig)
-		return errors.Wrapf(p.exec.Signal(sig), "sending signal '%s' to process '%s'", sig, p.id)
+		return errors.Wrapf(p.treeKiller.signal(p.exec, sig), "sending signal '%s' to process '%s'", sig, p.id)
 	}
 	return nil
 }
 
+func (p *basicProcess) Resize(_ context.Context, rows, cols uint16) error {
+	p.RLock()
+	defer p.RUnlock()
+
+	if p.info.Complete {
+		return errors.New("cannot resize a process that has already exited")
+	}
+
+	return errors.Wrapf(p.exec.Resize(rows, cols), "resizing process '%s'", p.id)
+}
+
+func (p *basicProcess) Stop(ctx context.Context, policy options.StopPolicy) error {
+	return stopProcess(ctx, p, policy, func(result *StopResult) {
+		p.Lock()
+		defer p.Unlock()
+		p.stopResult = result
+	})
+}
+
+func (p *basicProcess) outputCapture() *outputCapture {
+	return p.capture
+}
+
+func (p *basicProcess) setRestartLineage(lineage restartLineage) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.restarts = lineage
+	lineage.apply(&p.info)
+}
+
 func (p *basicProcess) Respawn(ctx context.Context) (Process, error) {
 	p.RLock()
 	defer p.RUnlock()
@@ -176,6 +254,10 @@ func (p *basicProcess) Wait(ctx context.Context) (int, error) {
 	return p.info.ExitCode, p.err
 }
 
+func (p *basicProcess) WaitReady(ctx context.Context) error {
+	return errors.Wrapf(p.readiness.wait(ctx), "waiting for process '%s' to become ready", p.id)
+}
+
 func (p *basicProcess) RegisterTrigger(_ context.Context, trigger ProcessTrigger) error {
 	if trigger == nil {
 		return errors.New("cannot register nil trigger")
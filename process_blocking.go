@@ -22,10 +22,17 @@ type blockingProcess struct {
 	complete chan struct{}
 	err      error
 
+	resources *resourceSampler
+
 	mu             sync.RWMutex
 	tags           map[string]struct{}
 	triggers       ProcessTriggerSequence
 	signalTriggers SignalTriggerSequence
+	stopResult     *StopResult
+	treeKiller     *processTreeKiller
+	restarts       restartLineage
+	readiness      *readinessChecker
+	capture        *outputCapture
 	info           ProcessInfo
 }
 
@@ -41,6 +48,7 @@ func newBlockingProcess(ctx context.Context, opts *options.Create) (Process, err
 	p := &blockingProcess{
 		id:       id,
 		tags:     make(map[string]struct{}),
+		restarts: restartLineageFromContext(ctx),
 		ops:      make(chan func(executor.Executor)),
 		complete: make(chan struct{}),
 	}
@@ -49,9 +57,28 @@ func newBlockingProcess(ctx context.Context, opts *options.Create) (Process, err
 		p.tags[t] = struct{}{}
 	}
 
+	if opts.KillTree && opts.Remote == nil {
+		p.treeKiller = newProcessTreeKiller()
+		exec.SetCancel(func() error {
+			return p.treeKiller.signal(exec, syscall.SIGKILL)
+		})
+	}
+
+	if p.capture, err = newOutputCapture(opts.Output.Capture); err != nil {
+		catcher := grip.NewBasicCatcher()
+		catcher.Add(err)
+		catcher.Wrap(opts.Close(), "closing options")
+		return nil, errors.Wrap(catcher.Resolve(), "setting up output capture")
+	}
+	p.capture.wrapOutput(exec)
+
+	p.readiness = newReadinessChecker(opts.Readiness)
+	p.readiness.wrapOutput(exec)
+
 	if err = p.RegisterTrigger(ctx, makeOptionsCloseTrigger()); err != nil {
 		catcher := grip.NewBasicCatcher()
 		catcher.Wrap(opts.Close(), "closing options")
+		catcher.Wrap(p.capture.close(), "closing output capture")
 		catcher.Add(err)
 		return nil, errors.Wrap(catcher.Resolve(), "registering options close trigger")
 	}
@@ -59,6 +86,7 @@ func newBlockingProcess(ctx context.Context, opts *options.Create) (Process, err
 	if err = exec.Start(); err != nil {
 		catcher := grip.NewBasicCatcher()
 		catcher.Wrap(opts.Close(), "closing options")
+		catcher.Wrap(p.capture.close(), "closing output capture")
 		catcher.Add(err)
 		return nil, errors.Wrap(catcher.Resolve(), "starting command")
 	}
@@ -76,7 +104,13 @@ func newBlockingProcess(ctx context.Context, opts *options.Create) (Process, err
 		p.info.Host, _ = os.Hostname()
 	}
 
+	if opts.Remote == nil {
+		p.resources = newResourceSampler(p.info.PID)
+		go p.resources.run(ctx, opts.ResourceSampleInterval, p.complete)
+	}
+
 	go p.reactor(ctx, deadline, exec)
+	go p.readiness.run(ctx, p.complete)
 
 	return p, nil
 }
@@ -97,7 +131,16 @@ func (p *blockingProcess) setInfo(info ProcessInfo) {
 func (p *blockingProcess) getInfo() ProcessInfo {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return p.info
+
+	info := p.info
+	if !info.Complete {
+		info.Resources = p.resources.get()
+	}
+	// Descendants that were sent SIGTERM can exit after the process does.
+	info.KilledPIDs = p.treeKiller.killedPIDs()
+	p.restarts.apply(&info)
+	p.readiness.apply(&info)
+	return info
 }
 
 func (p *blockingProcess) setErr(err error) {
@@ -142,6 +185,11 @@ func (p *blockingProcess) reactor(ctx context.Context, deadline time.Time, exec
 				info.EndAt = finishTime
 				info.Complete = true
 				info.IsRunning = false
+				info.Resources = p.resources.get()
+				info.StoppedBy = p.stopResult
+				info.KilledPIDs = p.treeKiller.killedPIDs()
+				p.restarts.apply(&info)
+				p.readiness.apply(&info)
 
 				info.Successful = exec.Success()
 				if sig, signaled := exec.SignalInfo(); signaled {
@@ -181,8 +229,13 @@ func (p *blockingProcess) reactor(ctx context.Context, deadline time.Time, exec
 			info.IsRunning = false
 			info.Successful = false
 			info.EndAt = time.Now()
+			info.Resources = p.resources.get()
 
 			p.mu.RLock()
+			info.StoppedBy = p.stopResult
+			info.KilledPIDs = p.treeKiller.killedPIDs()
+			p.restarts.apply(&info)
+			p.readiness.apply(&info)
 			p.triggers.Run(info)
 			p.mu.RUnlock()
 			p.setErr(errors.Wrap(ctx.Err(), "processing operations"))
@@ -206,6 +259,12 @@ func (p *blockingProcess) reactor(ctx context.Context, deadline time.Time, exec
 
 func (p *blockingProcess) ID() string { return p.id }
 func (p *blockingProcess) Info(ctx context.Context) ProcessInfo {
+	return redactProcessInfo(p.latestInfo(ctx))
+}
+
+// latestInfo returns the current info of the process, including the
+// credentials in its options.
+func (p *blockingProcess) latestInfo(ctx context.Context) ProcessInfo {
 	if p.hasCompleteInfo() {
 		return p.getInfo()
 	}
@@ -292,7 +351,7 @@ func (p *blockingProcess) Signal(ctx context.Context, sig syscall.Signal) error
 
 		if skipSignal := p.signalTriggers.Run(p.getInfo(), sig); !skipSignal {
 			sig = makeCompatible(sig)
-			out <- errors.Wrapf(exec.Signal(sig), "sending signal '%s' to process '%s'",
+			out <- errors.Wrapf(p.treeKiller.signal(exec, sig), "sending signal '%s' to process '%s'",
 				sig, p.id)
 		} else {
 			out <- nil
@@ -321,6 +380,48 @@ func (p *blockingProcess) Signal(ctx context.Context, sig syscall.Signal) error
 	}
 }
 
+func (p *blockingProcess) Resize(ctx context.Context, rows, cols uint16) error {
+	if p.hasCompleteInfo() {
+		return errors.New("cannot resize a process that has already exited")
+	}
+
+	out := make(chan error, 1)
+	operation := func(exec executor.Executor) {
+		defer close(out)
+
+		if exec == nil {
+			out <- errors.New("cannot resize nil process")
+			return
+		}
+
+		out <- errors.Wrapf(exec.Resize(rows, cols), "resizing process '%s'", p.id)
+	}
+
+	select {
+	case p.ops <- operation:
+		select {
+		case res := <-out:
+			return res
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "waiting for operation to be processed")
+		case <-p.complete:
+			return errors.New("cannot resize a process that has already exited")
+		}
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "waiting for operation to be enqueued")
+	case <-p.complete:
+		return errors.New("cannot resize a process that has already exited")
+	}
+}
+
+func (p *blockingProcess) Stop(ctx context.Context, policy options.StopPolicy) error {
+	return stopProcess(ctx, p, policy, func(result *StopResult) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.stopResult = result
+	})
+}
+
 func (p *blockingProcess) RegisterTrigger(_ context.Context, trigger ProcessTrigger) error {
 	if trigger == nil {
 		return errors.New("cannot register nil trigger")
@@ -363,6 +464,10 @@ func (p *blockingProcess) RegisterSignalTriggerID(ctx context.Context, id Signal
 	return errors.Wrap(p.RegisterSignalTrigger(ctx, makeTrigger()), "registering signal trigger")
 }
 
+func (p *blockingProcess) WaitReady(ctx context.Context) error {
+	return errors.Wrapf(p.readiness.wait(ctx), "waiting for process '%s' to become ready", p.id)
+}
+
 func (p *blockingProcess) Wait(ctx context.Context) (int, error) {
 	if p.hasCompleteInfo() {
 		return p.getInfo().ExitCode, p.getErr()
@@ -396,11 +501,23 @@ func (p *blockingProcess) Wait(ctx context.Context) (int, error) {
 }
 
 func (p *blockingProcess) Respawn(ctx context.Context) (Process, error) {
-	opts := p.Info(ctx).Options
+	opts := p.latestInfo(ctx).Options
 	optsCopy := opts.Copy()
 	return newBlockingProcess(ctx, optsCopy)
 }
 
+func (p *blockingProcess) outputCapture() *outputCapture {
+	return p.capture
+}
+
+func (p *blockingProcess) setRestartLineage(lineage restartLineage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.restarts = lineage
+	lineage.apply(&p.info)
+}
+
 func (p *blockingProcess) Tag(t string) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -60,6 +60,37 @@ func (p *synchronizedProcess) Signal(ctx context.Context, sig syscall.Signal) er
 	return errors.WithStack(p.proc.Signal(ctx, sig))
 }
 
+func (p *synchronizedProcess) Stop(ctx context.Context, policy options.StopPolicy) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return errors.WithStack(p.proc.Stop(ctx, policy))
+}
+
+func (p *synchronizedProcess) outputCapture() *outputCapture {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if cp, ok := p.proc.(capturingProcess); ok {
+		return cp.outputCapture()
+	}
+	return nil
+}
+
+func (p *synchronizedProcess) setRestartLineage(lineage restartLineage) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	setRestartLineage(p.proc, lineage)
+}
+
+func (p *synchronizedProcess) Resize(ctx context.Context, rows, cols uint16) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return errors.WithStack(p.proc.Resize(ctx, rows, cols))
+}
+
 func (p *synchronizedProcess) Tag(t string) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -110,6 +141,16 @@ func (p *synchronizedProcess) Wait(ctx context.Context) (int, error) {
 	return exitCode, errors.WithStack(err)
 }
 
+// WaitReady waits for the wrapped process to become ready. The lock is not
+// held while waiting so that the process can still be used in the meantime.
+func (p *synchronizedProcess) WaitReady(ctx context.Context) error {
+	p.mutex.RLock()
+	proc := p.proc
+	p.mutex.RUnlock()
+
+	return errors.WithStack(proc.WaitReady(ctx))
+}
+
 func (p *synchronizedProcess) Respawn(ctx context.Context) (Process, error) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
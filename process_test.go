@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/testutil"
 	testoptions "github.com/mongodb/jasper/testutil/options"
@@ -54,6 +55,32 @@ func TestProcessImplementations(t *testing.T) {
 							assert.True(t, time.Since(startAt) < 20*time.Second)
 						},
 					},
+					{
+						Name: "ResourcesAreSampledForLocalProcess",
+						Case: func(ctx context.Context, t *testing.T, _ *options.Create, makep ProcessConstructor) {
+							if runtime.GOOS != "linux" {
+								t.Skip("resource sampling is only supported on Linux")
+							}
+							opts := testoptions.SleepCreateOpts(2)
+							opts.ResourceSampleInterval = 10 * time.Millisecond
+							proc, err := makep(ctx, opts)
+							require.NoError(t, err)
+
+							time.Sleep(100 * time.Millisecond)
+							resources := proc.Info(ctx).Resources
+							require.NotNil(t, resources)
+							assert.NotZero(t, resources.NumSamples)
+							assert.NotZero(t, resources.Last.RSS)
+							assert.NotZero(t, resources.Peak.NumProcesses)
+							assert.False(t, resources.SampledAt.IsZero())
+
+							_, err = proc.Wait(ctx)
+							require.NoError(t, err)
+							final := proc.Info(ctx).Resources
+							require.NotNil(t, final)
+							assert.True(t, final.NumSamples >= resources.NumSamples)
+						},
+					},
 					{
 						Name: "OptionsCloseTriggerRegisteredByDefault",
 						Case: func(ctx context.Context, t *testing.T, opts *options.Create, makep ProcessConstructor) {
@@ -332,6 +359,62 @@ func TestProcessImplementations(t *testing.T) {
 							}
 						},
 					},
+					{
+						Name: "TTYProcessWritesToOutput",
+						Case: func(ctx context.Context, t *testing.T, opts *options.Create, makep ProcessConstructor) {
+							if runtime.GOOS == "windows" {
+								t.Skip("pseudo-terminals are not supported on Windows")
+							}
+							logger, err := NewInMemoryLogger(10)
+							require.NoError(t, err)
+							opts.Args = []string{"sh", "-c", "test -t 1 && stty size"}
+							opts.TTY = &options.TTY{Rows: 30, Cols: 100}
+							opts.Output.Loggers = []*options.LoggerConfig{logger}
+
+							proc, err := makep(ctx, opts)
+							require.NoError(t, err)
+							exitCode, err := proc.Wait(ctx)
+							require.NoError(t, err)
+							assert.Zero(t, exitCode)
+
+							logs, err := GetInMemoryLogStream(ctx, proc, 10)
+							require.NoError(t, err)
+							assert.Contains(t, strings.Join(logs, "\n"), "30 100")
+						},
+					},
+					{
+						Name: "ResizeChangesTTYWindowSize",
+						Case: func(ctx context.Context, t *testing.T, opts *options.Create, makep ProcessConstructor) {
+							if runtime.GOOS == "windows" {
+								t.Skip("pseudo-terminals are not supported on Windows")
+							}
+							output := utility.MakeSafeBuffer(bytes.Buffer{})
+							opts.Args = []string{"sh", "-c", "read line; stty size"}
+							opts.TTY = &options.TTY{}
+							opts.StandardInputStream = true
+							opts.Output.Output = output
+
+							proc, err := makep(ctx, opts)
+							require.NoError(t, err)
+							require.NoError(t, proc.Resize(ctx, 50, 132))
+							require.NoError(t, WriteStandardInput(ctx, proc, strings.NewReader("\n")))
+							require.NoError(t, CloseStandardInput(ctx, proc))
+							_, err = proc.Wait(ctx)
+							require.NoError(t, err)
+
+							assert.Contains(t, output.String(), "50 132")
+							assert.Error(t, proc.Resize(ctx, 24, 80), "should not be able to resize a completed process")
+						},
+					},
+					{
+						Name: "ResizeFailsWithoutTTY",
+						Case: func(ctx context.Context, t *testing.T, _ *options.Create, makep ProcessConstructor) {
+							proc, err := makep(ctx, testoptions.SleepCreateOpts(2))
+							require.NoError(t, err)
+							assert.Error(t, proc.Resize(ctx, 50, 132))
+							require.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+						},
+					},
 					{
 						Name: "ProcessStandardInput",
 						Case: func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
@@ -436,3 +519,45 @@ func TestProcessImplementations(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessInfoRedactsRemoteCredentials(t *testing.T) {
+	for procName, makeProc := range map[string]ProcessConstructor{
+		"Basic":    newBasicProcess,
+		"Blocking": newBlockingProcess,
+	} {
+		t.Run(procName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+			defer cancel()
+
+			opts := &options.Create{
+				Args: []string{"true"},
+				Remote: &options.Remote{
+					Host:          "localhost",
+					Args:          []string{"-o", "BatchMode=yes", "-p", "1"},
+					Key:           "key",
+					KeyPassphrase: "passphrase",
+					Password:      "password",
+				},
+			}
+			proc, err := makeProc(ctx, opts)
+			require.NoError(t, err)
+
+			for _, info := range []ProcessInfo{proc.Info(ctx), func() ProcessInfo {
+				_, _ = proc.Wait(ctx)
+				return proc.Info(ctx)
+			}()} {
+				require.NotNil(t, info.Options.Remote)
+				assert.Equal(t, "localhost", info.Options.Remote.Host)
+				assert.Empty(t, info.Options.Remote.Key)
+				assert.Empty(t, info.Options.Remote.KeyPassphrase)
+				assert.Empty(t, info.Options.Remote.Password)
+			}
+			assert.Equal(t, "password", opts.Remote.Password)
+
+			respawned, err := proc.Respawn(ctx)
+			require.NoError(t, err)
+			_, _ = respawned.Wait(ctx)
+			assert.Empty(t, respawned.Info(ctx).Options.Remote.Password)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package jasper
+
+import (
+	"strings"
+
+	"github.com/mongodb/jasper/options"
+)
+
+// matchesFilter returns whether or not the process info satisfies the filter.
+func matchesFilter(info ProcessInfo, f options.Filter) bool {
+	switch f {
+	case options.Running:
+		return info.IsRunning
+	case options.Terminated:
+		return !info.IsRunning
+	case options.Successful:
+		return info.Successful
+	case options.Failed:
+		return info.Complete && !info.Successful
+	case options.All:
+		return true
+	default:
+		return false
+	}
+}
+
+// MatchesProcessQuery returns whether or not the process info satisfies every
+// criterion of the query. The query must already be validated.
+func MatchesProcessQuery(info ProcessInfo, q options.ProcessQuery) bool {
+	if !matchesFilter(info, q.Status) {
+		return false
+	}
+
+	if len(q.Tags) != 0 {
+		var matchesTags bool
+		for _, tags := range q.Tags {
+			if hasAllTags(info.Options.Tags, tags) {
+				matchesTags = true
+				break
+			}
+		}
+		if !matchesTags {
+			return false
+		}
+	}
+
+	if q.MinExitCode != nil || q.MaxExitCode != nil {
+		if !info.Complete {
+			return false
+		}
+		if q.MinExitCode != nil && info.ExitCode < *q.MinExitCode {
+			return false
+		}
+		if q.MaxExitCode != nil && info.ExitCode > *q.MaxExitCode {
+			return false
+		}
+	}
+
+	if !q.StartedAfter.IsZero() && info.StartAt.Before(q.StartedAfter) {
+		return false
+	}
+	if !q.StartedBefore.IsZero() && (info.StartAt.IsZero() || info.StartAt.After(q.StartedBefore)) {
+		return false
+	}
+
+	if q.Args != "" && !strings.Contains(strings.Join(info.Options.Args, " "), q.Args) {
+		return false
+	}
+	if q.WorkingDirectory != "" && !strings.Contains(info.Options.WorkingDirectory, q.WorkingDirectory) {
+		return false
+	}
+	if q.Host != "" && info.Host != q.Host {
+		return false
+	}
+
+	return true
+}
+
+// hasAllTags returns whether or not every tag in the wanted tags is in the
+// process tags.
+func hasAllTags(procTags, tags []string) bool {
+	for _, tag := range tags {
+		var hasTag bool
+		for _, procTag := range procTags {
+			if tag == procTag {
+				hasTag = true
+				break
+			}
+		}
+		if !hasTag {
+			return false
+		}
+	}
+	return true
+}
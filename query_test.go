@@ -0,0 +1,52 @@
+package jasper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/jasper/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesProcessQuery(t *testing.T) {
+	now := time.Now()
+	info := ProcessInfo{
+		Host:       "localhost",
+		Complete:   true,
+		Successful: false,
+		ExitCode:   2,
+		StartAt:    now,
+		Options: options.Create{
+			Args:             []string{"echo", "foo", "bar"},
+			WorkingDirectory: "/tmp/jasper",
+			Tags:             []string{"foo", "bar"},
+		},
+	}
+
+	for testName, testCase := range map[string]struct {
+		query   options.ProcessQuery
+		matches bool
+	}{
+		"EmptyQueryMatches":                       {query: options.ProcessQuery{Status: options.All}, matches: true},
+		"MatchingStatusMatches":                   {query: options.ProcessQuery{Status: options.Failed}, matches: true},
+		"NonmatchingStatusDoesNotMatch":           {query: options.ProcessQuery{Status: options.Running}, matches: false},
+		"AnyMatchingTagSetMatches":                {query: options.ProcessQuery{Status: options.All, Tags: [][]string{{"bat"}, {"foo", "bar"}}}, matches: true},
+		"PartiallyMatchingTagSetDoesNotMatch":     {query: options.ProcessQuery{Status: options.All, Tags: [][]string{{"foo", "bat"}}}, matches: false},
+		"ExitCodeInRangeMatches":                  {query: options.ProcessQuery{Status: options.All, MinExitCode: utility.ToIntPtr(1), MaxExitCode: utility.ToIntPtr(2)}, matches: true},
+		"ExitCodeOutOfRangeDoesNotMatch":          {query: options.ProcessQuery{Status: options.All, MinExitCode: utility.ToIntPtr(3)}, matches: false},
+		"StartTimeInRangeMatches":                 {query: options.ProcessQuery{Status: options.All, StartedAfter: now.Add(-time.Minute), StartedBefore: now.Add(time.Minute)}, matches: true},
+		"StartTimeOutOfRangeDoesNotMatch":         {query: options.ProcessQuery{Status: options.All, StartedAfter: now.Add(time.Minute)}, matches: false},
+		"ArgsSubstringMatches":                    {query: options.ProcessQuery{Status: options.All, Args: "foo bar"}, matches: true},
+		"NonmatchingArgsSubstringDoesNotMatch":    {query: options.ProcessQuery{Status: options.All, Args: "bar foo"}, matches: false},
+		"WorkingDirectorySubstringMatches":        {query: options.ProcessQuery{Status: options.All, WorkingDirectory: "jasper"}, matches: true},
+		"NonmatchingWorkingDirectoryDoesNotMatch": {query: options.ProcessQuery{Status: options.All, WorkingDirectory: "/home"}, matches: false},
+		"MatchingHostMatches":                     {query: options.ProcessQuery{Status: options.All, Host: "localhost"}, matches: true},
+		"NonmatchingHostDoesNotMatch":             {query: options.ProcessQuery{Status: options.All, Host: "remotehost"}, matches: false},
+		"ExitCodeAboveMaximumDoesNotMatch":        {query: options.ProcessQuery{Status: options.All, MaxExitCode: utility.ToIntPtr(0)}, matches: false},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, testCase.matches, MatchesProcessQuery(info, testCase.query))
+		})
+	}
+}
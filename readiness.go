@@ -0,0 +1,287 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/mongodb/jasper/internal/executor"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// readinessChecker runs the readiness probes of a process until they all
+// succeed. A nil readinessChecker belongs to a process without readiness
+// probes, which is ready as soon as it starts. It is thread-safe.
+type readinessChecker struct {
+	readiness options.Readiness
+	logs      *logProbeMatcher
+	done      chan struct{}
+
+	mu      sync.RWMutex
+	readyAt time.Time
+	err     error
+}
+
+// newReadinessChecker returns a checker for the readiness options, or nil if
+// there are none.
+func newReadinessChecker(readiness *options.Readiness) *readinessChecker {
+	if readiness == nil {
+		return nil
+	}
+
+	c := &readinessChecker{
+		readiness: *readiness,
+		done:      make(chan struct{}),
+	}
+	var patterns []*regexp.Regexp
+	for _, probe := range readiness.Probes {
+		if probe.Log != nil {
+			// The pattern has already been validated.
+			patterns = append(patterns, regexp.MustCompile(probe.Log.Pattern))
+		}
+	}
+	if len(patterns) != 0 {
+		c.logs = newLogProbeMatcher(patterns)
+	}
+
+	return c
+}
+
+// wrapOutput makes the process' standard output and standard error also
+// written to the log probes. It must be called before the process starts.
+func (c *readinessChecker) wrapOutput(exec executor.Executor) {
+	if c == nil || c.logs == nil {
+		return
+	}
+
+	exec.SetStdout(c.logs.wrap(exec.Stdout()))
+	exec.SetStderr(c.logs.wrap(exec.Stderr()))
+}
+
+// run attempts the probes that have not yet succeeded at every interval
+// until either they have all succeeded, the process exits, the readiness
+// timeout elapses, or the context is done.
+func (c *readinessChecker) run(ctx context.Context, exited <-chan struct{}) {
+	if c == nil {
+		return
+	}
+
+	var timeout <-chan time.Time
+	if c.readiness.Timeout > 0 {
+		timer := time.NewTimer(c.readiness.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	ticker := time.NewTicker(c.readiness.Interval)
+	defer ticker.Stop()
+
+	pending := make([]options.ReadinessProbe, len(c.readiness.Probes))
+	_ = copy(pending, c.readiness.Probes)
+	for {
+		var failed []options.ReadinessProbe
+		for _, probe := range pending {
+			if !c.probe(ctx, probe) {
+				failed = append(failed, probe)
+			}
+		}
+		pending = failed
+		if len(pending) == 0 {
+			c.finish(nil)
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-exited:
+			c.finish(errors.New("process exited before it became ready"))
+			return
+		case <-timeout:
+			c.finish(errors.Errorf("process did not become ready within %s", c.readiness.Timeout))
+			return
+		case <-ctx.Done():
+			c.finish(errors.Wrap(ctx.Err(), "checking process readiness"))
+			return
+		}
+	}
+}
+
+// probe returns whether or not a single attempt of the probe succeeds.
+func (c *readinessChecker) probe(ctx context.Context, probe options.ReadinessProbe) bool {
+	pctx, cancel := context.WithTimeout(ctx, options.DefaultReadinessProbeTimeout)
+	defer cancel()
+
+	switch {
+	case probe.TCP != nil:
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(pctx, "tcp", probe.TCP.Address)
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+		return true
+	case probe.HTTP != nil:
+		req, err := http.NewRequestWithContext(pctx, http.MethodGet, probe.HTTP.URL, nil)
+		if err != nil {
+			return false
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		if probe.HTTP.Status != 0 {
+			return resp.StatusCode == probe.HTTP.Status
+		}
+		return resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices
+	case probe.Log != nil:
+		return c.logs.matched(probe.Log.Pattern)
+	case probe.File != nil:
+		_, err := os.Stat(probe.File.Path)
+		return err == nil
+	case probe.Command != nil:
+		proc, err := newBasicProcess(pctx, &options.Create{Args: probe.Command.Args})
+		if err != nil {
+			return false
+		}
+		_, err = proc.Wait(pctx)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// finish records the outcome of the readiness checks.
+func (c *readinessChecker) finish(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil {
+		c.readyAt = time.Now()
+	}
+	c.err = err
+	close(c.done)
+}
+
+// wait blocks until the process is ready and returns an error if it will not
+// become ready or the context is done.
+func (c *readinessChecker) wait(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+
+	select {
+	case <-c.done:
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		return c.err
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "waiting for process to become ready")
+	}
+}
+
+// apply sets the readiness of the process in its info.
+func (c *readinessChecker) apply(info *ProcessInfo) {
+	if c == nil {
+		return
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	info.Ready = !c.readyAt.IsZero()
+	info.ReadyAt = c.readyAt
+}
+
+// logProbeMatcher matches the lines of a process' output against the
+// patterns of its log probes. It is thread-safe.
+type logProbeMatcher struct {
+	mu       sync.Mutex
+	patterns []*regexp.Regexp
+	matches  map[string]bool
+}
+
+func newLogProbeMatcher(patterns []*regexp.Regexp) *logProbeMatcher {
+	return &logProbeMatcher{
+		patterns: patterns,
+		matches:  map[string]bool{},
+	}
+}
+
+// wrap returns a writer that writes to the given writer and matches each
+// line that is written against the patterns.
+func (m *logProbeMatcher) wrap(w io.Writer) io.Writer {
+	lw := &logProbeWriter{matcher: m}
+	if w == nil {
+		return lw
+	}
+	return io.MultiWriter(w, lw)
+}
+
+func (m *logProbeMatcher) match(line []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, pattern := range m.patterns {
+		if !m.matches[pattern.String()] && pattern.Match(line) {
+			m.matches[pattern.String()] = true
+		}
+	}
+}
+
+// matched returns whether or not a line matching the pattern has been
+// written.
+func (m *logProbeMatcher) matched(pattern string) bool {
+	if m == nil {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.matches[pattern]
+}
+
+// maxLogProbeLineSize is the maximum length of a line that is matched against
+// the log probes. Longer lines are truncated to their end.
+const maxLogProbeLineSize = 64 * 1024
+
+// logProbeWriter splits a single output stream into lines to match against
+// the log probes.
+type logProbeWriter struct {
+	matcher *logProbeMatcher
+	mu      sync.Mutex
+	partial []byte
+}
+
+func (w *logProbeWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	rest := append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(rest, '\n')
+		if i < 0 {
+			break
+		}
+		w.matcher.match(rest[:i])
+		rest = rest[i+1:]
+	}
+	if len(rest) > maxLogProbeLineSize {
+		rest = rest[len(rest)-maxLogProbeLineSize:]
+	}
+	// Match the partial line as well so that prompts without a trailing
+	// newline can still be matched.
+	if len(rest) != 0 {
+		w.matcher.match(rest)
+	}
+	w.partial = append([]byte(nil), rest...)
+
+	return len(p), nil
+}
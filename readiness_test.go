@@ -0,0 +1,112 @@
+package jasper
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	testoptions "github.com/mongodb/jasper/testutil/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadinessProbes(t *testing.T) {
+	// makeReadyProc creates a long-running process that is ready once the
+	// probe succeeds.
+	makeReadyProc := func(ctx context.Context, t *testing.T, probe options.ReadinessProbe) Process {
+		opts := testoptions.SleepCreateOpts(10)
+		opts.Readiness = &options.Readiness{
+			Probes:   []options.ReadinessProbe{probe},
+			Interval: 10 * time.Millisecond,
+			Timeout:  time.Second,
+		}
+		proc, err := newBasicProcess(ctx, opts)
+		require.NoError(t, err)
+		return proc
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T){
+		"TCPProbeSucceedsOnceListening": func(ctx context.Context, t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer listener.Close()
+
+			proc := makeReadyProc(ctx, t, options.ReadinessProbe{TCP: &options.TCPProbe{Address: listener.Addr().String()}})
+			assert.NoError(t, proc.WaitReady(ctx))
+			assert.True(t, proc.Info(ctx).Ready)
+		},
+		"TCPProbeFailsWithoutListener": func(ctx context.Context, t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			addr := listener.Addr().String()
+			require.NoError(t, listener.Close())
+
+			proc := makeReadyProc(ctx, t, options.ReadinessProbe{TCP: &options.TCPProbe{Address: addr}})
+			assert.Error(t, proc.WaitReady(ctx))
+			assert.False(t, proc.Info(ctx).Ready)
+		},
+		"HTTPProbeSucceedsWithSuccessfulStatus": func(ctx context.Context, t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(http.StatusNoContent)
+			}))
+			defer srv.Close()
+
+			proc := makeReadyProc(ctx, t, options.ReadinessProbe{HTTP: &options.HTTPProbe{URL: srv.URL}})
+			assert.NoError(t, proc.WaitReady(ctx))
+		},
+		"HTTPProbeFailsWithUnexpectedStatus": func(ctx context.Context, t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+
+			proc := makeReadyProc(ctx, t, options.ReadinessProbe{HTTP: &options.HTTPProbe{URL: srv.URL}})
+			assert.Error(t, proc.WaitReady(ctx))
+		},
+		"HTTPProbeSucceedsWithExpectedStatus": func(ctx context.Context, t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+
+			proc := makeReadyProc(ctx, t, options.ReadinessProbe{HTTP: &options.HTTPProbe{URL: srv.URL, Status: http.StatusServiceUnavailable}})
+			assert.NoError(t, proc.WaitReady(ctx))
+		},
+		"CommandProbeSucceedsWithSuccessfulCommand": func(ctx context.Context, t *testing.T) {
+			proc := makeReadyProc(ctx, t, options.ReadinessProbe{Command: &options.CommandProbe{Args: []string{"true"}}})
+			assert.NoError(t, proc.WaitReady(ctx))
+		},
+		"CommandProbeFailsWithFailedCommand": func(ctx context.Context, t *testing.T) {
+			proc := makeReadyProc(ctx, t, options.ReadinessProbe{Command: &options.CommandProbe{Args: []string{"false"}}})
+			assert.Error(t, proc.WaitReady(ctx))
+		},
+		"WaitReadyErrorsWhenContextIsDone": func(ctx context.Context, t *testing.T) {
+			proc := makeReadyProc(ctx, t, options.ReadinessProbe{Command: &options.CommandProbe{Args: []string{"false"}}})
+			wctx, wcancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer wcancel()
+			assert.Error(t, proc.WaitReady(wctx))
+		},
+		"LogProbeMatchesLineSplitAcrossWrites": func(ctx context.Context, t *testing.T) {
+			c := newReadinessChecker(&options.Readiness{Probes: []options.ReadinessProbe{{Log: &options.LogProbe{Pattern: "^ready$"}}}})
+			w := c.logs.wrap(nil)
+			_, err := w.Write([]byte("starting\nrea"))
+			require.NoError(t, err)
+			assert.False(t, c.logs.matched("^ready$"))
+			_, err = w.Write([]byte("dy\n"))
+			require.NoError(t, err)
+			assert.True(t, c.logs.matched("^ready$"))
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+			defer cancel()
+
+			testCase(ctx, t)
+		})
+	}
+}
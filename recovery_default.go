@@ -0,0 +1,27 @@
+//go:build !linux
+
+package jasper
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// findManagedProcesses is a placeholder implementation for platforms that do
+// not support finding processes through /proc.
+func findManagedProcesses(map[string]bool) ([]managedProcess, error) {
+	return nil, errors.New("process recovery is not supported on this platform")
+}
+
+// processStartTime is a placeholder implementation for platforms that do not
+// support finding processes through /proc.
+func processStartTime(uint64) (time.Time, error) {
+	return time.Time{}, errors.New("process recovery is not supported on this platform")
+}
+
+// isProcessAlive is a placeholder implementation for platforms that do not
+// support finding processes through /proc.
+func isProcessAlive(int, uint64) bool {
+	return false
+}
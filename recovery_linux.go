@@ -0,0 +1,128 @@
+package jasper
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// findManagedProcesses scans /proc for the processes whose ManagerEnvironID
+// environment variable is one of the given manager IDs. Processes whose
+// environment cannot be read, such as those owned by other users, are
+// skipped.
+func findManagedProcesses(managerIDs map[string]bool) ([]managedProcess, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, errors.Wrap(err, "listing processes")
+	}
+
+	self := os.Getpid()
+	var procs []managedProcess
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || pid == self {
+			continue
+		}
+
+		env, err := readProcEnviron(pid)
+		if err != nil || !managerIDs[env[ManagerEnvironID]] || env[EnvironID] == "" {
+			continue
+		}
+		stat, err := readProcStat(pid)
+		if err != nil || stat.state == 'Z' {
+			continue
+		}
+		args, err := readProcCmdline(pid)
+		if err != nil {
+			continue
+		}
+
+		procs = append(procs, managedProcess{
+			pid:        pid,
+			ppid:       stat.ppid,
+			startTicks: stat.startTicks,
+			args:       args,
+			env:        env,
+		})
+	}
+
+	return procs, nil
+}
+
+// readProcEnviron returns the initial environment of the process from
+// /proc/<pid>/environ.
+func readProcEnviron(pid int) (map[string]string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/environ", pid))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	env := map[string]string{}
+	for _, kv := range bytes.Split(data, []byte{0}) {
+		if key, val, ok := strings.Cut(string(kv), "="); ok {
+			env[key] = val
+		}
+	}
+	return env, nil
+}
+
+// readProcCmdline returns the arguments of the process from
+// /proc/<pid>/cmdline.
+func readProcCmdline(pid int) ([]string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	args := strings.Split(strings.TrimSuffix(string(data), "\x00"), "\x00")
+	if len(args) == 1 && args[0] == "" {
+		return nil, errors.New("process has no arguments")
+	}
+	return args, nil
+}
+
+// processStartTime returns the wall clock time at which a process started
+// given its start time in clock ticks since boot.
+func processStartTime(startTicks uint64) (time.Time, error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		val, ok := strings.CutPrefix(scanner.Text(), "btime ")
+		if !ok {
+			continue
+		}
+		bootTime, err := strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+		if err != nil {
+			return time.Time{}, errors.Wrap(err, "parsing boot time")
+		}
+		return time.Unix(bootTime, 0).Add(time.Duration(startTicks) * time.Second / clockTicksPerSecond), nil
+	}
+	if err := scanner.Err(); err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+
+	return time.Time{}, errors.New("boot time not found")
+}
+
+// isProcessAlive returns whether or not the process with the given PID that
+// started at the given time in clock ticks since boot is still running. A
+// different process that reuses the PID is not considered to be the same
+// process.
+func isProcessAlive(pid int, startTicks uint64) bool {
+	stat, err := readProcStat(pid)
+	if err != nil {
+		return false
+	}
+	return stat.state != 'Z' && stat.startTicks == startTicks
+}
@@ -0,0 +1,139 @@
+// Package audit records the operations that clients perform through the
+// remote Jasper services.
+package audit
+
+import (
+	"context"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/evergreen-ci/utility"
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/pkg/errors"
+)
+
+// Constants representing the services that produce audit records.
+const (
+	ServiceREST = "rest"
+	ServiceRPC  = "rpc"
+)
+
+// Record describes a single call to a remote service that could modify the
+// state of the manager, its processes or the host. It contains the arguments
+// of the call that identify what was done, but never their sensitive
+// contents, such as environment variable values or file contents.
+type Record struct {
+	// Time is when the service received the call.
+	Time time.Time
+	// Service is the kind of service that received the call.
+	Service string
+	// Method is the name of the RPC method or the HTTP method and path of the
+	// REST route that was called.
+	Method string
+	// Identity is the identity of the client that made the call, such as the
+	// common name of its TLS certificate or the ID of its token or API key.
+	Identity string
+	// Peer is the network address of the client.
+	Peer string
+	// ProcessID is the ID of the process that the call created or operated
+	// on, if any.
+	ProcessID string
+	// Args are the arguments of the process that the call created, if any.
+	Args []string
+	// EnvKeys are the names of the environment variables that the call set.
+	EnvKeys []string
+	// Paths are the file paths that the call wrote to or ran in.
+	Paths []string
+	// Success is whether the call succeeded.
+	Success bool
+	// Error is the reason that the call failed, if any.
+	Error string
+}
+
+// AddPaths adds the non-empty paths that are not already in the record.
+func (r *Record) AddPaths(paths ...string) {
+	for _, path := range paths {
+		if path == "" || utility.StringSliceContains(r.Paths, path) {
+			continue
+		}
+		r.Paths = append(r.Paths, path)
+	}
+}
+
+// SetEnv sets the record's environment variable names to the sorted keys of
+// env.
+func (r *Record) SetEnv(env map[string]string) {
+	r.EnvKeys = nil
+	for key := range env {
+		r.EnvKeys = append(r.EnvKeys, key)
+	}
+	sort.Strings(r.EnvKeys)
+}
+
+// SetError sets the outcome of the call from its error.
+func (r *Record) SetError(err error) {
+	r.Success = err == nil
+	r.Error = ""
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+// fields returns the record's non-empty fields.
+func (r *Record) fields() message.Fields {
+	fields := message.Fields{
+		"time":    r.Time,
+		"service": r.Service,
+		"method":  r.Method,
+		"success": r.Success,
+	}
+	for key, val := range map[string]string{
+		"identity":   r.Identity,
+		"peer":       r.Peer,
+		"process_id": r.ProcessID,
+		"error":      r.Error,
+	} {
+		if val != "" {
+			fields[key] = val
+		}
+	}
+	for key, val := range map[string][]string{
+		"args":     r.Args,
+		"env_keys": r.EnvKeys,
+		"paths":    r.Paths,
+	} {
+		if len(val) != 0 {
+			fields[key] = val
+		}
+	}
+	return fields
+}
+
+// Send sends the record to the sender as a structured message at the info
+// level, so the sender's threshold must allow info messages.
+func Send(ctx context.Context, sender send.Sender, r Record) {
+	sender.Send(ctx, message.NewSimpleFields(level.Info, r.fields()))
+}
+
+// NewFileSender returns a sender that appends each audit record to the file
+// at the given path as a single line of JSON. The file is created, readable
+// only by its owner, if it does not already exist. The caller is responsible
+// for closing the sender.
+func NewFileSender(path string) (send.Sender, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening audit log file '%s'", path)
+	}
+	if err = f.Close(); err != nil {
+		return nil, errors.Wrapf(err, "closing audit log file '%s'", path)
+	}
+
+	sender, err := send.NewJSONFileLogger("jasper-audit", path, send.LevelInfo{Default: level.Info, Threshold: level.Info})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating audit log sender")
+	}
+	return sender, nil
+}
@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	t.Run("AddPathsSkipsEmptyAndDuplicatePaths", func(t *testing.T) {
+		var r Record
+		r.AddPaths("/foo", "", "/bar")
+		r.AddPaths("/foo")
+		assert.Equal(t, []string{"/foo", "/bar"}, r.Paths)
+	})
+	t.Run("SetEnvOnlyKeepsSortedKeys", func(t *testing.T) {
+		var r Record
+		r.SetEnv(map[string]string{"b": "secret", "a": "secret"})
+		assert.Equal(t, []string{"a", "b"}, r.EnvKeys)
+	})
+	t.Run("SetError", func(t *testing.T) {
+		var r Record
+		r.SetError(errors.New("foo"))
+		assert.False(t, r.Success)
+		assert.Equal(t, "foo", r.Error)
+
+		r.SetError(nil)
+		assert.True(t, r.Success)
+		assert.Empty(t, r.Error)
+	})
+}
+
+func TestFileSender(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("{}\n"), 0600))
+
+	sender, err := NewFileSender(path)
+	require.NoError(t, err)
+	Send(ctx, sender, Record{Service: ServiceRPC, Method: "foo", Args: []string{"bar"}, Success: true})
+	Send(ctx, sender, Record{Service: ServiceREST, Method: "baz", Error: "qux"})
+	require.NoError(t, sender.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, lines, 3, "existing records should not be overwritten")
+
+	assert.Equal(t, "rpc", lines[1]["service"])
+	assert.Equal(t, "foo", lines[1]["method"])
+	assert.Equal(t, []interface{}{"bar"}, lines[1]["args"])
+	assert.Equal(t, true, lines[1]["success"])
+	assert.NotContains(t, lines[1], "error")
+
+	assert.Equal(t, "rest", lines[2]["service"])
+	assert.Equal(t, false, lines[2]["success"])
+	assert.Equal(t, "qux", lines[2]["error"])
+	assert.NotContains(t, lines[2], "args")
+}
@@ -0,0 +1,139 @@
+package remote
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mongodb/grip/level"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudit(t *testing.T) {
+	// Each service constructor starts a service that sends audit records to
+	// the sender and checks the given authorization, if any. The client is
+	// identified as "test-client.com".
+	for serviceName, makeServiceAndClient := range map[string]func(ctx context.Context, t *testing.T, sender send.Sender, authz *options.Authorization) Manager{
+		"REST": func(ctx context.Context, t *testing.T, sender send.Sender, authz *options.Authorization) Manager {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{
+				Auth:          &RESTAuth{BearerTokens: []BearerToken{{ID: "test-client.com", Token: "token"}}},
+				Authorization: authz,
+				Audit:         sender,
+			})
+			return newTestRESTClientWithOptions(t, addr, RESTClientOptions{BearerToken: "token"})
+		},
+		"RPC": func(ctx context.Context, t *testing.T, sender send.Sender, authz *options.Authorization) Manager {
+			mngr, err := jasper.NewSynchronizedManager(false)
+			require.NoError(t, err)
+			addr, err := tryStartRPCService(ctx, func(ctx context.Context, addr net.Addr) error {
+				closeService, err := StartRPCServiceWithOptions(ctx, mngr, addr, RPCServiceOptions{
+					Credentials:   loadTestCredentials(t, "server"),
+					Authorization: authz,
+					Audit:         sender,
+				})
+				if err != nil {
+					return err
+				}
+				t.Cleanup(func() { assert.NoError(t, closeService()) })
+				return nil
+			})
+			require.NoError(t, err)
+			client, err := newTestRPCClient(ctx, addr, loadTestCredentials(t, "client"))
+			require.NoError(t, err)
+			return client
+		},
+	} {
+		t.Run(serviceName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, client Manager, nextRecord func() message.Fields){
+				"CreateProcessIsRecordedWithoutEnvValues": func(ctx context.Context, t *testing.T, client Manager, nextRecord func() message.Fields) {
+					dir := t.TempDir()
+					proc, err := client.CreateProcess(ctx, &options.Create{
+						Args:             []string{"echo", "foo"},
+						Environment:      map[string]string{"SECRET": "bar"},
+						WorkingDirectory: dir,
+					})
+					require.NoError(t, err)
+
+					record := nextRecord()
+					assert.Equal(t, "test-client.com", record["identity"])
+					assert.NotEmpty(t, record["peer"])
+					assert.Regexp(t, "(?i)create$", record["method"])
+					assert.Equal(t, proc.ID(), record["process_id"])
+					assert.Equal(t, []string{"echo", "foo"}, record["args"])
+					assert.Equal(t, []string{"SECRET"}, record["env_keys"])
+					assert.Equal(t, []string{dir}, record["paths"])
+					assert.Equal(t, true, record["success"])
+					assert.NotContains(t, message.NewSimpleFields(level.Info, record).String(), "bar")
+				},
+				"ReadsAreNotRecorded": func(ctx context.Context, t *testing.T, client Manager, nextRecord func() message.Fields) {
+					_, err := client.List(ctx, options.All)
+					require.NoError(t, err)
+					require.NoError(t, client.WriteFile(ctx, options.WriteFile{
+						Path:    filepath.Join(t.TempDir(), "file"),
+						Content: []byte("foo"),
+					}))
+
+					record := nextRecord()
+					assert.Regexp(t, "(?i)file(/write)?$", record["method"])
+					assert.Equal(t, true, record["success"])
+					assert.NotContains(t, record, "process_id")
+				},
+				"FailedCallsAreRecorded": func(ctx context.Context, t *testing.T, client Manager, nextRecord func() message.Fields) {
+					path := filepath.Join(t.TempDir(), "file")
+					require.Error(t, client.DownloadFile(ctx, options.Download{Path: path}))
+
+					record := nextRecord()
+					assert.Equal(t, []string{path}, record["paths"])
+					assert.Equal(t, false, record["success"])
+					assert.NotEmpty(t, record["error"])
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+					defer cancel()
+
+					sender, err := send.NewInternalLogger("audit", send.LevelInfo{Default: level.Info, Threshold: level.Info})
+					require.NoError(t, err)
+					client := makeServiceAndClient(ctx, t, sender, nil)
+
+					testCase(ctx, t, client, func() message.Fields {
+						// The REST service sends the record after it
+						// responds to the client.
+						require.Eventually(t, sender.HasMessage, time.Second, 10*time.Millisecond)
+						fields, ok := sender.GetMessage().Message.Raw().(message.Fields)
+						require.True(t, ok)
+						return fields
+					})
+				})
+			}
+			t.Run("DeniedCallsAreRecorded", func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+				defer cancel()
+
+				sender, err := send.NewInternalLogger("audit", send.LevelInfo{Default: level.Info, Threshold: level.Info})
+				require.NoError(t, err)
+				client := makeServiceAndClient(ctx, t, sender, &options.Authorization{
+					Clients: map[string]options.ClientAccess{"test-client.com": {Role: options.RoleReadOnly}},
+				})
+
+				_, err = client.CreateProcess(ctx, &options.Create{Args: []string{"true"}})
+				require.Error(t, err)
+
+				require.Eventually(t, sender.HasMessage, time.Second, 10*time.Millisecond)
+				fields, ok := sender.GetMessage().Message.Raw().(message.Fields)
+				require.True(t, ok)
+				assert.Equal(t, "test-client.com", fields["identity"])
+				assert.Equal(t, false, fields["success"])
+				assert.Contains(t, fields["error"], "does not allow")
+			})
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package remote
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorization(t *testing.T) {
+	// Each service constructor starts a service whose authorization gives
+	// the client the given access if identified is true, and otherwise gives
+	// access only to some other client.
+	for serviceName, makeServiceAndClient := range map[string]func(ctx context.Context, t *testing.T, access options.ClientAccess, identified bool) Manager{
+		"REST": func(ctx context.Context, t *testing.T, access options.ClientAccess, identified bool) Manager {
+			authz := options.Authorization{Clients: map[string]options.ClientAccess{"other": access}}
+			if identified {
+				authz.Clients["client"] = access
+			}
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{
+				Auth:          &RESTAuth{BearerTokens: []BearerToken{{ID: "client", Token: "token"}}},
+				Authorization: &authz,
+			})
+			return newTestRESTClientWithOptions(t, addr, RESTClientOptions{BearerToken: "token"})
+		},
+		"RESTWithTLS": func(ctx context.Context, t *testing.T, access options.ClientAccess, identified bool) Manager {
+			authz := options.Authorization{Clients: map[string]options.ClientAccess{"other": access}}
+			if identified {
+				authz.Clients["test-client.com"] = access
+			}
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{
+				Credentials:   loadTestCredentials(t, "server"),
+				Authorization: &authz,
+			})
+			return newTestRESTClientWithOptions(t, addr, RESTClientOptions{Credentials: loadTestCredentials(t, "client")})
+		},
+		"RPC": func(ctx context.Context, t *testing.T, access options.ClientAccess, identified bool) Manager {
+			authz := options.Authorization{Clients: map[string]options.ClientAccess{"other": access}}
+			if identified {
+				authz.Clients["test-client.com"] = access
+			}
+			mngr, err := jasper.NewSynchronizedManager(false)
+			require.NoError(t, err)
+			addr, err := tryStartRPCService(ctx, func(ctx context.Context, addr net.Addr) error {
+				closeService, err := StartRPCServiceWithOptions(ctx, mngr, addr, RPCServiceOptions{
+					Credentials:   loadTestCredentials(t, "server"),
+					Authorization: &authz,
+				})
+				if err != nil {
+					return err
+				}
+				t.Cleanup(func() { assert.NoError(t, closeService()) })
+				return nil
+			})
+			require.NoError(t, err)
+			client, err := newTestRPCClient(ctx, addr, loadTestCredentials(t, "client"))
+			require.NoError(t, err)
+			return client
+		},
+	} {
+		t.Run(serviceName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, makeClient func(access options.ClientAccess, identified bool) Manager){
+				"UnknownClientIsRejected": func(ctx context.Context, t *testing.T, makeClient func(options.ClientAccess, bool) Manager) {
+					client := makeClient(options.ClientAccess{Role: options.RoleAdmin}, false)
+					_, err := client.List(ctx, options.All)
+					assert.Error(t, err)
+				},
+				"ReadOnlyClientCanOnlyRead": func(ctx context.Context, t *testing.T, makeClient func(options.ClientAccess, bool) Manager) {
+					client := makeClient(options.ClientAccess{Role: options.RoleReadOnly}, true)
+					_, err := client.List(ctx, options.All)
+					assert.NoError(t, err)
+					_, err = client.CreateProcess(ctx, &options.Create{Args: []string{"true"}})
+					assert.Error(t, err)
+				},
+				"ProcessOperatorCanCreateProcessesButNotCloseManager": func(ctx context.Context, t *testing.T, makeClient func(options.ClientAccess, bool) Manager) {
+					client := makeClient(options.ClientAccess{Role: options.RoleProcessOperator}, true)
+					proc, err := client.CreateProcess(ctx, &options.Create{Args: []string{"true"}})
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					assert.NoError(t, err)
+					assert.Error(t, client.WriteFile(ctx, options.WriteFile{
+						Path:    filepath.Join(t.TempDir(), "file"),
+						Content: []byte("foo"),
+					}))
+					assert.Error(t, client.Close(ctx))
+				},
+				"LoggingCacheOperationsRequireTheSameRoles": func(ctx context.Context, t *testing.T, makeClient func(options.ClientAccess, bool) Manager) {
+					// Each operation must require the same role from both
+					// services, regardless of whether it succeeds.
+					operations := map[string]struct {
+						role options.Role
+						call func(ctx context.Context, client Manager) error
+					}{
+						"Create": {
+							role: options.RoleProcessOperator,
+							call: func(ctx context.Context, client Manager) error {
+								_, err := client.LoggingCache(ctx).Create("foo", &options.Output{})
+								return err
+							},
+						},
+						"Get": {
+							role: options.RoleReadOnly,
+							call: func(ctx context.Context, client Manager) error {
+								_, err := client.LoggingCache(ctx).Get("foo")
+								return err
+							},
+						},
+						"Remove": {
+							role: options.RoleProcessOperator,
+							call: func(ctx context.Context, client Manager) error {
+								return client.LoggingCache(ctx).Remove("foo")
+							},
+						},
+						"CloseAndRemove": {
+							role: options.RoleProcessOperator,
+							call: func(ctx context.Context, client Manager) error {
+								return client.LoggingCache(ctx).CloseAndRemove(ctx, "foo")
+							},
+						},
+						"Clear": {
+							role: options.RoleAdmin,
+							call: func(ctx context.Context, client Manager) error {
+								return client.LoggingCache(ctx).Clear(ctx)
+							},
+						},
+						"Prune": {
+							role: options.RoleAdmin,
+							call: func(ctx context.Context, client Manager) error {
+								return client.LoggingCache(ctx).Prune(time.Now())
+							},
+						},
+						"Len": {
+							role: options.RoleReadOnly,
+							call: func(ctx context.Context, client Manager) error {
+								_, err := client.LoggingCache(ctx).Len()
+								return err
+							},
+						},
+						"SendMessages": {
+							role: options.RoleProcessOperator,
+							call: func(ctx context.Context, client Manager) error {
+								return client.SendMessages(ctx, options.LoggingPayload{LoggerID: "foo"})
+							},
+						},
+					}
+
+					for _, role := range []options.Role{options.RoleReadOnly, options.RoleProcessOperator, options.RoleAdmin} {
+						client := makeClient(options.ClientAccess{Role: role}, true)
+						for opName, op := range operations {
+							err := op.call(ctx, client)
+							denied := err != nil && strings.Contains(err.Error(), "does not allow operations that require role")
+							assert.Equal(t, !role.Includes(op.role), denied, "role '%s' calling '%s': %v", role, opName, err)
+						}
+					}
+				},
+				"AllowedArgsLimitCreatedProcesses": func(ctx context.Context, t *testing.T, makeClient func(options.ClientAccess, bool) Manager) {
+					client := makeClient(options.ClientAccess{
+						Role:        options.RoleProcessOperator,
+						AllowedArgs: [][]string{{"echo", "f*"}},
+					}, true)
+					_, err := client.CreateProcess(ctx, &options.Create{Args: []string{"echo", "foo", "bar"}})
+					assert.NoError(t, err)
+					_, err = client.CreateProcess(ctx, &options.Create{Args: []string{"echo", "bar"}})
+					assert.Error(t, err)
+					_, err = client.CreateProcess(ctx, &options.Create{Args: []string{"true"}})
+					assert.Error(t, err)
+				},
+				"AllowedArgsLimitTriggerProcesses": func(ctx context.Context, t *testing.T, makeClient func(options.ClientAccess, bool) Manager) {
+					client := makeClient(options.ClientAccess{
+						Role:        options.RoleProcessOperator,
+						AllowedArgs: [][]string{{"echo", "f*"}},
+					}, true)
+					_, err := client.CreateProcess(ctx, &options.Create{
+						Args:      []string{"echo", "foo"},
+						OnSuccess: []*options.Create{{Args: []string{"echo", "foo"}}},
+					})
+					assert.NoError(t, err)
+					for _, opts := range []*options.Create{
+						{
+							Args:      []string{"echo", "foo"},
+							OnSuccess: []*options.Create{{Args: []string{"true"}}},
+						},
+						{
+							Args:      []string{"echo", "foo"},
+							OnFailure: []*options.Create{{Args: []string{"true"}}},
+						},
+						{
+							Args:      []string{"echo", "foo"},
+							OnTimeout: []*options.Create{{Args: []string{"true"}}},
+						},
+						{
+							Args: []string{"echo", "foo"},
+							OnSuccess: []*options.Create{{
+								Args:      []string{"echo", "foo"},
+								OnFailure: []*options.Create{{Args: []string{"true"}}},
+							}},
+						},
+					} {
+						_, err = client.CreateProcess(ctx, opts)
+						assert.Error(t, err)
+					}
+				},
+				"AllowedPathsLimitWrittenFiles": func(ctx context.Context, t *testing.T, makeClient func(options.ClientAccess, bool) Manager) {
+					dir := t.TempDir()
+					client := makeClient(options.ClientAccess{
+						Role:         options.RoleAdmin,
+						AllowedPaths: []string{filepath.Join(dir, "allowed")},
+					}, true)
+					assert.NoError(t, client.WriteFile(ctx, options.WriteFile{
+						Path:    filepath.Join(dir, "allowed", "file"),
+						Content: []byte("foo"),
+					}))
+					assert.Error(t, client.WriteFile(ctx, options.WriteFile{
+						Path:    filepath.Join(dir, "allowed", "..", "file"),
+						Content: []byte("foo"),
+					}))
+					err := client.DownloadFile(ctx, options.Download{
+						URL:  "https://example.com",
+						Path: filepath.Join(dir, "file"),
+					})
+					require.Error(t, err)
+					assert.Contains(t, err.Error(), "not in an allowed directory")
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+					defer cancel()
+
+					testCase(ctx, t, func(access options.ClientAccess, identified bool) Manager {
+						return makeServiceAndClient(ctx, t, access, identified)
+					})
+				})
+			}
+		})
+	}
+}
@@ -249,6 +249,192 @@ func TestClientImplementations(t *testing.T) {
 						assert.Zero(t, stream)
 					},
 				},
+				{
+					Name: "StandardInputStream",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						for subTestName, subTestCase := range map[string]func(ctx context.Context, t *testing.T, opts *options.Create){
+							"WriteAndCloseSucceed": func(ctx context.Context, t *testing.T, opts *options.Create) {
+								proc, err := mngr.CreateProcess(ctx, opts)
+								require.NoError(t, err)
+
+								require.NoError(t, mngr.WriteStdin(ctx, proc.ID(), strings.NewReader("foo\n")))
+								require.NoError(t, mngr.WriteStdin(ctx, proc.ID(), strings.NewReader("bar\n")))
+								require.NoError(t, mngr.CloseStdin(ctx, proc.ID()))
+
+								_, err = proc.Wait(ctx)
+								require.NoError(t, err)
+
+								logs, err := mngr.GetLogStream(ctx, proc.ID(), 10)
+								require.NoError(t, err)
+								output := strings.Join(logs.Logs, "\n")
+								assert.Contains(t, output, "foo")
+								assert.Contains(t, output, "bar")
+							},
+							"WriteFailsAfterClose": func(ctx context.Context, t *testing.T, opts *options.Create) {
+								proc, err := mngr.CreateProcess(ctx, opts)
+								require.NoError(t, err)
+
+								require.NoError(t, mngr.CloseStdin(ctx, proc.ID()))
+								assert.Error(t, mngr.WriteStdin(ctx, proc.ID(), strings.NewReader("foo")))
+
+								_, err = proc.Wait(ctx)
+								require.NoError(t, err)
+							},
+							"WriteFailsWithoutStream": func(ctx context.Context, t *testing.T, opts *options.Create) {
+								opts.StandardInputStream = false
+								opts.Args = []string{"echo", "foo"}
+								proc, err := mngr.CreateProcess(ctx, opts)
+								require.NoError(t, err)
+
+								assert.Error(t, mngr.WriteStdin(ctx, proc.ID(), strings.NewReader("foo")))
+								assert.Error(t, mngr.CloseStdin(ctx, proc.ID()))
+							},
+							"WriteFailsWithNonexistentProcess": func(ctx context.Context, t *testing.T, opts *options.Create) {
+								assert.Error(t, mngr.WriteStdin(ctx, "foo", strings.NewReader("foo")))
+								assert.Error(t, mngr.CloseStdin(ctx, "foo"))
+							},
+						} {
+							t.Run(subTestName, func(t *testing.T) {
+								inMemLogger, err := jasper.NewInMemoryLogger(10)
+								require.NoError(t, err)
+
+								opts := &options.Create{
+									Args:                []string{"cat"},
+									StandardInputStream: true,
+									Output: options.Output{
+										Loggers: []*options.LoggerConfig{inMemLogger},
+									},
+								}
+								subTestCase(ctx, t, opts)
+							})
+						}
+					},
+				},
+				{
+					Name: "ResizeChangesTTYWindowSize",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						inMemLogger, err := jasper.NewInMemoryLogger(10)
+						require.NoError(t, err)
+						opts := &options.Create{
+							Args:                []string{"sh", "-c", "read line; stty size"},
+							TTY:                 &options.TTY{},
+							StandardInputStream: true,
+							Output: options.Output{
+								Loggers: []*options.LoggerConfig{inMemLogger},
+							},
+						}
+						proc, err := mngr.CreateProcess(ctx, opts)
+						require.NoError(t, err)
+
+						require.NoError(t, proc.Resize(ctx, 50, 132))
+						require.NoError(t, mngr.WriteStdin(ctx, proc.ID(), strings.NewReader("\n")))
+						require.NoError(t, mngr.CloseStdin(ctx, proc.ID()))
+						_, err = proc.Wait(ctx)
+						require.NoError(t, err)
+
+						logs, err := mngr.GetLogStream(ctx, proc.ID(), 10)
+						require.NoError(t, err)
+						assert.Contains(t, strings.Join(logs.Logs, "\n"), "50 132")
+					},
+				},
+				{
+					Name: "ResizeFailsWithoutTTY",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						proc, err := mngr.CreateProcess(ctx, testoptions.SleepCreateOpts(2))
+						require.NoError(t, err)
+						assert.Error(t, proc.Resize(ctx, 50, 132))
+					},
+				},
+				{
+					Name: "HistoryFailsWithoutServerHistory",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						history := mngr.History(ctx)
+						require.NotNil(t, history)
+						_, err := history.Find(ctx, options.HistoryQuery{})
+						assert.Error(t, err)
+					},
+				},
+				{
+					Name: "GetCapturedOutputReturnsOutput",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						opts := &options.Create{Args: []string{"sh", "-c", "echo foo; echo bar; echo baz >&2"}}
+						opts.Output.Capture = &options.OutputCapture{MaxBytes: 4}
+						proc, err := mngr.CreateProcess(ctx, opts)
+						require.NoError(t, err)
+						_, err = proc.Wait(ctx)
+						require.NoError(t, err)
+
+						out, err := mngr.GetCapturedOutput(ctx, proc.ID(), options.OutputQuery{})
+						require.NoError(t, err)
+						assert.Equal(t, options.OutputStreamStdout, out.Stream)
+						assert.Equal(t, "bar\n", string(out.Data))
+						assert.EqualValues(t, 4, out.Offset)
+						assert.EqualValues(t, 8, out.Size)
+						assert.True(t, out.Truncated)
+
+						out, err = mngr.GetCapturedOutput(ctx, proc.ID(), options.OutputQuery{
+							Stream: options.OutputStreamStderr,
+							Lines:  &options.LineRange{Start: -1},
+						})
+						require.NoError(t, err)
+						assert.Equal(t, options.OutputStreamStderr, out.Stream)
+						assert.Equal(t, "baz\n", string(out.Data))
+					},
+				},
+				{
+					Name: "GetCapturedOutputWithoutCaptureFails",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						proc, err := mngr.CreateProcess(ctx, testoptions.TrueCreateOpts())
+						require.NoError(t, err)
+						_, err = mngr.GetCapturedOutput(ctx, proc.ID(), options.OutputQuery{})
+						assert.Error(t, err)
+					},
+				},
+				{
+					Name: "GetCapturedOutputFromNonexistentProcessFails",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						_, err := mngr.GetCapturedOutput(ctx, "foo", options.OutputQuery{})
+						assert.Error(t, err)
+					},
+				},
+				{
+					Name: "FollowLogsFromNonexistentProcessFails",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						assert.Error(t, mngr.FollowLogs(ctx, "foo", 0, func(jasper.LogChunk) error { return nil }))
+					},
+				},
+				{
+					Name: "FollowLogsPushesOutputOfRunningProcess",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						inMemLogger, err := jasper.NewInMemoryLogger(100)
+						require.NoError(t, err)
+						first := strings.Repeat("a", 100)
+						second := strings.Repeat("b", 100)
+						opts := &options.Create{
+							Args: []string{"sh", "-c", fmt.Sprintf("echo %s; sleep 1; echo %s", first, second)},
+							Output: options.Output{
+								Loggers: []*options.LoggerConfig{inMemLogger},
+							},
+						}
+						proc, err := mngr.CreateProcess(ctx, opts)
+						require.NoError(t, err)
+
+						logs := []string{}
+						var done bool
+						require.NoError(t, mngr.FollowLogs(ctx, proc.ID(), 0, func(chunk jasper.LogChunk) error {
+							if len(logs) == 0 {
+								assert.Contains(t, chunk.Logs, first)
+								assert.NotContains(t, chunk.Logs, second)
+							}
+							logs = append(logs, chunk.Logs...)
+							done = chunk.Done
+							return nil
+						}))
+						assert.True(t, done)
+						assert.Contains(t, logs, second)
+						assert.True(t, proc.Complete(ctx))
+					},
+				},
 				{
 					Name: "WithInMemoryLogger",
 					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
@@ -277,6 +463,39 @@ func TestClientImplementations(t *testing.T) {
 								}
 								assert.Contains(t, logs, output)
 							},
+							"FollowLogsReturnsOutputOnSuccess": func(ctx context.Context, t *testing.T, proc jasper.Process) {
+								var chunks []jasper.LogChunk
+								require.NoError(t, mngr.FollowLogs(ctx, proc.ID(), 0, func(chunk jasper.LogChunk) error {
+									chunks = append(chunks, chunk)
+									return nil
+								}))
+								require.NotEmpty(t, chunks)
+								assert.True(t, chunks[len(chunks)-1].Done)
+
+								logs := []string{}
+								for _, chunk := range chunks {
+									logs = append(logs, chunk.Logs...)
+								}
+								assert.Contains(t, logs, output)
+							},
+							"FollowLogsResumesFromOffset": func(ctx context.Context, t *testing.T, proc jasper.Process) {
+								var next int
+								require.NoError(t, mngr.FollowLogs(ctx, proc.ID(), 0, func(chunk jasper.LogChunk) error {
+									next = chunk.NextOffset
+									return nil
+								}))
+								require.NotZero(t, next)
+
+								var chunks []jasper.LogChunk
+								require.NoError(t, mngr.FollowLogs(ctx, proc.ID(), next, func(chunk jasper.LogChunk) error {
+									chunks = append(chunks, chunk)
+									return nil
+								}))
+								require.Len(t, chunks, 1)
+								assert.True(t, chunks[0].Done)
+								assert.Empty(t, chunks[0].Logs)
+								assert.Equal(t, next, chunks[0].NextOffset)
+							},
 						} {
 							t.Run(testName, func(t *testing.T) {
 								proc, err := mngr.CreateProcess(ctx, opts)
@@ -540,6 +759,33 @@ func TestClientImplementations(t *testing.T) {
 						assert.Contains(t, string(fileContents), output)
 					},
 				},
+				{
+					Name: "CreateProcessWithLimits",
+					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
+						if runtime.GOOS != "linux" {
+							t.Skip("resource limits are only supported on Linux")
+						}
+						openFiles := uint64(64)
+						coreSize := uint64(0)
+						opts := testoptions.TrueCreateOpts()
+						opts.Limits = &options.Limits{OpenFiles: &openFiles, CoreSize: &coreSize}
+
+						proc, err := mngr.CreateProcess(ctx, opts)
+						require.NoError(t, err)
+
+						exitCode, err := proc.Wait(ctx)
+						require.NoError(t, err)
+						require.Zero(t, exitCode)
+
+						limits := proc.Info(ctx).Options.Limits
+						require.NotNil(t, limits)
+						require.NotNil(t, limits.OpenFiles)
+						assert.Equal(t, openFiles, *limits.OpenFiles)
+						require.NotNil(t, limits.CoreSize)
+						assert.Equal(t, coreSize, *limits.CoreSize)
+						assert.Nil(t, limits.CPUSeconds)
+					},
+				},
 				{
 					Name: "RegisterSignalTriggerIDChecksForInvalidTriggerID",
 					Case: func(ctx context.Context, t *testing.T, mngr Manager) {
@@ -630,3 +876,80 @@ func TestClientImplementations(t *testing.T) {
 		})
 	}
 }
+
+func TestClientHistory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	httpClient := utility.GetHTTPClient()
+	defer utility.PutHTTPClient(httpClient)
+
+	for managerName, makeClient := range map[string]func(ctx context.Context, mngr jasper.Manager) (Manager, error){
+		"RPC": makeInsecureRPCServiceAndClient,
+		"REST": func(ctx context.Context, mngr jasper.Manager) (Manager, error) {
+			_, client, err := makeRESTServiceAndClient(ctx, mngr, httpClient)
+			return client, err
+		},
+	} {
+		t.Run(managerName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, client Manager){
+				"FindReturnsClearedProcesses": func(ctx context.Context, t *testing.T, client Manager) {
+					opts := testoptions.FalseCreateOpts()
+					opts.Tags = []string{"foo"}
+					proc, err := client.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.Error(t, err)
+					client.Clear(ctx)
+
+					exitCode := 1
+					infos, err := client.History(ctx).Find(ctx, options.HistoryQuery{
+						Tags:     []string{"foo"},
+						Status:   options.Failed,
+						ExitCode: &exitCode,
+					})
+					require.NoError(t, err)
+					require.Len(t, infos, 1)
+					assert.Equal(t, proc.ID(), infos[0].ID)
+					assert.Equal(t, opts.Args, infos[0].Options.Args)
+					assert.Equal(t, []string{"foo"}, infos[0].Options.Tags)
+					assert.False(t, infos[0].EndAt.IsZero())
+
+					infos, err = client.History(ctx).Find(ctx, options.HistoryQuery{Status: options.Successful})
+					require.NoError(t, err)
+					assert.Empty(t, infos)
+				},
+				"FindFailsWithInvalidQuery": func(ctx context.Context, t *testing.T, client Manager) {
+					_, err := client.History(ctx).Find(ctx, options.HistoryQuery{Status: options.Running})
+					assert.Error(t, err)
+				},
+				"PutFails": func(ctx context.Context, t *testing.T, client Manager) {
+					proc, err := client.CreateProcess(ctx, testoptions.TrueCreateOpts())
+					require.NoError(t, err)
+					_, err = proc.Wait(ctx)
+					require.NoError(t, err)
+
+					assert.Error(t, client.History(ctx).Put(ctx, proc.Info(ctx)))
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					tctx, tcancel := context.WithTimeout(ctx, testutil.RPCTestTimeout)
+					defer tcancel()
+
+					history, err := jasper.NewBoltProcessHistory(filepath.Join(t.TempDir(), "history.db"))
+					require.NoError(t, err)
+					defer func() {
+						assert.NoError(t, history.Close())
+					}()
+					mngr, err := jasper.NewSynchronizedManager(false)
+					require.NoError(t, err)
+
+					client, err := makeClient(tctx, jasper.MakeHistoryManager(mngr, history))
+					require.NoError(t, err)
+
+					testCase(tctx, t, client)
+				})
+			}
+		})
+	}
+}
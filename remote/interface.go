@@ -2,6 +2,7 @@ package remote
 
 import (
 	"context"
+	"io"
 
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/options"
@@ -18,6 +19,21 @@ type Manager interface {
 	DownloadFile(ctx context.Context, opts options.Download) error
 	DownloadMongoDB(ctx context.Context, opts options.MongoDBDownload) error
 	GetLogStream(ctx context.Context, id string, count int) (jasper.LogStream, error)
+	// GetCapturedOutput gets part of the captured output of the process with
+	// the given ID, which must have been created with
+	// (options.Output).Capture set.
+	GetCapturedOutput(ctx context.Context, id string, query options.OutputQuery) (*jasper.CapturedOutput, error)
+	// FollowLogs pushes the in-memory output logs of the process with the
+	// given ID to the handler as they are written, skipping the first offset
+	// lines. It returns after the chunk marked as done has been handled.
+	FollowLogs(ctx context.Context, id string, offset int, handler func(jasper.LogChunk) error) error
+	// WriteStdin streams the input to the standard input of the running
+	// process with the given ID until the input is exhausted. The process must
+	// have been created with (options.Create).StandardInputStream set.
+	WriteStdin(ctx context.Context, id string, input io.Reader) error
+	// CloseStdin closes the standard input of the process with the given ID
+	// so that it reads EOF.
+	CloseStdin(ctx context.Context, id string) error
 	GetBuildloggerURLs(ctx context.Context, id string) ([]string, error)
 	SignalEvent(ctx context.Context, name string) error
 	SendMessages(context.Context, options.LoggingPayload) error
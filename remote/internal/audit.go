@@ -0,0 +1,135 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/remote/audit"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// NewAuditInterceptors returns the unary and stream server interceptors that
+// send an audit record to the sender for each call to a method that requires
+// more than the read-only role. They should run before the authorization
+// interceptors so that rejected calls are also recorded.
+func NewAuditInterceptors(sender send.Sender) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor, error) {
+	if sender == nil {
+		return nil, nil, errors.New("must specify an audit sender")
+	}
+
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if rpcMethodRole(info.FullMethod) == options.RoleReadOnly {
+			return handler(ctx, req)
+		}
+
+		record := newRPCAuditRecord(ctx, info.FullMethod)
+		auditMessage(record, req)
+		resp, err := handler(ctx, req)
+		auditMessage(record, resp)
+		auditOutcome(record, resp, err)
+		audit.Send(ctx, sender, *record)
+
+		return resp, err
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if rpcMethodRole(info.FullMethod) == options.RoleReadOnly {
+			return handler(srv, ss)
+		}
+
+		as := &auditedServerStream{ServerStream: ss, record: newRPCAuditRecord(ss.Context(), info.FullMethod)}
+		err := handler(srv, as)
+		auditOutcome(as.record, as.resp, err)
+		audit.Send(ss.Context(), sender, *as.record)
+
+		return err
+	}
+
+	return unary, stream, nil
+}
+
+// auditedServerStream is a server stream that adds the messages that it
+// receives and sends to an audit record.
+type auditedServerStream struct {
+	grpc.ServerStream
+	record *audit.Record
+	resp   interface{}
+}
+
+func (s *auditedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	auditMessage(s.record, m)
+	return nil
+}
+
+func (s *auditedServerStream) SendMsg(m interface{}) error {
+	s.resp = m
+	auditMessage(s.record, m)
+	return s.ServerStream.SendMsg(m)
+}
+
+// newRPCAuditRecord returns an audit record for a call to the method with the
+// given full name.
+func newRPCAuditRecord(ctx context.Context, fullMethod string) *audit.Record {
+	record := &audit.Record{
+		Time:     time.Now(),
+		Service:  audit.ServiceRPC,
+		Method:   fullMethod,
+		Identity: ClientIdentity(ctx),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.Peer = p.Addr.String()
+	}
+	return record
+}
+
+// auditMessage adds the sanitized arguments of a request or response message
+// to the audit record.
+func auditMessage(record *audit.Record, m interface{}) {
+	switch m := m.(type) {
+	case *CreateOptions:
+		record.Args = m.GetArgs()
+		record.SetEnv(m.GetEnvironment())
+		record.AddPaths(m.GetWorkingDirectory())
+	case *ProcessInfo:
+		record.ProcessID = m.GetId()
+	case *JasperProcessID:
+		record.ProcessID = m.GetValue()
+	case *SignalProcess:
+		record.ProcessID = m.GetProcessID().GetValue()
+	case *ResizeProcess:
+		record.ProcessID = m.GetId().GetValue()
+	case *StopProcess:
+		record.ProcessID = m.GetId().GetValue()
+	case *SignalTriggerParams:
+		record.ProcessID = m.GetProcessID().GetValue()
+	case *StdinChunk:
+		record.ProcessID = m.GetId().GetValue()
+	case *ProcessTags:
+		record.ProcessID = m.GetProcessID()
+	case *DownloadInfo:
+		record.AddPaths(m.GetPath(), m.GetArchiveOpts().GetTargetPath())
+	case *MongoDBDownloadOptions:
+		record.AddPaths(m.GetPath())
+	case *WriteFileInfo:
+		record.AddPaths(m.GetPath())
+	case *ScriptingOptions:
+		record.SetEnv(m.GetEnvironment())
+	case *ScriptingHarnessRunArgs:
+		record.Args = m.GetArgs()
+	}
+}
+
+// auditOutcome sets the outcome of the call in the audit record from its
+// response and error.
+func auditOutcome(record *audit.Record, resp interface{}, err error) {
+	if outcome, ok := resp.(*OperationOutcome); ok && err == nil && !outcome.GetSuccess() {
+		err = errors.New(outcome.GetText())
+	}
+	record.SetError(err)
+}
@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"context"
+	"path"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// rpcMethodRoles maps each RPC method to the role that is required to call
+// it. Methods that are not listed require the admin role. Each method must
+// require the same role as the corresponding route of the REST service.
+var rpcMethodRoles = map[string]options.Role{
+	"Status":             options.RoleReadOnly,
+	"ID":                 options.RoleReadOnly,
+	"List":               options.RoleReadOnly,
+	"Group":              options.RoleReadOnly,
+	"History":            options.RoleReadOnly,
+	"Subscribe":          options.RoleReadOnly,
+	"Get":                options.RoleReadOnly,
+	"WaitReady":          options.RoleReadOnly,
+	"Wait":               options.RoleReadOnly,
+	"GetTags":            options.RoleReadOnly,
+	"GetLogStream":       options.RoleReadOnly,
+	"GetCapturedOutput":  options.RoleReadOnly,
+	"FollowLogs":         options.RoleReadOnly,
+	"GetBuildloggerURLs": options.RoleReadOnly,
+	"LoggingCacheGet":    options.RoleReadOnly,
+	"LoggingCacheLen":    options.RoleReadOnly,
+
+	"Create":                     options.RoleProcessOperator,
+	"Signal":                     options.RoleProcessOperator,
+	"Resize":                     options.RoleProcessOperator,
+	"Stop":                       options.RoleProcessOperator,
+	"Respawn":                    options.RoleProcessOperator,
+	"TagProcess":                 options.RoleProcessOperator,
+	"ResetTags":                  options.RoleProcessOperator,
+	"RegisterSignalTriggerID":    options.RoleProcessOperator,
+	"SignalEvent":                options.RoleProcessOperator,
+	"WriteStdin":                 options.RoleProcessOperator,
+	"CloseStdin":                 options.RoleProcessOperator,
+	"LoggingCacheCreate":         options.RoleProcessOperator,
+	"LoggingCacheRemove":         options.RoleProcessOperator,
+	"LoggingCacheCloseAndRemove": options.RoleProcessOperator,
+	"SendMessages":               options.RoleProcessOperator,
+}
+
+// rpcMethodRole returns the role that is required to call the RPC method with
+// the given full name.
+func rpcMethodRole(fullMethod string) options.Role {
+	if role, ok := rpcMethodRoles[path.Base(fullMethod)]; ok {
+		return role
+	}
+	return options.RoleAdmin
+}
+
+// NewAuthorizationInterceptors returns the unary and stream server
+// interceptors that reject calls from clients whose access does not allow the
+// called method. Clients are identified by the common name of their verified
+// TLS certificate. The interceptors also make the client's access available to
+// the service so that it can check the arguments of the call.
+func NewAuthorizationInterceptors(authz options.Authorization) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor, error) {
+	if err := authz.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid authorization")
+	}
+
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorizeRPC(ctx, authz, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorizeRPC(ss.Context(), authz, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedServerStream{ServerStream: ss, ctx: ctx})
+	}
+
+	return unary, stream, nil
+}
+
+// authorizedServerStream is a server stream whose context contains the
+// client's access.
+type authorizedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedServerStream) Context() context.Context { return s.ctx }
+
+type clientAccessKey struct{}
+
+// authorizeRPC checks that the client is allowed to call the method and
+// returns a context containing the client's access.
+func authorizeRPC(ctx context.Context, authz options.Authorization, fullMethod string) (context.Context, error) {
+	access, err := authz.Access(ClientIdentity(ctx))
+	if err != nil {
+		return nil, newGRPCError(codes.PermissionDenied, err)
+	}
+	if err := access.Authorize(rpcMethodRole(fullMethod)); err != nil {
+		return nil, newGRPCError(codes.PermissionDenied, errors.Wrapf(err, "calling '%s'", fullMethod))
+	}
+	return context.WithValue(ctx, clientAccessKey{}, access), nil
+}
+
+// ClientIdentity returns the common name of the verified TLS certificate of
+// the client that made the call, or an empty string if the client did not
+// present one.
+func ClientIdentity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+// authorizePaths checks that the client is allowed to write to each of the
+// paths. All paths are allowed if the service does not check authorization.
+func authorizePaths(ctx context.Context, paths ...string) error {
+	access, ok := ctx.Value(clientAccessKey{}).(*options.ClientAccess)
+	if !ok {
+		return nil
+	}
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		if err := access.AuthorizePath(p); err != nil {
+			return newGRPCError(codes.PermissionDenied, err)
+		}
+	}
+	return nil
+}
+
+// authorizeCreate checks that the client is allowed to create a process with
+// the options, including the processes that its triggers create. All options
+// are allowed if the service does not check authorization.
+func authorizeCreate(ctx context.Context, opts *options.Create) error {
+	access, ok := ctx.Value(clientAccessKey{}).(*options.ClientAccess)
+	if !ok {
+		return nil
+	}
+	return newGRPCError(codes.PermissionDenied, access.AuthorizeCreate(opts))
+}
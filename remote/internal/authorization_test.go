@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCMethodRoles(t *testing.T) {
+	// adminMethods are the methods that intentionally require the admin role
+	// because they are not listed in the method roles.
+	adminMethods := map[string]bool{
+		"Clear":                     true,
+		"Close":                     true,
+		"WriteFile":                 true,
+		"ScriptingHarnessSetup":     true,
+		"ScriptingHarnessCleanup":   true,
+		"ScriptingHarnessRun":       true,
+		"ScriptingHarnessBuild":     true,
+		"ScriptingHarnessRunScript": true,
+		"ScriptingHarnessTest":      true,
+		"ScriptingHarnessCreate":    true,
+		"ScriptingHarnessGet":       true,
+		"LoggingCacheClear":         true,
+		"LoggingCachePrune":         true,
+		"ConfigureCache":            true,
+		"DownloadFile":              true,
+		"DownloadMongoDB":           true,
+	}
+
+	methods := map[string]bool{}
+	for _, method := range JasperProcessManager_ServiceDesc.Methods {
+		methods[method.MethodName] = true
+	}
+	for _, stream := range JasperProcessManager_ServiceDesc.Streams {
+		methods[stream.StreamName] = true
+	}
+
+	t.Run("EveryMethodHasIntendedRole", func(t *testing.T) {
+		for method := range methods {
+			_, listed := rpcMethodRoles[method]
+			assert.True(t, listed != adminMethods[method], "method '%s' must either have a role or be an admin method", method)
+		}
+	})
+	t.Run("EveryRoleIsForExistingMethod", func(t *testing.T) {
+		for method := range rpcMethodRoles {
+			assert.True(t, methods[method], "method '%s' does not exist", method)
+		}
+		for method := range adminMethods {
+			assert.True(t, methods[method], "admin method '%s' does not exist", method)
+		}
+	})
+	t.Run("UnlistedMethodRequiresAdmin", func(t *testing.T) {
+		assert.Equal(t, options.RoleAdmin, rpcMethodRole("/jasper.JasperProcessManager/Close"))
+		assert.Equal(t, options.RoleReadOnly, rpcMethodRole("/jasper.JasperProcessManager/Get"))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"os"
+	"strings"
 	"syscall"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Export takes a protobuf RPC CreateOptions struct and returns the analogous
@@ -23,14 +25,21 @@ import (
 // exported RPC CreateOptions and the returned Jasper CreateOptions.
 func (opts *CreateOptions) Export() (*options.Create, error) {
 	out := &options.Create{
-		Args:               opts.Args,
-		Environment:        opts.Environment,
-		WorkingDirectory:   opts.WorkingDirectory,
-		Timeout:            time.Duration(opts.TimeoutSeconds) * time.Second,
-		TimeoutSecs:        int(opts.TimeoutSeconds),
-		OverrideEnviron:    opts.OverrideEnviron,
-		Tags:               opts.Tags,
-		StandardInputBytes: opts.StandardInputBytes,
+		Args:                opts.Args,
+		Environment:         opts.Environment,
+		WorkingDirectory:    opts.WorkingDirectory,
+		Timeout:             time.Duration(opts.TimeoutSeconds) * time.Second,
+		TimeoutSecs:         int(opts.TimeoutSeconds),
+		OverrideEnviron:     opts.OverrideEnviron,
+		Tags:                opts.Tags,
+		StandardInputBytes:  opts.StandardInputBytes,
+		StandardInputStream: opts.StandardInputStream,
+		Limits:              opts.Limits.Export(),
+		TTY:                 opts.Tty.Export(),
+		KillTree:            opts.KillTree,
+		Restart:             opts.Restart.Export(),
+		Readiness:           opts.Readiness.Export(),
+		AdmissionPriority:   int(opts.AdmissionPriority),
 	}
 	if len(opts.StandardInputBytes) != 0 {
 		out.StandardInput = bytes.NewBuffer(opts.StandardInputBytes)
@@ -85,14 +94,21 @@ func ConvertCreateOptions(opts *options.Create) (*CreateOptions, error) {
 	}
 
 	co := &CreateOptions{
-		Args:               opts.Args,
-		Environment:        opts.Environment,
-		WorkingDirectory:   opts.WorkingDirectory,
-		TimeoutSeconds:     int64(opts.TimeoutSecs),
-		OverrideEnviron:    opts.OverrideEnviron,
-		Tags:               opts.Tags,
-		Output:             &output,
-		StandardInputBytes: opts.StandardInputBytes,
+		Args:                opts.Args,
+		Environment:         opts.Environment,
+		WorkingDirectory:    opts.WorkingDirectory,
+		TimeoutSeconds:      int64(opts.TimeoutSecs),
+		OverrideEnviron:     opts.OverrideEnviron,
+		Tags:                opts.Tags,
+		Output:              &output,
+		StandardInputBytes:  opts.StandardInputBytes,
+		StandardInputStream: opts.StandardInputStream,
+		Limits:              ConvertResourceLimits(opts.Limits),
+		Tty:                 ConvertTTYOptions(opts.TTY),
+		KillTree:            opts.KillTree,
+		Restart:             ConvertRestartPolicy(opts.Restart),
+		Readiness:           ConvertReadinessOptions(opts.Readiness),
+		AdmissionPriority:   int64(opts.AdmissionPriority),
 	}
 
 	for _, opt := range opts.OnSuccess {
@@ -120,6 +136,236 @@ func ConvertCreateOptions(opts *options.Create) (*CreateOptions, error) {
 	return co, nil
 }
 
+// Export takes a protobuf RPC ResourceLimits struct and returns the analogous
+// Jasper *options.Limits struct.
+func (l *ResourceLimits) Export() *options.Limits {
+	if l == nil {
+		return nil
+	}
+
+	exportLimit := func(val *wrapperspb.UInt64Value) *uint64 {
+		if val == nil {
+			return nil
+		}
+		exported := val.Value
+		return &exported
+	}
+
+	out := &options.Limits{
+		CPUSeconds:   exportLimit(l.CpuSeconds),
+		AddressSpace: exportLimit(l.AddressSpace),
+		OpenFiles:    exportLimit(l.OpenFiles),
+		NumProcs:     exportLimit(l.NumProcs),
+		CoreSize:     exportLimit(l.CoreSize),
+	}
+	if l.Cgroup != nil {
+		out.Cgroup = &options.CgroupLimits{
+			MemoryBytes: l.Cgroup.MemoryBytes,
+			CPUs:        l.Cgroup.Cpus,
+		}
+	}
+	return out
+}
+
+// ConvertResourceLimits takes a Jasper *options.Limits struct and returns an
+// equivalent protobuf RPC *ResourceLimits struct. ConvertResourceLimits is
+// the inverse of (*ResourceLimits) Export().
+func ConvertResourceLimits(l *options.Limits) *ResourceLimits {
+	if l == nil {
+		return nil
+	}
+
+	convertLimit := func(val *uint64) *wrapperspb.UInt64Value {
+		if val == nil {
+			return nil
+		}
+		return wrapperspb.UInt64(*val)
+	}
+
+	out := &ResourceLimits{
+		CpuSeconds:   convertLimit(l.CPUSeconds),
+		AddressSpace: convertLimit(l.AddressSpace),
+		OpenFiles:    convertLimit(l.OpenFiles),
+		NumProcs:     convertLimit(l.NumProcs),
+		CoreSize:     convertLimit(l.CoreSize),
+	}
+	if l.Cgroup != nil {
+		out.Cgroup = &CgroupLimits{
+			MemoryBytes: l.Cgroup.MemoryBytes,
+			Cpus:        l.Cgroup.CPUs,
+		}
+	}
+	return out
+}
+
+// Export takes a protobuf RPC TTYOptions struct and returns the analogous
+// Jasper *options.TTY struct.
+func (t *TTYOptions) Export() *options.TTY {
+	if t == nil {
+		return nil
+	}
+	return &options.TTY{
+		Rows: uint16(t.Rows),
+		Cols: uint16(t.Cols),
+	}
+}
+
+// ConvertTTYOptions takes a Jasper *options.TTY struct and returns an
+// equivalent protobuf RPC *TTYOptions struct. ConvertTTYOptions is the
+// inverse of (*TTYOptions) Export().
+func ConvertTTYOptions(t *options.TTY) *TTYOptions {
+	if t == nil {
+		return nil
+	}
+	return &TTYOptions{
+		Rows: uint32(t.Rows),
+		Cols: uint32(t.Cols),
+	}
+}
+
+// Export takes a protobuf RPC RestartCondition and returns the analogous
+// Jasper RestartCondition.
+func (c RestartCondition) Export() options.RestartCondition {
+	switch c {
+	case RestartCondition_RESTARTCONDITIONNEVER:
+		return options.RestartNever
+	case RestartCondition_RESTARTCONDITIONONFAILURE:
+		return options.RestartOnFailure
+	case RestartCondition_RESTARTCONDITIONALWAYS:
+		return options.RestartAlways
+	default:
+		return ""
+	}
+}
+
+// ConvertRestartCondition takes a Jasper RestartCondition and returns an
+// equivalent protobuf RPC RestartCondition. ConvertRestartCondition is the
+// inverse of (RestartCondition) Export().
+func ConvertRestartCondition(c options.RestartCondition) RestartCondition {
+	switch c {
+	case options.RestartNever:
+		return RestartCondition_RESTARTCONDITIONNEVER
+	case options.RestartOnFailure:
+		return RestartCondition_RESTARTCONDITIONONFAILURE
+	case options.RestartAlways:
+		return RestartCondition_RESTARTCONDITIONALWAYS
+	default:
+		return RestartCondition_RESTARTCONDITIONUNKNOWN
+	}
+}
+
+// Export takes a protobuf RPC RestartPolicy struct and returns the analogous
+// Jasper *options.Restart struct.
+func (p *RestartPolicy) Export() *options.Restart {
+	if p == nil {
+		return nil
+	}
+	return &options.Restart{
+		Condition:   p.Condition.Export(),
+		MaxRetries:  int(p.MaxRetries),
+		Backoff:     time.Duration(p.BackoffNanos),
+		MaxBackoff:  time.Duration(p.MaxBackoffNanos),
+		ResetWindow: time.Duration(p.ResetWindowNanos),
+	}
+}
+
+// ConvertRestartPolicy takes a Jasper *options.Restart struct and returns an
+// equivalent protobuf RPC *RestartPolicy struct. ConvertRestartPolicy is the
+// inverse of (*RestartPolicy) Export().
+func ConvertRestartPolicy(r *options.Restart) *RestartPolicy {
+	if r == nil {
+		return nil
+	}
+	return &RestartPolicy{
+		Condition:        ConvertRestartCondition(r.Condition),
+		MaxRetries:       int64(r.MaxRetries),
+		BackoffNanos:     int64(r.Backoff),
+		MaxBackoffNanos:  int64(r.MaxBackoff),
+		ResetWindowNanos: int64(r.ResetWindow),
+	}
+}
+
+// Export takes a protobuf RPC ReadinessOptions struct and returns the
+// analogous Jasper *options.Readiness struct.
+func (r *ReadinessOptions) Export() *options.Readiness {
+	if r == nil {
+		return nil
+	}
+	readiness := &options.Readiness{
+		Interval: time.Duration(r.IntervalNanos),
+		Timeout:  time.Duration(r.TimeoutNanos),
+	}
+	for _, probe := range r.Probes {
+		readiness.Probes = append(readiness.Probes, probe.Export())
+	}
+	return readiness
+}
+
+// ConvertReadinessOptions takes a Jasper *options.Readiness struct and returns
+// an equivalent protobuf RPC *ReadinessOptions struct.
+// ConvertReadinessOptions is the inverse of (*ReadinessOptions) Export().
+func ConvertReadinessOptions(r *options.Readiness) *ReadinessOptions {
+	if r == nil {
+		return nil
+	}
+	readiness := &ReadinessOptions{
+		IntervalNanos: int64(r.Interval),
+		TimeoutNanos:  int64(r.Timeout),
+	}
+	for _, probe := range r.Probes {
+		readiness.Probes = append(readiness.Probes, ConvertReadinessProbe(probe))
+	}
+	return readiness
+}
+
+// Export takes a protobuf RPC ReadinessProbe struct and returns the analogous
+// Jasper ReadinessProbe struct.
+func (p *ReadinessProbe) Export() options.ReadinessProbe {
+	var probe options.ReadinessProbe
+	if p == nil {
+		return probe
+	}
+	if p.Tcp != nil {
+		probe.TCP = &options.TCPProbe{Address: p.Tcp.Address}
+	}
+	if p.Http != nil {
+		probe.HTTP = &options.HTTPProbe{URL: p.Http.Url, Status: int(p.Http.Status)}
+	}
+	if p.Log != nil {
+		probe.Log = &options.LogProbe{Pattern: p.Log.Pattern}
+	}
+	if p.File != nil {
+		probe.File = &options.FileProbe{Path: p.File.Path}
+	}
+	if p.Command != nil {
+		probe.Command = &options.CommandProbe{Args: p.Command.Args}
+	}
+	return probe
+}
+
+// ConvertReadinessProbe takes a Jasper ReadinessProbe struct and returns an
+// equivalent protobuf RPC *ReadinessProbe struct. ConvertReadinessProbe is the
+// inverse of (*ReadinessProbe) Export().
+func ConvertReadinessProbe(p options.ReadinessProbe) *ReadinessProbe {
+	probe := &ReadinessProbe{}
+	if p.TCP != nil {
+		probe.Tcp = &TCPProbe{Address: p.TCP.Address}
+	}
+	if p.HTTP != nil {
+		probe.Http = &HTTPProbe{Url: p.HTTP.URL, Status: int32(p.HTTP.Status)}
+	}
+	if p.Log != nil {
+		probe.Log = &LogProbe{Pattern: p.Log.Pattern}
+	}
+	if p.File != nil {
+		probe.File = &FileProbe{Path: p.File.Path}
+	}
+	if p.Command != nil {
+		probe.Command = &CommandProbe{Args: p.Command.Args}
+	}
+	return probe
+}
+
 // Export takes a protobuf RPC ProcessInfo struct and returns the analogous
 // Jasper ProcessInfo struct.
 func (info *ProcessInfo) Export() (jasper.ProcessInfo, error) {
@@ -128,16 +374,23 @@ func (info *ProcessInfo) Export() (jasper.ProcessInfo, error) {
 		return jasper.ProcessInfo{}, errors.Wrap(err, "exporting create options")
 	}
 	return jasper.ProcessInfo{
-		ID:         info.Id,
-		PID:        int(info.Pid),
-		IsRunning:  info.Running,
-		Successful: info.Successful,
-		Complete:   info.Complete,
-		ExitCode:   int(info.ExitCode),
-		Timeout:    info.Timedout,
-		Options:    *opts,
-		StartAt:    info.StartAt.AsTime(),
-		EndAt:      info.EndAt.AsTime(),
+		ID:           info.Id,
+		PID:          int(info.Pid),
+		IsRunning:    info.Running,
+		Successful:   info.Successful,
+		Complete:     info.Complete,
+		ExitCode:     int(info.ExitCode),
+		Timeout:      info.Timedout,
+		Options:      *opts,
+		StartAt:      info.StartAt.AsTime(),
+		EndAt:        info.EndAt.AsTime(),
+		Resources:    info.Resources.Export(),
+		StoppedBy:    info.StoppedBy.Export(),
+		KilledPIDs:   exportPIDs(info.KilledPids),
+		RestartCount: int(info.RestartCount),
+		PreviousIDs:  info.PreviousIds,
+		Ready:        info.Ready,
+		ReadyAt:      info.ReadyAt.AsTime(),
 	}, nil
 }
 
@@ -150,19 +403,198 @@ func ConvertProcessInfo(info jasper.ProcessInfo) (*ProcessInfo, error) {
 		return nil, errors.Wrap(err, "converting create options")
 	}
 	return &ProcessInfo{
-		Id:         info.ID,
-		Pid:        int64(info.PID),
-		ExitCode:   int32(info.ExitCode),
-		Running:    info.IsRunning,
-		Successful: info.Successful,
-		Complete:   info.Complete,
-		Timedout:   info.Timeout,
-		StartAt:    timestamppb.New(info.StartAt),
-		EndAt:      timestamppb.New(info.EndAt),
-		Options:    opts,
+		Id:           info.ID,
+		Pid:          int64(info.PID),
+		ExitCode:     int32(info.ExitCode),
+		Running:      info.IsRunning,
+		Successful:   info.Successful,
+		Complete:     info.Complete,
+		Timedout:     info.Timeout,
+		StartAt:      timestamppb.New(info.StartAt),
+		EndAt:        timestamppb.New(info.EndAt),
+		Options:      opts,
+		Resources:    ConvertProcessResources(info.Resources),
+		StoppedBy:    ConvertStopResult(info.StoppedBy),
+		KilledPids:   convertPIDs(info.KilledPIDs),
+		RestartCount: int64(info.RestartCount),
+		PreviousIds:  info.PreviousIDs,
+		Ready:        info.Ready,
+		ReadyAt:      timestamppb.New(info.ReadyAt),
 	}, nil
 }
 
+// exportPIDs converts protobuf RPC PIDs to Jasper PIDs.
+func exportPIDs(pids []int64) []int {
+	if len(pids) == 0 {
+		return nil
+	}
+	out := make([]int, 0, len(pids))
+	for _, pid := range pids {
+		out = append(out, int(pid))
+	}
+	return out
+}
+
+// convertPIDs converts Jasper PIDs to protobuf RPC PIDs. convertPIDs is the
+// inverse of exportPIDs.
+func convertPIDs(pids []int) []int64 {
+	if len(pids) == 0 {
+		return nil
+	}
+	out := make([]int64, 0, len(pids))
+	for _, pid := range pids {
+		out = append(out, int64(pid))
+	}
+	return out
+}
+
+// Export takes a protobuf RPC StopResult struct and returns the analogous
+// Jasper *StopResult struct.
+func (r *StopResult) Export() *jasper.StopResult {
+	if r == nil {
+		return nil
+	}
+	return &jasper.StopResult{
+		Step:   int(r.Step),
+		Signal: syscall.Signal(r.Signal),
+	}
+}
+
+// ConvertStopResult takes a Jasper *StopResult struct and returns an
+// equivalent protobuf RPC *StopResult struct. ConvertStopResult is the
+// inverse of (*StopResult) Export().
+func ConvertStopResult(r *jasper.StopResult) *StopResult {
+	if r == nil {
+		return nil
+	}
+	return &StopResult{
+		Step:   int32(r.Step),
+		Signal: int32(r.Signal),
+	}
+}
+
+// Export takes a protobuf RPC StopTarget and returns the analogous Jasper
+// StopTarget.
+func (t StopTarget) Export() options.StopTarget {
+	switch t {
+	case StopTarget_STOPTARGETPROCESS:
+		return options.StopTargetProcess
+	case StopTarget_STOPTARGETGROUP:
+		return options.StopTargetGroup
+	case StopTarget_STOPTARGETTREE:
+		return options.StopTargetTree
+	default:
+		return ""
+	}
+}
+
+// ConvertStopTarget takes a Jasper StopTarget and returns an equivalent
+// protobuf RPC StopTarget. ConvertStopTarget is the inverse of (StopTarget)
+// Export().
+func ConvertStopTarget(t options.StopTarget) StopTarget {
+	switch t {
+	case options.StopTargetProcess:
+		return StopTarget_STOPTARGETPROCESS
+	case options.StopTargetGroup:
+		return StopTarget_STOPTARGETGROUP
+	case options.StopTargetTree:
+		return StopTarget_STOPTARGETTREE
+	default:
+		return StopTarget_STOPTARGETUNKNOWN
+	}
+}
+
+// Export takes a protobuf RPC StopPolicy struct and returns the analogous
+// Jasper StopPolicy struct.
+func (p *StopPolicy) Export() options.StopPolicy {
+	if p == nil {
+		return options.StopPolicy{}
+	}
+	policy := options.StopPolicy{Target: p.Target.Export()}
+	for _, step := range p.Steps {
+		policy.Steps = append(policy.Steps, options.StopStep{
+			Signal:      syscall.Signal(step.Signal),
+			GracePeriod: time.Duration(step.GracePeriodNanos),
+		})
+	}
+	return policy
+}
+
+// ConvertStopPolicy takes a Jasper StopPolicy struct and returns an
+// equivalent protobuf RPC *StopPolicy struct. ConvertStopPolicy is the
+// inverse of (*StopPolicy) Export().
+func ConvertStopPolicy(p options.StopPolicy) *StopPolicy {
+	policy := &StopPolicy{Target: ConvertStopTarget(p.Target)}
+	for _, step := range p.Steps {
+		policy.Steps = append(policy.Steps, &StopStep{
+			Signal:           int32(step.Signal),
+			GracePeriodNanos: int64(step.GracePeriod),
+		})
+	}
+	return policy
+}
+
+// Export takes a protobuf RPC ResourceUsage struct and returns the analogous
+// Jasper ResourceUsage struct.
+func (u *ResourceUsage) Export() jasper.ResourceUsage {
+	if u == nil {
+		return jasper.ResourceUsage{}
+	}
+	return jasper.ResourceUsage{
+		CPUUserTime:   time.Duration(u.CpuUserTimeNanos),
+		CPUSystemTime: time.Duration(u.CpuSystemTimeNanos),
+		RSS:           u.Rss,
+		ReadBytes:     u.ReadBytes,
+		WriteBytes:    u.WriteBytes,
+		OpenFiles:     int(u.OpenFiles),
+		NumProcesses:  int(u.NumProcesses),
+	}
+}
+
+// ConvertResourceUsage takes a Jasper ResourceUsage struct and returns an
+// equivalent protobuf RPC *ResourceUsage struct. ConvertResourceUsage is the
+// inverse of (*ResourceUsage) Export().
+func ConvertResourceUsage(u jasper.ResourceUsage) *ResourceUsage {
+	return &ResourceUsage{
+		CpuUserTimeNanos:   int64(u.CPUUserTime),
+		CpuSystemTimeNanos: int64(u.CPUSystemTime),
+		Rss:                u.RSS,
+		ReadBytes:          u.ReadBytes,
+		WriteBytes:         u.WriteBytes,
+		OpenFiles:          int64(u.OpenFiles),
+		NumProcesses:       int64(u.NumProcesses),
+	}
+}
+
+// Export takes a protobuf RPC ProcessResources struct and returns the
+// analogous Jasper *ProcessResources struct.
+func (r *ProcessResources) Export() *jasper.ProcessResources {
+	if r == nil {
+		return nil
+	}
+	return &jasper.ProcessResources{
+		Last:       r.Last.Export(),
+		Peak:       r.Peak.Export(),
+		NumSamples: int(r.NumSamples),
+		SampledAt:  r.SampledAt.AsTime(),
+	}
+}
+
+// ConvertProcessResources takes a Jasper *ProcessResources struct and returns
+// an equivalent protobuf RPC *ProcessResources struct.
+// ConvertProcessResources is the inverse of (*ProcessResources) Export().
+func ConvertProcessResources(r *jasper.ProcessResources) *ProcessResources {
+	if r == nil {
+		return nil
+	}
+	return &ProcessResources{
+		Last:       ConvertResourceUsage(r.Last),
+		Peak:       ConvertResourceUsage(r.Peak),
+		NumSamples: int64(r.NumSamples),
+		SampledAt:  timestamppb.New(r.SampledAt),
+	}
+}
+
 // Export takes a protobuf RPC Signals struct and returns the analogous
 // syscall.Signal.
 func (s Signals) Export() syscall.Signal {
@@ -219,6 +651,203 @@ func ConvertFilter(f options.Filter) *Filter {
 	}
 }
 
+// ExportQuery takes a protobuf RPC Filter struct and returns the analogous
+// Jasper ProcessQuery struct.
+func (f *Filter) ExportQuery() options.ProcessQuery {
+	query := options.ProcessQuery{
+		Status:           options.Filter(strings.ToLower(f.GetName().String())),
+		Args:             f.Args,
+		WorkingDirectory: f.WorkingDirectory,
+		Host:             f.Host,
+	}
+	for _, tagSet := range f.Tags {
+		query.Tags = append(query.Tags, tagSet.Tags)
+	}
+	if f.MinExitCode != nil {
+		minExitCode := int(f.MinExitCode.Value)
+		query.MinExitCode = &minExitCode
+	}
+	if f.MaxExitCode != nil {
+		maxExitCode := int(f.MaxExitCode.Value)
+		query.MaxExitCode = &maxExitCode
+	}
+	if f.StartedAfter != nil {
+		query.StartedAfter = f.StartedAfter.AsTime()
+	}
+	if f.StartedBefore != nil {
+		query.StartedBefore = f.StartedBefore.AsTime()
+	}
+	return query
+}
+
+// ConvertProcessQuery takes a Jasper ProcessQuery struct and returns an
+// equivalent protobuf RPC *Filter struct. ConvertProcessQuery is the inverse of
+// (*Filter) ExportQuery().
+func ConvertProcessQuery(q options.ProcessQuery) *Filter {
+	filter := ConvertFilter(q.Status)
+	if filter == nil {
+		filter = &Filter{}
+	}
+	filter.Args = q.Args
+	filter.WorkingDirectory = q.WorkingDirectory
+	filter.Host = q.Host
+	for _, tags := range q.Tags {
+		filter.Tags = append(filter.Tags, &TagSet{Tags: tags})
+	}
+	if q.MinExitCode != nil {
+		filter.MinExitCode = wrapperspb.Int64(int64(*q.MinExitCode))
+	}
+	if q.MaxExitCode != nil {
+		filter.MaxExitCode = wrapperspb.Int64(int64(*q.MaxExitCode))
+	}
+	if !q.StartedAfter.IsZero() {
+		filter.StartedAfter = timestamppb.New(q.StartedAfter)
+	}
+	if !q.StartedBefore.IsZero() {
+		filter.StartedBefore = timestamppb.New(q.StartedBefore)
+	}
+	return filter
+}
+
+// Export takes a protobuf RPC HistoryQuery struct and returns the analogous
+// Jasper HistoryQuery struct.
+func (q *HistoryQuery) Export() options.HistoryQuery {
+	query := options.HistoryQuery{
+		Tags:   q.Tags,
+		Status: options.Filter(strings.ToLower(q.Status.String())),
+		Limit:  int(q.Limit),
+	}
+	if q.CompletedAfter != nil {
+		query.CompletedAfter = q.CompletedAfter.AsTime()
+	}
+	if q.CompletedBefore != nil {
+		query.CompletedBefore = q.CompletedBefore.AsTime()
+	}
+	if q.ExitCode != nil {
+		exitCode := int(q.ExitCode.Value)
+		query.ExitCode = &exitCode
+	}
+	return query
+}
+
+// ConvertHistoryQuery takes a Jasper HistoryQuery struct and returns an
+// equivalent protobuf RPC *HistoryQuery struct. ConvertHistoryQuery is the
+// inverse of (*HistoryQuery) Export().
+func ConvertHistoryQuery(q options.HistoryQuery) *HistoryQuery {
+	query := &HistoryQuery{
+		Tags:  q.Tags,
+		Limit: int64(q.Limit),
+	}
+	if filter := ConvertFilter(q.Status); filter != nil {
+		query.Status = filter.Name
+	}
+	if !q.CompletedAfter.IsZero() {
+		query.CompletedAfter = timestamppb.New(q.CompletedAfter)
+	}
+	if !q.CompletedBefore.IsZero() {
+		query.CompletedBefore = timestamppb.New(q.CompletedBefore)
+	}
+	if q.ExitCode != nil {
+		query.ExitCode = wrapperspb.Int64(int64(*q.ExitCode))
+	}
+	return query
+}
+
+// Export takes a protobuf RPC ProcessEventType and returns the analogous
+// Jasper ProcessEventType.
+func (t ProcessEventType) Export() options.ProcessEventType {
+	switch t {
+	case ProcessEventType_PROCESSEVENTCREATED:
+		return options.ProcessCreated
+	case ProcessEventType_PROCESSEVENTSTARTED:
+		return options.ProcessStarted
+	case ProcessEventType_PROCESSEVENTSIGNALED:
+		return options.ProcessSignaled
+	case ProcessEventType_PROCESSEVENTEXITED:
+		return options.ProcessExited
+	case ProcessEventType_PROCESSEVENTTIMEDOUT:
+		return options.ProcessTimedOut
+	case ProcessEventType_PROCESSEVENTOOMKILLED:
+		return options.ProcessOOMKilled
+	default:
+		return ""
+	}
+}
+
+// ConvertProcessEventType takes a Jasper ProcessEventType and returns an
+// equivalent protobuf RPC ProcessEventType. ConvertProcessEventType is the
+// inverse of (ProcessEventType) Export().
+func ConvertProcessEventType(t options.ProcessEventType) ProcessEventType {
+	switch t {
+	case options.ProcessCreated:
+		return ProcessEventType_PROCESSEVENTCREATED
+	case options.ProcessStarted:
+		return ProcessEventType_PROCESSEVENTSTARTED
+	case options.ProcessSignaled:
+		return ProcessEventType_PROCESSEVENTSIGNALED
+	case options.ProcessExited:
+		return ProcessEventType_PROCESSEVENTEXITED
+	case options.ProcessTimedOut:
+		return ProcessEventType_PROCESSEVENTTIMEDOUT
+	case options.ProcessOOMKilled:
+		return ProcessEventType_PROCESSEVENTOOMKILLED
+	default:
+		return ProcessEventType_PROCESSEVENTUNKNOWN
+	}
+}
+
+// Export takes a protobuf RPC ProcessEventFilter struct and returns the
+// analogous Jasper ProcessEventFilter struct.
+func (f *ProcessEventFilter) Export() options.ProcessEventFilter {
+	filter := options.ProcessEventFilter{Tags: f.Tags}
+	for _, t := range f.Types {
+		filter.Types = append(filter.Types, t.Export())
+	}
+	return filter
+}
+
+// ConvertProcessEventFilter takes a Jasper ProcessEventFilter struct and
+// returns an equivalent protobuf RPC *ProcessEventFilter struct.
+// ConvertProcessEventFilter is the inverse of (*ProcessEventFilter) Export().
+func ConvertProcessEventFilter(f options.ProcessEventFilter) *ProcessEventFilter {
+	filter := &ProcessEventFilter{Tags: f.Tags}
+	for _, t := range f.Types {
+		filter.Types = append(filter.Types, ConvertProcessEventType(t))
+	}
+	return filter
+}
+
+// Export takes a protobuf RPC ProcessEvent struct and returns the analogous
+// Jasper ProcessEvent struct.
+func (e *ProcessEvent) Export() (jasper.ProcessEvent, error) {
+	info, err := e.Info.Export()
+	if err != nil {
+		return jasper.ProcessEvent{}, errors.Wrap(err, "exporting process info")
+	}
+	return jasper.ProcessEvent{
+		Type:   e.Type.Export(),
+		Time:   e.Time.AsTime(),
+		Signal: e.Signal.Export(),
+		Info:   info,
+	}, nil
+}
+
+// ConvertProcessEvent takes a Jasper ProcessEvent struct and returns an
+// equivalent protobuf RPC *ProcessEvent struct. ConvertProcessEvent is the
+// inverse of (*ProcessEvent) Export().
+func ConvertProcessEvent(e jasper.ProcessEvent) (*ProcessEvent, error) {
+	info, err := ConvertProcessInfo(e.Info)
+	if err != nil {
+		return nil, errors.Wrap(err, "converting process info")
+	}
+	return &ProcessEvent{
+		Type:   ConvertProcessEventType(e.Type),
+		Time:   timestamppb.New(e.Time),
+		Signal: ConvertSignal(e.Signal),
+		Info:   info,
+	}, nil
+}
+
 // Export takes a protobuf RPC OutputOptions struct and returns the analogous
 // Jasper OutputOptions struct.
 func (opts *OutputOptions) Export() (options.Output, error) {
@@ -236,6 +865,7 @@ func (opts *OutputOptions) Export() (options.Output, error) {
 		SendOutputToError: opts.RedirectOutputToError,
 		SendErrorToOutput: opts.RedirectErrorToOutput,
 		Loggers:           loggers,
+		Capture:           opts.Capture.Export(),
 	}, nil
 }
 
@@ -257,9 +887,36 @@ func ConvertOutputOptions(opts options.Output) (OutputOptions, error) {
 		RedirectOutputToError: opts.SendOutputToError,
 		RedirectErrorToOutput: opts.SendErrorToOutput,
 		Loggers:               loggers,
+		Capture:               ConvertOutputCaptureOptions(opts.Capture),
 	}, nil
 }
 
+// Export takes a protobuf RPC OutputCaptureOptions struct and returns the
+// analogous Jasper *options.OutputCapture struct.
+func (opts *OutputCaptureOptions) Export() *options.OutputCapture {
+	if opts == nil {
+		return nil
+	}
+	return &options.OutputCapture{
+		MaxBytes:    int(opts.MaxBytes),
+		SpillToFile: opts.SpillToFile,
+	}
+}
+
+// ConvertOutputCaptureOptions takes a Jasper *options.OutputCapture struct
+// and returns an equivalent protobuf RPC OutputCaptureOptions struct.
+// ConvertOutputCaptureOptions is the inverse of (*OutputCaptureOptions)
+// Export().
+func ConvertOutputCaptureOptions(opts *options.OutputCapture) *OutputCaptureOptions {
+	if opts == nil {
+		return nil
+	}
+	return &OutputCaptureOptions{
+		MaxBytes:    int64(opts.MaxBytes),
+		SpillToFile: opts.SpillToFile,
+	}
+}
+
 // Export takes a protobuf RPC Logger struct and returns the analogous
 // Jasper Logger struct.
 func (logger *LoggerConfig) Export() (*options.LoggerConfig, error) {
@@ -774,6 +1431,119 @@ func ConvertLogStream(l jasper.LogStream) *LogStream {
 	}
 }
 
+// Export takes a protobuf RPC OutputStream and returns the analogous Jasper
+// OutputStream.
+func (s OutputStream) Export() options.OutputStream {
+	switch s {
+	case OutputStream_OUTPUTSTREAMSTDOUT:
+		return options.OutputStreamStdout
+	case OutputStream_OUTPUTSTREAMSTDERR:
+		return options.OutputStreamStderr
+	default:
+		return ""
+	}
+}
+
+// ConvertOutputStream takes a Jasper OutputStream and returns an equivalent
+// protobuf RPC OutputStream. ConvertOutputStream is the inverse of
+// (OutputStream) Export().
+func ConvertOutputStream(s options.OutputStream) OutputStream {
+	switch s {
+	case options.OutputStreamStdout:
+		return OutputStream_OUTPUTSTREAMSTDOUT
+	case options.OutputStreamStderr:
+		return OutputStream_OUTPUTSTREAMSTDERR
+	default:
+		return OutputStream_OUTPUTSTREAMUNKNOWN
+	}
+}
+
+// Export takes a protobuf RPC OutputQuery and returns the analogous Jasper
+// OutputQuery.
+func (q *OutputQuery) Export() options.OutputQuery {
+	if q == nil {
+		return options.OutputQuery{}
+	}
+	query := options.OutputQuery{
+		Stream: q.Stream.Export(),
+		Offset: q.Offset,
+		Limit:  q.Limit,
+	}
+	if q.Lines != nil {
+		query.Lines = &options.LineRange{
+			Start: q.Lines.Start,
+			Count: q.Lines.Count,
+		}
+	}
+	return query
+}
+
+// ConvertOutputQuery takes a Jasper OutputQuery and returns an equivalent
+// protobuf RPC OutputQuery. ConvertOutputQuery is the inverse of
+// (*OutputQuery) Export().
+func ConvertOutputQuery(q options.OutputQuery) *OutputQuery {
+	query := &OutputQuery{
+		Stream: ConvertOutputStream(q.Stream),
+		Offset: q.Offset,
+		Limit:  q.Limit,
+	}
+	if q.Lines != nil {
+		query.Lines = &LineRange{
+			Start: q.Lines.Start,
+			Count: q.Lines.Count,
+		}
+	}
+	return query
+}
+
+// Export takes a protobuf RPC CapturedOutput and returns the analogous Jasper
+// CapturedOutput.
+func (o *CapturedOutput) Export() *jasper.CapturedOutput {
+	return &jasper.CapturedOutput{
+		Stream:    o.Stream.Export(),
+		Data:      o.Data,
+		Offset:    o.Offset,
+		Size:      o.Size,
+		Truncated: o.Truncated,
+	}
+}
+
+// ConvertCapturedOutput takes a Jasper CapturedOutput and returns an
+// equivalent protobuf RPC CapturedOutput. ConvertCapturedOutput is the
+// inverse of (*CapturedOutput) Export().
+func ConvertCapturedOutput(o *jasper.CapturedOutput) *CapturedOutput {
+	return &CapturedOutput{
+		Stream:    ConvertOutputStream(o.Stream),
+		Data:      o.Data,
+		Offset:    o.Offset,
+		Size:      o.Size,
+		Truncated: o.Truncated,
+	}
+}
+
+// Export takes a protobuf RPC LogChunk and returns the analogous
+// Jasper LogChunk.
+func (l *LogChunk) Export() jasper.LogChunk {
+	return jasper.LogChunk{
+		Logs:       l.Logs,
+		Offset:     int(l.Offset),
+		NextOffset: int(l.NextOffset),
+		Done:       l.Done,
+	}
+}
+
+// ConvertLogChunk takes a Jasper LogChunk and returns an equivalent
+// protobuf RPC LogChunk. ConvertLogChunk is the inverse of
+// (*LogChunk) Export().
+func ConvertLogChunk(l jasper.LogChunk) *LogChunk {
+	return &LogChunk{
+		Logs:       l.Logs,
+		Offset:     int64(l.Offset),
+		NextOffset: int64(l.NextOffset),
+		Done:       l.Done,
+	}
+}
+
 // Export takes a protobuf RPC LoggingPayloadFormat and returns the
 // analogous LoggingPayloadFormat.
 func (lf LoggingPayloadFormat) Export() options.LoggingPayloadFormat {
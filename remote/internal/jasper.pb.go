@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.36.10
 // 	protoc        v3.19.3
 // source: jasper.proto
 
@@ -12,8 +12,10 @@ import (
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -396,12 +398,224 @@ func (LoggingPayloadFormat) EnumDescriptor() ([]byte, []int) {
 	return file_jasper_proto_rawDescGZIP(), []int{6}
 }
 
-type LoggerConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ProcessEventType int32
+
+const (
+	ProcessEventType_PROCESSEVENTUNKNOWN   ProcessEventType = 0
+	ProcessEventType_PROCESSEVENTCREATED   ProcessEventType = 1
+	ProcessEventType_PROCESSEVENTSTARTED   ProcessEventType = 2
+	ProcessEventType_PROCESSEVENTSIGNALED  ProcessEventType = 3
+	ProcessEventType_PROCESSEVENTEXITED    ProcessEventType = 4
+	ProcessEventType_PROCESSEVENTTIMEDOUT  ProcessEventType = 5
+	ProcessEventType_PROCESSEVENTOOMKILLED ProcessEventType = 6
+)
+
+// Enum value maps for ProcessEventType.
+var (
+	ProcessEventType_name = map[int32]string{
+		0: "PROCESSEVENTUNKNOWN",
+		1: "PROCESSEVENTCREATED",
+		2: "PROCESSEVENTSTARTED",
+		3: "PROCESSEVENTSIGNALED",
+		4: "PROCESSEVENTEXITED",
+		5: "PROCESSEVENTTIMEDOUT",
+		6: "PROCESSEVENTOOMKILLED",
+	}
+	ProcessEventType_value = map[string]int32{
+		"PROCESSEVENTUNKNOWN":   0,
+		"PROCESSEVENTCREATED":   1,
+		"PROCESSEVENTSTARTED":   2,
+		"PROCESSEVENTSIGNALED":  3,
+		"PROCESSEVENTEXITED":    4,
+		"PROCESSEVENTTIMEDOUT":  5,
+		"PROCESSEVENTOOMKILLED": 6,
+	}
+)
+
+func (x ProcessEventType) Enum() *ProcessEventType {
+	p := new(ProcessEventType)
+	*p = x
+	return p
+}
+
+func (x ProcessEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProcessEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_jasper_proto_enumTypes[7].Descriptor()
+}
+
+func (ProcessEventType) Type() protoreflect.EnumType {
+	return &file_jasper_proto_enumTypes[7]
+}
+
+func (x ProcessEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProcessEventType.Descriptor instead.
+func (ProcessEventType) EnumDescriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{7}
+}
+
+type StopTarget int32
+
+const (
+	StopTarget_STOPTARGETUNKNOWN StopTarget = 0
+	StopTarget_STOPTARGETPROCESS StopTarget = 1
+	StopTarget_STOPTARGETGROUP   StopTarget = 2
+	StopTarget_STOPTARGETTREE    StopTarget = 3
+)
+
+// Enum value maps for StopTarget.
+var (
+	StopTarget_name = map[int32]string{
+		0: "STOPTARGETUNKNOWN",
+		1: "STOPTARGETPROCESS",
+		2: "STOPTARGETGROUP",
+		3: "STOPTARGETTREE",
+	}
+	StopTarget_value = map[string]int32{
+		"STOPTARGETUNKNOWN": 0,
+		"STOPTARGETPROCESS": 1,
+		"STOPTARGETGROUP":   2,
+		"STOPTARGETTREE":    3,
+	}
+)
+
+func (x StopTarget) Enum() *StopTarget {
+	p := new(StopTarget)
+	*p = x
+	return p
+}
+
+func (x StopTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StopTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_jasper_proto_enumTypes[8].Descriptor()
+}
+
+func (StopTarget) Type() protoreflect.EnumType {
+	return &file_jasper_proto_enumTypes[8]
+}
+
+func (x StopTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StopTarget.Descriptor instead.
+func (StopTarget) EnumDescriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{8}
+}
+
+type RestartCondition int32
+
+const (
+	RestartCondition_RESTARTCONDITIONUNKNOWN   RestartCondition = 0
+	RestartCondition_RESTARTCONDITIONNEVER     RestartCondition = 1
+	RestartCondition_RESTARTCONDITIONONFAILURE RestartCondition = 2
+	RestartCondition_RESTARTCONDITIONALWAYS    RestartCondition = 3
+)
+
+// Enum value maps for RestartCondition.
+var (
+	RestartCondition_name = map[int32]string{
+		0: "RESTARTCONDITIONUNKNOWN",
+		1: "RESTARTCONDITIONNEVER",
+		2: "RESTARTCONDITIONONFAILURE",
+		3: "RESTARTCONDITIONALWAYS",
+	}
+	RestartCondition_value = map[string]int32{
+		"RESTARTCONDITIONUNKNOWN":   0,
+		"RESTARTCONDITIONNEVER":     1,
+		"RESTARTCONDITIONONFAILURE": 2,
+		"RESTARTCONDITIONALWAYS":    3,
+	}
+)
+
+func (x RestartCondition) Enum() *RestartCondition {
+	p := new(RestartCondition)
+	*p = x
+	return p
+}
 
-	// Types that are assignable to Producer:
+func (x RestartCondition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestartCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_jasper_proto_enumTypes[9].Descriptor()
+}
+
+func (RestartCondition) Type() protoreflect.EnumType {
+	return &file_jasper_proto_enumTypes[9]
+}
+
+func (x RestartCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestartCondition.Descriptor instead.
+func (RestartCondition) EnumDescriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{9}
+}
+
+type OutputStream int32
+
+const (
+	OutputStream_OUTPUTSTREAMUNKNOWN OutputStream = 0
+	OutputStream_OUTPUTSTREAMSTDOUT  OutputStream = 1
+	OutputStream_OUTPUTSTREAMSTDERR  OutputStream = 2
+)
+
+// Enum value maps for OutputStream.
+var (
+	OutputStream_name = map[int32]string{
+		0: "OUTPUTSTREAMUNKNOWN",
+		1: "OUTPUTSTREAMSTDOUT",
+		2: "OUTPUTSTREAMSTDERR",
+	}
+	OutputStream_value = map[string]int32{
+		"OUTPUTSTREAMUNKNOWN": 0,
+		"OUTPUTSTREAMSTDOUT":  1,
+		"OUTPUTSTREAMSTDERR":  2,
+	}
+)
+
+func (x OutputStream) Enum() *OutputStream {
+	p := new(OutputStream)
+	*p = x
+	return p
+}
+
+func (x OutputStream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutputStream) Descriptor() protoreflect.EnumDescriptor {
+	return file_jasper_proto_enumTypes[10].Descriptor()
+}
+
+func (OutputStream) Type() protoreflect.EnumType {
+	return &file_jasper_proto_enumTypes[10]
+}
+
+func (x OutputStream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutputStream.Descriptor instead.
+func (OutputStream) EnumDescriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{10}
+}
+
+type LoggerConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Producer:
+	//
 	//	*LoggerConfig_Default
 	//	*LoggerConfig_File
 	//	*LoggerConfig_Inherited
@@ -410,16 +624,16 @@ type LoggerConfig struct {
 	//	*LoggerConfig_Buildloggerv2
 	//	*LoggerConfig_Buildloggerv3
 	//	*LoggerConfig_Raw
-	Producer isLoggerConfig_Producer `protobuf_oneof:"producer"`
+	Producer      isLoggerConfig_Producer `protobuf_oneof:"producer"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoggerConfig) Reset() {
 	*x = LoggerConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoggerConfig) String() string {
@@ -430,7 +644,7 @@ func (*LoggerConfig) ProtoMessage() {}
 
 func (x *LoggerConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -445,65 +659,81 @@ func (*LoggerConfig) Descriptor() ([]byte, []int) {
 	return file_jasper_proto_rawDescGZIP(), []int{0}
 }
 
-func (m *LoggerConfig) GetProducer() isLoggerConfig_Producer {
-	if m != nil {
-		return m.Producer
+func (x *LoggerConfig) GetProducer() isLoggerConfig_Producer {
+	if x != nil {
+		return x.Producer
 	}
 	return nil
 }
 
 func (x *LoggerConfig) GetDefault() *DefaultLoggerOptions {
-	if x, ok := x.GetProducer().(*LoggerConfig_Default); ok {
-		return x.Default
+	if x != nil {
+		if x, ok := x.Producer.(*LoggerConfig_Default); ok {
+			return x.Default
+		}
 	}
 	return nil
 }
 
 func (x *LoggerConfig) GetFile() *FileLoggerOptions {
-	if x, ok := x.GetProducer().(*LoggerConfig_File); ok {
-		return x.File
+	if x != nil {
+		if x, ok := x.Producer.(*LoggerConfig_File); ok {
+			return x.File
+		}
 	}
 	return nil
 }
 
 func (x *LoggerConfig) GetInherited() *InheritedLoggerOptions {
-	if x, ok := x.GetProducer().(*LoggerConfig_Inherited); ok {
-		return x.Inherited
+	if x != nil {
+		if x, ok := x.Producer.(*LoggerConfig_Inherited); ok {
+			return x.Inherited
+		}
 	}
 	return nil
 }
 
 func (x *LoggerConfig) GetInMemory() *InMemoryLoggerOptions {
-	if x, ok := x.GetProducer().(*LoggerConfig_InMemory); ok {
-		return x.InMemory
+	if x != nil {
+		if x, ok := x.Producer.(*LoggerConfig_InMemory); ok {
+			return x.InMemory
+		}
 	}
 	return nil
 }
 
 func (x *LoggerConfig) GetSplunk() *SplunkLoggerOptions {
-	if x, ok := x.GetProducer().(*LoggerConfig_Splunk); ok {
-		return x.Splunk
+	if x != nil {
+		if x, ok := x.Producer.(*LoggerConfig_Splunk); ok {
+			return x.Splunk
+		}
 	}
 	return nil
 }
 
 func (x *LoggerConfig) GetBuildloggerv2() *BuildloggerV2Options {
-	if x, ok := x.GetProducer().(*LoggerConfig_Buildloggerv2); ok {
-		return x.Buildloggerv2
+	if x != nil {
+		if x, ok := x.Producer.(*LoggerConfig_Buildloggerv2); ok {
+			return x.Buildloggerv2
+		}
 	}
 	return nil
 }
 
 func (x *LoggerConfig) GetBuildloggerv3() *BuildloggerV3Options {
-	if x, ok := x.GetProducer().(*LoggerConfig_Buildloggerv3); ok {
-		return x.Buildloggerv3
+	if x != nil {
+		if x, ok := x.Producer.(*LoggerConfig_Buildloggerv3); ok {
+			return x.Buildloggerv3
+		}
 	}
 	return nil
 }
 
 func (x *LoggerConfig) GetRaw() *RawLoggerConfig {
-	if x, ok := x.GetProducer().(*LoggerConfig_Raw); ok {
-		return x.Raw
+	if x != nil {
+		if x, ok := x.Producer.(*LoggerConfig_Raw); ok {
+			return x.Raw
+		}
 	}
 	return nil
 }
@@ -561,21 +791,18 @@ func (*LoggerConfig_Buildloggerv3) isLoggerConfig_Producer() {}
 func (*LoggerConfig_Raw) isLoggerConfig_Producer() {}
 
 type LogLevel struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threshold     int32                  `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Default       int32                  `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Threshold int32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	Default   int32 `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LogLevel) Reset() {
 	*x = LogLevel{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LogLevel) String() string {
@@ -586,7 +813,7 @@ func (*LogLevel) ProtoMessage() {}
 
 func (x *LogLevel) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -616,22 +843,19 @@ func (x *LogLevel) GetDefault() int32 {
 }
 
 type BufferOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buffered      bool                   `protobuf:"varint,1,opt,name=buffered,proto3" json:"buffered,omitempty"`
+	Duration      int64                  `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	MaxSize       int64                  `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Buffered bool  `protobuf:"varint,1,opt,name=buffered,proto3" json:"buffered,omitempty"`
-	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
-	MaxSize  int64 `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BufferOptions) Reset() {
 	*x = BufferOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BufferOptions) String() string {
@@ -642,7 +866,7 @@ func (*BufferOptions) ProtoMessage() {}
 
 func (x *BufferOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -679,22 +903,19 @@ func (x *BufferOptions) GetMaxSize() int64 {
 }
 
 type BaseOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         *LogLevel              `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Buffer        *BufferOptions         `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	Format        LogFormat              `protobuf:"varint,3,opt,name=format,proto3,enum=jasper.LogFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Level  *LogLevel      `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
-	Buffer *BufferOptions `protobuf:"bytes,2,opt,name=buffer,proto3" json:"buffer,omitempty"`
-	Format LogFormat      `protobuf:"varint,3,opt,name=format,proto3,enum=jasper.LogFormat" json:"format,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BaseOptions) Reset() {
 	*x = BaseOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BaseOptions) String() string {
@@ -705,7 +926,7 @@ func (*BaseOptions) ProtoMessage() {}
 
 func (x *BaseOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -742,21 +963,18 @@ func (x *BaseOptions) GetFormat() LogFormat {
 }
 
 type DefaultLoggerOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Base          *BaseOptions           `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Prefix string       `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	Base   *BaseOptions `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DefaultLoggerOptions) Reset() {
 	*x = DefaultLoggerOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DefaultLoggerOptions) String() string {
@@ -767,7 +985,7 @@ func (*DefaultLoggerOptions) ProtoMessage() {}
 
 func (x *DefaultLoggerOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -797,21 +1015,18 @@ func (x *DefaultLoggerOptions) GetBase() *BaseOptions {
 }
 
 type FileLoggerOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Base          *BaseOptions           `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Filename string       `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Base     *BaseOptions `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FileLoggerOptions) Reset() {
 	*x = FileLoggerOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *FileLoggerOptions) String() string {
@@ -822,7 +1037,7 @@ func (*FileLoggerOptions) ProtoMessage() {}
 
 func (x *FileLoggerOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -852,20 +1067,17 @@ func (x *FileLoggerOptions) GetBase() *BaseOptions {
 }
 
 type InheritedLoggerOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *BaseOptions           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Base *BaseOptions `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InheritedLoggerOptions) Reset() {
 	*x = InheritedLoggerOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *InheritedLoggerOptions) String() string {
@@ -876,7 +1088,7 @@ func (*InheritedLoggerOptions) ProtoMessage() {}
 
 func (x *InheritedLoggerOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -899,21 +1111,18 @@ func (x *InheritedLoggerOptions) GetBase() *BaseOptions {
 }
 
 type InMemoryLoggerOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InMemoryCap   int64                  `protobuf:"varint,1,opt,name=in_memory_cap,json=inMemoryCap,proto3" json:"in_memory_cap,omitempty"`
+	Base          *BaseOptions           `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	InMemoryCap int64        `protobuf:"varint,1,opt,name=in_memory_cap,json=inMemoryCap,proto3" json:"in_memory_cap,omitempty"`
-	Base        *BaseOptions `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InMemoryLoggerOptions) Reset() {
 	*x = InMemoryLoggerOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *InMemoryLoggerOptions) String() string {
@@ -924,7 +1133,7 @@ func (*InMemoryLoggerOptions) ProtoMessage() {}
 
 func (x *InMemoryLoggerOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -954,22 +1163,19 @@ func (x *InMemoryLoggerOptions) GetBase() *BaseOptions {
 }
 
 type SplunkInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Url     string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Token   string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SplunkInfo) Reset() {
 	*x = SplunkInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SplunkInfo) String() string {
@@ -980,7 +1186,7 @@ func (*SplunkInfo) ProtoMessage() {}
 
 func (x *SplunkInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1017,21 +1223,18 @@ func (x *SplunkInfo) GetChannel() string {
 }
 
 type SplunkLoggerOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Splunk        *SplunkInfo            `protobuf:"bytes,1,opt,name=splunk,proto3" json:"splunk,omitempty"`
+	Base          *BaseOptions           `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Splunk *SplunkInfo  `protobuf:"bytes,1,opt,name=splunk,proto3" json:"splunk,omitempty"`
-	Base   *BaseOptions `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SplunkLoggerOptions) Reset() {
 	*x = SplunkLoggerOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SplunkLoggerOptions) String() string {
@@ -1042,7 +1245,7 @@ func (*SplunkLoggerOptions) ProtoMessage() {}
 
 func (x *SplunkLoggerOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1072,26 +1275,23 @@ func (x *SplunkLoggerOptions) GetBase() *BaseOptions {
 }
 
 type BuildloggerV2Info struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreateTest    bool                   `protobuf:"varint,1,opt,name=create_test,json=createTest,proto3" json:"create_test,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Number        int64                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	Phase         string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Builder       string                 `protobuf:"bytes,5,opt,name=builder,proto3" json:"builder,omitempty"`
+	Test          string                 `protobuf:"bytes,6,opt,name=test,proto3" json:"test,omitempty"`
+	Command       string                 `protobuf:"bytes,7,opt,name=command,proto3" json:"command,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	CreateTest bool   `protobuf:"varint,1,opt,name=create_test,json=createTest,proto3" json:"create_test,omitempty"`
-	Url        string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Number     int64  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
-	Phase      string `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
-	Builder    string `protobuf:"bytes,5,opt,name=builder,proto3" json:"builder,omitempty"`
-	Test       string `protobuf:"bytes,6,opt,name=test,proto3" json:"test,omitempty"`
-	Command    string `protobuf:"bytes,7,opt,name=command,proto3" json:"command,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BuildloggerV2Info) Reset() {
 	*x = BuildloggerV2Info{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BuildloggerV2Info) String() string {
@@ -1102,7 +1302,7 @@ func (*BuildloggerV2Info) ProtoMessage() {}
 
 func (x *BuildloggerV2Info) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1167,21 +1367,18 @@ func (x *BuildloggerV2Info) GetCommand() string {
 }
 
 type BuildloggerV2Options struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buildlogger   *BuildloggerV2Info     `protobuf:"bytes,1,opt,name=buildlogger,proto3" json:"buildlogger,omitempty"`
+	Base          *BaseOptions           `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Buildlogger *BuildloggerV2Info `protobuf:"bytes,1,opt,name=buildlogger,proto3" json:"buildlogger,omitempty"`
-	Base        *BaseOptions       `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BuildloggerV2Options) Reset() {
 	*x = BuildloggerV2Options{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BuildloggerV2Options) String() string {
@@ -1192,7 +1389,7 @@ func (*BuildloggerV2Options) ProtoMessage() {}
 
 func (x *BuildloggerV2Options) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1222,41 +1419,38 @@ func (x *BuildloggerV2Options) GetBase() *BaseOptions {
 }
 
 type BuildloggerV3Info struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Project             string            `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Version             string            `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Variant             string            `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
-	TaskName            string            `protobuf:"bytes,4,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
-	TaskId              string            `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Execution           int32             `protobuf:"varint,6,opt,name=execution,proto3" json:"execution,omitempty"`
-	TestName            string            `protobuf:"bytes,7,opt,name=test_name,json=testName,proto3" json:"test_name,omitempty"`
-	Trial               int32             `protobuf:"varint,8,opt,name=trial,proto3" json:"trial,omitempty"`
-	ProcName            string            `protobuf:"bytes,9,opt,name=proc_name,json=procName,proto3" json:"proc_name,omitempty"`
-	Format              LogFormat         `protobuf:"varint,10,opt,name=format,proto3,enum=jasper.LogFormat" json:"format,omitempty"`
-	Tags                []string          `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
-	Args                map[string]string `protobuf:"bytes,12,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Mainline            bool              `protobuf:"varint,13,opt,name=mainline,proto3" json:"mainline,omitempty"`
-	Prefix              string            `protobuf:"bytes,14,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	MaxBufferSize       int64             `protobuf:"varint,15,opt,name=max_buffer_size,json=maxBufferSize,proto3" json:"max_buffer_size,omitempty"`
-	FlushInterval       int64             `protobuf:"varint,16,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`
-	DisableNewLineCheck bool              `protobuf:"varint,17,opt,name=disable_new_line_check,json=disableNewLineCheck,proto3" json:"disable_new_line_check,omitempty"`
-	BaseAddress         string            `protobuf:"bytes,18,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"`
-	RpcPort             string            `protobuf:"bytes,19,opt,name=rpc_port,json=rpcPort,proto3" json:"rpc_port,omitempty"`
-	Insecure            bool              `protobuf:"varint,20,opt,name=insecure,proto3" json:"insecure,omitempty"`
-	Username            string            `protobuf:"bytes,21,opt,name=username,proto3" json:"username,omitempty"`
-	ApiKey              string            `protobuf:"bytes,22,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Project             string                 `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Version             string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Variant             string                 `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`
+	TaskName            string                 `protobuf:"bytes,4,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	TaskId              string                 `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Execution           int32                  `protobuf:"varint,6,opt,name=execution,proto3" json:"execution,omitempty"`
+	TestName            string                 `protobuf:"bytes,7,opt,name=test_name,json=testName,proto3" json:"test_name,omitempty"`
+	Trial               int32                  `protobuf:"varint,8,opt,name=trial,proto3" json:"trial,omitempty"`
+	ProcName            string                 `protobuf:"bytes,9,opt,name=proc_name,json=procName,proto3" json:"proc_name,omitempty"`
+	Format              LogFormat              `protobuf:"varint,10,opt,name=format,proto3,enum=jasper.LogFormat" json:"format,omitempty"`
+	Tags                []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Args                map[string]string      `protobuf:"bytes,12,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Mainline            bool                   `protobuf:"varint,13,opt,name=mainline,proto3" json:"mainline,omitempty"`
+	Prefix              string                 `protobuf:"bytes,14,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	MaxBufferSize       int64                  `protobuf:"varint,15,opt,name=max_buffer_size,json=maxBufferSize,proto3" json:"max_buffer_size,omitempty"`
+	FlushInterval       int64                  `protobuf:"varint,16,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`
+	DisableNewLineCheck bool                   `protobuf:"varint,17,opt,name=disable_new_line_check,json=disableNewLineCheck,proto3" json:"disable_new_line_check,omitempty"`
+	BaseAddress         string                 `protobuf:"bytes,18,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"`
+	RpcPort             string                 `protobuf:"bytes,19,opt,name=rpc_port,json=rpcPort,proto3" json:"rpc_port,omitempty"`
+	Insecure            bool                   `protobuf:"varint,20,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	Username            string                 `protobuf:"bytes,21,opt,name=username,proto3" json:"username,omitempty"`
+	ApiKey              string                 `protobuf:"bytes,22,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *BuildloggerV3Info) Reset() {
 	*x = BuildloggerV3Info{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BuildloggerV3Info) String() string {
@@ -1267,7 +1461,7 @@ func (*BuildloggerV3Info) ProtoMessage() {}
 
 func (x *BuildloggerV3Info) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1437,22 +1631,19 @@ func (x *BuildloggerV3Info) GetApiKey() string {
 }
 
 type BuildloggerV3Options struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Buildloggerv3 *BuildloggerV3Info     `protobuf:"bytes,1,opt,name=buildloggerv3,proto3" json:"buildloggerv3,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Level         *LogLevel              `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Buildloggerv3 *BuildloggerV3Info `protobuf:"bytes,1,opt,name=buildloggerv3,proto3" json:"buildloggerv3,omitempty"`
-	Name          string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Level         *LogLevel          `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BuildloggerV3Options) Reset() {
 	*x = BuildloggerV3Options{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BuildloggerV3Options) String() string {
@@ -1463,7 +1654,7 @@ func (*BuildloggerV3Options) ProtoMessage() {}
 
 func (x *BuildloggerV3Options) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1500,21 +1691,18 @@ func (x *BuildloggerV3Options) GetLevel() *LogLevel {
 }
 
 type RawLoggerConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        RawLoggerConfigFormat  `protobuf:"varint,1,opt,name=format,proto3,enum=jasper.RawLoggerConfigFormat" json:"format,omitempty"`
+	ConfigData    []byte                 `protobuf:"bytes,2,opt,name=config_data,json=configData,proto3" json:"config_data,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Format     RawLoggerConfigFormat `protobuf:"varint,1,opt,name=format,proto3,enum=jasper.RawLoggerConfigFormat" json:"format,omitempty"`
-	ConfigData []byte                `protobuf:"bytes,2,opt,name=config_data,json=configData,proto3" json:"config_data,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RawLoggerConfig) Reset() {
 	*x = RawLoggerConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *RawLoggerConfig) String() string {
@@ -1525,7 +1713,7 @@ func (*RawLoggerConfig) ProtoMessage() {}
 
 func (x *RawLoggerConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1555,24 +1743,22 @@ func (x *RawLoggerConfig) GetConfigData() []byte {
 }
 
 type OutputOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Loggers               []*LoggerConfig `protobuf:"bytes,1,rep,name=loggers,proto3" json:"loggers,omitempty"`
-	SuppressOutput        bool            `protobuf:"varint,2,opt,name=suppress_output,json=suppressOutput,proto3" json:"suppress_output,omitempty"`
-	SuppressError         bool            `protobuf:"varint,3,opt,name=suppress_error,json=suppressError,proto3" json:"suppress_error,omitempty"`
-	RedirectOutputToError bool            `protobuf:"varint,4,opt,name=redirect_output_to_error,json=redirectOutputToError,proto3" json:"redirect_output_to_error,omitempty"`
-	RedirectErrorToOutput bool            `protobuf:"varint,5,opt,name=redirect_error_to_output,json=redirectErrorToOutput,proto3" json:"redirect_error_to_output,omitempty"`
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Loggers               []*LoggerConfig        `protobuf:"bytes,1,rep,name=loggers,proto3" json:"loggers,omitempty"`
+	SuppressOutput        bool                   `protobuf:"varint,2,opt,name=suppress_output,json=suppressOutput,proto3" json:"suppress_output,omitempty"`
+	SuppressError         bool                   `protobuf:"varint,3,opt,name=suppress_error,json=suppressError,proto3" json:"suppress_error,omitempty"`
+	RedirectOutputToError bool                   `protobuf:"varint,4,opt,name=redirect_output_to_error,json=redirectOutputToError,proto3" json:"redirect_output_to_error,omitempty"`
+	RedirectErrorToOutput bool                   `protobuf:"varint,5,opt,name=redirect_error_to_output,json=redirectErrorToOutput,proto3" json:"redirect_error_to_output,omitempty"`
+	Capture               *OutputCaptureOptions  `protobuf:"bytes,6,opt,name=capture,proto3" json:"capture,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *OutputOptions) Reset() {
 	*x = OutputOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *OutputOptions) String() string {
@@ -1583,7 +1769,7 @@ func (*OutputOptions) ProtoMessage() {}
 
 func (x *OutputOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1633,31 +1819,42 @@ func (x *OutputOptions) GetRedirectErrorToOutput() bool {
 	return false
 }
 
-type CreateOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *OutputOptions) GetCapture() *OutputCaptureOptions {
+	if x != nil {
+		return x.Capture
+	}
+	return nil
+}
 
-	Args               []string          `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
-	WorkingDirectory   string            `protobuf:"bytes,2,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
-	Environment        map[string]string `protobuf:"bytes,3,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	OverrideEnviron    bool              `protobuf:"varint,4,opt,name=override_environ,json=overrideEnviron,proto3" json:"override_environ,omitempty"`
-	TimeoutSeconds     int64             `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
-	Tags               []string          `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	OnSuccess          []*CreateOptions  `protobuf:"bytes,7,rep,name=on_success,json=onSuccess,proto3" json:"on_success,omitempty"`
-	OnFailure          []*CreateOptions  `protobuf:"bytes,8,rep,name=on_failure,json=onFailure,proto3" json:"on_failure,omitempty"`
-	OnTimeout          []*CreateOptions  `protobuf:"bytes,9,rep,name=on_timeout,json=onTimeout,proto3" json:"on_timeout,omitempty"`
-	Output             *OutputOptions    `protobuf:"bytes,10,opt,name=output,proto3" json:"output,omitempty"`
-	StandardInputBytes []byte            `protobuf:"bytes,11,opt,name=standard_input_bytes,json=standardInputBytes,proto3" json:"standard_input_bytes,omitempty"`
+type CreateOptions struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Args                []string               `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	WorkingDirectory    string                 `protobuf:"bytes,2,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	Environment         map[string]string      `protobuf:"bytes,3,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OverrideEnviron     bool                   `protobuf:"varint,4,opt,name=override_environ,json=overrideEnviron,proto3" json:"override_environ,omitempty"`
+	TimeoutSeconds      int64                  `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	Tags                []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	OnSuccess           []*CreateOptions       `protobuf:"bytes,7,rep,name=on_success,json=onSuccess,proto3" json:"on_success,omitempty"`
+	OnFailure           []*CreateOptions       `protobuf:"bytes,8,rep,name=on_failure,json=onFailure,proto3" json:"on_failure,omitempty"`
+	OnTimeout           []*CreateOptions       `protobuf:"bytes,9,rep,name=on_timeout,json=onTimeout,proto3" json:"on_timeout,omitempty"`
+	Output              *OutputOptions         `protobuf:"bytes,10,opt,name=output,proto3" json:"output,omitempty"`
+	StandardInputBytes  []byte                 `protobuf:"bytes,11,opt,name=standard_input_bytes,json=standardInputBytes,proto3" json:"standard_input_bytes,omitempty"`
+	Limits              *ResourceLimits        `protobuf:"bytes,12,opt,name=limits,proto3" json:"limits,omitempty"`
+	StandardInputStream bool                   `protobuf:"varint,13,opt,name=standard_input_stream,json=standardInputStream,proto3" json:"standard_input_stream,omitempty"`
+	Tty                 *TTYOptions            `protobuf:"bytes,14,opt,name=tty,proto3" json:"tty,omitempty"`
+	KillTree            bool                   `protobuf:"varint,15,opt,name=kill_tree,json=killTree,proto3" json:"kill_tree,omitempty"`
+	Restart             *RestartPolicy         `protobuf:"bytes,16,opt,name=restart,proto3" json:"restart,omitempty"`
+	Readiness           *ReadinessOptions      `protobuf:"bytes,17,opt,name=readiness,proto3" json:"readiness,omitempty"`
+	AdmissionPriority   int64                  `protobuf:"varint,18,opt,name=admission_priority,json=admissionPriority,proto3" json:"admission_priority,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *CreateOptions) Reset() {
 	*x = CreateOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CreateOptions) String() string {
@@ -1668,7 +1865,7 @@ func (*CreateOptions) ProtoMessage() {}
 
 func (x *CreateOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1760,21 +1957,67 @@ func (x *CreateOptions) GetStandardInputBytes() []byte {
 	return nil
 }
 
-type IDResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+func (x *CreateOptions) GetLimits() *ResourceLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
 }
 
-func (x *IDResponse) Reset() {
-	*x = IDResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateOptions) GetStandardInputStream() bool {
+	if x != nil {
+		return x.StandardInputStream
+	}
+	return false
+}
+
+func (x *CreateOptions) GetTty() *TTYOptions {
+	if x != nil {
+		return x.Tty
+	}
+	return nil
+}
+
+func (x *CreateOptions) GetKillTree() bool {
+	if x != nil {
+		return x.KillTree
+	}
+	return false
+}
+
+func (x *CreateOptions) GetRestart() *RestartPolicy {
+	if x != nil {
+		return x.Restart
+	}
+	return nil
+}
+
+func (x *CreateOptions) GetReadiness() *ReadinessOptions {
+	if x != nil {
+		return x.Readiness
+	}
+	return nil
+}
+
+func (x *CreateOptions) GetAdmissionPriority() int64 {
+	if x != nil {
+		return x.AdmissionPriority
 	}
+	return 0
+}
+
+type IDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IDResponse) Reset() {
+	*x = IDResponse{}
+	mi := &file_jasper_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *IDResponse) String() string {
@@ -1785,7 +2028,7 @@ func (*IDResponse) ProtoMessage() {}
 
 func (x *IDResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1808,30 +2051,34 @@ func (x *IDResponse) GetValue() string {
 }
 
 type ProcessInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pid           int64                  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	HostId        string                 `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Running       bool                   `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	Successful    bool                   `protobuf:"varint,5,opt,name=successful,proto3" json:"successful,omitempty"`
+	Complete      bool                   `protobuf:"varint,6,opt,name=complete,proto3" json:"complete,omitempty"`
+	Timedout      bool                   `protobuf:"varint,7,opt,name=timedout,proto3" json:"timedout,omitempty"`
+	Options       *CreateOptions         `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Resources     *ProcessResources      `protobuf:"bytes,12,opt,name=resources,proto3" json:"resources,omitempty"`
+	StoppedBy     *StopResult            `protobuf:"bytes,13,opt,name=stopped_by,json=stoppedBy,proto3" json:"stopped_by,omitempty"`
+	KilledPids    []int64                `protobuf:"varint,14,rep,packed,name=killed_pids,json=killedPids,proto3" json:"killed_pids,omitempty"`
+	RestartCount  int64                  `protobuf:"varint,15,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	PreviousIds   []string               `protobuf:"bytes,16,rep,name=previous_ids,json=previousIds,proto3" json:"previous_ids,omitempty"`
+	Ready         bool                   `protobuf:"varint,17,opt,name=ready,proto3" json:"ready,omitempty"`
+	ReadyAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pid        int64                  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
-	HostId     string                 `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Running    bool                   `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
-	Successful bool                   `protobuf:"varint,5,opt,name=successful,proto3" json:"successful,omitempty"`
-	Complete   bool                   `protobuf:"varint,6,opt,name=complete,proto3" json:"complete,omitempty"`
-	Timedout   bool                   `protobuf:"varint,7,opt,name=timedout,proto3" json:"timedout,omitempty"`
-	Options    *CreateOptions         `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
-	ExitCode   int32                  `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	StartAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	EndAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProcessInfo) Reset() {
 	*x = ProcessInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ProcessInfo) String() string {
@@ -1842,7 +2089,7 @@ func (*ProcessInfo) ProtoMessage() {}
 
 func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1934,22 +2181,68 @@ func (x *ProcessInfo) GetEndAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ProcessInfo) GetResources() *ProcessResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetStoppedBy() *StopResult {
+	if x != nil {
+		return x.StoppedBy
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetKilledPids() []int64 {
+	if x != nil {
+		return x.KilledPids
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetRestartCount() int64 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetPreviousIds() []string {
+	if x != nil {
+		return x.PreviousIds
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetReadyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return nil
+}
+
 type StatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	HostId string `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Active bool   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *StatusResponse) String() string {
@@ -1960,7 +2253,7 @@ func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1990,20 +2283,25 @@ func (x *StatusResponse) GetActive() bool {
 }
 
 type Filter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name FilterSpecifications `protobuf:"varint,1,opt,name=name,proto3,enum=jasper.FilterSpecifications" json:"name,omitempty"`
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             FilterSpecifications   `protobuf:"varint,1,opt,name=name,proto3,enum=jasper.FilterSpecifications" json:"name,omitempty"`
+	Tags             []*TagSet              `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	MinExitCode      *wrapperspb.Int64Value `protobuf:"bytes,3,opt,name=min_exit_code,json=minExitCode,proto3" json:"min_exit_code,omitempty"`
+	MaxExitCode      *wrapperspb.Int64Value `protobuf:"bytes,4,opt,name=max_exit_code,json=maxExitCode,proto3" json:"max_exit_code,omitempty"`
+	StartedAfter     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_after,json=startedAfter,proto3" json:"started_after,omitempty"`
+	StartedBefore    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_before,json=startedBefore,proto3" json:"started_before,omitempty"`
+	Args             string                 `protobuf:"bytes,7,opt,name=args,proto3" json:"args,omitempty"`
+	WorkingDirectory string                 `protobuf:"bytes,8,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	Host             string                 `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Filter) Reset() {
 	*x = Filter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Filter) String() string {
@@ -2014,7 +2312,7 @@ func (*Filter) ProtoMessage() {}
 
 func (x *Filter) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2036,22 +2334,75 @@ func (x *Filter) GetName() FilterSpecifications {
 	return FilterSpecifications_ALL
 }
 
+func (x *Filter) GetTags() []*TagSet {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Filter) GetMinExitCode() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.MinExitCode
+	}
+	return nil
+}
+
+func (x *Filter) GetMaxExitCode() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.MaxExitCode
+	}
+	return nil
+}
+
+func (x *Filter) GetStartedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAfter
+	}
+	return nil
+}
+
+func (x *Filter) GetStartedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedBefore
+	}
+	return nil
+}
+
+func (x *Filter) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
+}
+
+func (x *Filter) GetWorkingDirectory() string {
+	if x != nil {
+		return x.WorkingDirectory
+	}
+	return ""
+}
+
+func (x *Filter) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
 type SignalProcess struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessID     *JasperProcessID       `protobuf:"bytes,1,opt,name=ProcessID,proto3" json:"ProcessID,omitempty"`
+	Signal        Signals                `protobuf:"varint,2,opt,name=signal,proto3,enum=jasper.Signals" json:"signal,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	ProcessID *JasperProcessID `protobuf:"bytes,1,opt,name=ProcessID,proto3" json:"ProcessID,omitempty"`
-	Signal    Signals          `protobuf:"varint,2,opt,name=signal,proto3,enum=jasper.Signals" json:"signal,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SignalProcess) Reset() {
 	*x = SignalProcess{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SignalProcess) String() string {
@@ -2062,7 +2413,7 @@ func (*SignalProcess) ProtoMessage() {}
 
 func (x *SignalProcess) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2092,20 +2443,17 @@ func (x *SignalProcess) GetSignal() Signals {
 }
 
 type TagName struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TagName) Reset() {
 	*x = TagName{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TagName) String() string {
@@ -2116,7 +2464,7 @@ func (*TagName) ProtoMessage() {}
 
 func (x *TagName) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2139,21 +2487,18 @@ func (x *TagName) GetValue() string {
 }
 
 type ProcessTags struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessID     string                 `protobuf:"bytes,1,opt,name=processID,proto3" json:"processID,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	ProcessID string   `protobuf:"bytes,1,opt,name=processID,proto3" json:"processID,omitempty"`
-	Tags      []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProcessTags) Reset() {
 	*x = ProcessTags{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ProcessTags) String() string {
@@ -2164,7 +2509,7 @@ func (*ProcessTags) ProtoMessage() {}
 
 func (x *ProcessTags) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2194,20 +2539,17 @@ func (x *ProcessTags) GetTags() []string {
 }
 
 type JasperProcessID struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JasperProcessID) Reset() {
 	*x = JasperProcessID{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *JasperProcessID) String() string {
@@ -2218,7 +2560,7 @@ func (*JasperProcessID) ProtoMessage() {}
 
 func (x *JasperProcessID) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2241,22 +2583,19 @@ func (x *JasperProcessID) GetValue() string {
 }
 
 type OperationOutcome struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Success  bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Text     string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	ExitCode int32  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OperationOutcome) Reset() {
 	*x = OperationOutcome{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *OperationOutcome) String() string {
@@ -2267,7 +2606,7 @@ func (*OperationOutcome) ProtoMessage() {}
 
 func (x *OperationOutcome) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[25]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2304,23 +2643,20 @@ func (x *OperationOutcome) GetExitCode() int32 {
 }
 
 type BuildOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Arch          string                 `protobuf:"bytes,2,opt,name=arch,proto3" json:"arch,omitempty"`
+	Edition       string                 `protobuf:"bytes,3,opt,name=edition,proto3" json:"edition,omitempty"`
+	Debug         bool                   `protobuf:"varint,4,opt,name=debug,proto3" json:"debug,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Target  string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	Arch    string `protobuf:"bytes,2,opt,name=arch,proto3" json:"arch,omitempty"`
-	Edition string `protobuf:"bytes,3,opt,name=edition,proto3" json:"edition,omitempty"`
-	Debug   bool   `protobuf:"varint,4,opt,name=debug,proto3" json:"debug,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BuildOptions) Reset() {
 	*x = BuildOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BuildOptions) String() string {
@@ -2331,7 +2667,7 @@ func (*BuildOptions) ProtoMessage() {}
 
 func (x *BuildOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2375,22 +2711,19 @@ func (x *BuildOptions) GetDebug() bool {
 }
 
 type MongoDBDownloadOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuildOpts     *BuildOptions          `protobuf:"bytes,1,opt,name=build_opts,json=buildOpts,proto3" json:"build_opts,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Releases      []string               `protobuf:"bytes,3,rep,name=releases,proto3" json:"releases,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	BuildOpts *BuildOptions `protobuf:"bytes,1,opt,name=build_opts,json=buildOpts,proto3" json:"build_opts,omitempty"`
-	Path      string        `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Releases  []string      `protobuf:"bytes,3,rep,name=releases,proto3" json:"releases,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MongoDBDownloadOptions) Reset() {
 	*x = MongoDBDownloadOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *MongoDBDownloadOptions) String() string {
@@ -2401,7 +2734,7 @@ func (*MongoDBDownloadOptions) ProtoMessage() {}
 
 func (x *MongoDBDownloadOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2438,22 +2771,19 @@ func (x *MongoDBDownloadOptions) GetReleases() []string {
 }
 
 type CacheOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Disabled          bool  `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	PruneDelaySeconds int64 `protobuf:"varint,2,opt,name=prune_delay_seconds,json=pruneDelaySeconds,proto3" json:"prune_delay_seconds,omitempty"`
-	MaxSize           int64 `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Disabled          bool                   `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	PruneDelaySeconds int64                  `protobuf:"varint,2,opt,name=prune_delay_seconds,json=pruneDelaySeconds,proto3" json:"prune_delay_seconds,omitempty"`
+	MaxSize           int64                  `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CacheOptions) Reset() {
 	*x = CacheOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *CacheOptions) String() string {
@@ -2464,7 +2794,7 @@ func (*CacheOptions) ProtoMessage() {}
 
 func (x *CacheOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2501,22 +2831,19 @@ func (x *CacheOptions) GetMaxSize() int64 {
 }
 
 type ArchiveOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShouldExtract bool                   `protobuf:"varint,1,opt,name=should_extract,json=shouldExtract,proto3" json:"should_extract,omitempty"`
+	Format        ArchiveFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=jasper.ArchiveFormat" json:"format,omitempty"`
+	TargetPath    string                 `protobuf:"bytes,3,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	ShouldExtract bool          `protobuf:"varint,1,opt,name=should_extract,json=shouldExtract,proto3" json:"should_extract,omitempty"`
-	Format        ArchiveFormat `protobuf:"varint,2,opt,name=format,proto3,enum=jasper.ArchiveFormat" json:"format,omitempty"`
-	TargetPath    string        `protobuf:"bytes,3,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ArchiveOptions) Reset() {
 	*x = ArchiveOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ArchiveOptions) String() string {
@@ -2527,7 +2854,7 @@ func (*ArchiveOptions) ProtoMessage() {}
 
 func (x *ArchiveOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2564,22 +2891,19 @@ func (x *ArchiveOptions) GetTargetPath() string {
 }
 
 type DownloadInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	ArchiveOpts   *ArchiveOptions        `protobuf:"bytes,3,opt,name=archive_opts,json=archiveOpts,proto3" json:"archive_opts,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Url         string          `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Path        string          `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	ArchiveOpts *ArchiveOptions `protobuf:"bytes,3,opt,name=archive_opts,json=archiveOpts,proto3" json:"archive_opts,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DownloadInfo) Reset() {
 	*x = DownloadInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *DownloadInfo) String() string {
@@ -2590,7 +2914,7 @@ func (*DownloadInfo) ProtoMessage() {}
 
 func (x *DownloadInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2627,23 +2951,20 @@ func (x *DownloadInfo) GetArchiveOpts() *ArchiveOptions {
 }
 
 type WriteFileInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Append        bool                   `protobuf:"varint,4,opt,name=append,proto3" json:"append,omitempty"`
+	Perm          uint32                 `protobuf:"varint,3,opt,name=perm,proto3" json:"perm,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Append  bool   `protobuf:"varint,4,opt,name=append,proto3" json:"append,omitempty"`
-	Perm    uint32 `protobuf:"varint,3,opt,name=perm,proto3" json:"perm,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WriteFileInfo) Reset() {
 	*x = WriteFileInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *WriteFileInfo) String() string {
@@ -2654,7 +2975,7 @@ func (*WriteFileInfo) ProtoMessage() {}
 
 func (x *WriteFileInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2698,20 +3019,17 @@ func (x *WriteFileInfo) GetPerm() uint32 {
 }
 
 type BuildloggerURLs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Urls          []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Urls []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BuildloggerURLs) Reset() {
 	*x = BuildloggerURLs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BuildloggerURLs) String() string {
@@ -2722,7 +3040,7 @@ func (*BuildloggerURLs) ProtoMessage() {}
 
 func (x *BuildloggerURLs) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2745,21 +3063,18 @@ func (x *BuildloggerURLs) GetUrls() []string {
 }
 
 type LogRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id    *JasperProcessID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Count int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LogRequest) Reset() {
 	*x = LogRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LogRequest) String() string {
@@ -2770,7 +3085,7 @@ func (*LogRequest) ProtoMessage() {}
 
 func (x *LogRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2800,21 +3115,18 @@ func (x *LogRequest) GetCount() int64 {
 }
 
 type LogStream struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []string               `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Done          bool                   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Logs []string `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	Done bool     `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LogStream) Reset() {
 	*x = LogStream{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LogStream) String() string {
@@ -2825,7 +3137,7 @@ func (*LogStream) ProtoMessage() {}
 
 func (x *LogStream) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2855,21 +3167,18 @@ func (x *LogStream) GetDone() bool {
 }
 
 type SignalTriggerParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ProcessID       *JasperProcessID `protobuf:"bytes,1,opt,name=processID,proto3" json:"processID,omitempty"`
-	SignalTriggerID SignalTriggerID  `protobuf:"varint,2,opt,name=signalTriggerID,proto3,enum=jasper.SignalTriggerID" json:"signalTriggerID,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProcessID       *JasperProcessID       `protobuf:"bytes,1,opt,name=processID,proto3" json:"processID,omitempty"`
+	SignalTriggerID SignalTriggerID        `protobuf:"varint,2,opt,name=signalTriggerID,proto3,enum=jasper.SignalTriggerID" json:"signalTriggerID,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SignalTriggerParams) Reset() {
 	*x = SignalTriggerParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *SignalTriggerParams) String() string {
@@ -2880,7 +3189,7 @@ func (*SignalTriggerParams) ProtoMessage() {}
 
 func (x *SignalTriggerParams) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[35]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2910,20 +3219,17 @@ func (x *SignalTriggerParams) GetSignalTriggerID() SignalTriggerID {
 }
 
 type EventName struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventName) Reset() {
 	*x = EventName{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *EventName) String() string {
@@ -2934,7 +3240,7 @@ func (*EventName) ProtoMessage() {}
 
 func (x *EventName) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -2957,20 +3263,17 @@ func (x *EventName) GetValue() string {
 }
 
 type ScriptingHarnessID struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessID) Reset() {
 	*x = ScriptingHarnessID{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessID) String() string {
@@ -2981,7 +3284,7 @@ func (*ScriptingHarnessID) ProtoMessage() {}
 
 func (x *ScriptingHarnessID) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3004,24 +3307,21 @@ func (x *ScriptingHarnessID) GetId() string {
 }
 
 type ScriptingOptionsGolang struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Gopath         string   `protobuf:"bytes,1,opt,name=gopath,proto3" json:"gopath,omitempty"`
-	Goroot         string   `protobuf:"bytes,2,opt,name=goroot,proto3" json:"goroot,omitempty"`
-	Packages       []string `protobuf:"bytes,3,rep,name=packages,proto3" json:"packages,omitempty"`
-	Directory      string   `protobuf:"bytes,4,opt,name=directory,proto3" json:"directory,omitempty"`
-	UpdatePackages bool     `protobuf:"varint,5,opt,name=update_packages,json=updatePackages,proto3" json:"update_packages,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Gopath         string                 `protobuf:"bytes,1,opt,name=gopath,proto3" json:"gopath,omitempty"`
+	Goroot         string                 `protobuf:"bytes,2,opt,name=goroot,proto3" json:"goroot,omitempty"`
+	Packages       []string               `protobuf:"bytes,3,rep,name=packages,proto3" json:"packages,omitempty"`
+	Directory      string                 `protobuf:"bytes,4,opt,name=directory,proto3" json:"directory,omitempty"`
+	UpdatePackages bool                   `protobuf:"varint,5,opt,name=update_packages,json=updatePackages,proto3" json:"update_packages,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ScriptingOptionsGolang) Reset() {
 	*x = ScriptingOptionsGolang{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingOptionsGolang) String() string {
@@ -3032,7 +3332,7 @@ func (*ScriptingOptionsGolang) ProtoMessage() {}
 
 func (x *ScriptingOptionsGolang) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3083,25 +3383,22 @@ func (x *ScriptingOptionsGolang) GetUpdatePackages() bool {
 }
 
 type ScriptingOptionsPython struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	VirtualEnvPath    string   `protobuf:"bytes,1,opt,name=virtual_env_path,json=virtualEnvPath,proto3" json:"virtual_env_path,omitempty"`
-	RequirementsPath  string   `protobuf:"bytes,2,opt,name=requirements_path,json=requirementsPath,proto3" json:"requirements_path,omitempty"`
-	InterpreterBinary string   `protobuf:"bytes,3,opt,name=interpreter_binary,json=interpreterBinary,proto3" json:"interpreter_binary,omitempty"`
-	Packages          []string `protobuf:"bytes,4,rep,name=packages,proto3" json:"packages,omitempty"`
-	LegacyPython      bool     `protobuf:"varint,5,opt,name=legacy_python,json=legacyPython,proto3" json:"legacy_python,omitempty"`
-	AddTestReqs       bool     `protobuf:"varint,6,opt,name=add_test_reqs,json=addTestReqs,proto3" json:"add_test_reqs,omitempty"`
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	VirtualEnvPath    string                 `protobuf:"bytes,1,opt,name=virtual_env_path,json=virtualEnvPath,proto3" json:"virtual_env_path,omitempty"`
+	RequirementsPath  string                 `protobuf:"bytes,2,opt,name=requirements_path,json=requirementsPath,proto3" json:"requirements_path,omitempty"`
+	InterpreterBinary string                 `protobuf:"bytes,3,opt,name=interpreter_binary,json=interpreterBinary,proto3" json:"interpreter_binary,omitempty"`
+	Packages          []string               `protobuf:"bytes,4,rep,name=packages,proto3" json:"packages,omitempty"`
+	LegacyPython      bool                   `protobuf:"varint,5,opt,name=legacy_python,json=legacyPython,proto3" json:"legacy_python,omitempty"`
+	AddTestReqs       bool                   `protobuf:"varint,6,opt,name=add_test_reqs,json=addTestReqs,proto3" json:"add_test_reqs,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ScriptingOptionsPython) Reset() {
 	*x = ScriptingOptionsPython{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingOptionsPython) String() string {
@@ -3112,7 +3409,7 @@ func (*ScriptingOptionsPython) ProtoMessage() {}
 
 func (x *ScriptingOptionsPython) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3170,22 +3467,19 @@ func (x *ScriptingOptionsPython) GetAddTestReqs() bool {
 }
 
 type ScriptingOptionsRoswell struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Systems       []string               `protobuf:"bytes,2,rep,name=systems,proto3" json:"systems,omitempty"`
+	Lisp          string                 `protobuf:"bytes,3,opt,name=lisp,proto3" json:"lisp,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Path    string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Systems []string `protobuf:"bytes,2,rep,name=systems,proto3" json:"systems,omitempty"`
-	Lisp    string   `protobuf:"bytes,3,opt,name=lisp,proto3" json:"lisp,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingOptionsRoswell) Reset() {
 	*x = ScriptingOptionsRoswell{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingOptionsRoswell) String() string {
@@ -3196,7 +3490,7 @@ func (*ScriptingOptionsRoswell) ProtoMessage() {}
 
 func (x *ScriptingOptionsRoswell) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[40]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3233,27 +3527,25 @@ func (x *ScriptingOptionsRoswell) GetLisp() string {
 }
 
 type ScriptingOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Value:
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
 	//	*ScriptingOptions_Golang
 	//	*ScriptingOptions_Python
 	//	*ScriptingOptions_Roswell
-	Value       isScriptingOptions_Value `protobuf_oneof:"value"`
-	Environment map[string]string        `protobuf:"bytes,4,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Output      *OutputOptions           `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`
-	Duration    int64                    `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Value         isScriptingOptions_Value `protobuf_oneof:"value"`
+	Environment   map[string]string        `protobuf:"bytes,4,rep,name=environment,proto3" json:"environment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Output        *OutputOptions           `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`
+	Duration      int64                    `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingOptions) Reset() {
 	*x = ScriptingOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingOptions) String() string {
@@ -3264,7 +3556,7 @@ func (*ScriptingOptions) ProtoMessage() {}
 
 func (x *ScriptingOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3279,30 +3571,36 @@ func (*ScriptingOptions) Descriptor() ([]byte, []int) {
 	return file_jasper_proto_rawDescGZIP(), []int{41}
 }
 
-func (m *ScriptingOptions) GetValue() isScriptingOptions_Value {
-	if m != nil {
-		return m.Value
+func (x *ScriptingOptions) GetValue() isScriptingOptions_Value {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
 func (x *ScriptingOptions) GetGolang() *ScriptingOptionsGolang {
-	if x, ok := x.GetValue().(*ScriptingOptions_Golang); ok {
-		return x.Golang
+	if x != nil {
+		if x, ok := x.Value.(*ScriptingOptions_Golang); ok {
+			return x.Golang
+		}
 	}
 	return nil
 }
 
 func (x *ScriptingOptions) GetPython() *ScriptingOptionsPython {
-	if x, ok := x.GetValue().(*ScriptingOptions_Python); ok {
-		return x.Python
+	if x != nil {
+		if x, ok := x.Value.(*ScriptingOptions_Python); ok {
+			return x.Python
+		}
 	}
 	return nil
 }
 
 func (x *ScriptingOptions) GetRoswell() *ScriptingOptionsRoswell {
-	if x, ok := x.GetValue().(*ScriptingOptions_Roswell); ok {
-		return x.Roswell
+	if x != nil {
+		if x, ok := x.Value.(*ScriptingOptions_Roswell); ok {
+			return x.Roswell
+		}
 	}
 	return nil
 }
@@ -3351,21 +3649,18 @@ func (*ScriptingOptions_Python) isScriptingOptions_Value() {}
 func (*ScriptingOptions_Roswell) isScriptingOptions_Value() {}
 
 type ScriptingHarnessRunArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Args          []string               `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessRunArgs) Reset() {
 	*x = ScriptingHarnessRunArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessRunArgs) String() string {
@@ -3376,7 +3671,7 @@ func (*ScriptingHarnessRunArgs) ProtoMessage() {}
 
 func (x *ScriptingHarnessRunArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3406,22 +3701,19 @@ func (x *ScriptingHarnessRunArgs) GetArgs() []string {
 }
 
 type ScriptingHarnessBuildArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Directory     string                 `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	Args          []string               `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Directory string   `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
-	Args      []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessBuildArgs) Reset() {
 	*x = ScriptingHarnessBuildArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[43]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessBuildArgs) String() string {
@@ -3432,7 +3724,7 @@ func (*ScriptingHarnessBuildArgs) ProtoMessage() {}
 
 func (x *ScriptingHarnessBuildArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[43]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3469,21 +3761,18 @@ func (x *ScriptingHarnessBuildArgs) GetArgs() []string {
 }
 
 type ScriptingHarnessBuildResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       *OperationOutcome      `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Outcome *OperationOutcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
-	Path    string            `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessBuildResponse) Reset() {
 	*x = ScriptingHarnessBuildResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessBuildResponse) String() string {
@@ -3494,7 +3783,7 @@ func (*ScriptingHarnessBuildResponse) ProtoMessage() {}
 
 func (x *ScriptingHarnessBuildResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[44]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3524,21 +3813,18 @@ func (x *ScriptingHarnessBuildResponse) GetPath() string {
 }
 
 type ScriptingHarnessRunScriptArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Script        string                 `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Script string `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessRunScriptArgs) Reset() {
 	*x = ScriptingHarnessRunScriptArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessRunScriptArgs) String() string {
@@ -3549,7 +3835,7 @@ func (*ScriptingHarnessRunScriptArgs) ProtoMessage() {}
 
 func (x *ScriptingHarnessRunScriptArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[45]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3579,22 +3865,19 @@ func (x *ScriptingHarnessRunScriptArgs) GetScript() string {
 }
 
 type ScriptingHarnessTestArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Id            string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Directory     string                         `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	Options       []*ScriptingHarnessTestOptions `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id        string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Directory string                         `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
-	Options   []*ScriptingHarnessTestOptions `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessTestArgs) Reset() {
 	*x = ScriptingHarnessTestArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[46]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessTestArgs) String() string {
@@ -3605,7 +3888,7 @@ func (*ScriptingHarnessTestArgs) ProtoMessage() {}
 
 func (x *ScriptingHarnessTestArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[46]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3642,24 +3925,21 @@ func (x *ScriptingHarnessTestArgs) GetOptions() []*ScriptingHarnessTestOptions {
 }
 
 type ScriptingHarnessTestOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args          []string               `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name    string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Args    []string             `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	Pattern string               `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Timeout *durationpb.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Count   int32                `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessTestOptions) Reset() {
 	*x = ScriptingHarnessTestOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[47]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessTestOptions) String() string {
@@ -3670,7 +3950,7 @@ func (*ScriptingHarnessTestOptions) ProtoMessage() {}
 
 func (x *ScriptingHarnessTestOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[47]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3721,23 +4001,20 @@ func (x *ScriptingHarnessTestOptions) GetCount() int32 {
 }
 
 type ScriptingHarnessTestResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Outcome       string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	StartAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	Duration *durationpb.Duration   `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	Outcome  string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessTestResult) Reset() {
 	*x = ScriptingHarnessTestResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessTestResult) String() string {
@@ -3748,7 +4025,7 @@ func (*ScriptingHarnessTestResult) ProtoMessage() {}
 
 func (x *ScriptingHarnessTestResult) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3792,21 +4069,18 @@ func (x *ScriptingHarnessTestResult) GetOutcome() string {
 }
 
 type ScriptingHarnessTestResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Outcome       *OperationOutcome             `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Results       []*ScriptingHarnessTestResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Outcome *OperationOutcome             `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
-	Results []*ScriptingHarnessTestResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScriptingHarnessTestResponse) Reset() {
 	*x = ScriptingHarnessTestResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[49]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ScriptingHarnessTestResponse) String() string {
@@ -3817,7 +4091,7 @@ func (*ScriptingHarnessTestResponse) ProtoMessage() {}
 
 func (x *ScriptingHarnessTestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[49]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3847,21 +4121,18 @@ func (x *ScriptingHarnessTestResponse) GetResults() []*ScriptingHarnessTestResul
 }
 
 type LoggingCacheCreateArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Options       *OutputOptions         `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id      string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Options *OutputOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoggingCacheCreateArgs) Reset() {
 	*x = LoggingCacheCreateArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[50]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoggingCacheCreateArgs) String() string {
@@ -3872,7 +4143,7 @@ func (*LoggingCacheCreateArgs) ProtoMessage() {}
 
 func (x *LoggingCacheCreateArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[50]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3902,20 +4173,17 @@ func (x *LoggingCacheCreateArgs) GetOptions() *OutputOptions {
 }
 
 type LoggingCacheArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoggingCacheArgs) Reset() {
 	*x = LoggingCacheArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[51]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoggingCacheArgs) String() string {
@@ -3926,7 +4194,7 @@ func (*LoggingCacheArgs) ProtoMessage() {}
 
 func (x *LoggingCacheArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[51]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3949,23 +4217,20 @@ func (x *LoggingCacheArgs) GetId() string {
 }
 
 type LoggingCacheInstance struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       *OperationOutcome      `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ManagerID     string                 `protobuf:"bytes,3,opt,name=managerID,proto3" json:"managerID,omitempty"`
+	Accessed      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accessed,proto3" json:"accessed,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Outcome   *OperationOutcome      `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
-	Id        string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	ManagerID string                 `protobuf:"bytes,3,opt,name=managerID,proto3" json:"managerID,omitempty"`
-	Accessed  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accessed,proto3" json:"accessed,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoggingCacheInstance) Reset() {
 	*x = LoggingCacheInstance{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[52]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoggingCacheInstance) String() string {
@@ -3976,7 +4241,7 @@ func (*LoggingCacheInstance) ProtoMessage() {}
 
 func (x *LoggingCacheInstance) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[52]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -4020,21 +4285,18 @@ func (x *LoggingCacheInstance) GetAccessed() *timestamppb.Timestamp {
 }
 
 type LoggingCacheLenResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       *OperationOutcome      `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Len           int64                  `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Outcome *OperationOutcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
-	Len     int64             `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoggingCacheLenResponse) Reset() {
 	*x = LoggingCacheLenResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[53]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoggingCacheLenResponse) String() string {
@@ -4045,7 +4307,7 @@ func (*LoggingCacheLenResponse) ProtoMessage() {}
 
 func (x *LoggingCacheLenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[53]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -4075,23 +4337,21 @@ func (x *LoggingCacheLenResponse) GetLen() int64 {
 }
 
 type LoggingPayloadData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Data:
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
 	//	*LoggingPayloadData_Msg
 	//	*LoggingPayloadData_Raw
-	Data isLoggingPayloadData_Data `protobuf_oneof:"data"`
+	Data          isLoggingPayloadData_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoggingPayloadData) Reset() {
 	*x = LoggingPayloadData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[54]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoggingPayloadData) String() string {
@@ -4102,7 +4362,7 @@ func (*LoggingPayloadData) ProtoMessage() {}
 
 func (x *LoggingPayloadData) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[54]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -4117,23 +4377,27 @@ func (*LoggingPayloadData) Descriptor() ([]byte, []int) {
 	return file_jasper_proto_rawDescGZIP(), []int{54}
 }
 
-func (m *LoggingPayloadData) GetData() isLoggingPayloadData_Data {
-	if m != nil {
-		return m.Data
+func (x *LoggingPayloadData) GetData() isLoggingPayloadData_Data {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
 func (x *LoggingPayloadData) GetMsg() string {
-	if x, ok := x.GetData().(*LoggingPayloadData_Msg); ok {
-		return x.Msg
+	if x != nil {
+		if x, ok := x.Data.(*LoggingPayloadData_Msg); ok {
+			return x.Msg
+		}
 	}
 	return ""
 }
 
 func (x *LoggingPayloadData) GetRaw() []byte {
-	if x, ok := x.GetData().(*LoggingPayloadData_Raw); ok {
-		return x.Raw
+	if x != nil {
+		if x, ok := x.Data.(*LoggingPayloadData_Raw); ok {
+			return x.Raw
+		}
 	}
 	return nil
 }
@@ -4155,26 +4419,23 @@ func (*LoggingPayloadData_Msg) isLoggingPayloadData_Data() {}
 func (*LoggingPayloadData_Raw) isLoggingPayloadData_Data() {}
 
 type LoggingPayload struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	LoggerID          string                `protobuf:"bytes,1,opt,name=LoggerID,proto3" json:"LoggerID,omitempty"`
-	Priority          int32                 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
-	Format            LoggingPayloadFormat  `protobuf:"varint,3,opt,name=format,proto3,enum=jasper.LoggingPayloadFormat" json:"format,omitempty"`
-	IsMulti           bool                  `protobuf:"varint,4,opt,name=is_multi,json=isMulti,proto3" json:"is_multi,omitempty"`
-	PreferSendToError bool                  `protobuf:"varint,5,opt,name=prefer_send_to_error,json=preferSendToError,proto3" json:"prefer_send_to_error,omitempty"`
-	AddMetadata       bool                  `protobuf:"varint,6,opt,name=add_metadata,json=addMetadata,proto3" json:"add_metadata,omitempty"`
-	Data              []*LoggingPayloadData `protobuf:"bytes,7,rep,name=data,proto3" json:"data,omitempty"`
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LoggerID          string                 `protobuf:"bytes,1,opt,name=LoggerID,proto3" json:"LoggerID,omitempty"`
+	Priority          int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Format            LoggingPayloadFormat   `protobuf:"varint,3,opt,name=format,proto3,enum=jasper.LoggingPayloadFormat" json:"format,omitempty"`
+	IsMulti           bool                   `protobuf:"varint,4,opt,name=is_multi,json=isMulti,proto3" json:"is_multi,omitempty"`
+	PreferSendToError bool                   `protobuf:"varint,5,opt,name=prefer_send_to_error,json=preferSendToError,proto3" json:"prefer_send_to_error,omitempty"`
+	AddMetadata       bool                   `protobuf:"varint,6,opt,name=add_metadata,json=addMetadata,proto3" json:"add_metadata,omitempty"`
+	Data              []*LoggingPayloadData  `protobuf:"bytes,7,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LoggingPayload) Reset() {
 	*x = LoggingPayload{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_jasper_proto_msgTypes[55]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_jasper_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoggingPayload) String() string {
@@ -4185,7 +4446,7 @@ func (*LoggingPayload) ProtoMessage() {}
 
 func (x *LoggingPayload) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[55]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -4249,1649 +4510,2672 @@ func (x *LoggingPayload) GetData() []*LoggingPayloadData {
 	return nil
 }
 
-var File_jasper_proto protoreflect.FileDescriptor
+type ResourceUsage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CpuUserTimeNanos   int64                  `protobuf:"varint,1,opt,name=cpu_user_time_nanos,json=cpuUserTimeNanos,proto3" json:"cpu_user_time_nanos,omitempty"`
+	CpuSystemTimeNanos int64                  `protobuf:"varint,2,opt,name=cpu_system_time_nanos,json=cpuSystemTimeNanos,proto3" json:"cpu_system_time_nanos,omitempty"`
+	Rss                uint64                 `protobuf:"varint,3,opt,name=rss,proto3" json:"rss,omitempty"`
+	ReadBytes          uint64                 `protobuf:"varint,4,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes         uint64                 `protobuf:"varint,5,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	OpenFiles          int64                  `protobuf:"varint,6,opt,name=open_files,json=openFiles,proto3" json:"open_files,omitempty"`
+	NumProcesses       int64                  `protobuf:"varint,7,opt,name=num_processes,json=numProcesses,proto3" json:"num_processes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
 
-var file_jasper_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x03, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12,
-	0x2f, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65,
-	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x3e, 0x0a, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x68,
-	0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64,
-	0x12, 0x3c, 0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x35,
-	0x0a, 0x06, 0x73, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x4c, 0x6f,
-	0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73,
-	0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x12, 0x44, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f,
-	0x67, 0x67, 0x65, 0x72, 0x76, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65,
-	0x72, 0x56, 0x32, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x76, 0x32, 0x12, 0x44, 0x0a, 0x0d, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x76, 0x33, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x56, 0x33, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x48, 0x00, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x76,
-	0x33, 0x12, 0x2b, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x77, 0x4c, 0x6f, 0x67, 0x67, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x03, 0x72, 0x61, 0x77, 0x42, 0x0a,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x08, 0x4c, 0x6f,
-	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x62,
-	0x0a, 0x0d, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69,
-	0x7a, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2d, 0x0a, 0x06, 0x62, 0x75,
-	0x66, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x61, 0x73,
-	0x70, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x22, 0x57, 0x0a, 0x14, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c,
-	0x6f, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x58, 0x0a,
-	0x11, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27,
-	0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x16, 0x49, 0x6e, 0x68, 0x65, 0x72,
-	0x69, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x15, 0x49, 0x6e,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x5f, 0x63, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x43, 0x61, 0x70, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65,
-	0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x22, 0x6a, 0x0a, 0x13, 0x53, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x75, 0x6e,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72,
-	0x2e, 0x53, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x70, 0x6c,
-	0x75, 0x6e, 0x6b, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x0a,
-	0x11, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x56, 0x32, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
-	0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x7c, 0x0a, 0x14, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x56, 0x32, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x56, 0x32, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72,
-	0x12, 0x27, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0xfd, 0x05, 0x0a, 0x11, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x56, 0x33, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61,
-	0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73,
-	0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
-	0x72, 0x69, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x63, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x11, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x12, 0x37, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67,
-	0x67, 0x65, 0x72, 0x56, 0x33, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x69,
-	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x61, 0x69,
-	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x26, 0x0a,
-	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x42, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66,
-	0x6c, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x16,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
-	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79,
-	0x1a, 0x37, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x56, 0x33, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65,
-	0x72, 0x76, 0x33, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x56, 0x33,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65,
-	0x72, 0x76, 0x33, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e,
-	0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
-	0x69, 0x0a, 0x0f, 0x52, 0x61, 0x77, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x77, 0x4c,
-	0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x81, 0x02, 0x0a, 0x0d, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x07,
-	0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f,
-	0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73,
-	0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x18,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
-	0x74, 0x6f, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x6f,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x6f, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xc5,
-	0x04, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x48, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
-	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f,
-	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x45,
-	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x61, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09,
-	0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
-	0x34, 0x0a, 0x0a, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64,
-	0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x12, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x22, 0x0a, 0x0a, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x0b, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12,
-	0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69,
-	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x06,
-	0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x22,
-	0x41, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x22, 0x3a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6a, 0x61, 0x73,
-	0x70, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6f,
-	0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12,
-	0x35, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x52, 0x09, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22,
-	0x1f, 0x0a, 0x07, 0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x3f, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x67, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x22, 0x27, 0x0a, 0x0f, 0x4a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5d, 0x0a, 0x10, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x6a, 0x0a, 0x0c, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x72, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x64, 0x65, 0x62, 0x75, 0x67, 0x22, 0x7d, 0x0a, 0x16, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x42,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x33, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x4f, 0x70, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x0c, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f,
-	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70,
-	0x72, 0x75, 0x6e, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0e,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x6f, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x0c, 0x61,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x22, 0x69, 0x0a, 0x0d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x65, 0x72,
-	0x6d, 0x22, 0x25, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72,
-	0x55, 0x52, 0x4c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x4b, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x12, 0x41, 0x0a, 0x0f, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0f, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x44, 0x22, 0x21, 0x0a, 0x09,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x24, 0x0a, 0x12, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e,
-	0x65, 0x73, 0x73, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x16, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x67, 0x6f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x67, 0x6f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6f, 0x72, 0x6f,
-	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x6f, 0x72, 0x6f, 0x6f, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x16, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e,
-	0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x12, 0x28,
-	0x0a, 0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
-	0x6c, 0x45, 0x6e, 0x76, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
-	0x65, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x72, 0x42, 0x69,
-	0x6e, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x70, 0x79, 0x74, 0x68, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x50,
-	0x79, 0x74, 0x68, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x65, 0x73,
-	0x74, 0x5f, 0x72, 0x65, 0x71, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x64,
-	0x64, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x73, 0x22, 0x5b, 0x0a, 0x17, 0x53, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x6f, 0x73,
-	0x77, 0x65, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6c, 0x69, 0x73, 0x70, 0x22, 0xa4, 0x03, 0x0a, 0x10, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x67,
-	0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x61,
-	0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x06, 0x67,
-	0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50,
-	0x79, 0x74, 0x68, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x12,
-	0x3b, 0x0a, 0x07, 0x72, 0x6f, 0x73, 0x77, 0x65, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x6f, 0x73, 0x77, 0x65, 0x6c,
-	0x6c, 0x48, 0x00, 0x52, 0x07, 0x72, 0x6f, 0x73, 0x77, 0x65, 0x6c, 0x6c, 0x12, 0x4b, 0x0a, 0x0b,
-	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x69,
-	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a,
-	0x17, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73,
-	0x73, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x5d, 0x0a, 0x19,
-	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x67, 0x0a, 0x1d, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07,
-	0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0x47, 0x0a, 0x1d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e,
-	0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x87, 0x01,
-	0x0a, 0x18, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65,
-	0x73, 0x73, 0x54, 0x65, 0x73, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3d, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e,
-	0x65, 0x73, 0x73, 0x54, 0x65, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x1b, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x65, 0x73, 0x74,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x1a, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x35,
-	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22,
-	0x90, 0x01, 0x0a, 0x1c, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72,
-	0x6e, 0x65, 0x73, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x54,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x22, 0x59, 0x0a, 0x16, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x22, 0x0a,
-	0x10, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x41, 0x72, 0x67,
-	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0xb0, 0x01, 0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x61,
-	0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x08,
-	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x17, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x4c, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63,
-	0x6f, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x6c, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
-	0x12, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x03,
-	0x72, 0x61, 0x77, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9d, 0x02, 0x0a, 0x0e,
-	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e,
-	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x69, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x69, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x53, 0x65, 0x6e,
-	0x64, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x61, 0x64, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x71, 0x0a, 0x09, 0x4c,
-	0x6f, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x4f, 0x47, 0x46,
-	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12,
-	0x0a, 0x0e, 0x4c, 0x4f, 0x47, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x50, 0x4c, 0x41, 0x49, 0x4e,
-	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x47, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x4a,
-	0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x47, 0x46, 0x4f, 0x52, 0x4d,
-	0x41, 0x54, 0x42, 0x53, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x4f, 0x47, 0x46,
-	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x2a, 0x77,
-	0x0a, 0x15, 0x52, 0x61, 0x77, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x41, 0x57, 0x4c, 0x4f,
-	0x47, 0x47, 0x45, 0x52, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
-	0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x41, 0x57, 0x4c, 0x4f, 0x47,
-	0x47, 0x45, 0x52, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x42,
-	0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x41, 0x57, 0x4c, 0x4f, 0x47, 0x47,
-	0x45, 0x52, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a, 0x58, 0x0a, 0x14, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41,
-	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x46, 0x55, 0x4c, 0x10,
-	0x04, 0x2a, 0x65, 0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52,
-	0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49, 0x4c, 0x4c,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x41, 0x4e, 0x47, 0x55, 0x50, 0x10, 0x03, 0x12, 0x08,
-	0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x53, 0x45, 0x52,
-	0x31, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x53, 0x45, 0x52, 0x32, 0x10, 0x06, 0x12, 0x08,
-	0x0a, 0x04, 0x41, 0x42, 0x52, 0x54, 0x10, 0x07, 0x2a, 0x56, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a,
-	0x0b, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x10,
-	0x0a, 0x0c, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x54, 0x41, 0x52, 0x47, 0x5a, 0x10, 0x02,
-	0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5a, 0x49, 0x50, 0x10, 0x03,
-	0x2a, 0x31, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a,
-	0x10, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x01, 0x2a, 0x5b, 0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x11, 0x0a, 0x0d, 0x46,
-	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x4e, 0x57, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x42, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0e,
-	0x0a, 0x0a, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x10,
-	0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x03,
-	0x32, 0xcf, 0x14, 0x0a, 0x14, 0x4a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x02, 0x49, 0x44, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72,
-	0x2e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x13, 0x2e, 0x6a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x2d, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x13, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x30, 0x01,
-	0x12, 0x2f, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x13, 0x2e, 0x6a, 0x61, 0x73,
-	0x70, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x30,
-	0x01, 0x12, 0x33, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x4a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
-	0x44, 0x1a, 0x13, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x06, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x12, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x12, 0x39, 0x0a, 0x05, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x05,
-	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x18, 0x2e, 0x6a, 0x61,
-	0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x28, 0x01, 0x12, 0x3b, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x13, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x67, 0x73, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73,
-	0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x61, 0x67,
-	0x73, 0x12, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73,
-	0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12,
-	0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x67, 0x73, 0x12, 0x50, 0x0a,
-	0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
-	0x39, 0x0a, 0x04, 0x57, 0x61, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72,
-	0x2e, 0x4a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44,
-	0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x52, 0x65,
-	0x73, 0x70, 0x61, 0x77, 0x6e, 0x12, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x1a, 0x13,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x15, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67,
-	0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x1a, 0x2e, 0x6a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48,
-	0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x12, 0x4f, 0x0a, 0x17, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48,
-	0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x12, 0x1a, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67,
-	0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x44, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63,
-	0x6f, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67,
-	0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6e, 0x12, 0x1f, 0x2e, 0x6a, 0x61, 0x73,
-	0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72,
-	0x6e, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x18, 0x2e, 0x6a, 0x61,
-	0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x61, 0x0a, 0x15, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x21,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e,
-	0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x72, 0x67,
-	0x73, 0x1a, 0x25, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x19, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x75, 0x6e, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x25, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x52,
-	0x75, 0x6e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x18, 0x2e, 0x6a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x14, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e,
-	0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x65, 0x73, 0x74, 0x41, 0x72, 0x67, 0x73,
-	0x1a, 0x24, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
-	0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1c, 0x2e, 0x6a,
-	0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x4c, 0x6f,
-	0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x47, 0x65, 0x74, 0x12, 0x18, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1c, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72,
-	0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x2e, 0x6a, 0x61,
-	0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
-	0x50, 0x0a, 0x1a, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x2e,
-	0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
-	0x65, 0x12, 0x45, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x67,
-	0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4c, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67,
-	0x67, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4c, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
-	0x4e, 0x0a, 0x16, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e,
-	0x65, 0x73, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x1a, 0x1a, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x44, 0x12,
-	0x4b, 0x0a, 0x13, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e,
-	0x65, 0x73, 0x73, 0x47, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e,
-	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x72, 0x6e, 0x65, 0x73, 0x73,
-	0x49, 0x44, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x14, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x18,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x18,
-	0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x42, 0x12, 0x1e, 0x2e, 0x6a, 0x61,
-	0x73, 0x70, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x42, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x18, 0x2e, 0x6a, 0x61,
-	0x73, 0x70, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
-	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c,
-	0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x46, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x55, 0x52,
-	0x4c, 0x73, 0x12, 0x17, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4a, 0x61, 0x73, 0x70,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x1a, 0x17, 0x2e, 0x6a, 0x61,
-	0x73, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72,
-	0x55, 0x52, 0x4c, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x11, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
-	0x12, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x12, 0x16, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
-	0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x18, 0x2e, 0x6a, 0x61, 0x73, 0x70, 0x65,
-	0x72, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x42, 0x11, 0x5a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	mi := &file_jasper_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-var (
-	file_jasper_proto_rawDescOnce sync.Once
-	file_jasper_proto_rawDescData = file_jasper_proto_rawDesc
-)
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_jasper_proto_rawDescGZIP() []byte {
-	file_jasper_proto_rawDescOnce.Do(func() {
-		file_jasper_proto_rawDescData = protoimpl.X.CompressGZIP(file_jasper_proto_rawDescData)
-	})
-	return file_jasper_proto_rawDescData
+func (*ResourceUsage) ProtoMessage() {}
+
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_jasper_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_jasper_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
-var file_jasper_proto_goTypes = []interface{}{
-	(LogFormat)(0),                        // 0: jasper.LogFormat
-	(RawLoggerConfigFormat)(0),            // 1: jasper.RawLoggerConfigFormat
-	(FilterSpecifications)(0),             // 2: jasper.FilterSpecifications
-	(Signals)(0),                          // 3: jasper.Signals
-	(ArchiveFormat)(0),                    // 4: jasper.ArchiveFormat
-	(SignalTriggerID)(0),                  // 5: jasper.SignalTriggerID
-	(LoggingPayloadFormat)(0),             // 6: jasper.LoggingPayloadFormat
-	(*LoggerConfig)(nil),                  // 7: jasper.LoggerConfig
-	(*LogLevel)(nil),                      // 8: jasper.LogLevel
-	(*BufferOptions)(nil),                 // 9: jasper.BufferOptions
-	(*BaseOptions)(nil),                   // 10: jasper.BaseOptions
-	(*DefaultLoggerOptions)(nil),          // 11: jasper.DefaultLoggerOptions
-	(*FileLoggerOptions)(nil),             // 12: jasper.FileLoggerOptions
-	(*InheritedLoggerOptions)(nil),        // 13: jasper.InheritedLoggerOptions
-	(*InMemoryLoggerOptions)(nil),         // 14: jasper.InMemoryLoggerOptions
-	(*SplunkInfo)(nil),                    // 15: jasper.SplunkInfo
-	(*SplunkLoggerOptions)(nil),           // 16: jasper.SplunkLoggerOptions
-	(*BuildloggerV2Info)(nil),             // 17: jasper.BuildloggerV2Info
-	(*BuildloggerV2Options)(nil),          // 18: jasper.BuildloggerV2Options
-	(*BuildloggerV3Info)(nil),             // 19: jasper.BuildloggerV3Info
-	(*BuildloggerV3Options)(nil),          // 20: jasper.BuildloggerV3Options
-	(*RawLoggerConfig)(nil),               // 21: jasper.RawLoggerConfig
-	(*OutputOptions)(nil),                 // 22: jasper.OutputOptions
-	(*CreateOptions)(nil),                 // 23: jasper.CreateOptions
-	(*IDResponse)(nil),                    // 24: jasper.IDResponse
-	(*ProcessInfo)(nil),                   // 25: jasper.ProcessInfo
-	(*StatusResponse)(nil),                // 26: jasper.StatusResponse
-	(*Filter)(nil),                        // 27: jasper.Filter
-	(*SignalProcess)(nil),                 // 28: jasper.SignalProcess
-	(*TagName)(nil),                       // 29: jasper.TagName
-	(*ProcessTags)(nil),                   // 30: jasper.ProcessTags
-	(*JasperProcessID)(nil),               // 31: jasper.JasperProcessID
-	(*OperationOutcome)(nil),              // 32: jasper.OperationOutcome
-	(*BuildOptions)(nil),                  // 33: jasper.BuildOptions
-	(*MongoDBDownloadOptions)(nil),        // 34: jasper.MongoDBDownloadOptions
-	(*CacheOptions)(nil),                  // 35: jasper.CacheOptions
-	(*ArchiveOptions)(nil),                // 36: jasper.ArchiveOptions
-	(*DownloadInfo)(nil),                  // 37: jasper.DownloadInfo
-	(*WriteFileInfo)(nil),                 // 38: jasper.WriteFileInfo
-	(*BuildloggerURLs)(nil),               // 39: jasper.BuildloggerURLs
-	(*LogRequest)(nil),                    // 40: jasper.LogRequest
-	(*LogStream)(nil),                     // 41: jasper.LogStream
-	(*SignalTriggerParams)(nil),           // 42: jasper.SignalTriggerParams
-	(*EventName)(nil),                     // 43: jasper.EventName
-	(*ScriptingHarnessID)(nil),            // 44: jasper.ScriptingHarnessID
-	(*ScriptingOptionsGolang)(nil),        // 45: jasper.ScriptingOptionsGolang
-	(*ScriptingOptionsPython)(nil),        // 46: jasper.ScriptingOptionsPython
-	(*ScriptingOptionsRoswell)(nil),       // 47: jasper.ScriptingOptionsRoswell
-	(*ScriptingOptions)(nil),              // 48: jasper.ScriptingOptions
-	(*ScriptingHarnessRunArgs)(nil),       // 49: jasper.ScriptingHarnessRunArgs
-	(*ScriptingHarnessBuildArgs)(nil),     // 50: jasper.ScriptingHarnessBuildArgs
-	(*ScriptingHarnessBuildResponse)(nil), // 51: jasper.ScriptingHarnessBuildResponse
-	(*ScriptingHarnessRunScriptArgs)(nil), // 52: jasper.ScriptingHarnessRunScriptArgs
-	(*ScriptingHarnessTestArgs)(nil),      // 53: jasper.ScriptingHarnessTestArgs
-	(*ScriptingHarnessTestOptions)(nil),   // 54: jasper.ScriptingHarnessTestOptions
-	(*ScriptingHarnessTestResult)(nil),    // 55: jasper.ScriptingHarnessTestResult
-	(*ScriptingHarnessTestResponse)(nil),  // 56: jasper.ScriptingHarnessTestResponse
-	(*LoggingCacheCreateArgs)(nil),        // 57: jasper.LoggingCacheCreateArgs
-	(*LoggingCacheArgs)(nil),              // 58: jasper.LoggingCacheArgs
-	(*LoggingCacheInstance)(nil),          // 59: jasper.LoggingCacheInstance
-	(*LoggingCacheLenResponse)(nil),       // 60: jasper.LoggingCacheLenResponse
-	(*LoggingPayloadData)(nil),            // 61: jasper.LoggingPayloadData
-	(*LoggingPayload)(nil),                // 62: jasper.LoggingPayload
-	nil,                                   // 63: jasper.BuildloggerV3Info.ArgsEntry
-	nil,                                   // 64: jasper.CreateOptions.EnvironmentEntry
-	nil,                                   // 65: jasper.ScriptingOptions.EnvironmentEntry
-	(*timestamppb.Timestamp)(nil),         // 66: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),           // 67: google.protobuf.Duration
-	(*emptypb.Empty)(nil),                 // 68: google.protobuf.Empty
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{56}
 }
-var file_jasper_proto_depIdxs = []int32{
-	11, // 0: jasper.LoggerConfig.default:type_name -> jasper.DefaultLoggerOptions
-	12, // 1: jasper.LoggerConfig.file:type_name -> jasper.FileLoggerOptions
-	13, // 2: jasper.LoggerConfig.inherited:type_name -> jasper.InheritedLoggerOptions
-	14, // 3: jasper.LoggerConfig.in_memory:type_name -> jasper.InMemoryLoggerOptions
-	16, // 4: jasper.LoggerConfig.splunk:type_name -> jasper.SplunkLoggerOptions
-	18, // 5: jasper.LoggerConfig.buildloggerv2:type_name -> jasper.BuildloggerV2Options
-	20, // 6: jasper.LoggerConfig.buildloggerv3:type_name -> jasper.BuildloggerV3Options
-	21, // 7: jasper.LoggerConfig.raw:type_name -> jasper.RawLoggerConfig
-	8,  // 8: jasper.BaseOptions.level:type_name -> jasper.LogLevel
-	9,  // 9: jasper.BaseOptions.buffer:type_name -> jasper.BufferOptions
-	0,  // 10: jasper.BaseOptions.format:type_name -> jasper.LogFormat
-	10, // 11: jasper.DefaultLoggerOptions.base:type_name -> jasper.BaseOptions
-	10, // 12: jasper.FileLoggerOptions.base:type_name -> jasper.BaseOptions
-	10, // 13: jasper.InheritedLoggerOptions.base:type_name -> jasper.BaseOptions
-	10, // 14: jasper.InMemoryLoggerOptions.base:type_name -> jasper.BaseOptions
-	15, // 15: jasper.SplunkLoggerOptions.splunk:type_name -> jasper.SplunkInfo
-	10, // 16: jasper.SplunkLoggerOptions.base:type_name -> jasper.BaseOptions
-	17, // 17: jasper.BuildloggerV2Options.buildlogger:type_name -> jasper.BuildloggerV2Info
-	10, // 18: jasper.BuildloggerV2Options.base:type_name -> jasper.BaseOptions
-	0,  // 19: jasper.BuildloggerV3Info.format:type_name -> jasper.LogFormat
-	63, // 20: jasper.BuildloggerV3Info.args:type_name -> jasper.BuildloggerV3Info.ArgsEntry
-	19, // 21: jasper.BuildloggerV3Options.buildloggerv3:type_name -> jasper.BuildloggerV3Info
-	8,  // 22: jasper.BuildloggerV3Options.level:type_name -> jasper.LogLevel
-	1,  // 23: jasper.RawLoggerConfig.format:type_name -> jasper.RawLoggerConfigFormat
-	7,  // 24: jasper.OutputOptions.loggers:type_name -> jasper.LoggerConfig
-	64, // 25: jasper.CreateOptions.environment:type_name -> jasper.CreateOptions.EnvironmentEntry
-	23, // 26: jasper.CreateOptions.on_success:type_name -> jasper.CreateOptions
-	23, // 27: jasper.CreateOptions.on_failure:type_name -> jasper.CreateOptions
-	23, // 28: jasper.CreateOptions.on_timeout:type_name -> jasper.CreateOptions
-	22, // 29: jasper.CreateOptions.output:type_name -> jasper.OutputOptions
-	23, // 30: jasper.ProcessInfo.options:type_name -> jasper.CreateOptions
-	66, // 31: jasper.ProcessInfo.start_at:type_name -> google.protobuf.Timestamp
-	66, // 32: jasper.ProcessInfo.end_at:type_name -> google.protobuf.Timestamp
-	2,  // 33: jasper.Filter.name:type_name -> jasper.FilterSpecifications
-	31, // 34: jasper.SignalProcess.ProcessID:type_name -> jasper.JasperProcessID
-	3,  // 35: jasper.SignalProcess.signal:type_name -> jasper.Signals
-	33, // 36: jasper.MongoDBDownloadOptions.build_opts:type_name -> jasper.BuildOptions
-	4,  // 37: jasper.ArchiveOptions.format:type_name -> jasper.ArchiveFormat
-	36, // 38: jasper.DownloadInfo.archive_opts:type_name -> jasper.ArchiveOptions
-	31, // 39: jasper.LogRequest.id:type_name -> jasper.JasperProcessID
-	31, // 40: jasper.SignalTriggerParams.processID:type_name -> jasper.JasperProcessID
-	5,  // 41: jasper.SignalTriggerParams.signalTriggerID:type_name -> jasper.SignalTriggerID
-	45, // 42: jasper.ScriptingOptions.golang:type_name -> jasper.ScriptingOptionsGolang
-	46, // 43: jasper.ScriptingOptions.python:type_name -> jasper.ScriptingOptionsPython
-	47, // 44: jasper.ScriptingOptions.roswell:type_name -> jasper.ScriptingOptionsRoswell
-	65, // 45: jasper.ScriptingOptions.environment:type_name -> jasper.ScriptingOptions.EnvironmentEntry
-	22, // 46: jasper.ScriptingOptions.output:type_name -> jasper.OutputOptions
-	32, // 47: jasper.ScriptingHarnessBuildResponse.outcome:type_name -> jasper.OperationOutcome
-	54, // 48: jasper.ScriptingHarnessTestArgs.options:type_name -> jasper.ScriptingHarnessTestOptions
-	67, // 49: jasper.ScriptingHarnessTestOptions.timeout:type_name -> google.protobuf.Duration
-	66, // 50: jasper.ScriptingHarnessTestResult.start_at:type_name -> google.protobuf.Timestamp
-	67, // 51: jasper.ScriptingHarnessTestResult.duration:type_name -> google.protobuf.Duration
-	32, // 52: jasper.ScriptingHarnessTestResponse.outcome:type_name -> jasper.OperationOutcome
-	55, // 53: jasper.ScriptingHarnessTestResponse.results:type_name -> jasper.ScriptingHarnessTestResult
-	22, // 54: jasper.LoggingCacheCreateArgs.options:type_name -> jasper.OutputOptions
-	32, // 55: jasper.LoggingCacheInstance.outcome:type_name -> jasper.OperationOutcome
-	66, // 56: jasper.LoggingCacheInstance.accessed:type_name -> google.protobuf.Timestamp
-	32, // 57: jasper.LoggingCacheLenResponse.outcome:type_name -> jasper.OperationOutcome
-	6,  // 58: jasper.LoggingPayload.format:type_name -> jasper.LoggingPayloadFormat
-	61, // 59: jasper.LoggingPayload.data:type_name -> jasper.LoggingPayloadData
-	68, // 60: jasper.JasperProcessManager.ID:input_type -> google.protobuf.Empty
-	23, // 61: jasper.JasperProcessManager.Create:input_type -> jasper.CreateOptions
-	27, // 62: jasper.JasperProcessManager.List:input_type -> jasper.Filter
-	29, // 63: jasper.JasperProcessManager.Group:input_type -> jasper.TagName
-	31, // 64: jasper.JasperProcessManager.Get:input_type -> jasper.JasperProcessID
-	28, // 65: jasper.JasperProcessManager.Signal:input_type -> jasper.SignalProcess
-	68, // 66: jasper.JasperProcessManager.Clear:input_type -> google.protobuf.Empty
-	68, // 67: jasper.JasperProcessManager.Close:input_type -> google.protobuf.Empty
-	38, // 68: jasper.JasperProcessManager.WriteFile:input_type -> jasper.WriteFileInfo
-	30, // 69: jasper.JasperProcessManager.TagProcess:input_type -> jasper.ProcessTags
-	31, // 70: jasper.JasperProcessManager.ResetTags:input_type -> jasper.JasperProcessID
-	31, // 71: jasper.JasperProcessManager.GetTags:input_type -> jasper.JasperProcessID
-	42, // 72: jasper.JasperProcessManager.RegisterSignalTriggerID:input_type -> jasper.SignalTriggerParams
-	31, // 73: jasper.JasperProcessManager.Wait:input_type -> jasper.JasperProcessID
-	31, // 74: jasper.JasperProcessManager.Respawn:input_type -> jasper.JasperProcessID
-	44, // 75: jasper.JasperProcessManager.ScriptingHarnessSetup:input_type -> jasper.ScriptingHarnessID
-	44, // 76: jasper.JasperProcessManager.ScriptingHarnessCleanup:input_type -> jasper.ScriptingHarnessID
-	49, // 77: jasper.JasperProcessManager.ScriptingHarnessRun:input_type -> jasper.ScriptingHarnessRunArgs
-	50, // 78: jasper.JasperProcessManager.ScriptingHarnessBuild:input_type -> jasper.ScriptingHarnessBuildArgs
-	52, // 79: jasper.JasperProcessManager.ScriptingHarnessRunScript:input_type -> jasper.ScriptingHarnessRunScriptArgs
-	53, // 80: jasper.JasperProcessManager.ScriptingHarnessTest:input_type -> jasper.ScriptingHarnessTestArgs
-	57, // 81: jasper.JasperProcessManager.LoggingCacheCreate:input_type -> jasper.LoggingCacheCreateArgs
-	58, // 82: jasper.JasperProcessManager.LoggingCacheGet:input_type -> jasper.LoggingCacheArgs
-	58, // 83: jasper.JasperProcessManager.LoggingCacheRemove:input_type -> jasper.LoggingCacheArgs
-	58, // 84: jasper.JasperProcessManager.LoggingCacheCloseAndRemove:input_type -> jasper.LoggingCacheArgs
-	68, // 85: jasper.JasperProcessManager.LoggingCacheClear:input_type -> google.protobuf.Empty
-	68, // 86: jasper.JasperProcessManager.LoggingCacheLen:input_type -> google.protobuf.Empty
-	66, // 87: jasper.JasperProcessManager.LoggingCachePrune:input_type -> google.protobuf.Timestamp
-	48, // 88: jasper.JasperProcessManager.ScriptingHarnessCreate:input_type -> jasper.ScriptingOptions
-	44, // 89: jasper.JasperProcessManager.ScriptingHarnessGet:input_type -> jasper.ScriptingHarnessID
-	68, // 90: jasper.JasperProcessManager.Status:input_type -> google.protobuf.Empty
-	35, // 91: jasper.JasperProcessManager.ConfigureCache:input_type -> jasper.CacheOptions
-	37, // 92: jasper.JasperProcessManager.DownloadFile:input_type -> jasper.DownloadInfo
-	34, // 93: jasper.JasperProcessManager.DownloadMongoDB:input_type -> jasper.MongoDBDownloadOptions
-	40, // 94: jasper.JasperProcessManager.GetLogStream:input_type -> jasper.LogRequest
-	31, // 95: jasper.JasperProcessManager.GetBuildloggerURLs:input_type -> jasper.JasperProcessID
-	43, // 96: jasper.JasperProcessManager.SignalEvent:input_type -> jasper.EventName
-	62, // 97: jasper.JasperProcessManager.SendMessages:input_type -> jasper.LoggingPayload
-	24, // 98: jasper.JasperProcessManager.ID:output_type -> jasper.IDResponse
-	25, // 99: jasper.JasperProcessManager.Create:output_type -> jasper.ProcessInfo
-	25, // 100: jasper.JasperProcessManager.List:output_type -> jasper.ProcessInfo
-	25, // 101: jasper.JasperProcessManager.Group:output_type -> jasper.ProcessInfo
-	25, // 102: jasper.JasperProcessManager.Get:output_type -> jasper.ProcessInfo
-	32, // 103: jasper.JasperProcessManager.Signal:output_type -> jasper.OperationOutcome
-	32, // 104: jasper.JasperProcessManager.Clear:output_type -> jasper.OperationOutcome
-	32, // 105: jasper.JasperProcessManager.Close:output_type -> jasper.OperationOutcome
-	32, // 106: jasper.JasperProcessManager.WriteFile:output_type -> jasper.OperationOutcome
-	32, // 107: jasper.JasperProcessManager.TagProcess:output_type -> jasper.OperationOutcome
-	32, // 108: jasper.JasperProcessManager.ResetTags:output_type -> jasper.OperationOutcome
-	30, // 109: jasper.JasperProcessManager.GetTags:output_type -> jasper.ProcessTags
-	32, // 110: jasper.JasperProcessManager.RegisterSignalTriggerID:output_type -> jasper.OperationOutcome
-	32, // 111: jasper.JasperProcessManager.Wait:output_type -> jasper.OperationOutcome
-	25, // 112: jasper.JasperProcessManager.Respawn:output_type -> jasper.ProcessInfo
-	32, // 113: jasper.JasperProcessManager.ScriptingHarnessSetup:output_type -> jasper.OperationOutcome
-	32, // 114: jasper.JasperProcessManager.ScriptingHarnessCleanup:output_type -> jasper.OperationOutcome
-	32, // 115: jasper.JasperProcessManager.ScriptingHarnessRun:output_type -> jasper.OperationOutcome
-	51, // 116: jasper.JasperProcessManager.ScriptingHarnessBuild:output_type -> jasper.ScriptingHarnessBuildResponse
-	32, // 117: jasper.JasperProcessManager.ScriptingHarnessRunScript:output_type -> jasper.OperationOutcome
-	56, // 118: jasper.JasperProcessManager.ScriptingHarnessTest:output_type -> jasper.ScriptingHarnessTestResponse
-	59, // 119: jasper.JasperProcessManager.LoggingCacheCreate:output_type -> jasper.LoggingCacheInstance
-	59, // 120: jasper.JasperProcessManager.LoggingCacheGet:output_type -> jasper.LoggingCacheInstance
-	32, // 121: jasper.JasperProcessManager.LoggingCacheRemove:output_type -> jasper.OperationOutcome
-	32, // 122: jasper.JasperProcessManager.LoggingCacheCloseAndRemove:output_type -> jasper.OperationOutcome
-	32, // 123: jasper.JasperProcessManager.LoggingCacheClear:output_type -> jasper.OperationOutcome
-	60, // 124: jasper.JasperProcessManager.LoggingCacheLen:output_type -> jasper.LoggingCacheLenResponse
-	32, // 125: jasper.JasperProcessManager.LoggingCachePrune:output_type -> jasper.OperationOutcome
-	44, // 126: jasper.JasperProcessManager.ScriptingHarnessCreate:output_type -> jasper.ScriptingHarnessID
-	32, // 127: jasper.JasperProcessManager.ScriptingHarnessGet:output_type -> jasper.OperationOutcome
-	26, // 128: jasper.JasperProcessManager.Status:output_type -> jasper.StatusResponse
-	32, // 129: jasper.JasperProcessManager.ConfigureCache:output_type -> jasper.OperationOutcome
-	32, // 130: jasper.JasperProcessManager.DownloadFile:output_type -> jasper.OperationOutcome
-	32, // 131: jasper.JasperProcessManager.DownloadMongoDB:output_type -> jasper.OperationOutcome
-	41, // 132: jasper.JasperProcessManager.GetLogStream:output_type -> jasper.LogStream
-	39, // 133: jasper.JasperProcessManager.GetBuildloggerURLs:output_type -> jasper.BuildloggerURLs
-	32, // 134: jasper.JasperProcessManager.SignalEvent:output_type -> jasper.OperationOutcome
-	32, // 135: jasper.JasperProcessManager.SendMessages:output_type -> jasper.OperationOutcome
-	98, // [98:136] is the sub-list for method output_type
-	60, // [60:98] is the sub-list for method input_type
-	60, // [60:60] is the sub-list for extension type_name
-	60, // [60:60] is the sub-list for extension extendee
-	0,  // [0:60] is the sub-list for field type_name
+
+func (x *ResourceUsage) GetCpuUserTimeNanos() int64 {
+	if x != nil {
+		return x.CpuUserTimeNanos
+	}
+	return 0
 }
 
-func init() { file_jasper_proto_init() }
-func file_jasper_proto_init() {
-	if File_jasper_proto != nil {
-		return
+func (x *ResourceUsage) GetCpuSystemTimeNanos() int64 {
+	if x != nil {
+		return x.CpuSystemTimeNanos
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_jasper_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoggerConfig); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogLevel); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BufferOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BaseOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultLoggerOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileLoggerOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InheritedLoggerOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InMemoryLoggerOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SplunkInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SplunkLoggerOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildloggerV2Info); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildloggerV2Options); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildloggerV3Info); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildloggerV3Options); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RawLoggerConfig); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutputOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IDResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filter); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignalProcess); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagName); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessTags); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JasperProcessID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationOutcome); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MongoDBDownloadOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CacheOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_jasper_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteFileInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *ResourceUsage) GetRss() uint64 {
+	if x != nil {
+		return x.Rss
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetOpenFiles() int64 {
+	if x != nil {
+		return x.OpenFiles
+	}
+	return 0
+}
+
+func (x *ResourceUsage) GetNumProcesses() int64 {
+	if x != nil {
+		return x.NumProcesses
+	}
+	return 0
+}
+
+type ProcessResources struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Last          *ResourceUsage         `protobuf:"bytes,1,opt,name=last,proto3" json:"last,omitempty"`
+	Peak          *ResourceUsage         `protobuf:"bytes,2,opt,name=peak,proto3" json:"peak,omitempty"`
+	NumSamples    int64                  `protobuf:"varint,3,opt,name=num_samples,json=numSamples,proto3" json:"num_samples,omitempty"`
+	SampledAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=sampled_at,json=sampledAt,proto3" json:"sampled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessResources) Reset() {
+	*x = ProcessResources{}
+	mi := &file_jasper_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessResources) ProtoMessage() {}
+
+func (x *ProcessResources) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildloggerURLs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessResources.ProtoReflect.Descriptor instead.
+func (*ProcessResources) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ProcessResources) GetLast() *ResourceUsage {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+func (x *ProcessResources) GetPeak() *ResourceUsage {
+	if x != nil {
+		return x.Peak
+	}
+	return nil
+}
+
+func (x *ProcessResources) GetNumSamples() int64 {
+	if x != nil {
+		return x.NumSamples
+	}
+	return 0
+}
+
+func (x *ProcessResources) GetSampledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SampledAt
+	}
+	return nil
+}
+
+type ResourceLimits struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	CpuSeconds    *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=cpu_seconds,json=cpuSeconds,proto3" json:"cpu_seconds,omitempty"`
+	AddressSpace  *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=address_space,json=addressSpace,proto3" json:"address_space,omitempty"`
+	OpenFiles     *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=open_files,json=openFiles,proto3" json:"open_files,omitempty"`
+	NumProcs      *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=num_procs,json=numProcs,proto3" json:"num_procs,omitempty"`
+	CoreSize      *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=core_size,json=coreSize,proto3" json:"core_size,omitempty"`
+	Cgroup        *CgroupLimits           `protobuf:"bytes,6,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceLimits) Reset() {
+	*x = ResourceLimits{}
+	mi := &file_jasper_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLimits) ProtoMessage() {}
+
+func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLimits.ProtoReflect.Descriptor instead.
+func (*ResourceLimits) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResourceLimits) GetCpuSeconds() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.CpuSeconds
+	}
+	return nil
+}
+
+func (x *ResourceLimits) GetAddressSpace() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.AddressSpace
+	}
+	return nil
+}
+
+func (x *ResourceLimits) GetOpenFiles() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.OpenFiles
+	}
+	return nil
+}
+
+func (x *ResourceLimits) GetNumProcs() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.NumProcs
+	}
+	return nil
+}
+
+func (x *ResourceLimits) GetCoreSize() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.CoreSize
+	}
+	return nil
+}
+
+func (x *ResourceLimits) GetCgroup() *CgroupLimits {
+	if x != nil {
+		return x.Cgroup
+	}
+	return nil
+}
+
+type CgroupLimits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryBytes   int64                  `protobuf:"varint,1,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	Cpus          float64                `protobuf:"fixed64,2,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupLimits) Reset() {
+	*x = CgroupLimits{}
+	mi := &file_jasper_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupLimits) ProtoMessage() {}
+
+func (x *CgroupLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogStream); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupLimits.ProtoReflect.Descriptor instead.
+func (*CgroupLimits) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CgroupLimits) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *CgroupLimits) GetCpus() float64 {
+	if x != nil {
+		return x.Cpus
+	}
+	return 0
+}
+
+type FollowLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowLogsRequest) Reset() {
+	*x = FollowLogsRequest{}
+	mi := &file_jasper_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowLogsRequest) ProtoMessage() {}
+
+func (x *FollowLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignalTriggerParams); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowLogsRequest.ProtoReflect.Descriptor instead.
+func (*FollowLogsRequest) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FollowLogsRequest) GetId() *JasperProcessID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *FollowLogsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type LogChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []string               `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	NextOffset    int64                  `protobuf:"varint,3,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+	Done          bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogChunk) Reset() {
+	*x = LogChunk{}
+	mi := &file_jasper_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogChunk) ProtoMessage() {}
+
+func (x *LogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogChunk.ProtoReflect.Descriptor instead.
+func (*LogChunk) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LogChunk) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *LogChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LogChunk) GetNextOffset() int64 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+func (x *LogChunk) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type StdinChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StdinChunk) Reset() {
+	*x = StdinChunk{}
+	mi := &file_jasper_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StdinChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StdinChunk) ProtoMessage() {}
+
+func (x *StdinChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StdinChunk.ProtoReflect.Descriptor instead.
+func (*StdinChunk) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StdinChunk) GetId() *JasperProcessID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *StdinChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TTYOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          uint32                 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols          uint32                 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TTYOptions) Reset() {
+	*x = TTYOptions{}
+	mi := &file_jasper_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TTYOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTYOptions) ProtoMessage() {}
+
+func (x *TTYOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTYOptions.ProtoReflect.Descriptor instead.
+func (*TTYOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *TTYOptions) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *TTYOptions) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+type ResizeProcess struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size          *TTYOptions            `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeProcess) Reset() {
+	*x = ResizeProcess{}
+	mi := &file_jasper_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeProcess) ProtoMessage() {}
+
+func (x *ResizeProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeProcess.ProtoReflect.Descriptor instead.
+func (*ResizeProcess) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ResizeProcess) GetId() *JasperProcessID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ResizeProcess) GetSize() *TTYOptions {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+type HistoryQuery struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Tags            []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	CompletedAfter  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completed_after,json=completedAfter,proto3" json:"completed_after,omitempty"`
+	CompletedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=completed_before,json=completedBefore,proto3" json:"completed_before,omitempty"`
+	Status          FilterSpecifications   `protobuf:"varint,4,opt,name=status,proto3,enum=jasper.FilterSpecifications" json:"status,omitempty"`
+	ExitCode        *wrapperspb.Int64Value `protobuf:"bytes,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Limit           int64                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HistoryQuery) Reset() {
+	*x = HistoryQuery{}
+	mi := &file_jasper_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryQuery) ProtoMessage() {}
+
+func (x *HistoryQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventName); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryQuery.ProtoReflect.Descriptor instead.
+func (*HistoryQuery) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *HistoryQuery) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *HistoryQuery) GetCompletedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAfter
+	}
+	return nil
+}
+
+func (x *HistoryQuery) GetCompletedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedBefore
+	}
+	return nil
+}
+
+func (x *HistoryQuery) GetStatus() FilterSpecifications {
+	if x != nil {
+		return x.Status
+	}
+	return FilterSpecifications_ALL
+}
+
+func (x *HistoryQuery) GetExitCode() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.ExitCode
+	}
+	return nil
+}
+
+func (x *HistoryQuery) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TagSet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagSet) Reset() {
+	*x = TagSet{}
+	mi := &file_jasper_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSet) ProtoMessage() {}
+
+func (x *TagSet) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSet.ProtoReflect.Descriptor instead.
+func (*TagSet) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TagSet) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ProcessEventFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Types         []ProcessEventType     `protobuf:"varint,2,rep,packed,name=types,proto3,enum=jasper.ProcessEventType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessEventFilter) Reset() {
+	*x = ProcessEventFilter{}
+	mi := &file_jasper_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessEventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessEventFilter) ProtoMessage() {}
+
+func (x *ProcessEventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingOptionsGolang); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessEventFilter.ProtoReflect.Descriptor instead.
+func (*ProcessEventFilter) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ProcessEventFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ProcessEventFilter) GetTypes() []ProcessEventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type ProcessEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ProcessEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=jasper.ProcessEventType" json:"type,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Signal        Signals                `protobuf:"varint,3,opt,name=signal,proto3,enum=jasper.Signals" json:"signal,omitempty"`
+	Info          *ProcessInfo           `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessEvent) Reset() {
+	*x = ProcessEvent{}
+	mi := &file_jasper_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessEvent) ProtoMessage() {}
+
+func (x *ProcessEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingOptionsPython); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessEvent.ProtoReflect.Descriptor instead.
+func (*ProcessEvent) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ProcessEvent) GetType() ProcessEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ProcessEventType_PROCESSEVENTUNKNOWN
+}
+
+func (x *ProcessEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ProcessEvent) GetSignal() Signals {
+	if x != nil {
+		return x.Signal
+	}
+	return Signals_UNKNOWN
+}
+
+func (x *ProcessEvent) GetInfo() *ProcessInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type StopResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          int32                  `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	Signal        int32                  `protobuf:"varint,2,opt,name=signal,proto3" json:"signal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopResult) Reset() {
+	*x = StopResult{}
+	mi := &file_jasper_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResult) ProtoMessage() {}
+
+func (x *StopResult) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingOptionsRoswell); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResult.ProtoReflect.Descriptor instead.
+func (*StopResult) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StopResult) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *StopResult) GetSignal() int32 {
+	if x != nil {
+		return x.Signal
+	}
+	return 0
+}
+
+type StopStep struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Signal           int32                  `protobuf:"varint,1,opt,name=signal,proto3" json:"signal,omitempty"`
+	GracePeriodNanos int64                  `protobuf:"varint,2,opt,name=grace_period_nanos,json=gracePeriodNanos,proto3" json:"grace_period_nanos,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StopStep) Reset() {
+	*x = StopStep{}
+	mi := &file_jasper_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopStep) ProtoMessage() {}
+
+func (x *StopStep) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopStep.ProtoReflect.Descriptor instead.
+func (*StopStep) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StopStep) GetSignal() int32 {
+	if x != nil {
+		return x.Signal
+	}
+	return 0
+}
+
+func (x *StopStep) GetGracePeriodNanos() int64 {
+	if x != nil {
+		return x.GracePeriodNanos
+	}
+	return 0
+}
+
+type StopPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steps         []*StopStep            `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+	Target        StopTarget             `protobuf:"varint,2,opt,name=target,proto3,enum=jasper.StopTarget" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopPolicy) Reset() {
+	*x = StopPolicy{}
+	mi := &file_jasper_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPolicy) ProtoMessage() {}
+
+func (x *StopPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessRunArgs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPolicy.ProtoReflect.Descriptor instead.
+func (*StopPolicy) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StopPolicy) GetSteps() []*StopStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *StopPolicy) GetTarget() StopTarget {
+	if x != nil {
+		return x.Target
+	}
+	return StopTarget_STOPTARGETUNKNOWN
+}
+
+type StopProcess struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Policy        *StopPolicy            `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopProcess) Reset() {
+	*x = StopProcess{}
+	mi := &file_jasper_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopProcess) ProtoMessage() {}
+
+func (x *StopProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessBuildArgs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopProcess.ProtoReflect.Descriptor instead.
+func (*StopProcess) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StopProcess) GetId() *JasperProcessID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *StopProcess) GetPolicy() *StopPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type RestartPolicy struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Condition        RestartCondition       `protobuf:"varint,1,opt,name=condition,proto3,enum=jasper.RestartCondition" json:"condition,omitempty"`
+	MaxRetries       int64                  `protobuf:"varint,2,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	BackoffNanos     int64                  `protobuf:"varint,3,opt,name=backoff_nanos,json=backoffNanos,proto3" json:"backoff_nanos,omitempty"`
+	MaxBackoffNanos  int64                  `protobuf:"varint,4,opt,name=max_backoff_nanos,json=maxBackoffNanos,proto3" json:"max_backoff_nanos,omitempty"`
+	ResetWindowNanos int64                  `protobuf:"varint,5,opt,name=reset_window_nanos,json=resetWindowNanos,proto3" json:"reset_window_nanos,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RestartPolicy) Reset() {
+	*x = RestartPolicy{}
+	mi := &file_jasper_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartPolicy) ProtoMessage() {}
+
+func (x *RestartPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessBuildResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartPolicy.ProtoReflect.Descriptor instead.
+func (*RestartPolicy) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RestartPolicy) GetCondition() RestartCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return RestartCondition_RESTARTCONDITIONUNKNOWN
+}
+
+func (x *RestartPolicy) GetMaxRetries() int64 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetBackoffNanos() int64 {
+	if x != nil {
+		return x.BackoffNanos
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetMaxBackoffNanos() int64 {
+	if x != nil {
+		return x.MaxBackoffNanos
+	}
+	return 0
+}
+
+func (x *RestartPolicy) GetResetWindowNanos() int64 {
+	if x != nil {
+		return x.ResetWindowNanos
+	}
+	return 0
+}
+
+type ReadinessOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Probes        []*ReadinessProbe      `protobuf:"bytes,1,rep,name=probes,proto3" json:"probes,omitempty"`
+	IntervalNanos int64                  `protobuf:"varint,2,opt,name=interval_nanos,json=intervalNanos,proto3" json:"interval_nanos,omitempty"`
+	TimeoutNanos  int64                  `protobuf:"varint,3,opt,name=timeout_nanos,json=timeoutNanos,proto3" json:"timeout_nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadinessOptions) Reset() {
+	*x = ReadinessOptions{}
+	mi := &file_jasper_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadinessOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadinessOptions) ProtoMessage() {}
+
+func (x *ReadinessOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessRunScriptArgs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadinessOptions.ProtoReflect.Descriptor instead.
+func (*ReadinessOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReadinessOptions) GetProbes() []*ReadinessProbe {
+	if x != nil {
+		return x.Probes
+	}
+	return nil
+}
+
+func (x *ReadinessOptions) GetIntervalNanos() int64 {
+	if x != nil {
+		return x.IntervalNanos
+	}
+	return 0
+}
+
+func (x *ReadinessOptions) GetTimeoutNanos() int64 {
+	if x != nil {
+		return x.TimeoutNanos
+	}
+	return 0
+}
+
+type ReadinessProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tcp           *TCPProbe              `protobuf:"bytes,1,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	Http          *HTTPProbe             `protobuf:"bytes,2,opt,name=http,proto3" json:"http,omitempty"`
+	Log           *LogProbe              `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+	File          *FileProbe             `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	Command       *CommandProbe          `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadinessProbe) Reset() {
+	*x = ReadinessProbe{}
+	mi := &file_jasper_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadinessProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadinessProbe) ProtoMessage() {}
+
+func (x *ReadinessProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessTestArgs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadinessProbe.ProtoReflect.Descriptor instead.
+func (*ReadinessProbe) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReadinessProbe) GetTcp() *TCPProbe {
+	if x != nil {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *ReadinessProbe) GetHttp() *HTTPProbe {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *ReadinessProbe) GetLog() *LogProbe {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *ReadinessProbe) GetFile() *FileProbe {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ReadinessProbe) GetCommand() *CommandProbe {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type TCPProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TCPProbe) Reset() {
+	*x = TCPProbe{}
+	mi := &file_jasper_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TCPProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCPProbe) ProtoMessage() {}
+
+func (x *TCPProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessTestOptions); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCPProbe.ProtoReflect.Descriptor instead.
+func (*TCPProbe) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TCPProbe) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type HTTPProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPProbe) Reset() {
+	*x = HTTPProbe{}
+	mi := &file_jasper_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPProbe) ProtoMessage() {}
+
+func (x *HTTPProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessTestResult); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPProbe.ProtoReflect.Descriptor instead.
+func (*HTTPProbe) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *HTTPProbe) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HTTPProbe) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type LogProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogProbe) Reset() {
+	*x = LogProbe{}
+	mi := &file_jasper_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogProbe) ProtoMessage() {}
+
+func (x *LogProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScriptingHarnessTestResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogProbe.ProtoReflect.Descriptor instead.
+func (*LogProbe) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *LogProbe) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type FileProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileProbe) Reset() {
+	*x = FileProbe{}
+	mi := &file_jasper_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileProbe) ProtoMessage() {}
+
+func (x *FileProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoggingCacheCreateArgs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileProbe.ProtoReflect.Descriptor instead.
+func (*FileProbe) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *FileProbe) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CommandProbe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Args          []string               `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandProbe) Reset() {
+	*x = CommandProbe{}
+	mi := &file_jasper_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandProbe) ProtoMessage() {}
+
+func (x *CommandProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoggingCacheArgs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandProbe.ProtoReflect.Descriptor instead.
+func (*CommandProbe) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CommandProbe) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type OutputCaptureOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxBytes      int64                  `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	SpillToFile   bool                   `protobuf:"varint,2,opt,name=spill_to_file,json=spillToFile,proto3" json:"spill_to_file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputCaptureOptions) Reset() {
+	*x = OutputCaptureOptions{}
+	mi := &file_jasper_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputCaptureOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputCaptureOptions) ProtoMessage() {}
+
+func (x *OutputCaptureOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoggingCacheInstance); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputCaptureOptions.ProtoReflect.Descriptor instead.
+func (*OutputCaptureOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *OutputCaptureOptions) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *OutputCaptureOptions) GetSpillToFile() bool {
+	if x != nil {
+		return x.SpillToFile
+	}
+	return false
+}
+
+type LineRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineRange) Reset() {
+	*x = LineRange{}
+	mi := &file_jasper_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineRange) ProtoMessage() {}
+
+func (x *LineRange) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoggingCacheLenResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineRange.ProtoReflect.Descriptor instead.
+func (*LineRange) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *LineRange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LineRange) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type OutputQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stream        OutputStream           `protobuf:"varint,1,opt,name=stream,proto3,enum=jasper.OutputStream" json:"stream,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Lines         *LineRange             `protobuf:"bytes,4,opt,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputQuery) Reset() {
+	*x = OutputQuery{}
+	mi := &file_jasper_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputQuery) ProtoMessage() {}
+
+func (x *OutputQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoggingPayloadData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputQuery.ProtoReflect.Descriptor instead.
+func (*OutputQuery) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *OutputQuery) GetStream() OutputStream {
+	if x != nil {
+		return x.Stream
+	}
+	return OutputStream_OUTPUTSTREAMUNKNOWN
+}
+
+func (x *OutputQuery) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *OutputQuery) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *OutputQuery) GetLines() *LineRange {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type CapturedOutputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query         *OutputQuery           `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapturedOutputRequest) Reset() {
+	*x = CapturedOutputRequest{}
+	mi := &file_jasper_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturedOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturedOutputRequest) ProtoMessage() {}
+
+func (x *CapturedOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_jasper_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoggingPayload); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturedOutputRequest.ProtoReflect.Descriptor instead.
+func (*CapturedOutputRequest) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CapturedOutputRequest) GetId() *JasperProcessID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CapturedOutputRequest) GetQuery() *OutputQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type CapturedOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stream        OutputStream           `protobuf:"varint,1,opt,name=stream,proto3,enum=jasper.OutputStream" json:"stream,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Truncated     bool                   `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapturedOutput) Reset() {
+	*x = CapturedOutput{}
+	mi := &file_jasper_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturedOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturedOutput) ProtoMessage() {}
+
+func (x *CapturedOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturedOutput.ProtoReflect.Descriptor instead.
+func (*CapturedOutput) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CapturedOutput) GetStream() OutputStream {
+	if x != nil {
+		return x.Stream
+	}
+	return OutputStream_OUTPUTSTREAMUNKNOWN
+}
+
+func (x *CapturedOutput) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CapturedOutput) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *CapturedOutput) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CapturedOutput) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+var File_jasper_proto protoreflect.FileDescriptor
+
+const file_jasper_proto_rawDesc = "" +
+	"\n" +
+	"\fjasper.proto\x12\x06jasper\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xf3\x03\n" +
+	"\fLoggerConfig\x128\n" +
+	"\adefault\x18\x01 \x01(\v2\x1c.jasper.DefaultLoggerOptionsH\x00R\adefault\x12/\n" +
+	"\x04file\x18\x02 \x01(\v2\x19.jasper.FileLoggerOptionsH\x00R\x04file\x12>\n" +
+	"\tinherited\x18\x03 \x01(\v2\x1e.jasper.InheritedLoggerOptionsH\x00R\tinherited\x12<\n" +
+	"\tin_memory\x18\x04 \x01(\v2\x1d.jasper.InMemoryLoggerOptionsH\x00R\binMemory\x125\n" +
+	"\x06splunk\x18\x05 \x01(\v2\x1b.jasper.SplunkLoggerOptionsH\x00R\x06splunk\x12D\n" +
+	"\rbuildloggerv2\x18\x06 \x01(\v2\x1c.jasper.BuildloggerV2OptionsH\x00R\rbuildloggerv2\x12D\n" +
+	"\rbuildloggerv3\x18\a \x01(\v2\x1c.jasper.BuildloggerV3OptionsH\x00R\rbuildloggerv3\x12+\n" +
+	"\x03raw\x18\t \x01(\v2\x17.jasper.RawLoggerConfigH\x00R\x03rawB\n" +
+	"\n" +
+	"\bproducer\"B\n" +
+	"\bLogLevel\x12\x1c\n" +
+	"\tthreshold\x18\x01 \x01(\x05R\tthreshold\x12\x18\n" +
+	"\adefault\x18\x02 \x01(\x05R\adefault\"b\n" +
+	"\rBufferOptions\x12\x1a\n" +
+	"\bbuffered\x18\x01 \x01(\bR\bbuffered\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\x03R\bduration\x12\x19\n" +
+	"\bmax_size\x18\x03 \x01(\x03R\amaxSize\"\x8f\x01\n" +
+	"\vBaseOptions\x12&\n" +
+	"\x05level\x18\x01 \x01(\v2\x10.jasper.LogLevelR\x05level\x12-\n" +
+	"\x06buffer\x18\x02 \x01(\v2\x15.jasper.BufferOptionsR\x06buffer\x12)\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x11.jasper.LogFormatR\x06format\"W\n" +
+	"\x14DefaultLoggerOptions\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12'\n" +
+	"\x04base\x18\x02 \x01(\v2\x13.jasper.BaseOptionsR\x04base\"X\n" +
+	"\x11FileLoggerOptions\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12'\n" +
+	"\x04base\x18\x02 \x01(\v2\x13.jasper.BaseOptionsR\x04base\"A\n" +
+	"\x16InheritedLoggerOptions\x12'\n" +
+	"\x04base\x18\x01 \x01(\v2\x13.jasper.BaseOptionsR\x04base\"d\n" +
+	"\x15InMemoryLoggerOptions\x12\"\n" +
+	"\rin_memory_cap\x18\x01 \x01(\x03R\vinMemoryCap\x12'\n" +
+	"\x04base\x18\x02 \x01(\v2\x13.jasper.BaseOptionsR\x04base\"N\n" +
+	"\n" +
+	"SplunkInfo\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\"j\n" +
+	"\x13SplunkLoggerOptions\x12*\n" +
+	"\x06splunk\x18\x01 \x01(\v2\x12.jasper.SplunkInfoR\x06splunk\x12'\n" +
+	"\x04base\x18\x02 \x01(\v2\x13.jasper.BaseOptionsR\x04base\"\xbc\x01\n" +
+	"\x11BuildloggerV2Info\x12\x1f\n" +
+	"\vcreate_test\x18\x01 \x01(\bR\n" +
+	"createTest\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x03R\x06number\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12\x18\n" +
+	"\abuilder\x18\x05 \x01(\tR\abuilder\x12\x12\n" +
+	"\x04test\x18\x06 \x01(\tR\x04test\x12\x18\n" +
+	"\acommand\x18\a \x01(\tR\acommand\"|\n" +
+	"\x14BuildloggerV2Options\x12;\n" +
+	"\vbuildlogger\x18\x01 \x01(\v2\x19.jasper.BuildloggerV2InfoR\vbuildlogger\x12'\n" +
+	"\x04base\x18\x02 \x01(\v2\x13.jasper.BaseOptionsR\x04base\"\xfd\x05\n" +
+	"\x11BuildloggerV3Info\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x18\n" +
+	"\avariant\x18\x03 \x01(\tR\avariant\x12\x1b\n" +
+	"\ttask_name\x18\x04 \x01(\tR\btaskName\x12\x17\n" +
+	"\atask_id\x18\x05 \x01(\tR\x06taskId\x12\x1c\n" +
+	"\texecution\x18\x06 \x01(\x05R\texecution\x12\x1b\n" +
+	"\ttest_name\x18\a \x01(\tR\btestName\x12\x14\n" +
+	"\x05trial\x18\b \x01(\x05R\x05trial\x12\x1b\n" +
+	"\tproc_name\x18\t \x01(\tR\bprocName\x12)\n" +
+	"\x06format\x18\n" +
+	" \x01(\x0e2\x11.jasper.LogFormatR\x06format\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x127\n" +
+	"\x04args\x18\f \x03(\v2#.jasper.BuildloggerV3Info.ArgsEntryR\x04args\x12\x1a\n" +
+	"\bmainline\x18\r \x01(\bR\bmainline\x12\x16\n" +
+	"\x06prefix\x18\x0e \x01(\tR\x06prefix\x12&\n" +
+	"\x0fmax_buffer_size\x18\x0f \x01(\x03R\rmaxBufferSize\x12%\n" +
+	"\x0eflush_interval\x18\x10 \x01(\x03R\rflushInterval\x123\n" +
+	"\x16disable_new_line_check\x18\x11 \x01(\bR\x13disableNewLineCheck\x12!\n" +
+	"\fbase_address\x18\x12 \x01(\tR\vbaseAddress\x12\x19\n" +
+	"\brpc_port\x18\x13 \x01(\tR\arpcPort\x12\x1a\n" +
+	"\binsecure\x18\x14 \x01(\bR\binsecure\x12\x1a\n" +
+	"\busername\x18\x15 \x01(\tR\busername\x12\x17\n" +
+	"\aapi_key\x18\x16 \x01(\tR\x06apiKey\x1a7\n" +
+	"\tArgsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x93\x01\n" +
+	"\x14BuildloggerV3Options\x12?\n" +
+	"\rbuildloggerv3\x18\x01 \x01(\v2\x19.jasper.BuildloggerV3InfoR\rbuildloggerv3\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x05level\x18\x03 \x01(\v2\x10.jasper.LogLevelR\x05level\"i\n" +
+	"\x0fRawLoggerConfig\x125\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1d.jasper.RawLoggerConfigFormatR\x06format\x12\x1f\n" +
+	"\vconfig_data\x18\x02 \x01(\fR\n" +
+	"configData\"\xb9\x02\n" +
+	"\rOutputOptions\x12.\n" +
+	"\aloggers\x18\x01 \x03(\v2\x14.jasper.LoggerConfigR\aloggers\x12'\n" +
+	"\x0fsuppress_output\x18\x02 \x01(\bR\x0esuppressOutput\x12%\n" +
+	"\x0esuppress_error\x18\x03 \x01(\bR\rsuppressError\x127\n" +
+	"\x18redirect_output_to_error\x18\x04 \x01(\bR\x15redirectOutputToError\x127\n" +
+	"\x18redirect_error_to_output\x18\x05 \x01(\bR\x15redirectErrorToOutput\x126\n" +
+	"\acapture\x18\x06 \x01(\v2\x1c.jasper.OutputCaptureOptionsR\acapture\"\x84\a\n" +
+	"\rCreateOptions\x12\x12\n" +
+	"\x04args\x18\x01 \x03(\tR\x04args\x12+\n" +
+	"\x11working_directory\x18\x02 \x01(\tR\x10workingDirectory\x12H\n" +
+	"\venvironment\x18\x03 \x03(\v2&.jasper.CreateOptions.EnvironmentEntryR\venvironment\x12)\n" +
+	"\x10override_environ\x18\x04 \x01(\bR\x0foverrideEnviron\x12'\n" +
+	"\x0ftimeout_seconds\x18\x05 \x01(\x03R\x0etimeoutSeconds\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x124\n" +
+	"\n" +
+	"on_success\x18\a \x03(\v2\x15.jasper.CreateOptionsR\tonSuccess\x124\n" +
+	"\n" +
+	"on_failure\x18\b \x03(\v2\x15.jasper.CreateOptionsR\tonFailure\x124\n" +
+	"\n" +
+	"on_timeout\x18\t \x03(\v2\x15.jasper.CreateOptionsR\tonTimeout\x12-\n" +
+	"\x06output\x18\n" +
+	" \x01(\v2\x15.jasper.OutputOptionsR\x06output\x120\n" +
+	"\x14standard_input_bytes\x18\v \x01(\fR\x12standardInputBytes\x12.\n" +
+	"\x06limits\x18\f \x01(\v2\x16.jasper.ResourceLimitsR\x06limits\x122\n" +
+	"\x15standard_input_stream\x18\r \x01(\bR\x13standardInputStream\x12$\n" +
+	"\x03tty\x18\x0e \x01(\v2\x12.jasper.TTYOptionsR\x03tty\x12\x1b\n" +
+	"\tkill_tree\x18\x0f \x01(\bR\bkillTree\x12/\n" +
+	"\arestart\x18\x10 \x01(\v2\x15.jasper.RestartPolicyR\arestart\x126\n" +
+	"\treadiness\x18\x11 \x01(\v2\x18.jasper.ReadinessOptionsR\treadiness\x12-\n" +
+	"\x12admission_priority\x18\x12 \x01(\x03R\x11admissionPriority\x1a>\n" +
+	"\x10EnvironmentEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\"\n" +
+	"\n" +
+	"IDResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\x93\x05\n" +
+	"\vProcessInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03pid\x18\x02 \x01(\x03R\x03pid\x12\x17\n" +
+	"\ahost_id\x18\x03 \x01(\tR\x06hostId\x12\x18\n" +
+	"\arunning\x18\x04 \x01(\bR\arunning\x12\x1e\n" +
+	"\n" +
+	"successful\x18\x05 \x01(\bR\n" +
+	"successful\x12\x1a\n" +
+	"\bcomplete\x18\x06 \x01(\bR\bcomplete\x12\x1a\n" +
+	"\btimedout\x18\a \x01(\bR\btimedout\x12/\n" +
+	"\aoptions\x18\b \x01(\v2\x15.jasper.CreateOptionsR\aoptions\x12\x1b\n" +
+	"\texit_code\x18\t \x01(\x05R\bexitCode\x125\n" +
+	"\bstart_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
+	"\x06end_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x126\n" +
+	"\tresources\x18\f \x01(\v2\x18.jasper.ProcessResourcesR\tresources\x121\n" +
+	"\n" +
+	"stopped_by\x18\r \x01(\v2\x12.jasper.StopResultR\tstoppedBy\x12\x1f\n" +
+	"\vkilled_pids\x18\x0e \x03(\x03R\n" +
+	"killedPids\x12#\n" +
+	"\rrestart_count\x18\x0f \x01(\x03R\frestartCount\x12!\n" +
+	"\fprevious_ids\x18\x10 \x03(\tR\vpreviousIds\x12\x14\n" +
+	"\x05ready\x18\x11 \x01(\bR\x05ready\x125\n" +
+	"\bready_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\areadyAt\"A\n" +
+	"\x0eStatusResponse\x12\x17\n" +
+	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"\xb9\x03\n" +
+	"\x06Filter\x120\n" +
+	"\x04name\x18\x01 \x01(\x0e2\x1c.jasper.FilterSpecificationsR\x04name\x12\"\n" +
+	"\x04tags\x18\x02 \x03(\v2\x0e.jasper.TagSetR\x04tags\x12?\n" +
+	"\rmin_exit_code\x18\x03 \x01(\v2\x1b.google.protobuf.Int64ValueR\vminExitCode\x12?\n" +
+	"\rmax_exit_code\x18\x04 \x01(\v2\x1b.google.protobuf.Int64ValueR\vmaxExitCode\x12?\n" +
+	"\rstarted_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fstartedAfter\x12A\n" +
+	"\x0estarted_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rstartedBefore\x12\x12\n" +
+	"\x04args\x18\a \x01(\tR\x04args\x12+\n" +
+	"\x11working_directory\x18\b \x01(\tR\x10workingDirectory\x12\x12\n" +
+	"\x04host\x18\t \x01(\tR\x04host\"o\n" +
+	"\rSignalProcess\x125\n" +
+	"\tProcessID\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\tProcessID\x12'\n" +
+	"\x06signal\x18\x02 \x01(\x0e2\x0f.jasper.SignalsR\x06signal\"\x1f\n" +
+	"\aTagName\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"?\n" +
+	"\vProcessTags\x12\x1c\n" +
+	"\tprocessID\x18\x01 \x01(\tR\tprocessID\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"'\n" +
+	"\x0fJasperProcessID\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"]\n" +
+	"\x10OperationOutcome\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1b\n" +
+	"\texit_code\x18\x03 \x01(\x05R\bexitCode\"j\n" +
+	"\fBuildOptions\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x12\n" +
+	"\x04arch\x18\x02 \x01(\tR\x04arch\x12\x18\n" +
+	"\aedition\x18\x03 \x01(\tR\aedition\x12\x14\n" +
+	"\x05debug\x18\x04 \x01(\bR\x05debug\"}\n" +
+	"\x16MongoDBDownloadOptions\x123\n" +
+	"\n" +
+	"build_opts\x18\x01 \x01(\v2\x14.jasper.BuildOptionsR\tbuildOpts\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1a\n" +
+	"\breleases\x18\x03 \x03(\tR\breleases\"u\n" +
+	"\fCacheOptions\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x12.\n" +
+	"\x13prune_delay_seconds\x18\x02 \x01(\x03R\x11pruneDelaySeconds\x12\x19\n" +
+	"\bmax_size\x18\x03 \x01(\x03R\amaxSize\"\x87\x01\n" +
+	"\x0eArchiveOptions\x12%\n" +
+	"\x0eshould_extract\x18\x01 \x01(\bR\rshouldExtract\x12-\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x15.jasper.ArchiveFormatR\x06format\x12\x1f\n" +
+	"\vtarget_path\x18\x03 \x01(\tR\n" +
+	"targetPath\"o\n" +
+	"\fDownloadInfo\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x129\n" +
+	"\farchive_opts\x18\x03 \x01(\v2\x16.jasper.ArchiveOptionsR\varchiveOpts\"i\n" +
+	"\rWriteFileInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x16\n" +
+	"\x06append\x18\x04 \x01(\bR\x06append\x12\x12\n" +
+	"\x04perm\x18\x03 \x01(\rR\x04perm\"%\n" +
+	"\x0fBuildloggerURLs\x12\x12\n" +
+	"\x04urls\x18\x01 \x03(\tR\x04urls\"K\n" +
+	"\n" +
+	"LogRequest\x12'\n" +
+	"\x02id\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\x02id\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"3\n" +
+	"\tLogStream\x12\x12\n" +
+	"\x04logs\x18\x01 \x03(\tR\x04logs\x12\x12\n" +
+	"\x04done\x18\x02 \x01(\bR\x04done\"\x8f\x01\n" +
+	"\x13SignalTriggerParams\x125\n" +
+	"\tprocessID\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\tprocessID\x12A\n" +
+	"\x0fsignalTriggerID\x18\x02 \x01(\x0e2\x17.jasper.SignalTriggerIDR\x0fsignalTriggerID\"!\n" +
+	"\tEventName\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"$\n" +
+	"\x12ScriptingHarnessID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xab\x01\n" +
+	"\x16ScriptingOptionsGolang\x12\x16\n" +
+	"\x06gopath\x18\x01 \x01(\tR\x06gopath\x12\x16\n" +
+	"\x06goroot\x18\x02 \x01(\tR\x06goroot\x12\x1a\n" +
+	"\bpackages\x18\x03 \x03(\tR\bpackages\x12\x1c\n" +
+	"\tdirectory\x18\x04 \x01(\tR\tdirectory\x12'\n" +
+	"\x0fupdate_packages\x18\x05 \x01(\bR\x0eupdatePackages\"\x83\x02\n" +
+	"\x16ScriptingOptionsPython\x12(\n" +
+	"\x10virtual_env_path\x18\x01 \x01(\tR\x0evirtualEnvPath\x12+\n" +
+	"\x11requirements_path\x18\x02 \x01(\tR\x10requirementsPath\x12-\n" +
+	"\x12interpreter_binary\x18\x03 \x01(\tR\x11interpreterBinary\x12\x1a\n" +
+	"\bpackages\x18\x04 \x03(\tR\bpackages\x12#\n" +
+	"\rlegacy_python\x18\x05 \x01(\bR\flegacyPython\x12\"\n" +
+	"\radd_test_reqs\x18\x06 \x01(\bR\vaddTestReqs\"[\n" +
+	"\x17ScriptingOptionsRoswell\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\asystems\x18\x02 \x03(\tR\asystems\x12\x12\n" +
+	"\x04lisp\x18\x03 \x01(\tR\x04lisp\"\xa4\x03\n" +
+	"\x10ScriptingOptions\x128\n" +
+	"\x06golang\x18\x01 \x01(\v2\x1e.jasper.ScriptingOptionsGolangH\x00R\x06golang\x128\n" +
+	"\x06python\x18\x02 \x01(\v2\x1e.jasper.ScriptingOptionsPythonH\x00R\x06python\x12;\n" +
+	"\aroswell\x18\x03 \x01(\v2\x1f.jasper.ScriptingOptionsRoswellH\x00R\aroswell\x12K\n" +
+	"\venvironment\x18\x04 \x03(\v2).jasper.ScriptingOptions.EnvironmentEntryR\venvironment\x12-\n" +
+	"\x06output\x18\x05 \x01(\v2\x15.jasper.OutputOptionsR\x06output\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\x03R\bduration\x1a>\n" +
+	"\x10EnvironmentEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\a\n" +
+	"\x05value\"=\n" +
+	"\x17ScriptingHarnessRunArgs\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04args\x18\x02 \x03(\tR\x04args\"]\n" +
+	"\x19ScriptingHarnessBuildArgs\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tdirectory\x18\x02 \x01(\tR\tdirectory\x12\x12\n" +
+	"\x04args\x18\x03 \x03(\tR\x04args\"g\n" +
+	"\x1dScriptingHarnessBuildResponse\x122\n" +
+	"\aoutcome\x18\x01 \x01(\v2\x18.jasper.OperationOutcomeR\aoutcome\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"G\n" +
+	"\x1dScriptingHarnessRunScriptArgs\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06script\x18\x02 \x01(\tR\x06script\"\x87\x01\n" +
+	"\x18ScriptingHarnessTestArgs\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tdirectory\x18\x02 \x01(\tR\tdirectory\x12=\n" +
+	"\aoptions\x18\x03 \x03(\v2#.jasper.ScriptingHarnessTestOptionsR\aoptions\"\xaa\x01\n" +
+	"\x1bScriptingHarnessTestOptions\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04args\x18\x02 \x03(\tR\x04args\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\"\xb8\x01\n" +
+	"\x1aScriptingHarnessTestResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\bstart_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\"\x90\x01\n" +
+	"\x1cScriptingHarnessTestResponse\x122\n" +
+	"\aoutcome\x18\x01 \x01(\v2\x18.jasper.OperationOutcomeR\aoutcome\x12<\n" +
+	"\aresults\x18\x02 \x03(\v2\".jasper.ScriptingHarnessTestResultR\aresults\"Y\n" +
+	"\x16LoggingCacheCreateArgs\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\aoptions\x18\x02 \x01(\v2\x15.jasper.OutputOptionsR\aoptions\"\"\n" +
+	"\x10LoggingCacheArgs\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb0\x01\n" +
+	"\x14LoggingCacheInstance\x122\n" +
+	"\aoutcome\x18\x01 \x01(\v2\x18.jasper.OperationOutcomeR\aoutcome\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1c\n" +
+	"\tmanagerID\x18\x03 \x01(\tR\tmanagerID\x126\n" +
+	"\baccessed\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\baccessed\"_\n" +
+	"\x17LoggingCacheLenResponse\x122\n" +
+	"\aoutcome\x18\x01 \x01(\v2\x18.jasper.OperationOutcomeR\aoutcome\x12\x10\n" +
+	"\x03len\x18\x02 \x01(\x03R\x03len\"D\n" +
+	"\x12LoggingPayloadData\x12\x12\n" +
+	"\x03msg\x18\x01 \x01(\tH\x00R\x03msg\x12\x12\n" +
+	"\x03raw\x18\x02 \x01(\fH\x00R\x03rawB\x06\n" +
+	"\x04data\"\x9d\x02\n" +
+	"\x0eLoggingPayload\x12\x1a\n" +
+	"\bLoggerID\x18\x01 \x01(\tR\bLoggerID\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x124\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x1c.jasper.LoggingPayloadFormatR\x06format\x12\x19\n" +
+	"\bis_multi\x18\x04 \x01(\bR\aisMulti\x12/\n" +
+	"\x14prefer_send_to_error\x18\x05 \x01(\bR\x11preferSendToError\x12!\n" +
+	"\fadd_metadata\x18\x06 \x01(\bR\vaddMetadata\x12.\n" +
+	"\x04data\x18\a \x03(\v2\x1a.jasper.LoggingPayloadDataR\x04data\"\x87\x02\n" +
+	"\rResourceUsage\x12-\n" +
+	"\x13cpu_user_time_nanos\x18\x01 \x01(\x03R\x10cpuUserTimeNanos\x121\n" +
+	"\x15cpu_system_time_nanos\x18\x02 \x01(\x03R\x12cpuSystemTimeNanos\x12\x10\n" +
+	"\x03rss\x18\x03 \x01(\x04R\x03rss\x12\x1d\n" +
+	"\n" +
+	"read_bytes\x18\x04 \x01(\x04R\treadBytes\x12\x1f\n" +
+	"\vwrite_bytes\x18\x05 \x01(\x04R\n" +
+	"writeBytes\x12\x1d\n" +
+	"\n" +
+	"open_files\x18\x06 \x01(\x03R\topenFiles\x12#\n" +
+	"\rnum_processes\x18\a \x01(\x03R\fnumProcesses\"\xc4\x01\n" +
+	"\x10ProcessResources\x12)\n" +
+	"\x04last\x18\x01 \x01(\v2\x15.jasper.ResourceUsageR\x04last\x12)\n" +
+	"\x04peak\x18\x02 \x01(\v2\x15.jasper.ResourceUsageR\x04peak\x12\x1f\n" +
+	"\vnum_samples\x18\x03 \x01(\x03R\n" +
+	"numSamples\x129\n" +
+	"\n" +
+	"sampled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tsampledAt\"\xf3\x02\n" +
+	"\x0eResourceLimits\x12=\n" +
+	"\vcpu_seconds\x18\x01 \x01(\v2\x1c.google.protobuf.UInt64ValueR\n" +
+	"cpuSeconds\x12A\n" +
+	"\raddress_space\x18\x02 \x01(\v2\x1c.google.protobuf.UInt64ValueR\faddressSpace\x12;\n" +
+	"\n" +
+	"open_files\x18\x03 \x01(\v2\x1c.google.protobuf.UInt64ValueR\topenFiles\x129\n" +
+	"\tnum_procs\x18\x04 \x01(\v2\x1c.google.protobuf.UInt64ValueR\bnumProcs\x129\n" +
+	"\tcore_size\x18\x05 \x01(\v2\x1c.google.protobuf.UInt64ValueR\bcoreSize\x12,\n" +
+	"\x06cgroup\x18\x06 \x01(\v2\x14.jasper.CgroupLimitsR\x06cgroup\"E\n" +
+	"\fCgroupLimits\x12!\n" +
+	"\fmemory_bytes\x18\x01 \x01(\x03R\vmemoryBytes\x12\x12\n" +
+	"\x04cpus\x18\x02 \x01(\x01R\x04cpus\"T\n" +
+	"\x11FollowLogsRequest\x12'\n" +
+	"\x02id\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\x02id\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"k\n" +
+	"\bLogChunk\x12\x12\n" +
+	"\x04logs\x18\x01 \x03(\tR\x04logs\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x1f\n" +
+	"\vnext_offset\x18\x03 \x01(\x03R\n" +
+	"nextOffset\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\"I\n" +
+	"\n" +
+	"StdinChunk\x12'\n" +
+	"\x02id\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\x02id\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"4\n" +
+	"\n" +
+	"TTYOptions\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\rR\x04rows\x12\x12\n" +
+	"\x04cols\x18\x02 \x01(\rR\x04cols\"`\n" +
+	"\rResizeProcess\x12'\n" +
+	"\x02id\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\x02id\x12&\n" +
+	"\x04size\x18\x02 \x01(\v2\x12.jasper.TTYOptionsR\x04size\"\xb4\x02\n" +
+	"\fHistoryQuery\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12C\n" +
+	"\x0fcompleted_after\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0ecompletedAfter\x12E\n" +
+	"\x10completed_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fcompletedBefore\x124\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1c.jasper.FilterSpecificationsR\x06status\x128\n" +
+	"\texit_code\x18\x05 \x01(\v2\x1b.google.protobuf.Int64ValueR\bexitCode\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x03R\x05limit\"\x1c\n" +
+	"\x06TagSet\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"X\n" +
+	"\x12ProcessEventFilter\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12.\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x18.jasper.ProcessEventTypeR\x05types\"\xbe\x01\n" +
+	"\fProcessEvent\x12,\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x18.jasper.ProcessEventTypeR\x04type\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12'\n" +
+	"\x06signal\x18\x03 \x01(\x0e2\x0f.jasper.SignalsR\x06signal\x12'\n" +
+	"\x04info\x18\x04 \x01(\v2\x13.jasper.ProcessInfoR\x04info\"8\n" +
+	"\n" +
+	"StopResult\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\x05R\x04step\x12\x16\n" +
+	"\x06signal\x18\x02 \x01(\x05R\x06signal\"P\n" +
+	"\bStopStep\x12\x16\n" +
+	"\x06signal\x18\x01 \x01(\x05R\x06signal\x12,\n" +
+	"\x12grace_period_nanos\x18\x02 \x01(\x03R\x10gracePeriodNanos\"`\n" +
+	"\n" +
+	"StopPolicy\x12&\n" +
+	"\x05steps\x18\x01 \x03(\v2\x10.jasper.StopStepR\x05steps\x12*\n" +
+	"\x06target\x18\x02 \x01(\x0e2\x12.jasper.StopTargetR\x06target\"b\n" +
+	"\vStopProcess\x12'\n" +
+	"\x02id\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\x02id\x12*\n" +
+	"\x06policy\x18\x02 \x01(\v2\x12.jasper.StopPolicyR\x06policy\"\xe7\x01\n" +
+	"\rRestartPolicy\x126\n" +
+	"\tcondition\x18\x01 \x01(\x0e2\x18.jasper.RestartConditionR\tcondition\x12\x1f\n" +
+	"\vmax_retries\x18\x02 \x01(\x03R\n" +
+	"maxRetries\x12#\n" +
+	"\rbackoff_nanos\x18\x03 \x01(\x03R\fbackoffNanos\x12*\n" +
+	"\x11max_backoff_nanos\x18\x04 \x01(\x03R\x0fmaxBackoffNanos\x12,\n" +
+	"\x12reset_window_nanos\x18\x05 \x01(\x03R\x10resetWindowNanos\"\x8e\x01\n" +
+	"\x10ReadinessOptions\x12.\n" +
+	"\x06probes\x18\x01 \x03(\v2\x16.jasper.ReadinessProbeR\x06probes\x12%\n" +
+	"\x0einterval_nanos\x18\x02 \x01(\x03R\rintervalNanos\x12#\n" +
+	"\rtimeout_nanos\x18\x03 \x01(\x03R\ftimeoutNanos\"\xd6\x01\n" +
+	"\x0eReadinessProbe\x12\"\n" +
+	"\x03tcp\x18\x01 \x01(\v2\x10.jasper.TCPProbeR\x03tcp\x12%\n" +
+	"\x04http\x18\x02 \x01(\v2\x11.jasper.HTTPProbeR\x04http\x12\"\n" +
+	"\x03log\x18\x03 \x01(\v2\x10.jasper.LogProbeR\x03log\x12%\n" +
+	"\x04file\x18\x04 \x01(\v2\x11.jasper.FileProbeR\x04file\x12.\n" +
+	"\acommand\x18\x05 \x01(\v2\x14.jasper.CommandProbeR\acommand\"$\n" +
+	"\bTCPProbe\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"5\n" +
+	"\tHTTPProbe\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"$\n" +
+	"\bLogProbe\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\"\x1f\n" +
+	"\tFileProbe\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"\"\n" +
+	"\fCommandProbe\x12\x12\n" +
+	"\x04args\x18\x01 \x03(\tR\x04args\"W\n" +
+	"\x14OutputCaptureOptions\x12\x1b\n" +
+	"\tmax_bytes\x18\x01 \x01(\x03R\bmaxBytes\x12\"\n" +
+	"\rspill_to_file\x18\x02 \x01(\bR\vspillToFile\"7\n" +
+	"\tLineRange\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x03R\x05start\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x92\x01\n" +
+	"\vOutputQuery\x12,\n" +
+	"\x06stream\x18\x01 \x01(\x0e2\x14.jasper.OutputStreamR\x06stream\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12'\n" +
+	"\x05lines\x18\x04 \x01(\v2\x11.jasper.LineRangeR\x05lines\"k\n" +
+	"\x15CapturedOutputRequest\x12'\n" +
+	"\x02id\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\x02id\x12)\n" +
+	"\x05query\x18\x02 \x01(\v2\x13.jasper.OutputQueryR\x05query\"\x9c\x01\n" +
+	"\x0eCapturedOutput\x12,\n" +
+	"\x06stream\x18\x01 \x01(\x0e2\x14.jasper.OutputStreamR\x06stream\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1c\n" +
+	"\ttruncated\x18\x05 \x01(\bR\ttruncated*q\n" +
+	"\tLogFormat\x12\x14\n" +
+	"\x10LOGFORMATUNKNOWN\x10\x00\x12\x12\n" +
+	"\x0eLOGFORMATPLAIN\x10\x01\x12\x11\n" +
+	"\rLOGFORMATJSON\x10\x02\x12\x11\n" +
+	"\rLOGFORMATBSON\x10\x03\x12\x14\n" +
+	"\x10LOGFORMATDEFAULT\x10\x04*w\n" +
+	"\x15RawLoggerConfigFormat\x12\x1d\n" +
+	"\x19RAWLOGGERCONFIGFORMATJSON\x10\x00\x12\x1d\n" +
+	"\x19RAWLOGGERCONFIGFORMATBSON\x10\x01\x12 \n" +
+	"\x1cRAWLOGGERCONFIGFORMATUNKNOWN\x10\x02*X\n" +
+	"\x14FilterSpecifications\x12\a\n" +
+	"\x03ALL\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\x0e\n" +
+	"\n" +
+	"TERMINATED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\x12\x0e\n" +
+	"\n" +
+	"SUCCESSFUL\x10\x04*e\n" +
+	"\aSignals\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\r\n" +
+	"\tTERMINATE\x10\x01\x12\b\n" +
+	"\x04KILL\x10\x02\x12\n" +
+	"\n" +
+	"\x06HANGUP\x10\x03\x12\b\n" +
+	"\x04INIT\x10\x04\x12\t\n" +
+	"\x05USER1\x10\x05\x12\t\n" +
+	"\x05USER2\x10\x06\x12\b\n" +
+	"\x04ABRT\x10\a*V\n" +
+	"\rArchiveFormat\x12\x12\n" +
+	"\x0eARCHIVEUNKNOWN\x10\x00\x12\x0f\n" +
+	"\vARCHIVEAUTO\x10\x01\x12\x10\n" +
+	"\fARCHIVETARGZ\x10\x02\x12\x0e\n" +
+	"\n" +
+	"ARCHIVEZIP\x10\x03*1\n" +
+	"\x0fSignalTriggerID\x12\b\n" +
+	"\x04NONE\x10\x00\x12\x14\n" +
+	"\x10CLEANTERMINATION\x10\x01*[\n" +
+	"\x14LoggingPayloadFormat\x12\x11\n" +
+	"\rFORMATUNKNONW\x10\x00\x12\x0e\n" +
+	"\n" +
+	"FORMATBSON\x10\x01\x12\x0e\n" +
+	"\n" +
+	"FORMATJSON\x10\x02\x12\x10\n" +
+	"\fFORMATSTRING\x10\x03*\xc4\x01\n" +
+	"\x10ProcessEventType\x12\x17\n" +
+	"\x13PROCESSEVENTUNKNOWN\x10\x00\x12\x17\n" +
+	"\x13PROCESSEVENTCREATED\x10\x01\x12\x17\n" +
+	"\x13PROCESSEVENTSTARTED\x10\x02\x12\x18\n" +
+	"\x14PROCESSEVENTSIGNALED\x10\x03\x12\x16\n" +
+	"\x12PROCESSEVENTEXITED\x10\x04\x12\x18\n" +
+	"\x14PROCESSEVENTTIMEDOUT\x10\x05\x12\x19\n" +
+	"\x15PROCESSEVENTOOMKILLED\x10\x06*c\n" +
+	"\n" +
+	"StopTarget\x12\x15\n" +
+	"\x11STOPTARGETUNKNOWN\x10\x00\x12\x15\n" +
+	"\x11STOPTARGETPROCESS\x10\x01\x12\x13\n" +
+	"\x0fSTOPTARGETGROUP\x10\x02\x12\x12\n" +
+	"\x0eSTOPTARGETTREE\x10\x03*\x85\x01\n" +
+	"\x10RestartCondition\x12\x1b\n" +
+	"\x17RESTARTCONDITIONUNKNOWN\x10\x00\x12\x19\n" +
+	"\x15RESTARTCONDITIONNEVER\x10\x01\x12\x1d\n" +
+	"\x19RESTARTCONDITIONONFAILURE\x10\x02\x12\x1a\n" +
+	"\x16RESTARTCONDITIONALWAYS\x10\x03*W\n" +
+	"\fOutputStream\x12\x17\n" +
+	"\x13OUTPUTSTREAMUNKNOWN\x10\x00\x12\x16\n" +
+	"\x12OUTPUTSTREAMSTDOUT\x10\x01\x12\x16\n" +
+	"\x12OUTPUTSTREAMSTDERR\x10\x022\x82\x19\n" +
+	"\x14JasperProcessManager\x120\n" +
+	"\x02ID\x12\x16.google.protobuf.Empty\x1a\x12.jasper.IDResponse\x124\n" +
+	"\x06Create\x12\x15.jasper.CreateOptions\x1a\x13.jasper.ProcessInfo\x12-\n" +
+	"\x04List\x12\x0e.jasper.Filter\x1a\x13.jasper.ProcessInfo0\x01\x12/\n" +
+	"\x05Group\x12\x0f.jasper.TagName\x1a\x13.jasper.ProcessInfo0\x01\x123\n" +
+	"\x03Get\x12\x17.jasper.JasperProcessID\x1a\x13.jasper.ProcessInfo\x129\n" +
+	"\x06Signal\x12\x15.jasper.SignalProcess\x1a\x18.jasper.OperationOutcome\x129\n" +
+	"\x05Clear\x12\x16.google.protobuf.Empty\x1a\x18.jasper.OperationOutcome\x129\n" +
+	"\x05Close\x12\x16.google.protobuf.Empty\x1a\x18.jasper.OperationOutcome\x12>\n" +
+	"\tWriteFile\x12\x15.jasper.WriteFileInfo\x1a\x18.jasper.OperationOutcome(\x01\x12;\n" +
+	"\n" +
+	"TagProcess\x12\x13.jasper.ProcessTags\x1a\x18.jasper.OperationOutcome\x12>\n" +
+	"\tResetTags\x12\x17.jasper.JasperProcessID\x1a\x18.jasper.OperationOutcome\x127\n" +
+	"\aGetTags\x12\x17.jasper.JasperProcessID\x1a\x13.jasper.ProcessTags\x12P\n" +
+	"\x17RegisterSignalTriggerID\x12\x1b.jasper.SignalTriggerParams\x1a\x18.jasper.OperationOutcome\x129\n" +
+	"\x04Wait\x12\x17.jasper.JasperProcessID\x1a\x18.jasper.OperationOutcome\x127\n" +
+	"\aRespawn\x12\x17.jasper.JasperProcessID\x1a\x13.jasper.ProcessInfo\x12M\n" +
+	"\x15ScriptingHarnessSetup\x12\x1a.jasper.ScriptingHarnessID\x1a\x18.jasper.OperationOutcome\x12O\n" +
+	"\x17ScriptingHarnessCleanup\x12\x1a.jasper.ScriptingHarnessID\x1a\x18.jasper.OperationOutcome\x12P\n" +
+	"\x13ScriptingHarnessRun\x12\x1f.jasper.ScriptingHarnessRunArgs\x1a\x18.jasper.OperationOutcome\x12a\n" +
+	"\x15ScriptingHarnessBuild\x12!.jasper.ScriptingHarnessBuildArgs\x1a%.jasper.ScriptingHarnessBuildResponse\x12\\\n" +
+	"\x19ScriptingHarnessRunScript\x12%.jasper.ScriptingHarnessRunScriptArgs\x1a\x18.jasper.OperationOutcome\x12^\n" +
+	"\x14ScriptingHarnessTest\x12 .jasper.ScriptingHarnessTestArgs\x1a$.jasper.ScriptingHarnessTestResponse\x12R\n" +
+	"\x12LoggingCacheCreate\x12\x1e.jasper.LoggingCacheCreateArgs\x1a\x1c.jasper.LoggingCacheInstance\x12I\n" +
+	"\x0fLoggingCacheGet\x12\x18.jasper.LoggingCacheArgs\x1a\x1c.jasper.LoggingCacheInstance\x12H\n" +
+	"\x12LoggingCacheRemove\x12\x18.jasper.LoggingCacheArgs\x1a\x18.jasper.OperationOutcome\x12P\n" +
+	"\x1aLoggingCacheCloseAndRemove\x12\x18.jasper.LoggingCacheArgs\x1a\x18.jasper.OperationOutcome\x12E\n" +
+	"\x11LoggingCacheClear\x12\x16.google.protobuf.Empty\x1a\x18.jasper.OperationOutcome\x12J\n" +
+	"\x0fLoggingCacheLen\x12\x16.google.protobuf.Empty\x1a\x1f.jasper.LoggingCacheLenResponse\x12I\n" +
+	"\x11LoggingCachePrune\x12\x1a.google.protobuf.Timestamp\x1a\x18.jasper.OperationOutcome\x12N\n" +
+	"\x16ScriptingHarnessCreate\x12\x18.jasper.ScriptingOptions\x1a\x1a.jasper.ScriptingHarnessID\x12K\n" +
+	"\x13ScriptingHarnessGet\x12\x1a.jasper.ScriptingHarnessID\x1a\x18.jasper.OperationOutcome\x128\n" +
+	"\x06Status\x12\x16.google.protobuf.Empty\x1a\x16.jasper.StatusResponse\x12@\n" +
+	"\x0eConfigureCache\x12\x14.jasper.CacheOptions\x1a\x18.jasper.OperationOutcome\x12>\n" +
+	"\fDownloadFile\x12\x14.jasper.DownloadInfo\x1a\x18.jasper.OperationOutcome\x12K\n" +
+	"\x0fDownloadMongoDB\x12\x1e.jasper.MongoDBDownloadOptions\x1a\x18.jasper.OperationOutcome\x125\n" +
+	"\fGetLogStream\x12\x12.jasper.LogRequest\x1a\x11.jasper.LogStream\x12F\n" +
+	"\x12GetBuildloggerURLs\x12\x17.jasper.JasperProcessID\x1a\x17.jasper.BuildloggerURLs\x12:\n" +
+	"\vSignalEvent\x12\x11.jasper.EventName\x1a\x18.jasper.OperationOutcome\x12@\n" +
+	"\fSendMessages\x12\x16.jasper.LoggingPayload\x1a\x18.jasper.OperationOutcome\x12;\n" +
+	"\n" +
+	"FollowLogs\x12\x19.jasper.FollowLogsRequest\x1a\x10.jasper.LogChunk0\x01\x12<\n" +
+	"\n" +
+	"WriteStdin\x12\x12.jasper.StdinChunk\x1a\x18.jasper.OperationOutcome(\x01\x12?\n" +
+	"\n" +
+	"CloseStdin\x12\x17.jasper.JasperProcessID\x1a\x18.jasper.OperationOutcome\x129\n" +
+	"\x06Resize\x12\x15.jasper.ResizeProcess\x1a\x18.jasper.OperationOutcome\x126\n" +
+	"\aHistory\x12\x14.jasper.HistoryQuery\x1a\x13.jasper.ProcessInfo0\x01\x12?\n" +
+	"\tSubscribe\x12\x1a.jasper.ProcessEventFilter\x1a\x14.jasper.ProcessEvent0\x01\x125\n" +
+	"\x04Stop\x12\x13.jasper.StopProcess\x1a\x18.jasper.OperationOutcome\x12>\n" +
+	"\tWaitReady\x12\x17.jasper.JasperProcessID\x1a\x18.jasper.OperationOutcome\x12J\n" +
+	"\x11GetCapturedOutput\x12\x1d.jasper.CapturedOutputRequest\x1a\x16.jasper.CapturedOutputB\x11Z\x0fremote/internalb\x06proto3"
+
+var (
+	file_jasper_proto_rawDescOnce sync.Once
+	file_jasper_proto_rawDescData []byte
+)
+
+func file_jasper_proto_rawDescGZIP() []byte {
+	file_jasper_proto_rawDescOnce.Do(func() {
+		file_jasper_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_jasper_proto_rawDesc), len(file_jasper_proto_rawDesc)))
+	})
+	return file_jasper_proto_rawDescData
+}
+
+var file_jasper_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_jasper_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
+var file_jasper_proto_goTypes = []any{
+	(LogFormat)(0),                        // 0: jasper.LogFormat
+	(RawLoggerConfigFormat)(0),            // 1: jasper.RawLoggerConfigFormat
+	(FilterSpecifications)(0),             // 2: jasper.FilterSpecifications
+	(Signals)(0),                          // 3: jasper.Signals
+	(ArchiveFormat)(0),                    // 4: jasper.ArchiveFormat
+	(SignalTriggerID)(0),                  // 5: jasper.SignalTriggerID
+	(LoggingPayloadFormat)(0),             // 6: jasper.LoggingPayloadFormat
+	(ProcessEventType)(0),                 // 7: jasper.ProcessEventType
+	(StopTarget)(0),                       // 8: jasper.StopTarget
+	(RestartCondition)(0),                 // 9: jasper.RestartCondition
+	(OutputStream)(0),                     // 10: jasper.OutputStream
+	(*LoggerConfig)(nil),                  // 11: jasper.LoggerConfig
+	(*LogLevel)(nil),                      // 12: jasper.LogLevel
+	(*BufferOptions)(nil),                 // 13: jasper.BufferOptions
+	(*BaseOptions)(nil),                   // 14: jasper.BaseOptions
+	(*DefaultLoggerOptions)(nil),          // 15: jasper.DefaultLoggerOptions
+	(*FileLoggerOptions)(nil),             // 16: jasper.FileLoggerOptions
+	(*InheritedLoggerOptions)(nil),        // 17: jasper.InheritedLoggerOptions
+	(*InMemoryLoggerOptions)(nil),         // 18: jasper.InMemoryLoggerOptions
+	(*SplunkInfo)(nil),                    // 19: jasper.SplunkInfo
+	(*SplunkLoggerOptions)(nil),           // 20: jasper.SplunkLoggerOptions
+	(*BuildloggerV2Info)(nil),             // 21: jasper.BuildloggerV2Info
+	(*BuildloggerV2Options)(nil),          // 22: jasper.BuildloggerV2Options
+	(*BuildloggerV3Info)(nil),             // 23: jasper.BuildloggerV3Info
+	(*BuildloggerV3Options)(nil),          // 24: jasper.BuildloggerV3Options
+	(*RawLoggerConfig)(nil),               // 25: jasper.RawLoggerConfig
+	(*OutputOptions)(nil),                 // 26: jasper.OutputOptions
+	(*CreateOptions)(nil),                 // 27: jasper.CreateOptions
+	(*IDResponse)(nil),                    // 28: jasper.IDResponse
+	(*ProcessInfo)(nil),                   // 29: jasper.ProcessInfo
+	(*StatusResponse)(nil),                // 30: jasper.StatusResponse
+	(*Filter)(nil),                        // 31: jasper.Filter
+	(*SignalProcess)(nil),                 // 32: jasper.SignalProcess
+	(*TagName)(nil),                       // 33: jasper.TagName
+	(*ProcessTags)(nil),                   // 34: jasper.ProcessTags
+	(*JasperProcessID)(nil),               // 35: jasper.JasperProcessID
+	(*OperationOutcome)(nil),              // 36: jasper.OperationOutcome
+	(*BuildOptions)(nil),                  // 37: jasper.BuildOptions
+	(*MongoDBDownloadOptions)(nil),        // 38: jasper.MongoDBDownloadOptions
+	(*CacheOptions)(nil),                  // 39: jasper.CacheOptions
+	(*ArchiveOptions)(nil),                // 40: jasper.ArchiveOptions
+	(*DownloadInfo)(nil),                  // 41: jasper.DownloadInfo
+	(*WriteFileInfo)(nil),                 // 42: jasper.WriteFileInfo
+	(*BuildloggerURLs)(nil),               // 43: jasper.BuildloggerURLs
+	(*LogRequest)(nil),                    // 44: jasper.LogRequest
+	(*LogStream)(nil),                     // 45: jasper.LogStream
+	(*SignalTriggerParams)(nil),           // 46: jasper.SignalTriggerParams
+	(*EventName)(nil),                     // 47: jasper.EventName
+	(*ScriptingHarnessID)(nil),            // 48: jasper.ScriptingHarnessID
+	(*ScriptingOptionsGolang)(nil),        // 49: jasper.ScriptingOptionsGolang
+	(*ScriptingOptionsPython)(nil),        // 50: jasper.ScriptingOptionsPython
+	(*ScriptingOptionsRoswell)(nil),       // 51: jasper.ScriptingOptionsRoswell
+	(*ScriptingOptions)(nil),              // 52: jasper.ScriptingOptions
+	(*ScriptingHarnessRunArgs)(nil),       // 53: jasper.ScriptingHarnessRunArgs
+	(*ScriptingHarnessBuildArgs)(nil),     // 54: jasper.ScriptingHarnessBuildArgs
+	(*ScriptingHarnessBuildResponse)(nil), // 55: jasper.ScriptingHarnessBuildResponse
+	(*ScriptingHarnessRunScriptArgs)(nil), // 56: jasper.ScriptingHarnessRunScriptArgs
+	(*ScriptingHarnessTestArgs)(nil),      // 57: jasper.ScriptingHarnessTestArgs
+	(*ScriptingHarnessTestOptions)(nil),   // 58: jasper.ScriptingHarnessTestOptions
+	(*ScriptingHarnessTestResult)(nil),    // 59: jasper.ScriptingHarnessTestResult
+	(*ScriptingHarnessTestResponse)(nil),  // 60: jasper.ScriptingHarnessTestResponse
+	(*LoggingCacheCreateArgs)(nil),        // 61: jasper.LoggingCacheCreateArgs
+	(*LoggingCacheArgs)(nil),              // 62: jasper.LoggingCacheArgs
+	(*LoggingCacheInstance)(nil),          // 63: jasper.LoggingCacheInstance
+	(*LoggingCacheLenResponse)(nil),       // 64: jasper.LoggingCacheLenResponse
+	(*LoggingPayloadData)(nil),            // 65: jasper.LoggingPayloadData
+	(*LoggingPayload)(nil),                // 66: jasper.LoggingPayload
+	(*ResourceUsage)(nil),                 // 67: jasper.ResourceUsage
+	(*ProcessResources)(nil),              // 68: jasper.ProcessResources
+	(*ResourceLimits)(nil),                // 69: jasper.ResourceLimits
+	(*CgroupLimits)(nil),                  // 70: jasper.CgroupLimits
+	(*FollowLogsRequest)(nil),             // 71: jasper.FollowLogsRequest
+	(*LogChunk)(nil),                      // 72: jasper.LogChunk
+	(*StdinChunk)(nil),                    // 73: jasper.StdinChunk
+	(*TTYOptions)(nil),                    // 74: jasper.TTYOptions
+	(*ResizeProcess)(nil),                 // 75: jasper.ResizeProcess
+	(*HistoryQuery)(nil),                  // 76: jasper.HistoryQuery
+	(*TagSet)(nil),                        // 77: jasper.TagSet
+	(*ProcessEventFilter)(nil),            // 78: jasper.ProcessEventFilter
+	(*ProcessEvent)(nil),                  // 79: jasper.ProcessEvent
+	(*StopResult)(nil),                    // 80: jasper.StopResult
+	(*StopStep)(nil),                      // 81: jasper.StopStep
+	(*StopPolicy)(nil),                    // 82: jasper.StopPolicy
+	(*StopProcess)(nil),                   // 83: jasper.StopProcess
+	(*RestartPolicy)(nil),                 // 84: jasper.RestartPolicy
+	(*ReadinessOptions)(nil),              // 85: jasper.ReadinessOptions
+	(*ReadinessProbe)(nil),                // 86: jasper.ReadinessProbe
+	(*TCPProbe)(nil),                      // 87: jasper.TCPProbe
+	(*HTTPProbe)(nil),                     // 88: jasper.HTTPProbe
+	(*LogProbe)(nil),                      // 89: jasper.LogProbe
+	(*FileProbe)(nil),                     // 90: jasper.FileProbe
+	(*CommandProbe)(nil),                  // 91: jasper.CommandProbe
+	(*OutputCaptureOptions)(nil),          // 92: jasper.OutputCaptureOptions
+	(*LineRange)(nil),                     // 93: jasper.LineRange
+	(*OutputQuery)(nil),                   // 94: jasper.OutputQuery
+	(*CapturedOutputRequest)(nil),         // 95: jasper.CapturedOutputRequest
+	(*CapturedOutput)(nil),                // 96: jasper.CapturedOutput
+	nil,                                   // 97: jasper.BuildloggerV3Info.ArgsEntry
+	nil,                                   // 98: jasper.CreateOptions.EnvironmentEntry
+	nil,                                   // 99: jasper.ScriptingOptions.EnvironmentEntry
+	(*timestamppb.Timestamp)(nil),         // 100: google.protobuf.Timestamp
+	(*wrapperspb.Int64Value)(nil),         // 101: google.protobuf.Int64Value
+	(*durationpb.Duration)(nil),           // 102: google.protobuf.Duration
+	(*wrapperspb.UInt64Value)(nil),        // 103: google.protobuf.UInt64Value
+	(*emptypb.Empty)(nil),                 // 104: google.protobuf.Empty
+}
+var file_jasper_proto_depIdxs = []int32{
+	15,  // 0: jasper.LoggerConfig.default:type_name -> jasper.DefaultLoggerOptions
+	16,  // 1: jasper.LoggerConfig.file:type_name -> jasper.FileLoggerOptions
+	17,  // 2: jasper.LoggerConfig.inherited:type_name -> jasper.InheritedLoggerOptions
+	18,  // 3: jasper.LoggerConfig.in_memory:type_name -> jasper.InMemoryLoggerOptions
+	20,  // 4: jasper.LoggerConfig.splunk:type_name -> jasper.SplunkLoggerOptions
+	22,  // 5: jasper.LoggerConfig.buildloggerv2:type_name -> jasper.BuildloggerV2Options
+	24,  // 6: jasper.LoggerConfig.buildloggerv3:type_name -> jasper.BuildloggerV3Options
+	25,  // 7: jasper.LoggerConfig.raw:type_name -> jasper.RawLoggerConfig
+	12,  // 8: jasper.BaseOptions.level:type_name -> jasper.LogLevel
+	13,  // 9: jasper.BaseOptions.buffer:type_name -> jasper.BufferOptions
+	0,   // 10: jasper.BaseOptions.format:type_name -> jasper.LogFormat
+	14,  // 11: jasper.DefaultLoggerOptions.base:type_name -> jasper.BaseOptions
+	14,  // 12: jasper.FileLoggerOptions.base:type_name -> jasper.BaseOptions
+	14,  // 13: jasper.InheritedLoggerOptions.base:type_name -> jasper.BaseOptions
+	14,  // 14: jasper.InMemoryLoggerOptions.base:type_name -> jasper.BaseOptions
+	19,  // 15: jasper.SplunkLoggerOptions.splunk:type_name -> jasper.SplunkInfo
+	14,  // 16: jasper.SplunkLoggerOptions.base:type_name -> jasper.BaseOptions
+	21,  // 17: jasper.BuildloggerV2Options.buildlogger:type_name -> jasper.BuildloggerV2Info
+	14,  // 18: jasper.BuildloggerV2Options.base:type_name -> jasper.BaseOptions
+	0,   // 19: jasper.BuildloggerV3Info.format:type_name -> jasper.LogFormat
+	97,  // 20: jasper.BuildloggerV3Info.args:type_name -> jasper.BuildloggerV3Info.ArgsEntry
+	23,  // 21: jasper.BuildloggerV3Options.buildloggerv3:type_name -> jasper.BuildloggerV3Info
+	12,  // 22: jasper.BuildloggerV3Options.level:type_name -> jasper.LogLevel
+	1,   // 23: jasper.RawLoggerConfig.format:type_name -> jasper.RawLoggerConfigFormat
+	11,  // 24: jasper.OutputOptions.loggers:type_name -> jasper.LoggerConfig
+	92,  // 25: jasper.OutputOptions.capture:type_name -> jasper.OutputCaptureOptions
+	98,  // 26: jasper.CreateOptions.environment:type_name -> jasper.CreateOptions.EnvironmentEntry
+	27,  // 27: jasper.CreateOptions.on_success:type_name -> jasper.CreateOptions
+	27,  // 28: jasper.CreateOptions.on_failure:type_name -> jasper.CreateOptions
+	27,  // 29: jasper.CreateOptions.on_timeout:type_name -> jasper.CreateOptions
+	26,  // 30: jasper.CreateOptions.output:type_name -> jasper.OutputOptions
+	69,  // 31: jasper.CreateOptions.limits:type_name -> jasper.ResourceLimits
+	74,  // 32: jasper.CreateOptions.tty:type_name -> jasper.TTYOptions
+	84,  // 33: jasper.CreateOptions.restart:type_name -> jasper.RestartPolicy
+	85,  // 34: jasper.CreateOptions.readiness:type_name -> jasper.ReadinessOptions
+	27,  // 35: jasper.ProcessInfo.options:type_name -> jasper.CreateOptions
+	100, // 36: jasper.ProcessInfo.start_at:type_name -> google.protobuf.Timestamp
+	100, // 37: jasper.ProcessInfo.end_at:type_name -> google.protobuf.Timestamp
+	68,  // 38: jasper.ProcessInfo.resources:type_name -> jasper.ProcessResources
+	80,  // 39: jasper.ProcessInfo.stopped_by:type_name -> jasper.StopResult
+	100, // 40: jasper.ProcessInfo.ready_at:type_name -> google.protobuf.Timestamp
+	2,   // 41: jasper.Filter.name:type_name -> jasper.FilterSpecifications
+	77,  // 42: jasper.Filter.tags:type_name -> jasper.TagSet
+	101, // 43: jasper.Filter.min_exit_code:type_name -> google.protobuf.Int64Value
+	101, // 44: jasper.Filter.max_exit_code:type_name -> google.protobuf.Int64Value
+	100, // 45: jasper.Filter.started_after:type_name -> google.protobuf.Timestamp
+	100, // 46: jasper.Filter.started_before:type_name -> google.protobuf.Timestamp
+	35,  // 47: jasper.SignalProcess.ProcessID:type_name -> jasper.JasperProcessID
+	3,   // 48: jasper.SignalProcess.signal:type_name -> jasper.Signals
+	37,  // 49: jasper.MongoDBDownloadOptions.build_opts:type_name -> jasper.BuildOptions
+	4,   // 50: jasper.ArchiveOptions.format:type_name -> jasper.ArchiveFormat
+	40,  // 51: jasper.DownloadInfo.archive_opts:type_name -> jasper.ArchiveOptions
+	35,  // 52: jasper.LogRequest.id:type_name -> jasper.JasperProcessID
+	35,  // 53: jasper.SignalTriggerParams.processID:type_name -> jasper.JasperProcessID
+	5,   // 54: jasper.SignalTriggerParams.signalTriggerID:type_name -> jasper.SignalTriggerID
+	49,  // 55: jasper.ScriptingOptions.golang:type_name -> jasper.ScriptingOptionsGolang
+	50,  // 56: jasper.ScriptingOptions.python:type_name -> jasper.ScriptingOptionsPython
+	51,  // 57: jasper.ScriptingOptions.roswell:type_name -> jasper.ScriptingOptionsRoswell
+	99,  // 58: jasper.ScriptingOptions.environment:type_name -> jasper.ScriptingOptions.EnvironmentEntry
+	26,  // 59: jasper.ScriptingOptions.output:type_name -> jasper.OutputOptions
+	36,  // 60: jasper.ScriptingHarnessBuildResponse.outcome:type_name -> jasper.OperationOutcome
+	58,  // 61: jasper.ScriptingHarnessTestArgs.options:type_name -> jasper.ScriptingHarnessTestOptions
+	102, // 62: jasper.ScriptingHarnessTestOptions.timeout:type_name -> google.protobuf.Duration
+	100, // 63: jasper.ScriptingHarnessTestResult.start_at:type_name -> google.protobuf.Timestamp
+	102, // 64: jasper.ScriptingHarnessTestResult.duration:type_name -> google.protobuf.Duration
+	36,  // 65: jasper.ScriptingHarnessTestResponse.outcome:type_name -> jasper.OperationOutcome
+	59,  // 66: jasper.ScriptingHarnessTestResponse.results:type_name -> jasper.ScriptingHarnessTestResult
+	26,  // 67: jasper.LoggingCacheCreateArgs.options:type_name -> jasper.OutputOptions
+	36,  // 68: jasper.LoggingCacheInstance.outcome:type_name -> jasper.OperationOutcome
+	100, // 69: jasper.LoggingCacheInstance.accessed:type_name -> google.protobuf.Timestamp
+	36,  // 70: jasper.LoggingCacheLenResponse.outcome:type_name -> jasper.OperationOutcome
+	6,   // 71: jasper.LoggingPayload.format:type_name -> jasper.LoggingPayloadFormat
+	65,  // 72: jasper.LoggingPayload.data:type_name -> jasper.LoggingPayloadData
+	67,  // 73: jasper.ProcessResources.last:type_name -> jasper.ResourceUsage
+	67,  // 74: jasper.ProcessResources.peak:type_name -> jasper.ResourceUsage
+	100, // 75: jasper.ProcessResources.sampled_at:type_name -> google.protobuf.Timestamp
+	103, // 76: jasper.ResourceLimits.cpu_seconds:type_name -> google.protobuf.UInt64Value
+	103, // 77: jasper.ResourceLimits.address_space:type_name -> google.protobuf.UInt64Value
+	103, // 78: jasper.ResourceLimits.open_files:type_name -> google.protobuf.UInt64Value
+	103, // 79: jasper.ResourceLimits.num_procs:type_name -> google.protobuf.UInt64Value
+	103, // 80: jasper.ResourceLimits.core_size:type_name -> google.protobuf.UInt64Value
+	70,  // 81: jasper.ResourceLimits.cgroup:type_name -> jasper.CgroupLimits
+	35,  // 82: jasper.FollowLogsRequest.id:type_name -> jasper.JasperProcessID
+	35,  // 83: jasper.StdinChunk.id:type_name -> jasper.JasperProcessID
+	35,  // 84: jasper.ResizeProcess.id:type_name -> jasper.JasperProcessID
+	74,  // 85: jasper.ResizeProcess.size:type_name -> jasper.TTYOptions
+	100, // 86: jasper.HistoryQuery.completed_after:type_name -> google.protobuf.Timestamp
+	100, // 87: jasper.HistoryQuery.completed_before:type_name -> google.protobuf.Timestamp
+	2,   // 88: jasper.HistoryQuery.status:type_name -> jasper.FilterSpecifications
+	101, // 89: jasper.HistoryQuery.exit_code:type_name -> google.protobuf.Int64Value
+	7,   // 90: jasper.ProcessEventFilter.types:type_name -> jasper.ProcessEventType
+	7,   // 91: jasper.ProcessEvent.type:type_name -> jasper.ProcessEventType
+	100, // 92: jasper.ProcessEvent.time:type_name -> google.protobuf.Timestamp
+	3,   // 93: jasper.ProcessEvent.signal:type_name -> jasper.Signals
+	29,  // 94: jasper.ProcessEvent.info:type_name -> jasper.ProcessInfo
+	81,  // 95: jasper.StopPolicy.steps:type_name -> jasper.StopStep
+	8,   // 96: jasper.StopPolicy.target:type_name -> jasper.StopTarget
+	35,  // 97: jasper.StopProcess.id:type_name -> jasper.JasperProcessID
+	82,  // 98: jasper.StopProcess.policy:type_name -> jasper.StopPolicy
+	9,   // 99: jasper.RestartPolicy.condition:type_name -> jasper.RestartCondition
+	86,  // 100: jasper.ReadinessOptions.probes:type_name -> jasper.ReadinessProbe
+	87,  // 101: jasper.ReadinessProbe.tcp:type_name -> jasper.TCPProbe
+	88,  // 102: jasper.ReadinessProbe.http:type_name -> jasper.HTTPProbe
+	89,  // 103: jasper.ReadinessProbe.log:type_name -> jasper.LogProbe
+	90,  // 104: jasper.ReadinessProbe.file:type_name -> jasper.FileProbe
+	91,  // 105: jasper.ReadinessProbe.command:type_name -> jasper.CommandProbe
+	10,  // 106: jasper.OutputQuery.stream:type_name -> jasper.OutputStream
+	93,  // 107: jasper.OutputQuery.lines:type_name -> jasper.LineRange
+	35,  // 108: jasper.CapturedOutputRequest.id:type_name -> jasper.JasperProcessID
+	94,  // 109: jasper.CapturedOutputRequest.query:type_name -> jasper.OutputQuery
+	10,  // 110: jasper.CapturedOutput.stream:type_name -> jasper.OutputStream
+	104, // 111: jasper.JasperProcessManager.ID:input_type -> google.protobuf.Empty
+	27,  // 112: jasper.JasperProcessManager.Create:input_type -> jasper.CreateOptions
+	31,  // 113: jasper.JasperProcessManager.List:input_type -> jasper.Filter
+	33,  // 114: jasper.JasperProcessManager.Group:input_type -> jasper.TagName
+	35,  // 115: jasper.JasperProcessManager.Get:input_type -> jasper.JasperProcessID
+	32,  // 116: jasper.JasperProcessManager.Signal:input_type -> jasper.SignalProcess
+	104, // 117: jasper.JasperProcessManager.Clear:input_type -> google.protobuf.Empty
+	104, // 118: jasper.JasperProcessManager.Close:input_type -> google.protobuf.Empty
+	42,  // 119: jasper.JasperProcessManager.WriteFile:input_type -> jasper.WriteFileInfo
+	34,  // 120: jasper.JasperProcessManager.TagProcess:input_type -> jasper.ProcessTags
+	35,  // 121: jasper.JasperProcessManager.ResetTags:input_type -> jasper.JasperProcessID
+	35,  // 122: jasper.JasperProcessManager.GetTags:input_type -> jasper.JasperProcessID
+	46,  // 123: jasper.JasperProcessManager.RegisterSignalTriggerID:input_type -> jasper.SignalTriggerParams
+	35,  // 124: jasper.JasperProcessManager.Wait:input_type -> jasper.JasperProcessID
+	35,  // 125: jasper.JasperProcessManager.Respawn:input_type -> jasper.JasperProcessID
+	48,  // 126: jasper.JasperProcessManager.ScriptingHarnessSetup:input_type -> jasper.ScriptingHarnessID
+	48,  // 127: jasper.JasperProcessManager.ScriptingHarnessCleanup:input_type -> jasper.ScriptingHarnessID
+	53,  // 128: jasper.JasperProcessManager.ScriptingHarnessRun:input_type -> jasper.ScriptingHarnessRunArgs
+	54,  // 129: jasper.JasperProcessManager.ScriptingHarnessBuild:input_type -> jasper.ScriptingHarnessBuildArgs
+	56,  // 130: jasper.JasperProcessManager.ScriptingHarnessRunScript:input_type -> jasper.ScriptingHarnessRunScriptArgs
+	57,  // 131: jasper.JasperProcessManager.ScriptingHarnessTest:input_type -> jasper.ScriptingHarnessTestArgs
+	61,  // 132: jasper.JasperProcessManager.LoggingCacheCreate:input_type -> jasper.LoggingCacheCreateArgs
+	62,  // 133: jasper.JasperProcessManager.LoggingCacheGet:input_type -> jasper.LoggingCacheArgs
+	62,  // 134: jasper.JasperProcessManager.LoggingCacheRemove:input_type -> jasper.LoggingCacheArgs
+	62,  // 135: jasper.JasperProcessManager.LoggingCacheCloseAndRemove:input_type -> jasper.LoggingCacheArgs
+	104, // 136: jasper.JasperProcessManager.LoggingCacheClear:input_type -> google.protobuf.Empty
+	104, // 137: jasper.JasperProcessManager.LoggingCacheLen:input_type -> google.protobuf.Empty
+	100, // 138: jasper.JasperProcessManager.LoggingCachePrune:input_type -> google.protobuf.Timestamp
+	52,  // 139: jasper.JasperProcessManager.ScriptingHarnessCreate:input_type -> jasper.ScriptingOptions
+	48,  // 140: jasper.JasperProcessManager.ScriptingHarnessGet:input_type -> jasper.ScriptingHarnessID
+	104, // 141: jasper.JasperProcessManager.Status:input_type -> google.protobuf.Empty
+	39,  // 142: jasper.JasperProcessManager.ConfigureCache:input_type -> jasper.CacheOptions
+	41,  // 143: jasper.JasperProcessManager.DownloadFile:input_type -> jasper.DownloadInfo
+	38,  // 144: jasper.JasperProcessManager.DownloadMongoDB:input_type -> jasper.MongoDBDownloadOptions
+	44,  // 145: jasper.JasperProcessManager.GetLogStream:input_type -> jasper.LogRequest
+	35,  // 146: jasper.JasperProcessManager.GetBuildloggerURLs:input_type -> jasper.JasperProcessID
+	47,  // 147: jasper.JasperProcessManager.SignalEvent:input_type -> jasper.EventName
+	66,  // 148: jasper.JasperProcessManager.SendMessages:input_type -> jasper.LoggingPayload
+	71,  // 149: jasper.JasperProcessManager.FollowLogs:input_type -> jasper.FollowLogsRequest
+	73,  // 150: jasper.JasperProcessManager.WriteStdin:input_type -> jasper.StdinChunk
+	35,  // 151: jasper.JasperProcessManager.CloseStdin:input_type -> jasper.JasperProcessID
+	75,  // 152: jasper.JasperProcessManager.Resize:input_type -> jasper.ResizeProcess
+	76,  // 153: jasper.JasperProcessManager.History:input_type -> jasper.HistoryQuery
+	78,  // 154: jasper.JasperProcessManager.Subscribe:input_type -> jasper.ProcessEventFilter
+	83,  // 155: jasper.JasperProcessManager.Stop:input_type -> jasper.StopProcess
+	35,  // 156: jasper.JasperProcessManager.WaitReady:input_type -> jasper.JasperProcessID
+	95,  // 157: jasper.JasperProcessManager.GetCapturedOutput:input_type -> jasper.CapturedOutputRequest
+	28,  // 158: jasper.JasperProcessManager.ID:output_type -> jasper.IDResponse
+	29,  // 159: jasper.JasperProcessManager.Create:output_type -> jasper.ProcessInfo
+	29,  // 160: jasper.JasperProcessManager.List:output_type -> jasper.ProcessInfo
+	29,  // 161: jasper.JasperProcessManager.Group:output_type -> jasper.ProcessInfo
+	29,  // 162: jasper.JasperProcessManager.Get:output_type -> jasper.ProcessInfo
+	36,  // 163: jasper.JasperProcessManager.Signal:output_type -> jasper.OperationOutcome
+	36,  // 164: jasper.JasperProcessManager.Clear:output_type -> jasper.OperationOutcome
+	36,  // 165: jasper.JasperProcessManager.Close:output_type -> jasper.OperationOutcome
+	36,  // 166: jasper.JasperProcessManager.WriteFile:output_type -> jasper.OperationOutcome
+	36,  // 167: jasper.JasperProcessManager.TagProcess:output_type -> jasper.OperationOutcome
+	36,  // 168: jasper.JasperProcessManager.ResetTags:output_type -> jasper.OperationOutcome
+	34,  // 169: jasper.JasperProcessManager.GetTags:output_type -> jasper.ProcessTags
+	36,  // 170: jasper.JasperProcessManager.RegisterSignalTriggerID:output_type -> jasper.OperationOutcome
+	36,  // 171: jasper.JasperProcessManager.Wait:output_type -> jasper.OperationOutcome
+	29,  // 172: jasper.JasperProcessManager.Respawn:output_type -> jasper.ProcessInfo
+	36,  // 173: jasper.JasperProcessManager.ScriptingHarnessSetup:output_type -> jasper.OperationOutcome
+	36,  // 174: jasper.JasperProcessManager.ScriptingHarnessCleanup:output_type -> jasper.OperationOutcome
+	36,  // 175: jasper.JasperProcessManager.ScriptingHarnessRun:output_type -> jasper.OperationOutcome
+	55,  // 176: jasper.JasperProcessManager.ScriptingHarnessBuild:output_type -> jasper.ScriptingHarnessBuildResponse
+	36,  // 177: jasper.JasperProcessManager.ScriptingHarnessRunScript:output_type -> jasper.OperationOutcome
+	60,  // 178: jasper.JasperProcessManager.ScriptingHarnessTest:output_type -> jasper.ScriptingHarnessTestResponse
+	63,  // 179: jasper.JasperProcessManager.LoggingCacheCreate:output_type -> jasper.LoggingCacheInstance
+	63,  // 180: jasper.JasperProcessManager.LoggingCacheGet:output_type -> jasper.LoggingCacheInstance
+	36,  // 181: jasper.JasperProcessManager.LoggingCacheRemove:output_type -> jasper.OperationOutcome
+	36,  // 182: jasper.JasperProcessManager.LoggingCacheCloseAndRemove:output_type -> jasper.OperationOutcome
+	36,  // 183: jasper.JasperProcessManager.LoggingCacheClear:output_type -> jasper.OperationOutcome
+	64,  // 184: jasper.JasperProcessManager.LoggingCacheLen:output_type -> jasper.LoggingCacheLenResponse
+	36,  // 185: jasper.JasperProcessManager.LoggingCachePrune:output_type -> jasper.OperationOutcome
+	48,  // 186: jasper.JasperProcessManager.ScriptingHarnessCreate:output_type -> jasper.ScriptingHarnessID
+	36,  // 187: jasper.JasperProcessManager.ScriptingHarnessGet:output_type -> jasper.OperationOutcome
+	30,  // 188: jasper.JasperProcessManager.Status:output_type -> jasper.StatusResponse
+	36,  // 189: jasper.JasperProcessManager.ConfigureCache:output_type -> jasper.OperationOutcome
+	36,  // 190: jasper.JasperProcessManager.DownloadFile:output_type -> jasper.OperationOutcome
+	36,  // 191: jasper.JasperProcessManager.DownloadMongoDB:output_type -> jasper.OperationOutcome
+	45,  // 192: jasper.JasperProcessManager.GetLogStream:output_type -> jasper.LogStream
+	43,  // 193: jasper.JasperProcessManager.GetBuildloggerURLs:output_type -> jasper.BuildloggerURLs
+	36,  // 194: jasper.JasperProcessManager.SignalEvent:output_type -> jasper.OperationOutcome
+	36,  // 195: jasper.JasperProcessManager.SendMessages:output_type -> jasper.OperationOutcome
+	72,  // 196: jasper.JasperProcessManager.FollowLogs:output_type -> jasper.LogChunk
+	36,  // 197: jasper.JasperProcessManager.WriteStdin:output_type -> jasper.OperationOutcome
+	36,  // 198: jasper.JasperProcessManager.CloseStdin:output_type -> jasper.OperationOutcome
+	36,  // 199: jasper.JasperProcessManager.Resize:output_type -> jasper.OperationOutcome
+	29,  // 200: jasper.JasperProcessManager.History:output_type -> jasper.ProcessInfo
+	79,  // 201: jasper.JasperProcessManager.Subscribe:output_type -> jasper.ProcessEvent
+	36,  // 202: jasper.JasperProcessManager.Stop:output_type -> jasper.OperationOutcome
+	36,  // 203: jasper.JasperProcessManager.WaitReady:output_type -> jasper.OperationOutcome
+	96,  // 204: jasper.JasperProcessManager.GetCapturedOutput:output_type -> jasper.CapturedOutput
+	158, // [158:205] is the sub-list for method output_type
+	111, // [111:158] is the sub-list for method input_type
+	111, // [111:111] is the sub-list for extension type_name
+	111, // [111:111] is the sub-list for extension extendee
+	0,   // [0:111] is the sub-list for field type_name
+}
+
+func init() { file_jasper_proto_init() }
+func file_jasper_proto_init() {
+	if File_jasper_proto != nil {
+		return
 	}
-	file_jasper_proto_msgTypes[0].OneofWrappers = []interface{}{
+	file_jasper_proto_msgTypes[0].OneofWrappers = []any{
 		(*LoggerConfig_Default)(nil),
 		(*LoggerConfig_File)(nil),
 		(*LoggerConfig_Inherited)(nil),
@@ -5901,12 +7185,12 @@ func file_jasper_proto_init() {
 		(*LoggerConfig_Buildloggerv3)(nil),
 		(*LoggerConfig_Raw)(nil),
 	}
-	file_jasper_proto_msgTypes[41].OneofWrappers = []interface{}{
+	file_jasper_proto_msgTypes[41].OneofWrappers = []any{
 		(*ScriptingOptions_Golang)(nil),
 		(*ScriptingOptions_Python)(nil),
 		(*ScriptingOptions_Roswell)(nil),
 	}
-	file_jasper_proto_msgTypes[54].OneofWrappers = []interface{}{
+	file_jasper_proto_msgTypes[54].OneofWrappers = []any{
 		(*LoggingPayloadData_Msg)(nil),
 		(*LoggingPayloadData_Raw)(nil),
 	}
@@ -5914,9 +7198,9 @@ func file_jasper_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_jasper_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   59,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jasper_proto_rawDesc), len(file_jasper_proto_rawDesc)),
+			NumEnums:      11,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -5926,7 +7210,6 @@ func file_jasper_proto_init() {
 		MessageInfos:      file_jasper_proto_msgTypes,
 	}.Build()
 	File_jasper_proto = out.File
-	file_jasper_proto_rawDesc = nil
 	file_jasper_proto_goTypes = nil
 	file_jasper_proto_depIdxs = nil
 }
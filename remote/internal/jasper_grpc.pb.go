@@ -67,6 +67,15 @@ type JasperProcessManagerClient interface {
 	GetBuildloggerURLs(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*BuildloggerURLs, error)
 	SignalEvent(ctx context.Context, in *EventName, opts ...grpc.CallOption) (*OperationOutcome, error)
 	SendMessages(ctx context.Context, in *LoggingPayload, opts ...grpc.CallOption) (*OperationOutcome, error)
+	FollowLogs(ctx context.Context, in *FollowLogsRequest, opts ...grpc.CallOption) (JasperProcessManager_FollowLogsClient, error)
+	WriteStdin(ctx context.Context, opts ...grpc.CallOption) (JasperProcessManager_WriteStdinClient, error)
+	CloseStdin(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
+	Resize(ctx context.Context, in *ResizeProcess, opts ...grpc.CallOption) (*OperationOutcome, error)
+	History(ctx context.Context, in *HistoryQuery, opts ...grpc.CallOption) (JasperProcessManager_HistoryClient, error)
+	Subscribe(ctx context.Context, in *ProcessEventFilter, opts ...grpc.CallOption) (JasperProcessManager_SubscribeClient, error)
+	Stop(ctx context.Context, in *StopProcess, opts ...grpc.CallOption) (*OperationOutcome, error)
+	WaitReady(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
+	GetCapturedOutput(ctx context.Context, in *CapturedOutputRequest, opts ...grpc.CallOption) (*CapturedOutput, error)
 }
 
 type jasperProcessManagerClient struct {
@@ -490,6 +499,181 @@ func (c *jasperProcessManagerClient) SendMessages(ctx context.Context, in *Loggi
 	return out, nil
 }
 
+func (c *jasperProcessManagerClient) FollowLogs(ctx context.Context, in *FollowLogsRequest, opts ...grpc.CallOption) (JasperProcessManager_FollowLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[3], "/jasper.JasperProcessManager/FollowLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerFollowLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JasperProcessManager_FollowLogsClient interface {
+	Recv() (*LogChunk, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerFollowLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerFollowLogsClient) Recv() (*LogChunk, error) {
+	m := new(LogChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jasperProcessManagerClient) WriteStdin(ctx context.Context, opts ...grpc.CallOption) (JasperProcessManager_WriteStdinClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[4], "/jasper.JasperProcessManager/WriteStdin", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerWriteStdinClient{stream}
+	return x, nil
+}
+
+type JasperProcessManager_WriteStdinClient interface {
+	Send(*StdinChunk) error
+	CloseAndRecv() (*OperationOutcome, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerWriteStdinClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerWriteStdinClient) Send(m *StdinChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *jasperProcessManagerWriteStdinClient) CloseAndRecv() (*OperationOutcome, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(OperationOutcome)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jasperProcessManagerClient) CloseStdin(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/CloseStdin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) Resize(ctx context.Context, in *ResizeProcess, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Resize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) History(ctx context.Context, in *HistoryQuery, opts ...grpc.CallOption) (JasperProcessManager_HistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[5], "/jasper.JasperProcessManager/History", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JasperProcessManager_HistoryClient interface {
+	Recv() (*ProcessInfo, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerHistoryClient) Recv() (*ProcessInfo, error) {
+	m := new(ProcessInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jasperProcessManagerClient) Subscribe(ctx context.Context, in *ProcessEventFilter, opts ...grpc.CallOption) (JasperProcessManager_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[6], "/jasper.JasperProcessManager/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jasperProcessManagerSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type JasperProcessManager_SubscribeClient interface {
+	Recv() (*ProcessEvent, error)
+	grpc.ClientStream
+}
+
+type jasperProcessManagerSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *jasperProcessManagerSubscribeClient) Recv() (*ProcessEvent, error) {
+	m := new(ProcessEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jasperProcessManagerClient) Stop(ctx context.Context, in *StopProcess, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/Stop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) WaitReady(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/WaitReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetCapturedOutput(ctx context.Context, in *CapturedOutputRequest, opts ...grpc.CallOption) (*CapturedOutput, error) {
+	out := new(CapturedOutput)
+	err := c.cc.Invoke(ctx, "/jasper.JasperProcessManager/GetCapturedOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JasperProcessManagerServer is the server API for JasperProcessManager service.
 // All implementations must embed UnimplementedJasperProcessManagerServer
 // for forward compatibility
@@ -537,6 +721,15 @@ type JasperProcessManagerServer interface {
 	GetBuildloggerURLs(context.Context, *JasperProcessID) (*BuildloggerURLs, error)
 	SignalEvent(context.Context, *EventName) (*OperationOutcome, error)
 	SendMessages(context.Context, *LoggingPayload) (*OperationOutcome, error)
+	FollowLogs(*FollowLogsRequest, JasperProcessManager_FollowLogsServer) error
+	WriteStdin(JasperProcessManager_WriteStdinServer) error
+	CloseStdin(context.Context, *JasperProcessID) (*OperationOutcome, error)
+	Resize(context.Context, *ResizeProcess) (*OperationOutcome, error)
+	History(*HistoryQuery, JasperProcessManager_HistoryServer) error
+	Subscribe(*ProcessEventFilter, JasperProcessManager_SubscribeServer) error
+	Stop(context.Context, *StopProcess) (*OperationOutcome, error)
+	WaitReady(context.Context, *JasperProcessID) (*OperationOutcome, error)
+	GetCapturedOutput(context.Context, *CapturedOutputRequest) (*CapturedOutput, error)
 	mustEmbedUnimplementedJasperProcessManagerServer()
 }
 
@@ -658,6 +851,33 @@ func (UnimplementedJasperProcessManagerServer) SignalEvent(context.Context, *Eve
 func (UnimplementedJasperProcessManagerServer) SendMessages(context.Context, *LoggingPayload) (*OperationOutcome, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessages not implemented")
 }
+func (UnimplementedJasperProcessManagerServer) FollowLogs(*FollowLogsRequest, JasperProcessManager_FollowLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method FollowLogs not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) WriteStdin(JasperProcessManager_WriteStdinServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteStdin not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) CloseStdin(context.Context, *JasperProcessID) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseStdin not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) Resize(context.Context, *ResizeProcess) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resize not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) History(*HistoryQuery, JasperProcessManager_HistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) Subscribe(*ProcessEventFilter, JasperProcessManager_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) Stop(context.Context, *StopProcess) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) WaitReady(context.Context, *JasperProcessID) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitReady not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) GetCapturedOutput(context.Context, *CapturedOutputRequest) (*CapturedOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapturedOutput not implemented")
+}
 func (UnimplementedJasperProcessManagerServer) mustEmbedUnimplementedJasperProcessManagerServer() {}
 
 // UnsafeJasperProcessManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -1369,6 +1589,185 @@ func _JasperProcessManager_SendMessages_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JasperProcessManager_FollowLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FollowLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).FollowLogs(m, &jasperProcessManagerFollowLogsServer{stream})
+}
+
+type JasperProcessManager_FollowLogsServer interface {
+	Send(*LogChunk) error
+	grpc.ServerStream
+}
+
+type jasperProcessManagerFollowLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerFollowLogsServer) Send(m *LogChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JasperProcessManager_WriteStdin_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JasperProcessManagerServer).WriteStdin(&jasperProcessManagerWriteStdinServer{stream})
+}
+
+type JasperProcessManager_WriteStdinServer interface {
+	SendAndClose(*OperationOutcome) error
+	Recv() (*StdinChunk, error)
+	grpc.ServerStream
+}
+
+type jasperProcessManagerWriteStdinServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerWriteStdinServer) SendAndClose(m *OperationOutcome) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *jasperProcessManagerWriteStdinServer) Recv() (*StdinChunk, error) {
+	m := new(StdinChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _JasperProcessManager_CloseStdin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).CloseStdin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/CloseStdin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).CloseStdin(ctx, req.(*JasperProcessID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_Resize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeProcess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Resize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Resize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Resize(ctx, req.(*ResizeProcess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_History_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HistoryQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).History(m, &jasperProcessManagerHistoryServer{stream})
+}
+
+type JasperProcessManager_HistoryServer interface {
+	Send(*ProcessInfo) error
+	grpc.ServerStream
+}
+
+type jasperProcessManagerHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerHistoryServer) Send(m *ProcessInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JasperProcessManager_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProcessEventFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).Subscribe(m, &jasperProcessManagerSubscribeServer{stream})
+}
+
+type JasperProcessManager_SubscribeServer interface {
+	Send(*ProcessEvent) error
+	grpc.ServerStream
+}
+
+type jasperProcessManagerSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *jasperProcessManagerSubscribeServer) Send(m *ProcessEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _JasperProcessManager_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopProcess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/Stop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Stop(ctx, req.(*StopProcess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_WaitReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).WaitReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/WaitReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).WaitReady(ctx, req.(*JasperProcessID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_GetCapturedOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapturedOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).GetCapturedOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jasper.JasperProcessManager/GetCapturedOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).GetCapturedOutput(ctx, req.(*CapturedOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JasperProcessManager_ServiceDesc is the grpc.ServiceDesc for JasperProcessManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1516,6 +1915,26 @@ var JasperProcessManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessages",
 			Handler:    _JasperProcessManager_SendMessages_Handler,
 		},
+		{
+			MethodName: "CloseStdin",
+			Handler:    _JasperProcessManager_CloseStdin_Handler,
+		},
+		{
+			MethodName: "Resize",
+			Handler:    _JasperProcessManager_Resize_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _JasperProcessManager_Stop_Handler,
+		},
+		{
+			MethodName: "WaitReady",
+			Handler:    _JasperProcessManager_WaitReady_Handler,
+		},
+		{
+			MethodName: "GetCapturedOutput",
+			Handler:    _JasperProcessManager_GetCapturedOutput_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1533,6 +1952,26 @@ var JasperProcessManager_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _JasperProcessManager_WriteFile_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "FollowLogs",
+			Handler:       _JasperProcessManager_FollowLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WriteStdin",
+			Handler:       _JasperProcessManager_WriteStdin_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "History",
+			Handler:       _JasperProcessManager_History_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _JasperProcessManager_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "jasper.proto",
 }
@@ -3,8 +3,8 @@ package internal
 import (
 	"context"
 	"io"
+	"math"
 	"os"
-	"strings"
 	"sync"
 	"time"
 
@@ -16,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -117,6 +118,9 @@ func (s *jasperService) Create(ctx context.Context, opts *CreateOptions) (*Proce
 	if err != nil {
 		return nil, newGRPCError(codes.Internal, errors.Wrap(err, "exporting create options"))
 	}
+	if err := authorizeCreate(ctx, jopts); err != nil {
+		return nil, err
+	}
 
 	// Spawn a new context so that the process' context is not potentially
 	// canceled by the request's. See how rest_service.go's createProcess() does
@@ -150,12 +154,11 @@ func (s *jasperService) Create(ctx context.Context, opts *CreateOptions) (*Proce
 
 func (s *jasperService) List(f *Filter, stream JasperProcessManager_ListServer) error {
 	ctx := stream.Context()
-	name := strings.ToLower(f.GetName().String())
-	filter := options.Filter(name)
-	if err := filter.Validate(); err != nil {
-		return newGRPCError(codes.InvalidArgument, errors.Errorf("invalid filter"))
+	query := f.ExportQuery()
+	if err := query.Validate(); err != nil {
+		return newGRPCError(codes.InvalidArgument, errors.Wrap(err, "invalid filter"))
 	}
-	procs, err := s.manager.List(ctx, filter)
+	procs, err := s.manager.Query(ctx, query)
 	if err != nil {
 		return newGRPCError(codes.Internal, errors.WithStack(err))
 	}
@@ -201,6 +204,70 @@ func (s *jasperService) Group(t *TagName, stream JasperProcessManager_GroupServe
 	return nil
 }
 
+func (s *jasperService) History(q *HistoryQuery, stream JasperProcessManager_HistoryServer) error {
+	ctx := stream.Context()
+	history := s.manager.History(ctx)
+	if history == nil {
+		return newGRPCError(codes.FailedPrecondition, errors.New("process history not supported"))
+	}
+
+	query := q.Export()
+	if err := query.Validate(); err != nil {
+		return newGRPCError(codes.InvalidArgument, errors.Wrap(err, "invalid history query"))
+	}
+
+	infos, err := history.Find(ctx, query)
+	if err != nil {
+		return newGRPCError(codes.Internal, errors.Wrap(err, "finding process history"))
+	}
+
+	for _, info := range infos {
+		if ctx.Err() != nil {
+			return newGRPCError(codes.DeadlineExceeded, errors.New("history cancelled"))
+		}
+
+		convertedInfo, err := ConvertProcessInfo(info)
+		if err != nil {
+			return newGRPCError(codes.Internal, errors.Wrapf(err, "converting info for process '%s'", info.ID))
+		}
+		if err := stream.Send(convertedInfo); err != nil {
+			return newGRPCError(codes.Internal, errors.Wrap(err, "sending process info"))
+		}
+	}
+
+	return nil
+}
+
+func (s *jasperService) Subscribe(f *ProcessEventFilter, stream JasperProcessManager_SubscribeServer) error {
+	ctx := stream.Context()
+	filter := f.Export()
+	if err := filter.Validate(); err != nil {
+		return newGRPCError(codes.InvalidArgument, errors.Wrap(err, "invalid process event filter"))
+	}
+
+	events, err := s.manager.Subscribe(ctx, filter)
+	if err != nil {
+		return newGRPCError(codes.Internal, errors.Wrap(err, "subscribing to process events"))
+	}
+	// Send the headers so that the client knows that it is subscribed before
+	// any events occur.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return newGRPCError(codes.Internal, errors.Wrap(err, "sending headers"))
+	}
+
+	for event := range events {
+		convertedEvent, err := ConvertProcessEvent(event)
+		if err != nil {
+			return newGRPCError(codes.Internal, errors.Wrapf(err, "converting event for process '%s'", event.Info.ID))
+		}
+		if err := stream.Send(convertedEvent); err != nil {
+			return newGRPCError(codes.Internal, errors.Wrap(err, "sending process event"))
+		}
+	}
+
+	return nil
+}
+
 func (s *jasperService) Get(ctx context.Context, id *JasperProcessID) (*ProcessInfo, error) {
 	proc, err := s.manager.Get(ctx, id.Value)
 	if err != nil {
@@ -230,6 +297,58 @@ func (s *jasperService) Signal(ctx context.Context, sig *SignalProcess) (*Operat
 	}, nil
 }
 
+func (s *jasperService) Resize(ctx context.Context, req *ResizeProcess) (*OperationOutcome, error) {
+	if req.Size == nil || req.Size.Rows > math.MaxUint16 || req.Size.Cols > math.MaxUint16 {
+		return nil, newGRPCError(codes.InvalidArgument, errors.New("invalid pseudo-terminal size"))
+	}
+
+	proc, err := s.manager.Get(ctx, req.Id.Value)
+	if err != nil {
+		return nil, newGRPCError(codes.NotFound, errors.Wrapf(err, "getting process '%s'", req.Id.Value))
+	}
+
+	size := req.Size.Export()
+	if err = proc.Resize(ctx, size.Rows, size.Cols); err != nil {
+		return nil, newGRPCError(codes.Internal, errors.Wrapf(err, "resizing process '%s'", req.Id.Value))
+	}
+
+	return &OperationOutcome{Success: true}, nil
+}
+
+func (s *jasperService) Stop(ctx context.Context, req *StopProcess) (*OperationOutcome, error) {
+	policy := req.Policy.Export()
+	if err := policy.Validate(); err != nil {
+		return nil, newGRPCError(codes.InvalidArgument, errors.Wrap(err, "invalid stop policy"))
+	}
+
+	proc, err := s.manager.Get(ctx, req.Id.Value)
+	if err != nil {
+		return nil, newGRPCError(codes.NotFound, errors.Wrapf(err, "getting process '%s'", req.Id.Value))
+	}
+
+	if err = proc.Stop(ctx, policy); err != nil {
+		return nil, newGRPCError(codes.Internal, errors.Wrapf(err, "stopping process '%s'", req.Id.Value))
+	}
+
+	return &OperationOutcome{Success: true}, nil
+}
+
+func (s *jasperService) WaitReady(ctx context.Context, id *JasperProcessID) (*OperationOutcome, error) {
+	proc, err := s.manager.Get(ctx, id.Value)
+	if err != nil {
+		return nil, newGRPCError(codes.NotFound, errors.Wrapf(err, "getting process '%s'", id.Value))
+	}
+
+	if err = proc.WaitReady(ctx); err != nil {
+		return &OperationOutcome{
+			Success: false,
+			Text:    errors.Wrap(err, "waiting for process to become ready").Error(),
+		}, nil
+	}
+
+	return &OperationOutcome{Success: true}, nil
+}
+
 func (s *jasperService) Wait(ctx context.Context, id *JasperProcessID) (*OperationOutcome, error) {
 	proc, err := s.manager.Get(ctx, id.Value)
 	if err != nil {
@@ -340,6 +459,9 @@ func (s *jasperService) DownloadMongoDB(ctx context.Context, opts *MongoDBDownlo
 	if err := jopts.Validate(); err != nil {
 		return nil, newGRPCError(codes.InvalidArgument, errors.Wrap(err, "invalid MongoDB download options"))
 	}
+	if err := authorizePaths(ctx, jopts.Path); err != nil {
+		return nil, err
+	}
 
 	if err := jasper.SetupDownloadMongoDBReleases(ctx, s.cache, jopts); err != nil {
 		return nil, newGRPCError(codes.Internal, errors.Wrap(err, "setting up download"))
@@ -377,6 +499,9 @@ func (s *jasperService) DownloadFile(ctx context.Context, opts *DownloadInfo) (*
 	if err := jopts.Validate(); err != nil {
 		return nil, newGRPCError(codes.InvalidArgument, errors.Wrap(err, "invalid download options"))
 	}
+	if err := authorizePaths(ctx, jopts.Path, jopts.ArchiveOpts.TargetPath); err != nil {
+		return nil, err
+	}
 
 	if err := jopts.Download(); err != nil {
 		return nil, newGRPCError(codes.Internal, errors.Wrap(err, "downloading file"))
@@ -402,6 +527,46 @@ func (s *jasperService) GetLogStream(ctx context.Context, request *LogRequest) (
 	return stream, nil
 }
 
+func (s *jasperService) GetCapturedOutput(ctx context.Context, request *CapturedOutputRequest) (*CapturedOutput, error) {
+	id := request.Id.GetValue()
+	query := request.Query.Export()
+	if err := query.Validate(); err != nil {
+		return nil, newGRPCError(codes.InvalidArgument, errors.Wrap(err, "invalid output query"))
+	}
+
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		return nil, newGRPCError(codes.NotFound, errors.Wrapf(err, "getting process '%s'", id))
+	}
+
+	out, err := jasper.GetCapturedOutput(ctx, proc, query)
+	if err != nil {
+		return nil, newGRPCError(codes.Internal, errors.Wrapf(err, "getting captured output for process '%s'", id))
+	}
+
+	return ConvertCapturedOutput(out), nil
+}
+
+func (s *jasperService) FollowLogs(request *FollowLogsRequest, stream JasperProcessManager_FollowLogsServer) error {
+	ctx := stream.Context()
+	id := request.Id
+	proc, err := s.manager.Get(ctx, id.Value)
+	if err != nil {
+		return newGRPCError(codes.NotFound, errors.Wrapf(err, "getting process '%s'", id.Value))
+	}
+
+	if err := jasper.FollowInMemoryLogs(ctx, proc, int(request.Offset), func(chunk jasper.LogChunk) error {
+		return errors.Wrap(stream.Send(ConvertLogChunk(chunk)), "sending log chunk")
+	}); err != nil {
+		if ctx.Err() != nil {
+			return newGRPCError(codes.Canceled, errors.Wrapf(err, "following logs for process '%s'", id.Value))
+		}
+		return newGRPCError(codes.Internal, errors.Wrapf(err, "following logs for process '%s'", id.Value))
+	}
+
+	return nil
+}
+
 func (s *jasperService) GetBuildloggerURLs(ctx context.Context, id *JasperProcessID) (*BuildloggerURLs, error) {
 	proc, err := s.manager.Get(ctx, id.Value)
 	if err != nil {
@@ -478,6 +643,9 @@ func (s *jasperService) WriteFile(stream JasperProcessManager_WriteFileServer) e
 		}
 
 		jopts = opts.Export()
+		if err := authorizePaths(stream.Context(), jopts.Path); err != nil {
+			return err
+		}
 
 		if err := jopts.Validate(); err != nil {
 			if sendErr := stream.SendAndClose(&OperationOutcome{
@@ -522,6 +690,89 @@ func (s *jasperService) WriteFile(stream JasperProcessManager_WriteFileServer) e
 	return nil
 }
 
+func (s *jasperService) WriteStdin(stream JasperProcessManager_WriteStdinServer) error {
+	ctx := stream.Context()
+
+	sendError := func(err error, exitCode int32) error {
+		if sendErr := stream.SendAndClose(&OperationOutcome{
+			Success:  false,
+			Text:     err.Error(),
+			ExitCode: exitCode,
+		}); sendErr != nil {
+			return newGRPCError(codes.Internal, errors.Wrapf(sendErr, "sending error response to client: %s", err.Error()))
+		}
+		return nil
+	}
+
+	chunk, err := stream.Recv()
+	if err == io.EOF {
+		return sendError(errors.New("must specify the process to write to"), -2)
+	}
+	if err != nil {
+		return sendError(errors.Wrap(err, "receiving from client stream"), -2)
+	}
+	if chunk.Id == nil {
+		return sendError(errors.New("must specify the process to write to"), -2)
+	}
+
+	proc, err := s.manager.Get(ctx, chunk.Id.Value)
+	if err != nil {
+		return sendError(errors.Wrapf(err, "getting process '%s'", chunk.Id.Value), -3)
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		defer func() {
+			writer.CloseWithError(recovery.HandlePanicWithError(recover(), nil, "receiving standard input"))
+		}()
+		for {
+			if len(chunk.Data) != 0 {
+				if _, err := writer.Write(chunk.Data); err != nil {
+					return
+				}
+			}
+			chunk, err = stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				writer.CloseWithError(errors.Wrap(err, "receiving from client stream"))
+				return
+			}
+		}
+	}()
+
+	if err := jasper.WriteStandardInput(ctx, proc, reader); err != nil {
+		reader.CloseWithError(err)
+		return sendError(errors.Wrapf(err, "writing standard input to process '%s'", proc.ID()), -4)
+	}
+
+	if err := stream.SendAndClose(&OperationOutcome{
+		Success: true,
+	}); err != nil {
+		return newGRPCError(codes.Internal, errors.Wrap(err, "sending success response to client"))
+	}
+
+	return nil
+}
+
+func (s *jasperService) CloseStdin(ctx context.Context, id *JasperProcessID) (*OperationOutcome, error) {
+	proc, err := s.manager.Get(ctx, id.Value)
+	if err != nil {
+		return nil, newGRPCError(codes.NotFound, errors.Wrapf(err, "getting process '%s'", id.Value))
+	}
+
+	if err := jasper.CloseStandardInput(ctx, proc); err != nil {
+		return &OperationOutcome{
+			Success:  false,
+			Text:     errors.Wrapf(err, "closing standard input of process '%s'", id.Value).Error(),
+			ExitCode: -2,
+		}, nil
+	}
+
+	return &OperationOutcome{Success: true}, nil
+}
+
 func (s *jasperService) SendMessages(ctx context.Context, lp *LoggingPayload) (*OperationOutcome, error) {
 	lc := s.manager.LoggingCache(ctx)
 	if lc == nil {
@@ -0,0 +1,399 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evergreen-ci/certdepot"
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/send"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+const (
+	// RESTAPIKeyIDHeader is the header containing the ID of the API key that
+	// signed a request to the REST service.
+	RESTAPIKeyIDHeader = "X-Jasper-Key-Id"
+	// RESTTimestampHeader is the header containing the Unix time in seconds at
+	// which a request to the REST service was signed.
+	RESTTimestampHeader = "X-Jasper-Timestamp"
+	// RESTContentHashHeader is the header containing the hex-encoded SHA-256
+	// hash of the body of a signed request to the REST service, or
+	// RESTUnsignedPayload if the body is not signed.
+	RESTContentHashHeader = "X-Jasper-Content-Sha256"
+	// RESTSignatureHeader is the header containing the hex-encoded HMAC-SHA256
+	// signature of a request to the REST service.
+	RESTSignatureHeader = "X-Jasper-Signature"
+	// RESTUnsignedPayload is the content hash of a signed request whose body
+	// is streamed and therefore cannot be hashed before it is sent.
+	RESTUnsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// DefaultRESTMaxClockSkew is the default maximum difference between the
+	// time at which a request is signed and the time at which the REST
+	// service receives it.
+	DefaultRESTMaxClockSkew = 5 * time.Minute
+)
+
+// APIKey is a shared secret that is used to sign requests to the REST service.
+type APIKey struct {
+	// ID identifies the key and is sent in plain text with each request.
+	ID string `bson:"id" json:"id" yaml:"id"`
+	// Secret is used to sign requests and is never sent.
+	Secret string `bson:"secret" json:"secret" yaml:"secret"`
+}
+
+// Validate checks that the API key has an ID and a secret.
+func (k *APIKey) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(k.ID == "", "API key must have an ID")
+	catcher.NewWhen(k.Secret == "", "API key must have a secret")
+	return catcher.Resolve()
+}
+
+// BearerToken is a token that is sent as is to authenticate requests to the
+// REST service.
+type BearerToken struct {
+	// ID identifies the client that uses the token. It is never sent.
+	ID string `bson:"id" json:"id" yaml:"id"`
+	// Token is the value of the token.
+	Token string `bson:"token" json:"token" yaml:"token"`
+}
+
+// RESTAuth describes the credentials that the REST service accepts. A request
+// is authenticated if it has any one of the following:
+//
+//   - An "Authorization: Bearer <token>" header with one of the bearer tokens.
+//   - An HMAC signature from one of the API keys. The request must set the
+//     RESTAPIKeyIDHeader, RESTTimestampHeader, RESTContentHashHeader and
+//     RESTSignatureHeader headers, where the signature is the HMAC-SHA256 of
+//     the method, request URI, timestamp and content hash, separated by
+//     newlines. See SignRESTRequest.
+//
+// Signed requests are only accepted within MaxClockSkew of their timestamp.
+// The signature does not prevent a request from being replayed within that
+// window, so the service should also use TLS.
+//
+// The ID of the bearer token or API key identifies the client for
+// authorization.
+type RESTAuth struct {
+	BearerTokens []BearerToken `bson:"bearer_tokens,omitempty" json:"bearer_tokens,omitempty" yaml:"bearer_tokens,omitempty"`
+	APIKeys      []APIKey      `bson:"api_keys,omitempty" json:"api_keys,omitempty" yaml:"api_keys,omitempty"`
+	MaxClockSkew time.Duration `bson:"max_clock_skew,omitempty" json:"max_clock_skew,omitempty" yaml:"max_clock_skew,omitempty"`
+}
+
+// NewRESTAuthFromFile reads the JSON-encoded REST authentication settings from
+// the file at the given path.
+func NewRESTAuthFromFile(path string) (*RESTAuth, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading authentication file")
+	}
+
+	auth := RESTAuth{}
+	if err := json.Unmarshal(contents, &auth); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling JSON contents of authentication file")
+	}
+	if err := auth.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid authentication from file")
+	}
+
+	return &auth, nil
+}
+
+// Validate checks that at least one credential is accepted, that no
+// credential is empty and that IDs are unique. It sets MaxClockSkew
+// to DefaultRESTMaxClockSkew if it is unset.
+func (a *RESTAuth) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	catcher.NewWhen(len(a.BearerTokens) == 0 && len(a.APIKeys) == 0, "must specify at least one bearer token or API key")
+	ids := map[string]bool{}
+	for _, token := range a.BearerTokens {
+		catcher.NewWhen(token.ID == "", "bearer token must have an ID")
+		catcher.ErrorfWhen(token.Token == "", "bearer token '%s' cannot be empty", token.ID)
+		catcher.ErrorfWhen(ids[token.ID], "duplicate bearer token ID '%s'", token.ID)
+		ids[token.ID] = true
+	}
+	for _, key := range a.APIKeys {
+		catcher.Wrapf(key.Validate(), "invalid API key '%s'", key.ID)
+		catcher.ErrorfWhen(ids[key.ID], "duplicate ID '%s'", key.ID)
+		ids[key.ID] = true
+	}
+	catcher.NewWhen(a.MaxClockSkew < 0, "max clock skew cannot be negative")
+	if a.MaxClockSkew == 0 {
+		a.MaxClockSkew = DefaultRESTMaxClockSkew
+	}
+	return catcher.Resolve()
+}
+
+// RESTServiceOptions describe how the REST service secures and audits
+// requests.
+type RESTServiceOptions struct {
+	// Credentials, if set, are used to serve requests over TLS. Clients must
+	// present a certificate signed by the credentials' CA.
+	Credentials *certdepot.Credentials
+	// Auth, if set, requires each request to be authenticated.
+	Auth *RESTAuth
+	// Authorization, if set, limits the operations that each client can
+	// perform.
+	Authorization *options.Authorization
+	// Audit, if set, is the sender to which the service sends an audit
+	// record of each request that could modify the manager, its processes or
+	// the host.
+	Audit send.Sender
+}
+
+// Validate checks that the credentials and authentication settings are valid.
+func (o *RESTServiceOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	if o.Credentials != nil {
+		catcher.Wrap(o.Credentials.Validate(), "invalid credentials")
+	}
+	if o.Auth != nil {
+		catcher.Wrap(o.Auth.Validate(), "invalid authentication")
+	}
+	if o.Authorization != nil {
+		catcher.Wrap(o.Authorization.Validate(), "invalid authorization")
+	}
+	return catcher.Resolve()
+}
+
+// RESTClientOptions describe how a REST client connects and authenticates to
+// the REST service.
+type RESTClientOptions struct {
+	// Credentials, if set, are used to connect to the service over TLS and
+	// to present the client certificate to the service.
+	Credentials *certdepot.Credentials `bson:"credentials,omitempty" json:"credentials,omitempty" yaml:"credentials,omitempty"`
+	// BearerToken, if set, is sent with each request.
+	BearerToken string `bson:"bearer_token,omitempty" json:"bearer_token,omitempty" yaml:"bearer_token,omitempty"`
+	// APIKey, if set, is used to sign each request.
+	APIKey *APIKey `bson:"api_key,omitempty" json:"api_key,omitempty" yaml:"api_key,omitempty"`
+}
+
+// NewRESTClientOptionsFromFile reads the client options from the JSON file at
+// the given path. The file has the same format as a certdepot credentials
+// file with the optional additional fields "bearer_token" and "api_key", so
+// the same credentials file can be used for both the RPC and REST clients.
+// If the file does not contain a certificate, the client does not use TLS.
+func NewRESTClientOptionsFromFile(path string) (*RESTClientOptions, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading credentials file")
+	}
+
+	file := struct {
+		certdepot.Credentials
+		BearerToken string  `json:"bearer_token"`
+		APIKey      *APIKey `json:"api_key"`
+	}{}
+	if err := json.Unmarshal(contents, &file); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling JSON contents of credentials file")
+	}
+
+	opts := RESTClientOptions{
+		BearerToken: file.BearerToken,
+		APIKey:      file.APIKey,
+	}
+	if len(file.CACert) != 0 || len(file.Cert) != 0 || len(file.Key) != 0 {
+		opts.Credentials = &file.Credentials
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid credentials from file")
+	}
+
+	return &opts, nil
+}
+
+// Validate checks that the credentials are valid and that at most one of the
+// bearer token and API key is set.
+func (o *RESTClientOptions) Validate() error {
+	catcher := grip.NewBasicCatcher()
+	if o.Credentials != nil {
+		catcher.Wrap(o.Credentials.Validate(), "invalid credentials")
+	}
+	catcher.NewWhen(o.BearerToken != "" && o.APIKey != nil, "cannot specify both a bearer token and an API key")
+	if o.APIKey != nil {
+		catcher.Wrap(o.APIKey.Validate(), "invalid API key")
+	}
+	return catcher.Resolve()
+}
+
+// SignRESTRequest signs the request with the API key at the current time. If
+// the request body can be read again using GetBody, the signature covers the
+// body; otherwise, the body is sent as RESTUnsignedPayload.
+func SignRESTRequest(req *http.Request, key APIKey) error {
+	if err := key.Validate(); err != nil {
+		return errors.Wrap(err, "invalid API key")
+	}
+
+	contentHash := RESTUnsignedPayload
+	switch {
+	case req.Body == nil || req.Body == http.NoBody:
+		contentHash = hashRESTPayload(nil)
+	case req.GetBody != nil:
+		body, err := req.GetBody()
+		if err != nil {
+			return errors.Wrap(err, "getting request body")
+		}
+		defer body.Close()
+		payload, err := io.ReadAll(body)
+		if err != nil {
+			return errors.Wrap(err, "reading request body")
+		}
+		contentHash = hashRESTPayload(payload)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(RESTAPIKeyIDHeader, key.ID)
+	req.Header.Set(RESTTimestampHeader, timestamp)
+	req.Header.Set(RESTContentHashHeader, contentHash)
+	req.Header.Set(RESTSignatureHeader, signRESTRequest(key.Secret, req.Method, req.URL.RequestURI(), timestamp, contentHash))
+
+	return nil
+}
+
+func hashRESTPayload(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
+
+func signRESTRequest(secret, method, uri, timestamp, contentHash string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = io.WriteString(mac, strings.Join([]string{method, uri, timestamp, contentHash}, "\n"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewRESTAuthMiddleware returns middleware for the REST service's gimlet
+// application that rejects requests that are not authenticated according to
+// the given settings.
+func NewRESTAuthMiddleware(auth RESTAuth) (gimlet.Middleware, error) {
+	if err := auth.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid authentication")
+	}
+
+	keys := make(map[string]string, len(auth.APIKeys))
+	for _, key := range auth.APIKeys {
+		keys[key.ID] = key.Secret
+	}
+
+	return &restAuthMiddleware{
+		tokens:       append([]BearerToken{}, auth.BearerTokens...),
+		keys:         keys,
+		maxClockSkew: auth.MaxClockSkew,
+	}, nil
+}
+
+type restAuthMiddleware struct {
+	tokens       []BearerToken
+	keys         map[string]string
+	maxClockSkew time.Duration
+}
+
+func (m *restAuthMiddleware) ServeHTTP(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	identity, err := m.authenticate(r)
+	if err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    errors.Wrap(err, "authenticating request").Error(),
+		})
+		return
+	}
+
+	next(rw, r.WithContext(context.WithValue(r.Context(), restIdentityKey{}, identity)))
+}
+
+type restIdentityKey struct{}
+
+// restClientIdentity returns the identity of the client that made the
+// request. This is the ID of the bearer token or API key that authenticated
+// the request, if any, and otherwise the common name of the client's verified
+// TLS certificate. It returns an empty string if the client is not
+// identified.
+func restClientIdentity(r *http.Request) string {
+	if identity, ok := r.Context().Value(restIdentityKey{}).(string); ok {
+		return identity
+	}
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return r.TLS.VerifiedChains[0][0].Subject.CommonName
+}
+
+// authenticate checks the request's credentials and returns the ID of the
+// bearer token or API key that it was authenticated with.
+func (m *restAuthMiddleware) authenticate(r *http.Request) (string, error) {
+	if header := r.Header.Get("Authorization"); header != "" {
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok {
+			return "", errors.New("unsupported authorization scheme")
+		}
+		// Compare against every token so that the time taken does not
+		// reveal which token matched.
+		var identity string
+		for _, expected := range m.tokens {
+			if subtle.ConstantTimeCompare([]byte(token), []byte(expected.Token)) == 1 {
+				identity = expected.ID
+			}
+		}
+		if identity == "" {
+			return "", errors.New("invalid bearer token")
+		}
+		return identity, nil
+	}
+
+	if keyID := r.Header.Get(RESTAPIKeyIDHeader); keyID != "" {
+		if err := m.verifySignature(r, keyID); err != nil {
+			return "", errors.WithStack(err)
+		}
+		return keyID, nil
+	}
+
+	return "", errors.New("request has no credentials")
+}
+
+func (m *restAuthMiddleware) verifySignature(r *http.Request, keyID string) error {
+	secret, ok := m.keys[keyID]
+	if !ok {
+		return errors.New("invalid API key")
+	}
+
+	timestamp := r.Header.Get(RESTTimestampHeader)
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "parsing request timestamp")
+	}
+	if skew := time.Since(time.Unix(signedAt, 0)).Abs(); skew > m.maxClockSkew {
+		return errors.Errorf("request timestamp is outside the allowed clock skew of %s", m.maxClockSkew)
+	}
+
+	contentHash := r.Header.Get(RESTContentHashHeader)
+	if contentHash != RESTUnsignedPayload {
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			return errors.Wrap(err, "reading request body")
+		}
+		r.Body = io.NopCloser(bytes.NewReader(payload))
+		if !hmac.Equal([]byte(contentHash), []byte(hashRESTPayload(payload))) {
+			return errors.New("request body does not match content hash")
+		}
+	}
+
+	expected := signRESTRequest(secret, r.Method, r.URL.RequestURI(), timestamp, contentHash)
+	if !hmac.Equal([]byte(r.Header.Get(RESTSignatureHeader)), []byte(expected)) {
+		return errors.New("invalid request signature")
+	}
+
+	return nil
+}
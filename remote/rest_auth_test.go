@@ -0,0 +1,248 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	testoptions "github.com/mongodb/jasper/testutil/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTAuth(t *testing.T) {
+	apiKey := APIKey{ID: "ci", Secret: "secret"}
+	auth := &RESTAuth{
+		BearerTokens: []BearerToken{{ID: "ci-token", Token: "token"}},
+		APIKeys:      []APIKey{apiKey},
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T){
+		"BearerTokenIsAccepted": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Auth: auth})
+			client := newTestRESTClientWithOptions(t, addr, RESTClientOptions{BearerToken: "token"})
+			_, err := client.List(ctx, options.All)
+			assert.NoError(t, err)
+		},
+		"InvalidBearerTokenIsRejected": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Auth: auth})
+			client := newTestRESTClientWithOptions(t, addr, RESTClientOptions{BearerToken: "foo"})
+			_, err := client.List(ctx, options.All)
+			assert.Error(t, err)
+		},
+		"RequestWithoutCredentialsIsRejected": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Auth: auth})
+			client := newTestRESTClientWithOptions(t, addr, RESTClientOptions{})
+			_, err := client.List(ctx, options.All)
+			assert.Error(t, err)
+		},
+		"SignedRequestsAreAccepted": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Auth: auth})
+			client := newTestRESTClientWithOptions(t, addr, RESTClientOptions{APIKey: &apiKey})
+			opts := testoptions.SleepCreateOpts(10)
+			opts.StandardInputStream = true
+			proc, err := client.CreateProcess(ctx, opts)
+			require.NoError(t, err)
+			// Standard input is streamed, so its body is not signed.
+			assert.NoError(t, client.WriteStdin(ctx, proc.ID(), io.MultiReader(bytes.NewBufferString("foo"))))
+			assert.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+		},
+		"RequestSignedWithInvalidSecretIsRejected": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Auth: auth})
+			client := newTestRESTClientWithOptions(t, addr, RESTClientOptions{APIKey: &APIKey{ID: apiKey.ID, Secret: "foo"}})
+			_, err := client.List(ctx, options.All)
+			assert.Error(t, err)
+		},
+		"MutualTLSConnectionIsAccepted": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Credentials: loadTestCredentials(t, "server")})
+			client := newTestRESTClientWithOptions(t, addr, RESTClientOptions{Credentials: loadTestCredentials(t, "client")})
+			_, err := client.List(ctx, options.All)
+			assert.NoError(t, err)
+		},
+		"MutualTLSWithBearerTokenIsAccepted": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Credentials: loadTestCredentials(t, "server"), Auth: auth})
+			client := newTestRESTClientWithOptions(t, addr, RESTClientOptions{Credentials: loadTestCredentials(t, "client"), BearerToken: "token"})
+			_, err := client.List(ctx, options.All)
+			assert.NoError(t, err)
+		},
+		"ConnectionWithoutTLSIsRejected": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Credentials: loadTestCredentials(t, "server")})
+			client := newTestRESTClientWithOptions(t, addr, RESTClientOptions{})
+			_, err := client.List(ctx, options.All)
+			assert.Error(t, err)
+		},
+		"ConnectionWithoutClientCertificateIsRejected": func(ctx context.Context, t *testing.T) {
+			addr := startTestRESTService(ctx, t, RESTServiceOptions{Credentials: loadTestCredentials(t, "server")})
+			creds := loadTestCredentials(t, "client")
+			tlsConf, err := creds.Resolve()
+			require.NoError(t, err)
+			tlsConf.Certificates = nil
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.TLSClientConfig = tlsConf
+			httpClient := &http.Client{Transport: transport}
+			defer httpClient.CloseIdleConnections()
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s/jasper/v1/id", addr), nil)
+			require.NoError(t, err)
+			resp, err := httpClient.Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+			assert.Error(t, err)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			testCase(ctx, t)
+		})
+	}
+}
+
+func TestRESTAuthMiddleware(t *testing.T) {
+	apiKey := APIKey{ID: "ci", Secret: "secret"}
+	middleware, err := NewRESTAuthMiddleware(RESTAuth{
+		BearerTokens: []BearerToken{{ID: "ci-token", Token: "token"}},
+		APIKeys:      []APIKey{apiKey},
+		MaxClockSkew: time.Minute,
+	})
+	require.NoError(t, err)
+
+	// serve runs the request through the middleware and returns the response
+	// status code and the body received by the next handler.
+	serve := func(t *testing.T, req *http.Request) (int, string) {
+		var body bytes.Buffer
+		rw := httptest.NewRecorder()
+		middleware.ServeHTTP(rw, req, func(rw http.ResponseWriter, r *http.Request) {
+			_, err := body.ReadFrom(r.Body)
+			require.NoError(t, err)
+		})
+		return rw.Code, body.String()
+	}
+	newRequest := func(t *testing.T, body string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "http://localhost/jasper/v1/create?foo=bar", bytes.NewBufferString(body))
+		require.NoError(t, err)
+		return req
+	}
+	sign := func(t *testing.T, req *http.Request) *http.Request {
+		require.NoError(t, SignRESTRequest(req, apiKey))
+		return req
+	}
+
+	for testName, testCase := range map[string]func(t *testing.T){
+		"SignedRequestPassesBodyToNextHandler": func(t *testing.T) {
+			code, body := serve(t, sign(t, newRequest(t, "foo")))
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "foo", body)
+		},
+		"SignedRequestWithModifiedBodyIsRejected": func(t *testing.T) {
+			req := sign(t, newRequest(t, "foo"))
+			req.Body = http.NoBody
+			code, _ := serve(t, req)
+			assert.Equal(t, http.StatusUnauthorized, code)
+		},
+		"SignedRequestWithModifiedURIIsRejected": func(t *testing.T) {
+			req := sign(t, newRequest(t, "foo"))
+			req.URL.RawQuery = "foo=baz"
+			code, _ := serve(t, req)
+			assert.Equal(t, http.StatusUnauthorized, code)
+		},
+		"StaleSignedRequestIsRejected": func(t *testing.T) {
+			req := sign(t, newRequest(t, ""))
+			timestamp := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+			req.Header.Set(RESTTimestampHeader, timestamp)
+			req.Header.Set(RESTSignatureHeader, signRESTRequest(apiKey.Secret, req.Method, req.URL.RequestURI(), timestamp, req.Header.Get(RESTContentHashHeader)))
+			code, _ := serve(t, req)
+			assert.Equal(t, http.StatusUnauthorized, code)
+		},
+		"StreamedBodyIsUnsigned": func(t *testing.T) {
+			req := newRequest(t, "foo")
+			req.GetBody = nil
+			sign(t, req)
+			assert.Equal(t, RESTUnsignedPayload, req.Header.Get(RESTContentHashHeader))
+			code, body := serve(t, req)
+			assert.Equal(t, http.StatusOK, code)
+			assert.Equal(t, "foo", body)
+		},
+		"UnsupportedAuthorizationSchemeIsRejected": func(t *testing.T) {
+			req := newRequest(t, "")
+			req.SetBasicAuth("user", "token")
+			code, _ := serve(t, req)
+			assert.Equal(t, http.StatusUnauthorized, code)
+		},
+	} {
+		t.Run(testName, testCase)
+	}
+}
+
+func TestRESTAuthOptions(t *testing.T) {
+	t.Run("AuthRequiresCredential", func(t *testing.T) {
+		assert.Error(t, (&RESTAuth{}).Validate())
+		assert.Error(t, (&RESTAuth{BearerTokens: []BearerToken{{ID: "foo"}}}).Validate())
+		assert.Error(t, (&RESTAuth{APIKeys: []APIKey{{ID: "foo"}}}).Validate())
+	})
+	t.Run("AuthRejectsDuplicateIDs", func(t *testing.T) {
+		auth := RESTAuth{APIKeys: []APIKey{{ID: "foo", Secret: "bar"}, {ID: "foo", Secret: "bat"}}}
+		assert.Error(t, auth.Validate())
+		auth = RESTAuth{
+			BearerTokens: []BearerToken{{ID: "foo", Token: "bar"}},
+			APIKeys:      []APIKey{{ID: "foo", Secret: "bat"}},
+		}
+		assert.Error(t, auth.Validate())
+	})
+	t.Run("AuthDefaultsMaxClockSkew", func(t *testing.T) {
+		auth := RESTAuth{BearerTokens: []BearerToken{{ID: "foo", Token: "bar"}}}
+		require.NoError(t, auth.Validate())
+		assert.Equal(t, DefaultRESTMaxClockSkew, auth.MaxClockSkew)
+	})
+	t.Run("ClientRejectsBearerTokenAndAPIKey", func(t *testing.T) {
+		opts := RESTClientOptions{BearerToken: "foo", APIKey: &APIKey{ID: "bar", Secret: "bat"}}
+		assert.Error(t, opts.Validate())
+	})
+	t.Run("ClientOptionsFromFile", func(t *testing.T) {
+		writeFile := func(t *testing.T, contents interface{}) string {
+			b, err := json.Marshal(contents)
+			require.NoError(t, err)
+			path := filepath.Join(t.TempDir(), "creds.json")
+			require.NoError(t, os.WriteFile(path, b, 0600))
+			return path
+		}
+
+		t.Run("WithoutCertificateDoesNotUseTLS", func(t *testing.T) {
+			opts, err := NewRESTClientOptionsFromFile(writeFile(t, map[string]string{"bearer_token": "foo"}))
+			require.NoError(t, err)
+			assert.Nil(t, opts.Credentials)
+			assert.Equal(t, "foo", opts.BearerToken)
+		})
+		t.Run("ReadsCertificateAndAPIKey", func(t *testing.T) {
+			opts, err := NewRESTClientOptionsFromFile(writeFile(t, map[string]interface{}{
+				"ca_cert": []byte("ca"),
+				"cert":    []byte("cert"),
+				"key":     []byte("key"),
+				"api_key": APIKey{ID: "foo", Secret: "bar"},
+			}))
+			require.NoError(t, err)
+			require.NotNil(t, opts.Credentials)
+			assert.Equal(t, []byte("cert"), opts.Credentials.Cert)
+			require.NotNil(t, opts.APIKey)
+			assert.Equal(t, "foo", opts.APIKey.ID)
+		})
+		t.Run("IncompleteCertificateErrors", func(t *testing.T) {
+			_, err := NewRESTClientOptionsFromFile(writeFile(t, map[string]interface{}{"cert": []byte("cert")}))
+			assert.Error(t, err)
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -8,7 +9,10 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
@@ -40,16 +44,72 @@ func NewRESTClientWithExistingClient(addr net.Addr, client *http.Client) Manager
 	}
 }
 
+// NewRESTClientWithOptions creates a REST client that connects to the given
+// address running the Jasper REST service. If the options contain
+// credentials, the client connects to the service over TLS; otherwise, it
+// behaves like NewRESTClient. The HTTP client should be cleaned up by calling
+// CloseConnection.
+func NewRESTClientWithOptions(addr net.Addr, opts RESTClientOptions) (Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid client options")
+	}
+
+	c := &restClient{
+		prefix:      fmt.Sprintf("http://%s/jasper/v1", addr),
+		bearerToken: opts.BearerToken,
+		apiKey:      opts.APIKey,
+	}
+	if opts.Credentials == nil {
+		c.client = utility.GetHTTPClient()
+		c.ownClient = true
+		return c, nil
+	}
+
+	tlsConf, err := opts.Credentials.Resolve()
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving credentials into TLS config")
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConf
+	c.prefix = fmt.Sprintf("https://%s/jasper/v1", addr)
+	c.client = &http.Client{Transport: transport}
+	c.ownTransport = true
+
+	return c, nil
+}
+
+// NewRESTClientWithFile is the same as NewRESTClientWithOptions, but the
+// options are read from the file given by filePath if the filePath is
+// non-empty. See NewRESTClientOptionsFromFile for the file format.
+func NewRESTClientWithFile(addr net.Addr, filePath string) (Manager, error) {
+	if filePath == "" {
+		return NewRESTClient(addr), nil
+	}
+
+	opts, err := NewRESTClientOptionsFromFile(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting client options from file")
+	}
+
+	return NewRESTClientWithOptions(addr, *opts)
+}
+
 type restClient struct {
-	prefix    string
-	client    *http.Client
-	ownClient bool
+	prefix       string
+	client       *http.Client
+	ownClient    bool
+	ownTransport bool
+	bearerToken  string
+	apiKey       *APIKey
 }
 
 func (c *restClient) CloseConnection() error {
 	if c.ownClient {
 		utility.PutHTTPClient(c.client)
 	}
+	if c.ownTransport {
+		c.client.CloseIdleConnections()
+	}
 	return nil
 }
 
@@ -96,13 +156,35 @@ func handleError(resp *http.Response) error {
 }
 
 func (c *restClient) doRequest(ctx context.Context, method string, url string, body io.Reader) (*http.Response, error) {
+	return c.doRequestWithClient(ctx, c.client, method, url, body)
+}
+
+// doStreamingRequest is the same as doRequest, but the request is not limited
+// by the HTTP client's timeout, so the request or response body can be
+// streamed for as long as the context allows.
+func (c *restClient) doStreamingRequest(ctx context.Context, method string, url string, body io.Reader) (*http.Response, error) {
+	client := *c.client
+	client.Timeout = 0
+	return c.doRequestWithClient(ctx, &client, method, url, body)
+}
+
+func (c *restClient) doRequestWithClient(ctx context.Context, client *http.Client, method string, url string, body io.Reader) (*http.Response, error) {
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
 		return nil, errors.Wrap(err, "building request")
 	}
 
 	req = req.WithContext(ctx)
-	resp, err := c.client.Do(req)
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+	if c.apiKey != nil {
+		if err = SignRESTRequest(req, *c.apiKey); err != nil {
+			return nil, errors.Wrap(err, "signing request")
+		}
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, errors.Wrap(err, "making request")
 	}
@@ -198,6 +280,57 @@ func (c *restClient) List(ctx context.Context, f options.Filter) ([]jasper.Proce
 	return out, errors.WithStack(err)
 }
 
+func (c *restClient) Query(ctx context.Context, q options.ProcessQuery) ([]jasper.Process, error) {
+	if err := q.Validate(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/list/%s?%s", string(q.Status), processQueryValues(q).Encode()), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "making request")
+	}
+	defer resp.Body.Close()
+
+	if err = handleError(resp); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	out, err := c.getListOfProcesses(resp)
+
+	return out, errors.WithStack(err)
+}
+
+// processQueryValues returns the URL query parameters that represent the
+// process query, excluding its status.
+func processQueryValues(q options.ProcessQuery) url.Values {
+	vals := url.Values{}
+	for _, tags := range q.Tags {
+		vals.Add("tags", strings.Join(tags, ","))
+	}
+	if q.MinExitCode != nil {
+		vals.Set("min_exit_code", strconv.Itoa(*q.MinExitCode))
+	}
+	if q.MaxExitCode != nil {
+		vals.Set("max_exit_code", strconv.Itoa(*q.MaxExitCode))
+	}
+	if !q.StartedAfter.IsZero() {
+		vals.Set("started_after", q.StartedAfter.Format(time.RFC3339Nano))
+	}
+	if !q.StartedBefore.IsZero() {
+		vals.Set("started_before", q.StartedBefore.Format(time.RFC3339Nano))
+	}
+	if q.Args != "" {
+		vals.Set("args", q.Args)
+	}
+	if q.WorkingDirectory != "" {
+		vals.Set("working_directory", q.WorkingDirectory)
+	}
+	if q.Host != "" {
+		vals.Set("host", q.Host)
+	}
+	return vals
+}
+
 func (c *restClient) Group(ctx context.Context, name string) ([]jasper.Process, error) {
 	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/list/group/%s", name), nil)
 	if err != nil {
@@ -303,6 +436,165 @@ func (c *restClient) GetLogStream(ctx context.Context, id string, count int) (ja
 	return stream, nil
 }
 
+func (c *restClient) GetCapturedOutput(ctx context.Context, id string, query options.OutputQuery) (*jasper.CapturedOutput, error) {
+	if err := query.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid output query")
+	}
+
+	body, err := makeBody(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "building request")
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/process/%s/output", id), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	out := &jasper.CapturedOutput{}
+	if err = gimlet.GetJSON(resp.Body, out); err != nil {
+		return nil, errors.Wrap(err, "reading captured output from response")
+	}
+
+	return out, nil
+}
+
+func (c *restClient) FollowLogs(ctx context.Context, id string, offset int, handler func(jasper.LogChunk) error) error {
+	resp, err := c.doStreamingRequest(ctx, http.MethodGet, c.getURL("/process/%s/follow-logs?offset=%d", id, offset), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = readServerSentEvents(resp.Body, func(data []byte) (bool, error) {
+		chunk := jasper.LogChunk{}
+		if err := json.Unmarshal(data, &chunk); err != nil {
+			return false, errors.Wrap(err, "reading log chunk from log stream")
+		}
+		if err := handler(chunk); err != nil {
+			return false, err
+		}
+		return chunk.Done, nil
+	})
+	if err == io.EOF {
+		return errors.New("log stream ended before the process completed")
+	}
+	return err
+}
+
+func (c *restClient) Subscribe(ctx context.Context, f options.ProcessEventFilter) (<-chan jasper.ProcessEvent, error) {
+	if err := f.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid filter")
+	}
+
+	vals := url.Values{}
+	for _, tag := range f.Tags {
+		vals.Add("tags", tag)
+	}
+	for _, t := range f.Types {
+		vals.Add("types", string(t))
+	}
+
+	// The service responds once it has subscribed, so the request returns
+	// before any events are missed.
+	resp, err := c.doStreamingRequest(ctx, http.MethodGet, c.getURL("/subscribe?%s", vals.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan jasper.ProcessEvent, jasper.ProcessEventBufferSize)
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+
+		err := readServerSentEvents(resp.Body, func(data []byte) (bool, error) {
+			event := jasper.ProcessEvent{}
+			if err := json.Unmarshal(data, &event); err != nil {
+				return false, errors.Wrap(err, "reading process event from event stream")
+			}
+			select {
+			case events <- event:
+				return false, nil
+			case <-ctx.Done():
+				return true, nil
+			}
+		})
+		if err != nil && err != io.EOF && ctx.Err() == nil {
+			grip.Warning(ctx, errors.Wrap(err, "reading process events"))
+		}
+	}()
+
+	return events, nil
+}
+
+// readServerSentEvents calls the handler with the data of each server-sent
+// event read from r until the handler returns done or an error. It returns
+// io.EOF if the stream ends first. An error event is returned as a
+// gimlet.ErrorResponse.
+func readServerSentEvents(r io.Reader, handler func(data []byte) (done bool, err error)) error {
+	reader := bufio.NewReader(r)
+	var event string
+	var data []byte
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return io.EOF
+		}
+		if err != nil {
+			return errors.Wrap(err, "reading event stream")
+		}
+
+		line = bytes.TrimRight(line, "\r\n")
+		switch {
+		case len(line) == 0:
+			if data == nil {
+				continue
+			}
+			if event == "error" {
+				gimerr := gimlet.ErrorResponse{}
+				if err := json.Unmarshal(data, &gimerr); err != nil {
+					return errors.Wrap(err, "reading error from event stream")
+				}
+				return gimerr
+			}
+
+			done, err := handler(data)
+			if err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
+			event, data = "", nil
+		case bytes.HasPrefix(line, []byte("event:")):
+			event = string(bytes.TrimSpace(bytes.TrimPrefix(line, []byte("event:"))))
+		case bytes.HasPrefix(line, []byte("data:")):
+			data = append(data, bytes.TrimSpace(bytes.TrimPrefix(line, []byte("data:")))...)
+		}
+	}
+}
+
+func (c *restClient) WriteStdin(ctx context.Context, id string, input io.Reader) error {
+	resp, err := c.doStreamingRequest(ctx, http.MethodPut, c.getURL("/process/%s/stdin", id), input)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *restClient) CloseStdin(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, c.getURL("/process/%s/stdin", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 func (c *restClient) DownloadFile(ctx context.Context, opts options.Download) error {
 	body, err := makeBody(opts)
 	if err != nil {
@@ -395,6 +687,10 @@ func (c *restClient) SendMessages(ctx context.Context, lp options.LoggingPayload
 	return nil
 }
 
+func (c *restClient) History(ctx context.Context) jasper.ProcessHistory {
+	return &restProcessHistory{client: c}
+}
+
 func (c *restClient) LoggingCache(ctx context.Context) jasper.LoggingCache {
 	return &restLoggingCache{
 		client: c,
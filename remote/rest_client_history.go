@@ -0,0 +1,49 @@
+package remote
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/evergreen-ci/gimlet"
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// restProcessHistory is the client-side representation of a
+// jasper.ProcessHistory for making requests to the remote REST service.
+type restProcessHistory struct {
+	client *restClient
+}
+
+func (h *restProcessHistory) Put(ctx context.Context, info jasper.ProcessInfo) error {
+	return errors.New("operation not supported for remote managers")
+}
+
+func (h *restProcessHistory) Find(ctx context.Context, q options.HistoryQuery) ([]jasper.ProcessInfo, error) {
+	if err := q.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid history query")
+	}
+
+	body, err := makeBody(q)
+	if err != nil {
+		return nil, errors.Wrap(err, "building request")
+	}
+
+	resp, err := h.client.doRequest(ctx, http.MethodPost, h.client.getURL("/history"), body)
+	if err != nil {
+		return nil, errors.Wrap(err, "making request")
+	}
+	defer resp.Body.Close()
+
+	if err = handleError(resp); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	out := []jasper.ProcessInfo{}
+	if err = gimlet.GetJSON(resp.Body, &out); err != nil {
+		return nil, errors.Wrap(err, "reading process history from response")
+	}
+
+	return out, nil
+}
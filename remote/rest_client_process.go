@@ -9,6 +9,7 @@ import (
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 	"github.com/pkg/errors"
 )
 
@@ -58,6 +59,36 @@ func (p *restProcess) Signal(ctx context.Context, sig syscall.Signal) error {
 	return nil
 }
 
+func (p *restProcess) Resize(ctx context.Context, rows, cols uint16) error {
+	body, err := makeBody(options.TTY{Rows: rows, Cols: cols})
+	if err != nil {
+		return errors.Wrap(err, "building request")
+	}
+
+	resp, err := p.client.doRequest(ctx, http.MethodPatch, p.client.getURL("/process/%s/resize", p.id), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (p *restProcess) Stop(ctx context.Context, policy options.StopPolicy) error {
+	body, err := makeBody(policy)
+	if err != nil {
+		return errors.Wrap(err, "building request")
+	}
+
+	resp, err := p.client.doRequest(ctx, http.MethodPatch, p.client.getURL("/process/%s/stop", p.id), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 func (p *restProcess) Wait(ctx context.Context) (int, error) {
 	resp, err := p.client.doRequest(ctx, http.MethodGet, p.client.getURL("/process/%s/wait", p.id), nil)
 	if err != nil {
@@ -75,6 +106,16 @@ func (p *restProcess) Wait(ctx context.Context) (int, error) {
 	return waitResp.ExitCode, nil
 }
 
+func (p *restProcess) WaitReady(ctx context.Context) error {
+	resp, err := p.client.doRequest(ctx, http.MethodGet, p.client.getURL("/process/%s/ready", p.id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 func (p *restProcess) Respawn(ctx context.Context) (jasper.Process, error) {
 	resp, err := p.client.doRequest(ctx, http.MethodGet, p.client.getURL("/process/%s/respawn", p.id), nil)
 	if err != nil {
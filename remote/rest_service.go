@@ -1,22 +1,33 @@
 package remote
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
 
+	"github.com/evergreen-ci/certdepot"
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/lru"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
 	"github.com/mongodb/grip/recovery"
+	"github.com/mongodb/grip/send"
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/remote/audit"
+	"github.com/mongodb/jasper/util"
 	"github.com/pkg/errors"
 )
 
@@ -25,6 +36,8 @@ import (
 type Service struct {
 	hostID     string
 	manager    jasper.Manager
+	authz      *options.Authorization
+	audit      send.Sender
 	cache      *lru.Cache
 	cacheOpts  options.Cache
 	cacheMutex sync.RWMutex
@@ -52,45 +65,298 @@ func (s *Service) App(ctx context.Context) *gimlet.APIApp {
 
 	app := gimlet.NewApp()
 
-	app.AddRoute("/").Version(1).Get().Handler(s.rootRoute)
-	app.AddRoute("/id").Version(1).Get().Handler(s.id)
-	app.AddRoute("/create").Version(1).Post().Handler(s.createProcess)
-	app.AddRoute("/download").Version(1).Post().Handler(s.downloadFile)
-	app.AddRoute("/download/cache").Version(1).Post().Handler(s.configureCache)
-	app.AddRoute("/download/mongodb").Version(1).Post().Handler(s.downloadMongoDB)
-	app.AddRoute("/list/oom").Version(1).Get().Handler(s.oomTrackerList)
-	app.AddRoute("/list/oom").Version(1).Delete().Handler(s.oomTrackerClear)
-	app.AddRoute("/list/{filter}").Version(1).Get().Handler(s.listProcesses)
-	app.AddRoute("/list/group/{name}").Version(1).Get().Handler(s.listGroupMembers)
-	app.AddRoute("/process/{id}").Version(1).Get().Handler(s.getProcess)
-	app.AddRoute("/process/{id}/tags").Version(1).Get().Handler(s.getProcessTags)
-	app.AddRoute("/process/{id}/tags").Version(1).Delete().Handler(s.deleteProcessTags)
-	app.AddRoute("/process/{id}/tags").Version(1).Post().Handler(s.addProcessTag)
-	app.AddRoute("/process/{id}/wait").Version(1).Get().Handler(s.waitForProcess)
-	app.AddRoute("/process/{id}/respawn").Version(1).Get().Handler(s.respawnProcess)
-	app.AddRoute("/process/{id}/metrics").Version(1).Get().Handler(s.processMetrics)
-	app.AddRoute("/process/{id}/logs/{count}").Version(1).Get().Handler(s.getLogStream)
-	app.AddRoute("/process/{id}/loginfo").Version(1).Get().Handler(s.getBuildloggerURLs)
-	app.AddRoute("/process/{id}/signal/{signal}").Version(1).Patch().Handler(s.signalProcess)
-	app.AddRoute("/process/{id}/trigger/signal/{trigger-id}").Version(1).Patch().Handler(s.registerSignalTriggerID)
-	app.AddRoute("/signal/event/{name}").Version(1).Patch().Handler(s.signalEvent)
-	app.AddRoute("/logging/id/{id}").Version(1).Post().Handler(s.loggingCacheCreate)
-	app.AddRoute("/logging/id/{id}").Version(1).Get().Handler(s.loggingCacheGet)
-	app.AddRoute("/logging/id/{id}").Version(1).Delete().Handler(s.loggingCacheRemove)
-	app.AddRoute("/logging/id/{id}/close").Version(1).Delete().Handler(s.loggingCacheCloseAndRemove)
-	app.AddRoute("/logging/clear").Version(1).Delete().Handler(s.loggingCacheClear)
-	app.AddRoute("/logging/prune/{time}").Version(1).Delete().Handler(s.loggingCachePrune)
-	app.AddRoute("/logging/len").Version(1).Get().Handler(s.loggingCacheLen)
-	app.AddRoute("/logging/id/{id}/send").Version(1).Post().Handler(s.sendMessages)
-	app.AddRoute("/file/write").Version(1).Put().Handler(s.writeFile)
-	app.AddRoute("/clear").Version(1).Post().Handler(s.clearManager)
-	app.AddRoute("/close").Version(1).Delete().Handler(s.closeManager)
+	app.AddRoute("/").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.rootRoute))
+	app.AddRoute("/id").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.id))
+	app.AddRoute("/create").Version(1).Post().Handler(s.requireRole(options.RoleProcessOperator, s.createProcess))
+	app.AddRoute("/download").Version(1).Post().Handler(s.requireRole(options.RoleAdmin, s.downloadFile))
+	app.AddRoute("/download/cache").Version(1).Post().Handler(s.requireRole(options.RoleAdmin, s.configureCache))
+	app.AddRoute("/download/mongodb").Version(1).Post().Handler(s.requireRole(options.RoleAdmin, s.downloadMongoDB))
+	app.AddRoute("/list/oom").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.oomTrackerList))
+	app.AddRoute("/list/oom").Version(1).Delete().Handler(s.requireRole(options.RoleAdmin, s.oomTrackerClear))
+	app.AddRoute("/list/{filter}").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.listProcesses))
+	app.AddRoute("/list/group/{name}").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.listGroupMembers))
+	app.AddRoute("/history").Version(1).Post().Handler(s.requireRole(options.RoleReadOnly, s.findHistory))
+	app.AddRoute("/subscribe").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.subscribe))
+	app.AddRoute("/process/{id}").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.getProcess))
+	app.AddRoute("/process/{id}/tags").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.getProcessTags))
+	app.AddRoute("/process/{id}/tags").Version(1).Delete().Handler(s.requireRole(options.RoleProcessOperator, s.deleteProcessTags))
+	app.AddRoute("/process/{id}/tags").Version(1).Post().Handler(s.requireRole(options.RoleProcessOperator, s.addProcessTag))
+	app.AddRoute("/process/{id}/wait").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.waitForProcess))
+	app.AddRoute("/process/{id}/respawn").Version(1).Get().Handler(s.requireRole(options.RoleProcessOperator, s.respawnProcess))
+	app.AddRoute("/process/{id}/metrics").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.processMetrics))
+	app.AddRoute("/process/{id}/logs/{count}").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.getLogStream))
+	app.AddRoute("/process/{id}/output").Version(1).Post().Handler(s.requireRole(options.RoleReadOnly, s.getCapturedOutput))
+	app.AddRoute("/process/{id}/follow-logs").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.followLogs))
+	app.AddRoute("/process/{id}/stdin").Version(1).Put().Handler(s.requireRole(options.RoleProcessOperator, s.writeStdin))
+	app.AddRoute("/process/{id}/stdin").Version(1).Delete().Handler(s.requireRole(options.RoleProcessOperator, s.closeStdin))
+	app.AddRoute("/process/{id}/loginfo").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.getBuildloggerURLs))
+	app.AddRoute("/process/{id}/signal/{signal}").Version(1).Patch().Handler(s.requireRole(options.RoleProcessOperator, s.signalProcess))
+	app.AddRoute("/process/{id}/resize").Version(1).Patch().Handler(s.requireRole(options.RoleProcessOperator, s.resizeProcess))
+	app.AddRoute("/process/{id}/stop").Version(1).Patch().Handler(s.requireRole(options.RoleProcessOperator, s.stopProcess))
+	app.AddRoute("/process/{id}/ready").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.waitForProcessReady))
+	app.AddRoute("/process/{id}/trigger/signal/{trigger-id}").Version(1).Patch().Handler(s.requireRole(options.RoleProcessOperator, s.registerSignalTriggerID))
+	app.AddRoute("/signal/event/{name}").Version(1).Patch().Handler(s.requireRole(options.RoleProcessOperator, s.signalEvent))
+	app.AddRoute("/logging/id/{id}").Version(1).Post().Handler(s.requireRole(options.RoleProcessOperator, s.loggingCacheCreate))
+	app.AddRoute("/logging/id/{id}").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.loggingCacheGet))
+	app.AddRoute("/logging/id/{id}").Version(1).Delete().Handler(s.requireRole(options.RoleProcessOperator, s.loggingCacheRemove))
+	app.AddRoute("/logging/id/{id}/close").Version(1).Delete().Handler(s.requireRole(options.RoleProcessOperator, s.loggingCacheCloseAndRemove))
+	app.AddRoute("/logging/clear").Version(1).Delete().Handler(s.requireRole(options.RoleAdmin, s.loggingCacheClear))
+	app.AddRoute("/logging/prune/{time}").Version(1).Delete().Handler(s.requireRole(options.RoleAdmin, s.loggingCachePrune))
+	app.AddRoute("/logging/len").Version(1).Get().Handler(s.requireRole(options.RoleReadOnly, s.loggingCacheLen))
+	app.AddRoute("/logging/id/{id}/send").Version(1).Post().Handler(s.requireRole(options.RoleProcessOperator, s.sendMessages))
+	app.AddRoute("/file/write").Version(1).Put().Handler(s.requireRole(options.RoleAdmin, s.writeFile))
+	app.AddRoute("/clear").Version(1).Post().Handler(s.requireRole(options.RoleAdmin, s.clearManager))
+	app.AddRoute("/close").Version(1).Delete().Handler(s.requireRole(options.RoleAdmin, s.closeManager))
 
 	go s.pruneCache(ctx)
 
 	return app
 }
 
+// StartRESTService starts a REST service with the specified address addr
+// around the given manager. If the options contain credentials, the service
+// only accepts TLS connections from clients that present a certificate signed
+// by the credentials' CA; if they contain authentication settings, every
+// request must be authenticated. The caller is responsible for closing the
+// service using the returned util.CloseFunc.
+func StartRESTService(ctx context.Context, manager jasper.Manager, addr net.Addr, opts RESTServiceOptions) (util.CloseFunc, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid service options")
+	}
+
+	var tlsConf *tls.Config
+	if opts.Credentials != nil {
+		var err error
+		tlsConf, err = opts.Credentials.Resolve()
+		if err != nil {
+			return nil, errors.Wrap(err, "generating TLS config from server credentials")
+		}
+	}
+
+	srv := NewRESTService(manager)
+	if opts.Authorization != nil {
+		if err := srv.SetAuthorization(*opts.Authorization); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	if opts.Audit != nil {
+		srv.SetAuditSender(opts.Audit)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	app := srv.App(ctx)
+	app.SetPrefix("jasper")
+	if opts.Auth != nil {
+		middleware, err := NewRESTAuthMiddleware(*opts.Auth)
+		if err != nil {
+			cancel()
+			return nil, errors.Wrap(err, "creating authentication middleware")
+		}
+		app.AddMiddleware(middleware)
+	}
+	handler, err := app.Handler()
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "resolving REST app")
+	}
+
+	lis, err := net.Listen(addr.Network(), addr.String())
+	if err != nil {
+		cancel()
+		return nil, errors.Wrapf(err, "listening on '%s'", addr.String())
+	}
+
+	server := &http.Server{
+		Handler:           withConnController(handler),
+		ReadTimeout:       time.Minute,
+		ReadHeaderTimeout: time.Minute / 2,
+		WriteTimeout:      time.Minute,
+		TLSConfig:         tlsConf,
+	}
+	go func() {
+		defer recovery.LogStackTraceAndContinue("REST service")
+		var err error
+		if tlsConf != nil {
+			err = server.ServeTLS(lis, "", "")
+		} else {
+			err = server.Serve(lis)
+		}
+		grip.NoticeWhen(ctx, err != http.ErrServerClosed, errors.Wrap(err, "serving REST service"))
+	}()
+
+	return func() error { cancel(); return errors.WithStack(server.Close()) }, nil
+}
+
+// StartRESTServiceWithFiles is the same as StartRESTService, but the TLS
+// credentials and authentication settings are read from files as described by
+// NewRESTServiceOptionsFromFiles.
+func StartRESTServiceWithFiles(ctx context.Context, manager jasper.Manager, addr net.Addr, credsFilePath, authFilePath string) (util.CloseFunc, error) {
+	opts, err := NewRESTServiceOptionsFromFiles(credsFilePath, authFilePath)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return StartRESTService(ctx, manager, addr, *opts)
+}
+
+// NewRESTServiceOptionsFromFiles returns service options whose TLS credentials
+// are read from the file given by credsFilePath and whose authentication
+// settings are read from the file given by authFilePath if the paths are
+// non-empty. The credentials file should contain the JSON-encoded bytes from
+// (*certdepot.Credentials).Export() and the authentication file should
+// contain a JSON-encoded RESTAuth.
+func NewRESTServiceOptionsFromFiles(credsFilePath, authFilePath string) (*RESTServiceOptions, error) {
+	var opts RESTServiceOptions
+	if credsFilePath != "" {
+		creds, err := certdepot.NewCredentialsFromFile(credsFilePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting credentials from file")
+		}
+		opts.Credentials = creds
+	}
+	if authFilePath != "" {
+		auth, err := NewRESTAuthFromFile(authFilePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting authentication from file")
+		}
+		opts.Auth = auth
+	}
+
+	return &opts, nil
+}
+
+// SetAuthorization limits the operations that each client can perform. It
+// must be called before App.
+func (s *Service) SetAuthorization(authz options.Authorization) error {
+	if err := authz.Validate(); err != nil {
+		return errors.Wrap(err, "invalid authorization")
+	}
+	s.authz = &authz
+	return nil
+}
+
+// SetAuditSender sets the sender to which the service sends an audit record
+// of each request that requires more than the read-only role.
+func (s *Service) SetAuditSender(sender send.Sender) {
+	s.audit = sender
+}
+
+type restAccessKey struct{}
+
+// requireRole returns a handler that only calls the given handler if the
+// client's role includes the required role. It allows all requests if the
+// service does not check authorization. Requests that require more than the
+// read-only role are audited, whether or not they are allowed.
+func (s *Service) requireRole(role options.Role, handler http.HandlerFunc) http.HandlerFunc {
+	authorized := func(rw http.ResponseWriter, r *http.Request) {
+		if s.authz == nil {
+			handler(rw, r)
+			return
+		}
+
+		access, err := s.authz.Access(restClientIdentity(r))
+		if err == nil {
+			err = access.Authorize(role)
+		}
+		if err != nil {
+			writeError(r.Context(), rw, gimlet.ErrorResponse{
+				StatusCode: http.StatusForbidden,
+				Message:    errors.Wrap(err, "authorizing request").Error(),
+			})
+			return
+		}
+
+		handler(rw, r.WithContext(context.WithValue(r.Context(), restAccessKey{}, access)))
+	}
+	if role == options.RoleReadOnly {
+		return authorized
+	}
+	return s.audited(authorized)
+}
+
+type restAuditRecordKey struct{}
+
+// audited returns a handler that sends an audit record of each request to
+// the service's audit sender, if it has one. The handler can add the
+// request's arguments to the record returned by auditRecord.
+func (s *Service) audited(handler http.HandlerFunc) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		if s.audit == nil {
+			handler(rw, r)
+			return
+		}
+
+		record := &audit.Record{
+			Time:     time.Now(),
+			Service:  audit.ServiceREST,
+			Method:   fmt.Sprintf("%s %s", r.Method, r.URL.Path),
+			Identity: restClientIdentity(r),
+			Peer:     r.RemoteAddr,
+		}
+		// The logging cache routes also have an ID, but it does not
+		// identify a process.
+		if strings.Contains(r.URL.Path, "/process/") {
+			record.ProcessID = gimlet.GetVars(r)["id"]
+		}
+
+		arw := &auditResponseWriter{ResponseWriter: rw, status: http.StatusOK}
+		handler(arw, r.WithContext(context.WithValue(r.Context(), restAuditRecordKey{}, record)))
+
+		record.Success = arw.status < http.StatusBadRequest
+		audit.Send(r.Context(), s.audit, *record)
+	}
+}
+
+// auditRecord returns the audit record of the request. If the request is not
+// audited, it returns a record that is discarded.
+func auditRecord(r *http.Request) *audit.Record {
+	if record, ok := r.Context().Value(restAuditRecordKey{}).(*audit.Record); ok {
+		return record
+	}
+	return &audit.Record{}
+}
+
+// auditResponseWriter is a response writer that records the response status
+// code.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rw *auditResponseWriter) WriteHeader(status int) {
+	rw.status = status
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+// authorizeRequest checks that the client is allowed to create a process with
+// the options, if any, and to write to each of the paths. It writes an error
+// response if the client is not allowed to do so.
+func authorizeRequest(rw http.ResponseWriter, r *http.Request, opts *options.Create, paths ...string) bool {
+	access, ok := r.Context().Value(restAccessKey{}).(*options.ClientAccess)
+	if !ok {
+		return true
+	}
+
+	catcher := grip.NewBasicCatcher()
+	if opts != nil {
+		catcher.Add(access.AuthorizeCreate(opts))
+	}
+	for _, path := range paths {
+		if path != "" {
+			catcher.Add(access.AuthorizePath(path))
+		}
+	}
+	if catcher.HasErrors() {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusForbidden,
+			Message:    errors.Wrap(catcher.Resolve(), "authorizing request").Error(),
+		})
+		return false
+	}
+	return true
+}
+
 // SetDisableCachePruning toggles the underlying option for the
 // services cache.
 func (s *Service) SetDisableCachePruning(v bool) {
@@ -155,6 +421,9 @@ func getProcInfoNoHang(ctx context.Context, p jasper.Process) jasper.ProcessInfo
 }
 
 func writeError(ctx context.Context, rw http.ResponseWriter, err gimlet.ErrorResponse) {
+	if record, ok := ctx.Value(restAuditRecordKey{}).(*audit.Record); ok {
+		record.Error = err.Message
+	}
 	gimlet.WriteJSONResponse(ctx, rw, err.StatusCode, err)
 }
 
@@ -181,6 +450,10 @@ func (s *Service) createProcess(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	record := auditRecord(r)
+	record.Args = opts.Args
+	record.SetEnv(opts.Environment)
+	record.AddPaths(opts.WorkingDirectory)
 	ctx := r.Context()
 
 	if err := opts.Validate(); err != nil {
@@ -190,6 +463,9 @@ func (s *Service) createProcess(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	if !authorizeRequest(rw, r, opts) {
+		return
+	}
 
 	pctx, cancel := context.WithCancel(context.Background())
 
@@ -202,6 +478,7 @@ func (s *Service) createProcess(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	record.ProcessID = proc.ID()
 
 	if err := proc.RegisterTrigger(ctx, func(_ jasper.ProcessInfo) {
 		cancel()
@@ -262,9 +539,16 @@ func (s *Service) getBuildloggerURLs(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(r.Context(), rw, urls)
 }
 
+// listProcesses returns the processes that match the filter. The optional
+// query parameters narrow the processes further: each "tags" parameter is a
+// comma-separated set of tags that must all match, and a process matches if it
+// matches any of the sets; "min_exit_code" and "max_exit_code" bound the exit
+// code of completed processes; "started_after" and "started_before" are
+// RFC3339 times bounding the start time; and "args", "working_directory" and
+// "host" match the process's arguments, working directory and host.
 func (s *Service) listProcesses(rw http.ResponseWriter, r *http.Request) {
-	filter := options.Filter(gimlet.GetVars(r)["filter"])
-	if err := filter.Validate(); err != nil {
+	query, err := parseProcessQuery(gimlet.GetVars(r)["filter"], r.URL.Query())
+	if err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusBadRequest,
 			Message:    errors.Wrap(err, "invalid filter").Error(),
@@ -274,7 +558,7 @@ func (s *Service) listProcesses(rw http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	procs, err := s.manager.List(ctx, filter)
+	procs, err := s.manager.Query(ctx, query)
 	if err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusNotFound,
@@ -291,6 +575,54 @@ func (s *Service) listProcesses(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(r.Context(), rw, out)
 }
 
+// parseProcessQuery creates a process query from the filter and the URL query
+// parameters of a list request.
+func parseProcessQuery(filter string, vals url.Values) (options.ProcessQuery, error) {
+	query := options.ProcessQuery{
+		Status:           options.Filter(filter),
+		Args:             vals.Get("args"),
+		WorkingDirectory: vals.Get("working_directory"),
+		Host:             vals.Get("host"),
+	}
+	for _, tags := range vals["tags"] {
+		query.Tags = append(query.Tags, strings.Split(tags, ","))
+	}
+
+	catcher := grip.NewBasicCatcher()
+	parseExitCode := func(name string) *int {
+		val := vals.Get(name)
+		if val == "" {
+			return nil
+		}
+		exitCode, err := strconv.Atoi(val)
+		if err != nil {
+			catcher.Errorf("invalid %s '%s'", name, val)
+			return nil
+		}
+		return &exitCode
+	}
+	parseTime := func(name string) time.Time {
+		val := vals.Get(name)
+		if val == "" {
+			return time.Time{}
+		}
+		t, err := time.Parse(time.RFC3339Nano, val)
+		if err != nil {
+			catcher.Errorf("invalid %s '%s'", name, val)
+		}
+		return t
+	}
+	query.MinExitCode = parseExitCode("min_exit_code")
+	query.MaxExitCode = parseExitCode("max_exit_code")
+	query.StartedAfter = parseTime("started_after")
+	query.StartedBefore = parseTime("started_before")
+	if catcher.HasErrors() {
+		return options.ProcessQuery{}, catcher.Resolve()
+	}
+
+	return query, errors.WithStack(query.Validate())
+}
+
 func (s *Service) listGroupMembers(rw http.ResponseWriter, r *http.Request) {
 	name := gimlet.GetVars(r)["name"]
 
@@ -313,6 +645,45 @@ func (s *Service) listGroupMembers(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(r.Context(), rw, out)
 }
 
+func (s *Service) findHistory(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	history := s.manager.History(ctx)
+	if history == nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    jasper.ErrProcessHistoryNotSupported.Error(),
+		})
+		return
+	}
+
+	query := options.HistoryQuery{}
+	if err := gimlet.GetJSON(r.Body, &query); err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "reading history query from JSON request body").Error(),
+		})
+		return
+	}
+	if err := query.Validate(); err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid history query").Error(),
+		})
+		return
+	}
+
+	out, err := history.Find(ctx, query)
+	if err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrap(err, "finding process history").Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(ctx, rw, out)
+}
+
 func (s *Service) getProcess(rw http.ResponseWriter, r *http.Request) {
 	id := gimlet.GetVars(r)["id"]
 	ctx := r.Context()
@@ -433,6 +804,29 @@ func (s *Service) waitForProcess(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(r.Context(), rw, restWaitResponse{ExitCode: exitCode})
 }
 
+func (s *Service) waitForProcessReady(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	ctx := r.Context()
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "no process '%s' found", id).Error(),
+		})
+		return
+	}
+
+	if err = proc.WaitReady(ctx); err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "waiting for process '%s' to become ready", id).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(r.Context(), rw, struct{}{})
+}
+
 func (s *Service) respawnProcess(rw http.ResponseWriter, r *http.Request) {
 	id := gimlet.GetVars(r)["id"]
 	ctx := r.Context()
@@ -458,6 +852,7 @@ func (s *Service) respawnProcess(rw http.ResponseWriter, r *http.Request) {
 		cancel()
 		return
 	}
+	auditRecord(r).ProcessID = newProc.ID()
 	if err := s.manager.Register(ctx, newProc); err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusInternalServerError,
@@ -518,6 +913,77 @@ func (s *Service) signalProcess(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(r.Context(), rw, struct{}{})
 }
 
+func (s *Service) resizeProcess(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	var size options.TTY
+	if err := gimlet.GetJSON(r.Body, &size); err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "reading pseudo-terminal size from request").Error(),
+		})
+		return
+	}
+
+	ctx := r.Context()
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "no process '%s' found", id).Error(),
+		})
+		return
+	}
+
+	if err := proc.Resize(ctx, size.Rows, size.Cols); err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(r.Context(), rw, struct{}{})
+}
+
+func (s *Service) stopProcess(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	var policy options.StopPolicy
+	if err := gimlet.GetJSON(r.Body, &policy); err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "reading stop policy from request").Error(),
+		})
+		return
+	}
+	if err := policy.Validate(); err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid stop policy").Error(),
+		})
+		return
+	}
+
+	ctx := r.Context()
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "no process '%s' found", id).Error(),
+		})
+		return
+	}
+
+	if err := proc.Stop(ctx, policy); err != nil {
+		writeError(r.Context(), rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "stopping process '%s'", id).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(r.Context(), rw, struct{}{})
+}
+
 func (s *Service) downloadFile(rw http.ResponseWriter, r *http.Request) {
 	var opts options.Download
 	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
@@ -527,6 +993,7 @@ func (s *Service) downloadFile(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	auditRecord(r).AddPaths(opts.Path, opts.ArchiveOpts.TargetPath)
 
 	if err := opts.Validate(); err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
@@ -535,6 +1002,9 @@ func (s *Service) downloadFile(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	if !authorizeRequest(rw, r, nil, opts.Path, opts.ArchiveOpts.TargetPath) {
+		return
+	}
 
 	if err := opts.Download(); err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
@@ -586,6 +1056,277 @@ func (s *Service) getLogStream(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(r.Context(), rw, stream)
 }
 
+func (s *Service) getCapturedOutput(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := gimlet.GetVars(r)["id"]
+
+	query := options.OutputQuery{}
+	if err := gimlet.GetJSON(r.Body, &query); err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "reading output query from JSON request body").Error(),
+		})
+		return
+	}
+	if err := query.Validate(); err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid output query").Error(),
+		})
+		return
+	}
+
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "no process '%s' found", id).Error(),
+		})
+		return
+	}
+
+	out, err := jasper.GetCapturedOutput(ctx, proc, query)
+	if err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "getting captured output for process '%s'", id).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(ctx, rw, out)
+}
+
+// followLogs streams the in-memory output logs of a process as server-sent
+// events as they are written. Each event contains a JSON-encoded
+// jasper.LogChunk. The optional "offset" query parameter is the number of lines
+// to skip, which allows clients to resume following where they left off. If an
+// error occurs after the stream has started, it is sent as an "error" event.
+func (s *Service) followLogs(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	ctx := r.Context()
+
+	var offset int
+	if val := r.URL.Query().Get("offset"); val != "" {
+		var err error
+		offset, err = strconv.Atoi(val)
+		if err != nil || offset < 0 {
+			writeError(ctx, rw, gimlet.ErrorResponse{
+				StatusCode: http.StatusBadRequest,
+				Message:    errors.Errorf("invalid offset '%s'", val).Error(),
+			})
+			return
+		}
+	}
+
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "no process '%s' found", id).Error(),
+		})
+		return
+	}
+
+	// The log stream lasts until the process completes, so it must not be cut
+	// off by the server's write timeout.
+	if err = connController(rw, r).SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not clear write deadline",
+			"process": id,
+		}))
+		return
+	}
+
+	rc := http.NewResponseController(rw)
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	if err = rc.Flush(); err != nil {
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not flush response headers",
+			"process": id,
+		}))
+		return
+	}
+
+	err = jasper.FollowInMemoryLogs(ctx, proc, offset, func(chunk jasper.LogChunk) error {
+		if err := writeServerSentEvent(rw, "", chunk); err != nil {
+			return errors.Wrap(err, "writing log chunk")
+		}
+		return errors.Wrap(rc.Flush(), "flushing log chunk")
+	})
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+
+	grip.Warning(ctx, writeServerSentEvent(rw, "error", gimlet.ErrorResponse{
+		StatusCode: http.StatusInternalServerError,
+		Message:    errors.Wrapf(err, "following logs for process '%s'", id).Error(),
+	}))
+	grip.Warning(ctx, rc.Flush())
+}
+
+// subscribe streams the lifecycle events of the manager's processes as
+// server-sent events, each of which contains a JSON-encoded
+// jasper.ProcessEvent. The optional "tags" and "types" query parameters, which
+// may be repeated, filter the events.
+func (s *Service) subscribe(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vals := r.URL.Query()
+	filter := options.ProcessEventFilter{Tags: vals["tags"]}
+	for _, t := range vals["types"] {
+		filter.Types = append(filter.Types, options.ProcessEventType(t))
+	}
+	if err := filter.Validate(); err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    errors.Wrap(err, "invalid process event filter").Error(),
+		})
+		return
+	}
+
+	events, err := s.manager.Subscribe(ctx, filter)
+	if err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrap(err, "subscribing to process events").Error(),
+		})
+		return
+	}
+
+	// The event stream lasts until the client unsubscribes, so it must not be
+	// cut off by the server's write timeout.
+	if err = connController(rw, r).SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		grip.Warning(ctx, message.WrapError(err, "could not clear write deadline"))
+		return
+	}
+
+	rc := http.NewResponseController(rw)
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	if err = rc.Flush(); err != nil {
+		grip.Warning(ctx, message.WrapError(err, "could not flush response headers"))
+		return
+	}
+
+	for event := range events {
+		if err := writeServerSentEvent(rw, "", event); err != nil {
+			grip.Warning(ctx, message.WrapError(err, "could not write process event"))
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			grip.Warning(ctx, message.WrapError(err, "could not flush process event"))
+			return
+		}
+	}
+}
+
+type connControllerKey struct{}
+
+// withConnController makes the response controller of each request's
+// connection available to the handlers, since the response writers that gimlet
+// passes to them do not expose the connection's deadlines.
+func withConnController(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), connControllerKey{}, http.NewResponseController(rw))
+		h.ServeHTTP(rw, r.WithContext(ctx))
+	})
+}
+
+// connController returns the response controller that sets the deadlines of
+// the request's connection. If the service's handler is not wrapped by
+// withConnController, setting the deadlines is not supported.
+func connController(rw http.ResponseWriter, r *http.Request) *http.ResponseController {
+	if rc, ok := r.Context().Value(connControllerKey{}).(*http.ResponseController); ok {
+		return rc
+	}
+	return http.NewResponseController(rw)
+}
+
+// writeServerSentEvent writes a server-sent event with the JSON-encoded data.
+// If event is empty, the event type is omitted.
+func writeServerSentEvent(w io.Writer, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "marshalling event data")
+	}
+
+	var buf bytes.Buffer
+	if event != "" {
+		fmt.Fprintf(&buf, "event: %s\n", event)
+	}
+	fmt.Fprintf(&buf, "data: %s\n\n", payload)
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// writeStdin streams the request body to the standard input of a running
+// process until the body is exhausted.
+func (s *Service) writeStdin(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	ctx := r.Context()
+
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "no process '%s' found", id).Error(),
+		})
+		return
+	}
+
+	// The request body is streamed for as long as the client has input and
+	// the response is only written afterward, so neither can be cut off by
+	// the server's timeouts.
+	rc := connController(rw, r)
+	for _, setDeadline := range []func(time.Time) error{rc.SetReadDeadline, rc.SetWriteDeadline} {
+		if err = setDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			writeError(ctx, rw, gimlet.ErrorResponse{
+				StatusCode: http.StatusInternalServerError,
+				Message:    errors.Wrap(err, "clearing deadlines").Error(),
+			})
+			return
+		}
+	}
+
+	if err := jasper.WriteStandardInput(ctx, proc, r.Body); err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "writing standard input to process '%s'", id).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(ctx, rw, struct{}{})
+}
+
+func (s *Service) closeStdin(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	ctx := r.Context()
+
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    errors.Wrapf(err, "no process '%s' found", id).Error(),
+		})
+		return
+	}
+
+	if err := jasper.CloseStandardInput(ctx, proc); err != nil {
+		writeError(ctx, rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrapf(err, "closing standard input of process '%s'", id).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(ctx, rw, struct{}{})
+}
+
 func (s *Service) signalEvent(rw http.ResponseWriter, r *http.Request) {
 	vars := gimlet.GetVars(r)
 	name := vars["name"]
@@ -611,6 +1352,7 @@ func (s *Service) writeFile(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	auditRecord(r).AddPaths(opts.Path)
 
 	if err := opts.Validate(); err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
@@ -619,6 +1361,9 @@ func (s *Service) writeFile(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	if !authorizeRequest(rw, r, nil, opts.Path) {
+		return
+	}
 
 	if err := opts.DoWrite(); err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
@@ -696,6 +1441,7 @@ func (s *Service) downloadMongoDB(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	auditRecord(r).AddPaths(opts.Path)
 
 	if err := opts.Validate(); err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
@@ -704,6 +1450,9 @@ func (s *Service) downloadMongoDB(rw http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
+	if !authorizeRequest(rw, r, nil, opts.Path) {
+		return
+	}
 
 	if err := jasper.SetupDownloadMongoDBReleases(r.Context(), s.cache, opts); err != nil {
 		writeError(r.Context(), rw, gimlet.ErrorResponse{
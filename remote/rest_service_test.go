@@ -3,6 +3,7 @@ package remote
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -546,3 +547,100 @@ func TestRESTService(t *testing.T) {
 		})
 	}
 }
+
+func TestRESTServiceStreamsOutlastTimeouts(t *testing.T) {
+	const timeout = 500 * time.Millisecond
+
+	for name, test := range map[string]func(ctx context.Context, t *testing.T, client Manager){
+		"SubscribeOutlastsTimeouts": func(ctx context.Context, t *testing.T, client Manager) {
+			events, err := client.Subscribe(ctx, options.ProcessEventFilter{})
+			require.NoError(t, err)
+
+			time.Sleep(2 * timeout)
+
+			_, err = client.CreateProcess(ctx, testoptions.TrueCreateOpts())
+			require.NoError(t, err)
+
+			select {
+			case <-ctx.Done():
+				require.FailNow(t, "context done before receiving event")
+			case _, ok := <-events:
+				assert.True(t, ok, "event stream should still be open")
+			}
+		},
+		"FollowLogsOutlastsTimeouts": func(ctx context.Context, t *testing.T, client Manager) {
+			inMemLogger, err := jasper.NewInMemoryLogger(100)
+			require.NoError(t, err)
+			opts := &options.Create{
+				Args:   []string{"sh", "-c", fmt.Sprintf("echo foo; sleep %f; echo bar", (2 * timeout).Seconds())},
+				Output: options.Output{Loggers: []*options.LoggerConfig{inMemLogger}},
+			}
+			proc, err := client.CreateProcess(ctx, opts)
+			require.NoError(t, err)
+
+			logs := []string{}
+			var done bool
+			require.NoError(t, client.FollowLogs(ctx, proc.ID(), 0, func(chunk jasper.LogChunk) error {
+				logs = append(logs, chunk.Logs...)
+				done = chunk.Done
+				return nil
+			}))
+			assert.True(t, done)
+			assert.Contains(t, strings.Join(logs, "\n"), "foo")
+			assert.Contains(t, strings.Join(logs, "\n"), "bar")
+		},
+		"WriteStdinOutlastsTimeouts": func(ctx context.Context, t *testing.T, client Manager) {
+			inMemLogger, err := jasper.NewInMemoryLogger(10)
+			require.NoError(t, err)
+			opts := &options.Create{
+				Args:                []string{"cat"},
+				StandardInputStream: true,
+				Output:              options.Output{Loggers: []*options.LoggerConfig{inMemLogger}},
+			}
+			proc, err := client.CreateProcess(ctx, opts)
+			require.NoError(t, err)
+
+			input, inputWriter := io.Pipe()
+			go func() {
+				_, _ = io.WriteString(inputWriter, "foo\n")
+				time.Sleep(2 * timeout)
+				_, _ = io.WriteString(inputWriter, "bar\n")
+				_ = inputWriter.Close()
+			}()
+			require.NoError(t, client.WriteStdin(ctx, proc.ID(), input))
+			require.NoError(t, client.CloseStdin(ctx, proc.ID()))
+
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			logs, err := client.GetLogStream(ctx, proc.ID(), 10)
+			require.NoError(t, err)
+			output := strings.Join(logs.Logs, "\n")
+			assert.Contains(t, output, "foo")
+			assert.Contains(t, output, "bar")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+
+			mngr, err := jasper.NewSynchronizedManager(false)
+			require.NoError(t, err)
+
+			app := NewRESTService(mngr).App(ctx)
+			app.SetPrefix("jasper")
+			handler, err := app.Handler()
+			require.NoError(t, err)
+
+			server := httptest.NewUnstartedServer(withConnController(handler))
+			server.Config.ReadTimeout = timeout
+			server.Config.WriteTimeout = timeout
+			server.Start()
+			defer func() {
+				cancel()
+				server.Close()
+			}()
+
+			test(ctx, t, NewRESTClientWithExistingClient(server.Listener.Addr(), &http.Client{Timeout: timeout}))
+		})
+	}
+}
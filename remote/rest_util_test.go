@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
 	"time"
 
+	"github.com/evergreen-ci/certdepot"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/jasper"
 	"github.com/mongodb/jasper/testutil"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func makeRESTServiceAndClient(ctx context.Context, mngr jasper.Manager, httpClient *http.Client) (*Service, Manager, error) {
@@ -92,3 +98,51 @@ func newTestRESTClient(ctx context.Context, addr net.Addr, httpClient *http.Clie
 
 	return client
 }
+
+// startTestRESTService starts a REST service with the given options on a free
+// port that is closed when the test finishes. The service address uses
+// 127.0.0.1 because the test server certificate is only valid for that IP
+// address.
+func startTestRESTService(ctx context.Context, t *testing.T, opts RESTServiceOptions) net.Addr {
+	mngr, err := jasper.NewSynchronizedManager(false)
+	require.NoError(t, err)
+	for {
+		select {
+		case <-ctx.Done():
+			require.FailNow(t, "context done before service could start")
+		default:
+		}
+
+		addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("127.0.0.1:%d", testutil.GetPortNumber()))
+		require.NoError(t, err)
+		closeService, err := StartRESTService(ctx, mngr, addr, opts)
+		if err != nil {
+			continue
+		}
+		t.Cleanup(func() { assert.NoError(t, closeService()) })
+		return addr
+	}
+}
+
+// newTestRESTClientWithOptions creates a REST client with the given options
+// that is closed when the test finishes.
+func newTestRESTClientWithOptions(t *testing.T, addr net.Addr, opts RESTClientOptions) Manager {
+	client, err := NewRESTClientWithOptions(addr, opts)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, client.CloseConnection()) })
+	return client
+}
+
+// loadTestCredentials loads the test credentials with the given name from the
+// testdata directory.
+func loadTestCredentials(t *testing.T, name string) *certdepot.Credentials {
+	caCert, err := os.ReadFile(filepath.Join("testdata", "ca.crt"))
+	require.NoError(t, err)
+	cert, err := os.ReadFile(filepath.Join("testdata", name+".crt"))
+	require.NoError(t, err)
+	key, err := os.ReadFile(filepath.Join("testdata", name+".key"))
+	require.NoError(t, err)
+	creds, err := certdepot.NewCredentials(caCert, cert, key)
+	require.NoError(t, err)
+	return creds
+}
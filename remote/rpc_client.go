@@ -18,6 +18,10 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// stdinChunkSize is the maximum size of each chunk of standard input that is
+// streamed to the service.
+const stdinChunkSize = 32 * 1024
+
 type rpcClient struct {
 	client       internal.JasperProcessManagerClient
 	clientCloser util.CloseFunc
@@ -133,6 +137,33 @@ func (c *rpcClient) List(ctx context.Context, f options.Filter) ([]jasper.Proces
 	return out, nil
 }
 
+func (c *rpcClient) Query(ctx context.Context, q options.ProcessQuery) ([]jasper.Process, error) {
+	if err := q.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid query")
+	}
+	procs, err := c.client.List(ctx, internal.ConvertProcessQuery(q))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting streaming client")
+	}
+
+	out := []jasper.Process{}
+	for {
+		info, err := procs.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "receiving process list")
+		}
+
+		out = append(out, &rpcProcess{
+			client: c.client,
+			info:   info,
+		})
+	}
+
+	return out, nil
+}
+
 func (c *rpcClient) Group(ctx context.Context, name string) ([]jasper.Process, error) {
 	procs, err := c.client.Group(ctx, &internal.TagName{Value: name})
 	if err != nil {
@@ -242,6 +273,152 @@ func (c *rpcClient) GetLogStream(ctx context.Context, id string, count int) (jas
 	return stream.Export(), nil
 }
 
+func (c *rpcClient) GetCapturedOutput(ctx context.Context, id string, query options.OutputQuery) (*jasper.CapturedOutput, error) {
+	if err := query.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid output query")
+	}
+
+	out, err := c.client.GetCapturedOutput(ctx, &internal.CapturedOutputRequest{
+		Id:    &internal.JasperProcessID{Value: id},
+		Query: internal.ConvertOutputQuery(query),
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return out.Export(), nil
+}
+
+func (c *rpcClient) FollowLogs(ctx context.Context, id string, offset int, handler func(jasper.LogChunk) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.FollowLogs(ctx, &internal.FollowLogsRequest{
+		Id:     &internal.JasperProcessID{Value: id},
+		Offset: int64(offset),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return errors.New("log stream ended before the process completed")
+		} else if err != nil {
+			return errors.Wrap(err, "receiving log chunk")
+		}
+
+		exportedChunk := chunk.Export()
+		if err := handler(exportedChunk); err != nil {
+			return err
+		}
+		if exportedChunk.Done {
+			return nil
+		}
+	}
+}
+
+func (c *rpcClient) Subscribe(ctx context.Context, f options.ProcessEventFilter) (<-chan jasper.ProcessEvent, error) {
+	if err := f.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid filter")
+	}
+
+	stream, err := c.client.Subscribe(ctx, internal.ConvertProcessEventFilter(f))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting streaming client")
+	}
+	// The service sends the headers once it has subscribed, so waiting for
+	// them ensures that no events are missed.
+	if _, err = stream.Header(); err != nil {
+		return nil, errors.Wrap(err, "subscribing to process events")
+	}
+
+	events := make(chan jasper.ProcessEvent, jasper.ProcessEventBufferSize)
+	go func() {
+		defer close(events)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					grip.Warning(ctx, errors.Wrap(err, "receiving process event"))
+				}
+				return
+			}
+
+			exportedEvent, err := event.Export()
+			if err != nil {
+				grip.Warning(ctx, errors.Wrap(err, "exporting process event"))
+				continue
+			}
+			select {
+			case events <- exportedEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (c *rpcClient) WriteStdin(ctx context.Context, id string, input io.Reader) error {
+	stream, err := c.client.WriteStdin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting client stream")
+	}
+
+	// The server identifies the process from the first chunk.
+	chunk := &internal.StdinChunk{Id: &internal.JasperProcessID{Value: id}}
+	buf := make([]byte, stdinChunkSize)
+	for {
+		n, readErr := input.Read(buf)
+		chunk.Data = buf[:n]
+		if n != 0 || chunk.Id != nil {
+			// If the server closed the stream early, the error is returned
+			// when receiving the response.
+			if err := stream.Send(chunk); err == io.EOF {
+				break
+			} else if err != nil {
+				return errors.Wrap(err, "sending standard input")
+			}
+			chunk.Id = nil
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			catcher := grip.NewBasicCatcher()
+			catcher.Wrap(readErr, "reading standard input")
+			catcher.Wrapf(stream.CloseSend(), "closing send stream after error during read: %s", readErr.Error())
+			return catcher.Resolve()
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
+func (c *rpcClient) CloseStdin(ctx context.Context, id string) error {
+	resp, err := c.client.CloseStdin(ctx, &internal.JasperProcessID{Value: id})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
 func (c *rpcClient) GetBuildloggerURLs(ctx context.Context, id string) ([]string, error) {
 	resp, err := c.client.GetBuildloggerURLs(ctx, &internal.JasperProcessID{Value: id})
 	if err != nil {
@@ -305,6 +482,10 @@ func (c *rpcClient) SendMessages(ctx context.Context, lp options.LoggingPayload)
 	return nil
 }
 
+func (c *rpcClient) History(ctx context.Context) jasper.ProcessHistory {
+	return &rpcProcessHistory{client: c.client}
+}
+
 func (c *rpcClient) LoggingCache(ctx context.Context) jasper.LoggingCache {
 	return &rpcLoggingCache{ctx: ctx, client: c.client}
 }
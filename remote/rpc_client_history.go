@@ -0,0 +1,50 @@
+package remote
+
+import (
+	"context"
+	"io"
+
+	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
+	internal "github.com/mongodb/jasper/remote/internal"
+	"github.com/pkg/errors"
+)
+
+// rpcProcessHistory is the client-side representation of a
+// jasper.ProcessHistory for making requests to the remote gRPC service.
+type rpcProcessHistory struct {
+	client internal.JasperProcessManagerClient
+}
+
+func (h *rpcProcessHistory) Put(ctx context.Context, info jasper.ProcessInfo) error {
+	return errors.New("operation not supported for remote managers")
+}
+
+func (h *rpcProcessHistory) Find(ctx context.Context, q options.HistoryQuery) ([]jasper.ProcessInfo, error) {
+	if err := q.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid history query")
+	}
+
+	stream, err := h.client.History(ctx, internal.ConvertHistoryQuery(q))
+	if err != nil {
+		return nil, errors.Wrap(err, "getting streaming client")
+	}
+
+	out := []jasper.ProcessInfo{}
+	for {
+		info, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.Wrap(err, "receiving process history")
+		}
+
+		exportedInfo, err := info.Export()
+		if err != nil {
+			return nil, errors.Wrap(err, "exporting process info")
+		}
+		out = append(out, exportedInfo)
+	}
+
+	return out, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 	internal "github.com/mongodb/jasper/remote/internal"
 	"github.com/pkg/errors"
 )
@@ -84,6 +85,51 @@ func (p *rpcProcess) Signal(ctx context.Context, sig syscall.Signal) error {
 	return nil
 }
 
+func (p *rpcProcess) Resize(ctx context.Context, rows, cols uint16) error {
+	resp, err := p.client.Resize(ctx, &internal.ResizeProcess{
+		Id:   &internal.JasperProcessID{Value: p.info.Id},
+		Size: internal.ConvertTTYOptions(&options.TTY{Rows: rows, Cols: cols}),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
+func (p *rpcProcess) Stop(ctx context.Context, policy options.StopPolicy) error {
+	resp, err := p.client.Stop(ctx, &internal.StopProcess{
+		Id:     &internal.JasperProcessID{Value: p.info.Id},
+		Policy: internal.ConvertStopPolicy(policy),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
+func (p *rpcProcess) WaitReady(ctx context.Context) error {
+	resp, err := p.client.WaitReady(ctx, &internal.JasperProcessID{Value: p.info.Id})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
 func (p *rpcProcess) Wait(ctx context.Context) (int, error) {
 	resp, err := p.client.Wait(ctx, &internal.JasperProcessID{Value: p.info.Id})
 	if err != nil {
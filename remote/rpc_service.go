@@ -9,7 +9,9 @@ import (
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/logging"
 	"github.com/mongodb/grip/recovery"
+	"github.com/mongodb/grip/send"
 	"github.com/mongodb/jasper"
+	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/remote/internal"
 	"github.com/mongodb/jasper/util"
 	"github.com/pkg/errors"
@@ -30,24 +32,64 @@ func AttachService(ctx context.Context, manager jasper.Manager, s *grpc.Server)
 // service. The caller is responsible for closing the connection using the
 // returned jasper.CloseFunc.
 func StartRPCService(ctx context.Context, manager jasper.Manager, addr net.Addr, creds *certdepot.Credentials) (util.CloseFunc, error) {
-	lis, err := net.Listen(addr.Network(), addr.String())
-	if err != nil {
-		return nil, errors.Wrapf(err, "listening on '%s'", addr.String())
+	return StartRPCServiceWithOptions(ctx, manager, addr, RPCServiceOptions{Credentials: creds})
+}
+
+// RPCServiceOptions describe how the RPC service secures and audits calls.
+type RPCServiceOptions struct {
+	// Credentials, if set, are used to establish a secure TLS connection with
+	// clients.
+	Credentials *certdepot.Credentials
+	// Authorization, if set, limits the operations that each client can
+	// perform. Clients are identified by the common name of their TLS
+	// certificate.
+	Authorization *options.Authorization
+	// Audit, if set, is the sender to which the service sends an audit
+	// record of each call that could modify the manager, its processes or
+	// the host.
+	Audit send.Sender
+}
+
+// StartRPCServiceWithOptions is the same as StartRPCService, but the service
+// is secured according to the given options.
+func StartRPCServiceWithOptions(ctx context.Context, manager jasper.Manager, addr net.Addr, opts RPCServiceOptions) (util.CloseFunc, error) {
+	unaryInterceptors := []grpc.UnaryServerInterceptor{aviation.MakeGripUnaryInterceptor(logging.MakeGrip(grip.GetSender()))}
+	streamInterceptors := []grpc.StreamServerInterceptor{aviation.MakeGripStreamInterceptor(logging.MakeGrip(grip.GetSender()))}
+	if opts.Audit != nil {
+		unary, stream, err := internal.NewAuditInterceptors(opts.Audit)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating audit interceptors")
+		}
+		unaryInterceptors = append(unaryInterceptors, unary)
+		streamInterceptors = append(streamInterceptors, stream)
+	}
+	if opts.Authorization != nil {
+		unary, stream, err := internal.NewAuthorizationInterceptors(*opts.Authorization)
+		if err != nil {
+			return nil, errors.Wrap(err, "creating authorization interceptors")
+		}
+		unaryInterceptors = append(unaryInterceptors, unary)
+		streamInterceptors = append(streamInterceptors, stream)
 	}
 
-	opts := []grpc.ServerOption{
-		grpc.UnaryInterceptor(aviation.MakeGripUnaryInterceptor(logging.MakeGrip(grip.GetSender()))),
-		grpc.StreamInterceptor(aviation.MakeGripStreamInterceptor(logging.MakeGrip(grip.GetSender()))),
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	}
-	if creds != nil {
-		tlsConf, err := creds.Resolve()
+	if opts.Credentials != nil {
+		tlsConf, err := opts.Credentials.Resolve()
 		if err != nil {
 			return nil, errors.Wrap(err, "generating TLS config from server credentials")
 		}
-		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConf)))
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConf)))
+	}
+
+	lis, err := net.Listen(addr.Network(), addr.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "listening on '%s'", addr.String())
 	}
 
-	service := grpc.NewServer(opts...)
+	service := grpc.NewServer(serverOpts...)
 
 	ctx, cancel := context.WithCancel(ctx)
 	if err := AttachService(ctx, manager, service); err != nil {
@@ -0,0 +1,160 @@
+package jasper
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/grip/recovery"
+)
+
+// DefaultResourceSampleInterval is the default duration between samples of a
+// process' resource usage.
+const DefaultResourceSampleInterval = time.Second
+
+// ResourceUsage is a single measurement of the resources consumed by a process
+// and all of its descendants.
+type ResourceUsage struct {
+	// CPUUserTime and CPUSystemTime are the cumulative CPU time spent by the
+	// process tree in user and kernel mode, respectively.
+	CPUUserTime   time.Duration `json:"cpu_user_time" bson:"cpu_user_time"`
+	CPUSystemTime time.Duration `json:"cpu_system_time" bson:"cpu_system_time"`
+	// RSS is the resident set size of the process tree in bytes.
+	RSS uint64 `json:"rss" bson:"rss"`
+	// ReadBytes and WriteBytes are the cumulative number of bytes that the
+	// process tree has caused to be read from and written to storage.
+	ReadBytes  uint64 `json:"read_bytes" bson:"read_bytes"`
+	WriteBytes uint64 `json:"write_bytes" bson:"write_bytes"`
+	// OpenFiles is the number of open file descriptors in the process tree.
+	OpenFiles int `json:"open_files" bson:"open_files"`
+	// NumProcesses is the number of live processes in the process tree.
+	NumProcesses int `json:"num_processes" bson:"num_processes"`
+}
+
+// ProcessResources reports the resource usage of a process tree over the
+// lifetime of the process. Peak contains the maximum observed value of each
+// individual measurement, so the values in Peak may come from different
+// samples.
+type ProcessResources struct {
+	Last       ResourceUsage `json:"last" bson:"last"`
+	Peak       ResourceUsage `json:"peak" bson:"peak"`
+	NumSamples int           `json:"num_samples" bson:"num_samples"`
+	SampledAt  time.Time     `json:"sampled_at" bson:"sampled_at"`
+}
+
+// update records a new sample, updating the peak values as needed.
+func (r *ProcessResources) update(usage ResourceUsage, at time.Time) {
+	r.Last = usage
+	r.NumSamples++
+	r.SampledAt = at
+
+	r.Peak.CPUUserTime = maxDuration(r.Peak.CPUUserTime, usage.CPUUserTime)
+	r.Peak.CPUSystemTime = maxDuration(r.Peak.CPUSystemTime, usage.CPUSystemTime)
+	r.Peak.RSS = maxUint64(r.Peak.RSS, usage.RSS)
+	r.Peak.ReadBytes = maxUint64(r.Peak.ReadBytes, usage.ReadBytes)
+	r.Peak.WriteBytes = maxUint64(r.Peak.WriteBytes, usage.WriteBytes)
+	r.Peak.OpenFiles = maxInt(r.Peak.OpenFiles, usage.OpenFiles)
+	r.Peak.NumProcesses = maxInt(r.Peak.NumProcesses, usage.NumProcesses)
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// resourceSampler periodically samples the resource usage of a local process
+// tree rooted at a PID.
+type resourceSampler struct {
+	pid       int
+	mu        sync.RWMutex
+	resources *ProcessResources
+}
+
+func newResourceSampler(pid int) *resourceSampler {
+	return &resourceSampler{pid: pid}
+}
+
+// run samples the process tree's resources until the context is done or the
+// process has exited. If sampling is not supported on this platform, it
+// returns immediately.
+func (s *resourceSampler) run(ctx context.Context, interval time.Duration, exited <-chan struct{}) {
+	defer recovery.LogStackTraceAndContinue("resource sampler")
+
+	if interval <= 0 {
+		interval = DefaultResourceSampleInterval
+	}
+
+	if err := s.sample(); err != nil {
+		grip.Debug(ctx, message.WrapError(err, message.Fields{
+			"message": "could not sample process resources",
+			"pid":     s.pid,
+		}))
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-exited:
+			return
+		case <-ticker.C:
+			// The process may exit between checking the exit channel and
+			// sampling, so errors are expected near the end of its
+			// lifetime.
+			_ = s.sample()
+		}
+	}
+}
+
+func (s *resourceSampler) sample() error {
+	usage, err := sampleProcessTree(s.pid)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.resources == nil {
+		s.resources = &ProcessResources{}
+	}
+	s.resources.update(usage, time.Now())
+
+	return nil
+}
+
+// get returns a copy of the currently sampled resources, or nil if no samples
+// have been taken.
+func (s *resourceSampler) get() *ProcessResources {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.resources == nil {
+		return nil
+	}
+	resources := *s.resources
+	return &resources
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+
+package jasper
+
+import "github.com/pkg/errors"
+
+// sampleProcessTree is a placeholder implementation for platforms that do not
+// support resource sampling through /proc.
+func sampleProcessTree(int) (ResourceUsage, error) {
+	return ResourceUsage{}, errors.New("resource sampling is not supported on this platform")
+}
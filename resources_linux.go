@@ -0,0 +1,200 @@
+package jasper
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// clockTicksPerSecond is the number of clock ticks per second used by the
+// kernel to report CPU times in /proc. This is almost universally 100 on
+// Linux, and cannot be queried without cgo.
+const clockTicksPerSecond = 100
+
+// sampleProcessTree measures the resource usage of the process with the given
+// PID and all of its descendants by reading from /proc.
+func sampleProcessTree(pid int) (ResourceUsage, error) {
+	root, err := readProcStat(pid)
+	if err != nil {
+		return ResourceUsage{}, errors.Wrapf(err, "reading stats for process with PID %d", pid)
+	}
+
+	usage := ResourceUsage{}
+	// The root's children CPU times include all descendants that have already
+	// been waited on, so the tree's cumulative CPU usage does not drop when a
+	// child exits.
+	usage.CPUUserTime += root.childUserTime
+	usage.CPUSystemTime += root.childSystemTime
+
+	for _, member := range append([]int{pid}, listDescendants(pid)...) {
+		stat := root
+		if member != pid {
+			if stat, err = readProcStat(member); err != nil {
+				// The descendant may have exited since it was listed.
+				continue
+			}
+		}
+
+		usage.NumProcesses++
+		usage.CPUUserTime += stat.userTime
+		usage.CPUSystemTime += stat.systemTime
+		usage.RSS += stat.rss
+
+		if read, write, err := readProcIO(member); err == nil {
+			usage.ReadBytes += read
+			usage.WriteBytes += write
+		}
+		if fds, err := os.ReadDir(fmt.Sprintf("/proc/%d/fd", member)); err == nil {
+			usage.OpenFiles += len(fds)
+		}
+	}
+
+	return usage, nil
+}
+
+type procStat struct {
+	state           byte
+	ppid            int
+	pgrp            int
+	userTime        time.Duration
+	systemTime      time.Duration
+	childUserTime   time.Duration
+	childSystemTime time.Duration
+	rss             uint64
+	// startTicks is the time that the process started in clock ticks since
+	// boot. Together with the PID, it uniquely identifies a process.
+	startTicks uint64
+}
+
+// readProcStat parses the relevant fields from /proc/<pid>/stat.
+func readProcStat(pid int) (procStat, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return procStat{}, errors.WithStack(err)
+	}
+
+	// The command name is enclosed in parentheses and may itself contain
+	// spaces or parentheses, so the remaining fields begin after the last
+	// closing parenthesis.
+	end := bytes.LastIndexByte(data, ')')
+	if end < 0 {
+		return procStat{}, errors.New("malformed stat file")
+	}
+	fields := strings.Fields(string(data[end+1:]))
+	// fields[0] is the third field in the stat file (the process state).
+	const (
+		stateIdx     = 0
+		ppidIdx      = 1
+		pgrpIdx      = 2
+		utimeIdx     = 11
+		stimeIdx     = 12
+		cutimeIdx    = 13
+		cstimeIdx    = 14
+		startTimeIdx = 19
+		rssIdx       = 21
+	)
+	if len(fields) <= rssIdx {
+		return procStat{}, errors.New("stat file has too few fields")
+	}
+
+	var nums [rssIdx + 1]int64
+	for _, idx := range []int{ppidIdx, pgrpIdx, utimeIdx, stimeIdx, cutimeIdx, cstimeIdx, startTimeIdx, rssIdx} {
+		if nums[idx], err = strconv.ParseInt(fields[idx], 10, 64); err != nil {
+			return procStat{}, errors.Wrapf(err, "parsing stat field %d", idx+3)
+		}
+	}
+
+	ticks := func(n int64) time.Duration {
+		return time.Duration(n) * time.Second / clockTicksPerSecond
+	}
+
+	return procStat{
+		state:           fields[stateIdx][0],
+		ppid:            int(nums[ppidIdx]),
+		pgrp:            int(nums[pgrpIdx]),
+		userTime:        ticks(nums[utimeIdx]),
+		systemTime:      ticks(nums[stimeIdx]),
+		childUserTime:   ticks(nums[cutimeIdx]),
+		childSystemTime: ticks(nums[cstimeIdx]),
+		rss:             uint64(nums[rssIdx]) * uint64(os.Getpagesize()),
+		startTicks:      uint64(nums[startTimeIdx]),
+	}, nil
+}
+
+// readProcIO returns the number of bytes read from and written to storage by
+// the process from /proc/<pid>/io. Reading this file requires the same
+// permissions as ptrace.
+func readProcIO(pid int) (read, write uint64, err error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/io", pid))
+	if err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, val, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "read_bytes":
+			read, _ = strconv.ParseUint(strings.TrimSpace(val), 10, 64)
+		case "write_bytes":
+			write, _ = strconv.ParseUint(strings.TrimSpace(val), 10, 64)
+		}
+	}
+
+	return read, write, errors.WithStack(scanner.Err())
+}
+
+// listDescendants returns the PIDs of all live descendants of the process with
+// the given PID.
+func listDescendants(pid int) []int {
+	var descendants []int
+	queue := []int{pid}
+	seen := map[int]bool{pid: true}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		for _, child := range listChildren(parent) {
+			if seen[child] {
+				continue
+			}
+			seen[child] = true
+			descendants = append(descendants, child)
+			queue = append(queue, child)
+		}
+	}
+	return descendants
+}
+
+// listChildren returns the PIDs of the direct children of the process with the
+// given PID using /proc/<pid>/task/<tid>/children.
+func listChildren(pid int) []int {
+	taskFiles, err := filepath.Glob(fmt.Sprintf("/proc/%d/task/*/children", pid))
+	if err != nil {
+		return nil
+	}
+
+	var children []int
+	for _, fn := range taskFiles {
+		data, err := os.ReadFile(fn)
+		if err != nil {
+			continue
+		}
+		for _, field := range strings.Fields(string(data)) {
+			if child, err := strconv.Atoi(field); err == nil {
+				children = append(children, child)
+			}
+		}
+	}
+	return children
+}
@@ -0,0 +1,36 @@
+package jasper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessResources(t *testing.T) {
+	t.Run("UpdateTracksLastAndPeakValues", func(t *testing.T) {
+		r := &ProcessResources{}
+		first := time.Now()
+		r.update(ResourceUsage{CPUUserTime: time.Second, RSS: 200, OpenFiles: 3, NumProcesses: 2}, first)
+		second := first.Add(time.Second)
+		r.update(ResourceUsage{CPUUserTime: 2 * time.Second, RSS: 100, OpenFiles: 5, NumProcesses: 1}, second)
+
+		assert.Equal(t, 2, r.NumSamples)
+		assert.Equal(t, second, r.SampledAt)
+		assert.Equal(t, ResourceUsage{CPUUserTime: 2 * time.Second, RSS: 100, OpenFiles: 5, NumProcesses: 1}, r.Last)
+		assert.Equal(t, ResourceUsage{CPUUserTime: 2 * time.Second, RSS: 200, OpenFiles: 5, NumProcesses: 2}, r.Peak)
+	})
+	t.Run("NilSamplerReturnsNoResources", func(t *testing.T) {
+		var s *resourceSampler
+		assert.Nil(t, s.get())
+	})
+	t.Run("SamplerWithoutSamplesReturnsNoResources", func(t *testing.T) {
+		assert.Nil(t, newResourceSampler(-1).get())
+	})
+	t.Run("SampleFailsForNonexistentProcess", func(t *testing.T) {
+		s := newResourceSampler(-1)
+		require.Error(t, s.sample())
+		assert.Nil(t, s.get())
+	})
+}
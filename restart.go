@@ -0,0 +1,238 @@
+package jasper
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/options"
+)
+
+// restartLineage is the restart history of a process that was created to
+// restart a previous process.
+type restartLineage struct {
+	// count is the total number of restarts.
+	count int
+	// retries is the number of consecutive restarts since the restart policy's
+	// reset window last elapsed.
+	retries int
+	// previousIDs are the IDs of the restarted processes, from oldest to
+	// newest.
+	previousIDs []string
+}
+
+// apply sets the restart history in the process info.
+func (l restartLineage) apply(info *ProcessInfo) {
+	info.RestartCount = l.count
+	info.PreviousIDs = l.previousIDs
+}
+
+// next returns the lineage of the process that restarts the process with the
+// given info and lineage.
+func (l restartLineage) next(info ProcessInfo, retries int) restartLineage {
+	previousIDs := make([]string, 0, len(l.previousIDs)+1)
+	previousIDs = append(previousIDs, l.previousIDs...)
+	return restartLineage{
+		count:       l.count + 1,
+		retries:     retries + 1,
+		previousIDs: append(previousIDs, info.ID),
+	}
+}
+
+// restartableProcess is a process whose restart history can be recorded.
+type restartableProcess interface {
+	setRestartLineage(restartLineage)
+}
+
+// setRestartLineage records the restart history of the process if the process
+// supports it.
+func setRestartLineage(proc Process, lineage restartLineage) {
+	if rp, ok := proc.(restartableProcess); ok {
+		rp.setRestartLineage(lineage)
+		return
+	}
+	grip.Warning(context.Background(), message.Fields{
+		"message": "cannot record restart history of process",
+		"process": proc.ID(),
+	})
+}
+
+// restartSupervisedKey is the context key that marks the creation of a process
+// whose restarts are already supervised by an outer manager.
+type restartSupervisedKey struct{}
+
+// superviseRestarts returns whether the manager should supervise the restarts
+// of the process created with the given context and options. If so, it also
+// returns the context with which to create the process so that the managers
+// that it wraps do not also supervise it.
+func superviseRestarts(ctx context.Context, opts *options.Create) (context.Context, bool) {
+	if opts == nil || opts.Restart == nil || opts.Restart.Condition == options.RestartNever {
+		return ctx, false
+	}
+	if supervised, _ := ctx.Value(restartSupervisedKey{}).(bool); supervised {
+		return ctx, false
+	}
+	return context.WithValue(ctx, restartSupervisedKey{}, true), true
+}
+
+// withoutRestartSupervision returns the context without the mark of an outer
+// manager supervising restarts, so that the manager that restarts a process
+// supervises the new process.
+func withoutRestartSupervision(ctx context.Context) context.Context {
+	return context.WithValue(ctx, restartSupervisedKey{}, false)
+}
+
+// restartLineageKey is the context key for the restart history of a process
+// that is created to restart a previous process.
+type restartLineageKey struct{}
+
+// withRestartLineage returns the context with which to create a process that
+// restarts a previous process, so that the process records its restart
+// history from the moment it is created, before any wrapped manager records
+// or lists it.
+func withRestartLineage(ctx context.Context, lineage restartLineage) context.Context {
+	return context.WithValue(ctx, restartLineageKey{}, lineage)
+}
+
+// restartLineageFromContext returns the restart history of the process being
+// created with the context, if any.
+func restartLineageFromContext(ctx context.Context) restartLineage {
+	lineage, _ := ctx.Value(restartLineageKey{}).(restartLineage)
+	return lineage
+}
+
+// restartFunc creates a process with the given options to restart a previous
+// process and records the given restart history in it. It returns a nil
+// process if the process should no longer be restarted.
+type restartFunc func(context.Context, *options.Create, restartLineage) (Process, error)
+
+// processRestarter restarts the processes of a manager according to their
+// restart policies. The zero value is ready to use and is thread-safe.
+type processRestarter struct {
+	mu     sync.Mutex
+	closed bool
+	done   chan struct{}
+}
+
+// supervise restarts the process once it exits if its restart policy requires
+// it. A process is not restarted if it was signaled through Jasper (e.g. with
+// Signal, Stop, or by closing the manager), if the context is done when it
+// exits, or once the restarter is closed. Restarted processes are not bound to
+// the context, so they are supervised until one of the other conditions is
+// met.
+func (r *processRestarter) supervise(ctx context.Context, proc Process, lineage restartLineage, restart restartFunc) {
+	var signaled atomic.Bool
+	onExit := func(info ProcessInfo) {
+		if signaled.Load() || ctx.Err() != nil {
+			return
+		}
+		go r.restart(withoutRestartSupervision(context.WithoutCancel(ctx)), info, lineage, restart)
+	}
+	// The triggers cannot be registered if the process has already exited.
+	handleErr := func(err error) {
+		if info := proc.Info(ctx); info.Complete {
+			onExit(info)
+			return
+		}
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not register triggers to supervise process restarts",
+			"process": proc.ID(),
+		}))
+	}
+
+	if err := proc.RegisterSignalTrigger(ctx, func(ProcessInfo, syscall.Signal) bool {
+		signaled.Store(true)
+		return false
+	}); err != nil {
+		handleErr(err)
+		return
+	}
+	if err := proc.RegisterTrigger(ctx, onExit); err != nil {
+		handleErr(err)
+	}
+}
+
+// restart restarts the exited process after the backoff delay if its restart
+// policy requires it.
+func (r *processRestarter) restart(ctx context.Context, info ProcessInfo, lineage restartLineage, restart restartFunc) {
+	policy := info.Options.Restart
+	if policy == nil || !policy.ShouldRestart(info.Successful) {
+		return
+	}
+
+	retries := lineage.retries
+	if policy.ResetWindow > 0 && info.EndAt.Sub(info.StartAt) >= policy.ResetWindow {
+		retries = 0
+	}
+	if policy.MaxRetries > 0 && retries >= policy.MaxRetries {
+		grip.Info(ctx, message.Fields{
+			"message":     "not restarting process because it has reached the maximum number of retries",
+			"process":     info.ID,
+			"max_retries": policy.MaxRetries,
+		})
+		return
+	}
+
+	timer := time.NewTimer(policy.Delay(retries))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-r.doneChan():
+		return
+	}
+
+	proc, err := restart(ctx, info.Options.Copy(), lineage.next(info, retries))
+	if err != nil {
+		grip.Warning(ctx, message.WrapError(err, message.Fields{
+			"message": "could not restart process",
+			"process": info.ID,
+		}))
+		return
+	}
+	if proc == nil {
+		return
+	}
+
+	grip.Info(ctx, message.Fields{
+		"message":  "restarted process",
+		"process":  proc.ID(),
+		"previous": info.ID,
+	})
+}
+
+func (r *processRestarter) doneChan() chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.done == nil {
+		r.done = make(chan struct{})
+	}
+	return r.done
+}
+
+// isClosed returns whether or not the restarter is closed.
+func (r *processRestarter) isClosed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.closed
+}
+
+// close stops all pending and future restarts.
+func (r *processRestarter) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return
+	}
+	r.closed = true
+	if r.done == nil {
+		r.done = make(chan struct{})
+	}
+	close(r.done)
+}
@@ -0,0 +1,185 @@
+package jasper
+
+import (
+	"context"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	testoptions "github.com/mongodb/jasper/testutil/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestartPolicy(t *testing.T) {
+	// latestRestart waits until the manager has at least the given number of
+	// processes and returns the info of the most restarted process.
+	latestRestart := func(ctx context.Context, t *testing.T, mngr Manager, numProcs int) ProcessInfo {
+		var latest ProcessInfo
+		require.Eventually(t, func() bool {
+			procs, err := mngr.List(ctx, options.All)
+			require.NoError(t, err)
+			if len(procs) < numProcs {
+				return false
+			}
+			for _, proc := range procs {
+				if info := proc.Info(ctx); info.RestartCount >= latest.RestartCount {
+					latest = info
+				}
+			}
+			return true
+		}, 5*time.Second, 10*time.Millisecond)
+		return latest
+	}
+	// assertNotRestarted asserts that the manager still has the given number
+	// of processes after the restart backoff has elapsed.
+	assertNotRestarted := func(ctx context.Context, t *testing.T, mngr Manager, numProcs int) {
+		time.Sleep(100 * time.Millisecond)
+		procs, err := mngr.List(ctx, options.All)
+		require.NoError(t, err)
+		assert.Len(t, procs, numProcs)
+	}
+	restartOpts := func(opts *options.Create, condition options.RestartCondition, maxRetries int) *options.Create {
+		opts.Restart = &options.Restart{
+			Condition:  condition,
+			MaxRetries: maxRetries,
+			Backoff:    10 * time.Millisecond,
+			MaxBackoff: 20 * time.Millisecond,
+		}
+		return opts
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, mngr Manager){
+		"OnFailureRestartsFailedProcessUntilMaxRetries": func(ctx context.Context, t *testing.T, mngr Manager) {
+			proc, err := mngr.CreateProcess(ctx, restartOpts(testoptions.FalseCreateOpts(), options.RestartOnFailure, 2))
+			require.NoError(t, err)
+
+			latest := latestRestart(ctx, t, mngr, 3)
+			assert.Equal(t, 2, latest.RestartCount)
+			require.Len(t, latest.PreviousIDs, 2)
+			assert.Equal(t, proc.ID(), latest.PreviousIDs[0])
+			assert.Zero(t, proc.Info(ctx).RestartCount)
+			assertNotRestarted(ctx, t, mngr, 3)
+		},
+		"OnFailureDoesNotRestartSuccessfulProcess": func(ctx context.Context, t *testing.T, mngr Manager) {
+			proc, err := mngr.CreateProcess(ctx, restartOpts(testoptions.TrueCreateOpts(), options.RestartOnFailure, 0))
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.NoError(t, err)
+
+			assertNotRestarted(ctx, t, mngr, 1)
+		},
+		"AlwaysRestartsSuccessfulProcess": func(ctx context.Context, t *testing.T, mngr Manager) {
+			proc, err := mngr.CreateProcess(ctx, restartOpts(testoptions.TrueCreateOpts(), options.RestartAlways, 1))
+			require.NoError(t, err)
+
+			latest := latestRestart(ctx, t, mngr, 2)
+			assert.Equal(t, 1, latest.RestartCount)
+			assert.Equal(t, []string{proc.ID()}, latest.PreviousIDs)
+			assert.Equal(t, testoptions.TrueCreateOpts().Args, latest.Options.Args)
+		},
+		"NeverDoesNotRestartProcess": func(ctx context.Context, t *testing.T, mngr Manager) {
+			proc, err := mngr.CreateProcess(ctx, restartOpts(testoptions.FalseCreateOpts(), options.RestartNever, 0))
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.Error(t, err)
+
+			assertNotRestarted(ctx, t, mngr, 1)
+		},
+		"ResetWindowResetsRetries": func(ctx context.Context, t *testing.T, mngr Manager) {
+			opts := restartOpts(&options.Create{Args: []string{"sleep", "0.1"}}, options.RestartAlways, 1)
+			opts.Restart.ResetWindow = 50 * time.Millisecond
+			_, err := mngr.CreateProcess(ctx, opts)
+			require.NoError(t, err)
+
+			latest := latestRestart(ctx, t, mngr, 3)
+			assert.GreaterOrEqual(t, latest.RestartCount, 2)
+		},
+		"SignaledProcessIsNotRestarted": func(ctx context.Context, t *testing.T, mngr Manager) {
+			proc, err := mngr.CreateProcess(ctx, restartOpts(testoptions.SleepCreateOpts(10), options.RestartAlways, 0))
+			require.NoError(t, err)
+			require.NoError(t, proc.Signal(ctx, syscall.SIGTERM))
+			_, err = proc.Wait(ctx)
+			require.Error(t, err)
+
+			assertNotRestarted(ctx, t, mngr, 1)
+		},
+		"ProcessIsNotRestartedAfterContextIsDone": func(ctx context.Context, t *testing.T, mngr Manager) {
+			pctx, cancel := context.WithCancel(ctx)
+			proc, err := mngr.CreateProcess(pctx, restartOpts(testoptions.SleepCreateOpts(10), options.RestartAlways, 0))
+			require.NoError(t, err)
+			cancel()
+			_, err = proc.Wait(ctx)
+			require.Error(t, err)
+
+			assertNotRestarted(ctx, t, mngr, 1)
+		},
+		"CloseStopsRestarts": func(ctx context.Context, t *testing.T, mngr Manager) {
+			opts := restartOpts(testoptions.FalseCreateOpts(), options.RestartAlways, 0)
+			opts.Restart.Backoff = 200 * time.Millisecond
+			opts.Restart.MaxBackoff = opts.Restart.Backoff
+			proc, err := mngr.CreateProcess(ctx, opts)
+			require.NoError(t, err)
+			_, err = proc.Wait(ctx)
+			require.Error(t, err)
+
+			require.NoError(t, mngr.Close(ctx))
+			time.Sleep(2 * opts.Restart.Backoff)
+			procs, err := mngr.List(ctx, options.All)
+			require.NoError(t, err)
+			assert.Len(t, procs, 1)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			for managerName, makeManager := range map[string]func(ctx context.Context, t *testing.T) Manager{
+				"SynchronizedManager": func(_ context.Context, t *testing.T) Manager {
+					mngr, err := NewSynchronizedManager(false)
+					require.NoError(t, err)
+					return mngr
+				},
+				"SynchronizedJournaledManager": func(ctx context.Context, t *testing.T) Manager {
+					journal, err := NewBoltProcessJournal(filepath.Join(t.TempDir(), "journal.db"))
+					require.NoError(t, err)
+					t.Cleanup(func() {
+						assert.NoError(t, journal.Close())
+					})
+					basicMngr, err := newBasicProcessManager(map[string]Process{}, false)
+					require.NoError(t, err)
+					journaledMngr, err := MakeJournaledManager(ctx, basicMngr, journal)
+					require.NoError(t, err)
+					return MakeSynchronizedManager(journaledMngr)
+				},
+				"RestartingHistoryJournaledManager": func(ctx context.Context, t *testing.T) Manager {
+					journal, err := NewBoltProcessJournal(filepath.Join(t.TempDir(), "journal.db"))
+					require.NoError(t, err)
+					history, err := NewBoltProcessHistory(filepath.Join(t.TempDir(), "history.db"))
+					require.NoError(t, err)
+					t.Cleanup(func() {
+						assert.NoError(t, journal.Close())
+						assert.NoError(t, history.Close())
+					})
+					syncMngr, err := NewSynchronizedManager(false)
+					require.NoError(t, err)
+					journaledMngr, err := MakeJournaledManager(ctx, syncMngr, journal)
+					require.NoError(t, err)
+					return MakeRestartingManager(MakeHistoryManager(journaledMngr, history))
+				},
+			} {
+				t.Run(managerName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+					defer cancel()
+
+					mngr := makeManager(ctx, t)
+					defer func() {
+						assert.NoError(t, mngr.Close(ctx))
+					}()
+
+					testCase(ctx, t, mngr)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package jasper
+
+import (
+	"context"
+	"syscall"
+	"time"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// stopPollInterval is the interval between checks for whether the other
+// processes in a stop target have exited.
+const stopPollInterval = 50 * time.Millisecond
+
+// StopResult reports the step of a stop policy that ended a process.
+type StopResult struct {
+	// Step is the index of the step in the stop policy's steps.
+	Step int `json:"step" bson:"step"`
+	// Signal is the signal that the step sent.
+	Signal syscall.Signal `json:"signal" bson:"signal"`
+}
+
+// stopProcess stops the process by following the stop policy. Before each
+// step's signal is sent, record is called with the step so that the process
+// can report which step ended it once it exits. If the process is not stopped,
+// record is called with nil so that a later exit is not attributed to the
+// stop policy.
+//
+// For group and tree targets, the other processes in the target are
+// discovered before each step while the process is still running, since
+// descendants can no longer be found once the process exits and they are
+// reparented. The process itself is always signaled with its Signal method so
+// that its signal triggers run.
+func stopProcess(ctx context.Context, proc Process, policy options.StopPolicy, record func(*StopResult)) (err error) {
+	if err = policy.Validate(); err != nil {
+		return errors.Wrap(err, "invalid stop policy")
+	}
+	if proc.Complete(ctx) {
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			record(nil)
+		}
+	}()
+
+	pid := proc.Info(ctx).PID
+	members := map[int]uint64{}
+	for i, step := range policy.Steps {
+		if policy.Target != options.StopTargetProcess && proc.Running(ctx) {
+			found, err := stopTargetMembers(pid, policy.Target)
+			if err != nil {
+				return errors.Wrapf(err, "finding processes in stop target '%s'", policy.Target)
+			}
+			for member, startTicks := range found {
+				members[member] = startTicks
+			}
+		}
+
+		record(&StopResult{Step: i, Signal: step.Signal})
+
+		if proc.Running(ctx) {
+			if err := proc.Signal(ctx, step.Signal); err != nil && proc.Running(ctx) {
+				return errors.Wrapf(err, "sending signal '%s' in step %d", step.Signal, i)
+			}
+		}
+		for member, startTicks := range members {
+			if !isProcessAlive(member, startTicks) {
+				delete(members, member)
+				continue
+			}
+			grip.Debug(ctx, message.WrapError(signalPID(member, step.Signal), message.Fields{
+				"message": "could not signal process in stop target",
+				"process": proc.ID(),
+				"pid":     member,
+				"signal":  step.Signal,
+			}))
+		}
+
+		if waitForStop(ctx, proc, members, step.GracePeriod) {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return errors.Errorf("process '%s' did not stop after %d step(s) of the stop policy", proc.ID(), len(policy.Steps))
+}
+
+// waitForStop waits up to the grace period for the process and the other
+// processes in the stop target to exit. It returns whether or not they all
+// exited.
+func waitForStop(ctx context.Context, proc Process, members map[int]uint64, gracePeriod time.Duration) bool {
+	wctx, cancel := context.WithTimeout(ctx, gracePeriod)
+	defer cancel()
+
+	_, _ = proc.Wait(wctx)
+	if !proc.Complete(ctx) {
+		return false
+	}
+
+	ticker := time.NewTicker(stopPollInterval)
+	defer ticker.Stop()
+	for {
+		for member, startTicks := range members {
+			if !isProcessAlive(member, startTicks) {
+				delete(members, member)
+			}
+		}
+		if len(members) == 0 {
+			return true
+		}
+
+		select {
+		case <-wctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,26 @@
+//go:build !linux
+
+package jasper
+
+import (
+	"syscall"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// stopTargetMembers is a placeholder implementation for platforms that do not
+// support finding processes through /proc, so only the process itself can be
+// stopped.
+func stopTargetMembers(_ int, target options.StopTarget) (map[int]uint64, error) {
+	if target == options.StopTargetProcess {
+		return map[int]uint64{}, nil
+	}
+	return nil, errors.Errorf("stop target '%s' is not supported on this platform", target)
+}
+
+// signalPID is a placeholder implementation for platforms that do not support
+// finding processes through /proc.
+func signalPID(int, syscall.Signal) error {
+	return errors.New("signaling processes by PID is not supported on this platform")
+}
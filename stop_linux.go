@@ -0,0 +1,67 @@
+package jasper
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
+)
+
+// stopTargetMembers returns the processes other than the process with the
+// given PID that are in the stop target, mapped to the time that they started
+// in clock ticks since boot.
+func stopTargetMembers(pid int, target options.StopTarget) (map[int]uint64, error) {
+	switch target {
+	case options.StopTargetGroup:
+		return listProcessGroup(pid)
+	case options.StopTargetTree:
+		members := map[int]uint64{}
+		for _, descendant := range listDescendants(pid) {
+			if stat, err := readProcStat(descendant); err == nil && stat.state != 'Z' {
+				members[descendant] = stat.startTicks
+			}
+		}
+		return members, nil
+	default:
+		return map[int]uint64{}, nil
+	}
+}
+
+// listProcessGroup returns the processes other than the process with the given
+// PID in the process group that it leads by scanning /proc.
+func listProcessGroup(pid int) (map[int]uint64, error) {
+	leader, err := readProcStat(pid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading stats for process with PID %d", pid)
+	}
+	if leader.pgrp != pid {
+		return nil, errors.Errorf("process with PID %d is not the leader of its process group", pid)
+	}
+
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, errors.Wrap(err, "listing processes")
+	}
+
+	members := map[int]uint64{}
+	for _, entry := range entries {
+		member, err := strconv.Atoi(entry.Name())
+		if err != nil || member == pid {
+			continue
+		}
+		stat, err := readProcStat(member)
+		if err != nil || stat.state == 'Z' || stat.pgrp != pid {
+			continue
+		}
+		members[member] = stat.startTicks
+	}
+
+	return members, nil
+}
+
+// signalPID sends the signal to the process with the given PID.
+func signalPID(pid int, sig syscall.Signal) error {
+	return errors.Wrapf(syscall.Kill(pid, sig), "sending signal '%s' to process with PID %d", sig, pid)
+}
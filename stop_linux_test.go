@@ -0,0 +1,119 @@
+package jasper
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopProcess(t *testing.T) {
+	// SIGWINCH is ignored by default, so it never stops a process.
+	ignoredStep := options.StopStep{Signal: syscall.SIGWINCH, GracePeriod: 200 * time.Millisecond}
+	killStep := options.StopStep{Signal: syscall.SIGKILL, GracePeriod: 5 * time.Second}
+
+	// startChild starts a shell that runs a sleeping child and waits for the
+	// child to start. It returns the PID of the child.
+	startChild := func(ctx context.Context, t *testing.T, opts *options.Create) (Process, int) {
+		opts.Args = []string{"sh", "-c", "sleep 30 & wait"}
+		proc, err := newBasicProcess(ctx, opts)
+		require.NoError(t, err)
+
+		var children []int
+		require.Eventually(t, func() bool {
+			children = listDescendants(proc.Info(ctx).PID)
+			return len(children) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		return proc, children[0]
+	}
+	isRunning := func(pid int) bool {
+		stat, err := readProcStat(pid)
+		return err == nil && stat.state != 'Z'
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T){
+		"EscalatesToNextStepAfterGracePeriod": func(ctx context.Context, t *testing.T) {
+			proc, err := newBasicProcess(ctx, &options.Create{Args: []string{"sleep", "30"}})
+			require.NoError(t, err)
+
+			require.NoError(t, proc.Stop(ctx, options.StopPolicy{Steps: []options.StopStep{ignoredStep, killStep}}))
+
+			info := proc.Info(ctx)
+			assert.Equal(t, int(syscall.SIGKILL), info.ExitCode)
+			require.NotNil(t, info.StoppedBy)
+			assert.Equal(t, StopResult{Step: 1, Signal: syscall.SIGKILL}, *info.StoppedBy)
+		},
+		"ErrorsIfProcessOutlastsAllSteps": func(ctx context.Context, t *testing.T) {
+			proc, err := newBasicProcess(ctx, &options.Create{Args: []string{"sleep", "30"}})
+			require.NoError(t, err)
+			defer func() {
+				_ = proc.Signal(ctx, syscall.SIGKILL)
+			}()
+
+			assert.Error(t, proc.Stop(ctx, options.StopPolicy{Steps: []options.StopStep{ignoredStep}}))
+			assert.True(t, proc.Running(ctx))
+			assert.Nil(t, proc.Info(ctx).StoppedBy)
+		},
+		"ErrorsWhenContextIsDone": func(ctx context.Context, t *testing.T) {
+			proc, err := newBasicProcess(ctx, &options.Create{Args: []string{"sleep", "30"}})
+			require.NoError(t, err)
+
+			tctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			step := options.StopStep{Signal: syscall.SIGWINCH, GracePeriod: time.Minute}
+			assert.Error(t, proc.Stop(tctx, options.StopPolicy{Steps: []options.StopStep{step, killStep}}))
+			assert.True(t, proc.Running(ctx))
+
+			// The process's exit must not be attributed to a stop policy
+			// that was abandoned.
+			require.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+			_, _ = proc.Wait(ctx)
+			assert.Nil(t, proc.Info(ctx).StoppedBy)
+		},
+		"TreeTargetStopsDescendants": func(ctx context.Context, t *testing.T) {
+			proc, child := startChild(ctx, t, &options.Create{})
+
+			require.NoError(t, proc.Stop(ctx, options.StopPolicy{
+				Steps:  []options.StopStep{{Signal: syscall.SIGTERM, GracePeriod: 5 * time.Second}, killStep},
+				Target: options.StopTargetTree,
+			}))
+			assert.True(t, proc.Complete(ctx))
+			assert.False(t, isRunning(child))
+		},
+		"GroupTargetStopsGroupMembers": func(ctx context.Context, t *testing.T) {
+			proc, child := startChild(ctx, t, &options.Create{GroupLeader: true})
+
+			require.NoError(t, proc.Stop(ctx, options.StopPolicy{
+				Steps:  []options.StopStep{ignoredStep, killStep},
+				Target: options.StopTargetGroup,
+			}))
+			assert.False(t, isRunning(child))
+
+			info := proc.Info(ctx)
+			require.NotNil(t, info.StoppedBy)
+			assert.Equal(t, 1, info.StoppedBy.Step)
+		},
+		"GroupTargetErrorsForProcessThatIsNotGroupLeader": func(ctx context.Context, t *testing.T) {
+			proc, err := newBasicProcess(ctx, &options.Create{Args: []string{"sleep", "30"}})
+			require.NoError(t, err)
+			defer func() {
+				_ = proc.Signal(ctx, syscall.SIGKILL)
+			}()
+
+			assert.Error(t, proc.Stop(ctx, options.StopPolicy{Steps: []options.StopStep{killStep}, Target: options.StopTargetGroup}))
+			assert.True(t, proc.Running(ctx))
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+			defer cancel()
+
+			testCase(ctx, t)
+		})
+	}
+}
@@ -388,6 +388,115 @@ func ProcessTests() []ProcessTestCase {
 				assert.Error(t, proc.Signal(ctx, syscall.SIGTERM))
 			},
 		},
+		{
+			Name: "StopRecordsStepThatEndedProcess",
+			Case: func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+				proc, err := makeProc(ctx, testoptions.SleepCreateOpts(10))
+				require.NoError(t, err)
+
+				policy := options.StopPolicy{Steps: []options.StopStep{
+					{Signal: syscall.SIGTERM, GracePeriod: 5 * time.Second},
+					{Signal: syscall.SIGKILL, GracePeriod: 5 * time.Second},
+				}}
+				require.NoError(t, proc.Stop(ctx, policy))
+
+				info := proc.Info(ctx)
+				assert.True(t, info.Complete)
+				require.NotNil(t, info.StoppedBy)
+				assert.Equal(t, StopResult{Step: 0, Signal: syscall.SIGTERM}, *info.StoppedBy)
+			},
+		},
+		{
+			Name: "StopIsNoopForCompletedProcess",
+			Case: func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+				proc, err := makeProc(ctx, opts)
+				require.NoError(t, err)
+				_, err = proc.Wait(ctx)
+				require.NoError(t, err)
+
+				require.NoError(t, proc.Stop(ctx, options.StopPolicy{}))
+				assert.Nil(t, proc.Info(ctx).StoppedBy)
+			},
+		},
+		{
+			Name: "StopErrorsWithInvalidPolicy",
+			Case: func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+				proc, err := makeProc(ctx, testoptions.SleepCreateOpts(10))
+				require.NoError(t, err)
+				defer func() {
+					_ = proc.Signal(ctx, syscall.SIGKILL)
+				}()
+
+				assert.Error(t, proc.Stop(ctx, options.StopPolicy{Steps: []options.StopStep{{Signal: syscall.SIGTERM}}}))
+				assert.True(t, proc.Running(ctx))
+			},
+		},
+		{
+			Name: "WaitReadyWithoutProbesSucceeds",
+			Case: func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+				proc, err := makeProc(ctx, opts)
+				require.NoError(t, err)
+
+				assert.NoError(t, proc.WaitReady(ctx))
+				assert.False(t, proc.Info(ctx).Ready)
+			},
+		},
+		{
+			Name: "WaitReadyWaitsForProbesToSucceed",
+			Case: func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+				opts = &options.Create{
+					Args: []string{"sh", "-c", "sleep 0.2; echo 'waiting for connections'; sleep 10"},
+					Readiness: &options.Readiness{
+						Probes:   []options.ReadinessProbe{{Log: &options.LogProbe{Pattern: "waiting for connections$"}}},
+						Interval: 10 * time.Millisecond,
+					},
+				}
+				proc, err := makeProc(ctx, opts)
+				require.NoError(t, err)
+				defer func() {
+					_ = proc.Signal(ctx, syscall.SIGKILL)
+				}()
+
+				require.NoError(t, proc.WaitReady(ctx))
+				info := proc.Info(ctx)
+				assert.True(t, info.IsRunning)
+				assert.True(t, info.Ready)
+				assert.True(t, info.ReadyAt.After(info.StartAt))
+			},
+		},
+		{
+			Name: "WaitReadyErrorsIfProcessExitsBeforeItIsReady",
+			Case: func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+				opts.Readiness = &options.Readiness{
+					Probes:   []options.ReadinessProbe{{File: &options.FileProbe{Path: filepath.Join(testutil.BuildDirectory(), "nonexistent")}}},
+					Interval: 10 * time.Millisecond,
+				}
+				proc, err := makeProc(ctx, opts)
+				require.NoError(t, err)
+
+				assert.Error(t, proc.WaitReady(ctx))
+				assert.False(t, proc.Info(ctx).Ready)
+			},
+		},
+		{
+			Name: "WaitReadyErrorsAfterReadinessTimeout",
+			Case: func(ctx context.Context, t *testing.T, opts *options.Create, makeProc ProcessConstructor) {
+				opts = testoptions.SleepCreateOpts(10)
+				opts.Readiness = &options.Readiness{
+					Probes:   []options.ReadinessProbe{{File: &options.FileProbe{Path: filepath.Join(testutil.BuildDirectory(), "nonexistent")}}},
+					Interval: 10 * time.Millisecond,
+					Timeout:  100 * time.Millisecond,
+				}
+				proc, err := makeProc(ctx, opts)
+				require.NoError(t, err)
+				defer func() {
+					_ = proc.Signal(ctx, syscall.SIGKILL)
+				}()
+
+				assert.Error(t, proc.WaitReady(ctx))
+				assert.True(t, proc.Running(ctx))
+			},
+		},
 	}
 }
 
@@ -546,6 +655,181 @@ func ManagerTests() []ManagerTestCase {
 				assert.Empty(t, procs)
 			},
 		},
+		{
+			Name: "QueryWithoutResultsDoesNotError",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				procs, err := mngr.Query(ctx, options.ProcessQuery{Tags: [][]string{{"foo"}}})
+				require.NoError(t, err)
+				assert.Empty(t, procs)
+			},
+		},
+		{
+			Name: "QueryReturnsProcessesMatchingAnyTagSet",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				var procIDs []string
+				for _, tags := range [][]string{{"foo", "bar"}, {"foo"}, {"bat"}} {
+					opts := modifyOpts(testoptions.TrueCreateOpts())
+					opts.Tags = tags
+					proc, err := mngr.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					procIDs = append(procIDs, proc.ID())
+				}
+
+				procs, err := mngr.Query(ctx, options.ProcessQuery{Tags: [][]string{{"foo", "bar"}, {"bat"}}})
+				require.NoError(t, err)
+				require.Len(t, procs, 2)
+				for _, proc := range procs {
+					assert.Contains(t, []string{procIDs[0], procIDs[2]}, proc.ID())
+				}
+			},
+		},
+		{
+			Name: "QueryReturnsCompletedProcessesInExitCodeRange",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				trueProc, err := mngr.CreateProcess(ctx, modifyOpts(testoptions.TrueCreateOpts()))
+				require.NoError(t, err)
+				_, err = trueProc.Wait(ctx)
+				require.NoError(t, err)
+
+				falseProc, err := mngr.CreateProcess(ctx, modifyOpts(testoptions.FalseCreateOpts()))
+				require.NoError(t, err)
+				_, err = falseProc.Wait(ctx)
+				require.Error(t, err)
+
+				minExitCode := 1
+				procs, err := mngr.Query(ctx, options.ProcessQuery{MinExitCode: &minExitCode})
+				require.NoError(t, err)
+				require.Len(t, procs, 1)
+				assert.Equal(t, falseProc.ID(), procs[0].ID())
+
+				maxExitCode := 0
+				procs, err = mngr.Query(ctx, options.ProcessQuery{MaxExitCode: &maxExitCode})
+				require.NoError(t, err)
+				require.Len(t, procs, 1)
+				assert.Equal(t, trueProc.ID(), procs[0].ID())
+			},
+		},
+		{
+			Name: "QueryReturnsProcessesMatchingArgsAndStartTime",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				start := time.Now().Add(-time.Minute)
+				trueProc, err := mngr.CreateProcess(ctx, modifyOpts(testoptions.TrueCreateOpts()))
+				require.NoError(t, err)
+				_, err = mngr.CreateProcess(ctx, modifyOpts(testoptions.FalseCreateOpts()))
+				require.NoError(t, err)
+
+				procs, err := mngr.Query(ctx, options.ProcessQuery{Args: "tru", StartedAfter: start})
+				require.NoError(t, err)
+				require.Len(t, procs, 1)
+				assert.Equal(t, trueProc.ID(), procs[0].ID())
+
+				procs, err = mngr.Query(ctx, options.ProcessQuery{Args: "tru", StartedBefore: start})
+				require.NoError(t, err)
+				assert.Empty(t, procs)
+			},
+		},
+		{
+			Name: "QueryErrorsWithInvalidQuery",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				procs, err := mngr.Query(ctx, options.ProcessQuery{Status: options.Filter("foo")})
+				assert.Error(t, err)
+				assert.Empty(t, procs)
+			},
+		},
+		{
+			Name: "SubscribeReceivesLifecycleEvents",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				sctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				events, err := mngr.Subscribe(sctx, options.ProcessEventFilter{})
+				require.NoError(t, err)
+
+				proc, err := mngr.CreateProcess(ctx, modifyOpts(testoptions.TrueCreateOpts()))
+				require.NoError(t, err)
+				_, err = proc.Wait(ctx)
+				require.NoError(t, err)
+
+				for _, eventType := range []options.ProcessEventType{options.ProcessCreated, options.ProcessStarted, options.ProcessExited} {
+					select {
+					case event := <-events:
+						assert.Equal(t, eventType, event.Type)
+						assert.Equal(t, proc.ID(), event.Info.ID)
+						assert.NotZero(t, event.Time)
+					case <-ctx.Done():
+						require.FailNow(t, "context is done before receiving event", eventType)
+					}
+				}
+			},
+		},
+		{
+			Name: "SubscribeReceivesSignaledEvent",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				sctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				events, err := mngr.Subscribe(sctx, options.ProcessEventFilter{Types: []options.ProcessEventType{options.ProcessSignaled}})
+				require.NoError(t, err)
+
+				proc, err := mngr.CreateProcess(ctx, modifyOpts(testoptions.SleepCreateOpts(10)))
+				require.NoError(t, err)
+				require.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+
+				select {
+				case event := <-events:
+					assert.Equal(t, options.ProcessSignaled, event.Type)
+					assert.Equal(t, syscall.SIGKILL, event.Signal)
+					assert.Equal(t, proc.ID(), event.Info.ID)
+				case <-ctx.Done():
+					require.FailNow(t, "context is done before receiving event")
+				}
+			},
+		},
+		{
+			Name: "SubscribeFiltersEventsByTag",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				sctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				events, err := mngr.Subscribe(sctx, options.ProcessEventFilter{Tags: []string{"foo"}, Types: []options.ProcessEventType{options.ProcessCreated}})
+				require.NoError(t, err)
+
+				_, err = mngr.CreateProcess(ctx, modifyOpts(testoptions.TrueCreateOpts()))
+				require.NoError(t, err)
+				opts := modifyOpts(testoptions.TrueCreateOpts())
+				opts.Tags = []string{"foo"}
+				proc, err := mngr.CreateProcess(ctx, opts)
+				require.NoError(t, err)
+
+				select {
+				case event := <-events:
+					assert.Equal(t, proc.ID(), event.Info.ID)
+				case <-ctx.Done():
+					require.FailNow(t, "context is done before receiving event")
+				}
+			},
+		},
+		{
+			Name: "SubscribeClosesChannelWhenContextIsDone",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				sctx, cancel := context.WithCancel(ctx)
+				events, err := mngr.Subscribe(sctx, options.ProcessEventFilter{})
+				require.NoError(t, err)
+				cancel()
+
+				select {
+				case _, ok := <-events:
+					assert.False(t, ok)
+				case <-ctx.Done():
+					require.FailNow(t, "context is done before channel is closed")
+				}
+			},
+		},
+		{
+			Name: "SubscribeErrorsWithInvalidFilter",
+			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
+				events, err := mngr.Subscribe(ctx, options.ProcessEventFilter{Types: []options.ProcessEventType{"foo"}})
+				assert.Error(t, err)
+				assert.Nil(t, events)
+			},
+		},
 		{
 			Name: "GetProcessErrorsWithNonexistentProcess",
 			Case: func(ctx context.Context, t *testing.T, mngr Manager, modifyOpts testoptions.ModifyOpts) {
@@ -0,0 +1,101 @@
+package jasper
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/containerd/cgroups/v3/cgroup2"
+	"github.com/pkg/errors"
+)
+
+// cgroupV2Mountpoint is the mount point of the unified cgroup hierarchy.
+const cgroupV2Mountpoint = "/sys/fs/cgroup"
+
+// cgroupV2 is a trackerCgroup in the unified cgroup hierarchy. Each process
+// tracker gets its own subtree, so processes that its tracked processes move
+// into child cgroups are still tracked.
+type cgroupV2 struct {
+	manager *cgroup2.Manager
+	path    string
+}
+
+func newCgroupV2(name string) (*cgroupV2, error) {
+	group := "/" + name
+	manager, err := cgroup2.NewManager(cgroupV2Mountpoint, group, &cgroup2.Resources{})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating cgroup v2")
+	}
+	return &cgroupV2{
+		manager: manager,
+		path:    filepath.Join(cgroupV2Mountpoint, group),
+	}, nil
+}
+
+func (c *cgroupV2) Add(pid int) error {
+	return c.manager.AddProc(uint64(pid))
+}
+
+// PIDs lists all PIDs in the cgroup's subtree.
+func (c *cgroupV2) PIDs() ([]int, error) {
+	procs, err := c.manager.Procs(true)
+	if err != nil {
+		return nil, err
+	}
+
+	pids := make([]int, 0, len(procs))
+	for _, proc := range procs {
+		pids = append(pids, int(proc))
+	}
+	return pids, nil
+}
+
+// Kill kills all processes in the cgroup's subtree and waits for them to
+// leave it.
+func (c *cgroupV2) Kill() error {
+	if err := c.manager.Kill(); err != nil {
+		return errors.Wrap(err, "killing cgroup v2 processes")
+	}
+	return waitForEmptyCgroup(c)
+}
+
+// Delete deletes the cgroup along with any child cgroups in its subtree, such
+// as ones created by the tracked processes. The subtree must not contain any
+// processes.
+func (c *cgroupV2) Delete() error {
+	if c.Deleted() {
+		return nil
+	}
+
+	var children []string
+	if err := filepath.WalkDir(c.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() && path != c.path {
+			children = append(children, path)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "finding child cgroups")
+	}
+
+	// A cgroup can only be removed once it has no children, so the subtree is
+	// removed depth-first. Each directory is visited before its children, so
+	// the children are removed first in reverse order.
+	for i := len(children) - 1; i >= 0; i-- {
+		if err := os.Remove(children[i]); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "deleting child cgroup '%s'", children[i])
+		}
+	}
+
+	return c.manager.Delete()
+}
+
+func (c *cgroupV2) Deleted() bool {
+	_, err := os.Stat(c.path)
+	return os.IsNotExist(err)
+}
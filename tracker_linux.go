@@ -3,7 +3,9 @@ package jasper
 import (
 	"context"
 	"syscall"
+	"time"
 
+	"github.com/containerd/cgroups/v3"
 	"github.com/containerd/cgroups/v3/cgroup1"
 	"github.com/mongodb/grip"
 	"github.com/mongodb/grip/message"
@@ -17,21 +19,133 @@ const (
 	// over any other subsystem for this purpose; its purpose is to ensure all
 	// processes can be tracked in a single subsystem for cleanup.
 	defaultSubsystem = cgroup1.Freezer
+
+	// cgroupDrainTimeout is the maximum amount of time to wait for killed
+	// processes to leave a cgroup.
+	cgroupDrainTimeout = 5 * time.Second
+	// cgroupDrainInterval is the interval between checks for whether killed
+	// processes have left a cgroup.
+	cgroupDrainInterval = 10 * time.Millisecond
 )
 
+// trackerCgroup is a cgroup that holds all processes tracked by a
+// linuxProcessTracker. Implementations exist for both the legacy (v1) and
+// unified (v2) cgroup hierarchies.
+type trackerCgroup interface {
+	// Add moves the process with the given PID into the cgroup.
+	Add(pid int) error
+	// PIDs lists all PIDs in the cgroup.
+	PIDs() ([]int, error)
+	// Kill terminates all processes in the cgroup.
+	Kill() error
+	// Delete removes the cgroup.
+	Delete() error
+	// Deleted returns whether or not the cgroup has been removed.
+	Deleted() bool
+}
+
+// newTrackerCgroup creates a cgroup with the given name, picking the cgroup v2
+// backend if the host uses the unified hierarchy and the cgroup v1 backend
+// otherwise.
+func newTrackerCgroup(name string) (trackerCgroup, error) {
+	if cgroups.Mode() == cgroups.Unified {
+		return newCgroupV2(name)
+	}
+	return newCgroupV1(name)
+}
+
+// cgroupV1 is a trackerCgroup in the legacy cgroup hierarchy that tracks
+// processes in the freezer subsystem.
+type cgroupV1 struct {
+	cgroup cgroup1.Cgroup
+}
+
+func newCgroupV1(name string) (*cgroupV1, error) {
+	cgroup, err := cgroup1.New(cgroup1.StaticPath("/"+name), &specs.LinuxResources{})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating cgroup v1")
+	}
+	return &cgroupV1{cgroup: cgroup}, nil
+}
+
+func (c *cgroupV1) Add(pid int) error {
+	return c.cgroup.Add(cgroup1.Process{Subsystem: defaultSubsystem, Pid: pid})
+}
+
+func (c *cgroupV1) PIDs() ([]int, error) {
+	procs, err := c.cgroup.Processes(defaultSubsystem, false)
+	if err != nil {
+		return nil, err
+	}
+
+	pids := make([]int, 0, len(procs))
+	for _, proc := range procs {
+		pids = append(pids, proc.Pid)
+	}
+	return pids, nil
+}
+
+func (c *cgroupV1) Kill() error {
+	pids, err := c.PIDs()
+	if err != nil {
+		return errors.Wrap(err, "finding tracked processes")
+	}
+
+	catcher := grip.NewBasicCatcher()
+	for _, pid := range pids {
+		catcher.Wrapf(cleanupProcess(pid), "cleaning up process with PID '%d'", pid)
+	}
+	if catcher.HasErrors() {
+		return catcher.Resolve()
+	}
+	return waitForEmptyCgroup(c)
+}
+
+func (c *cgroupV1) Delete() error {
+	return c.cgroup.Delete()
+}
+
+func (c *cgroupV1) Deleted() bool {
+	return c.cgroup.State() == cgroup1.Deleted
+}
+
+// waitForEmptyCgroup waits for killed processes to leave the cgroup, since a
+// cgroup cannot be deleted until it is empty.
+func waitForEmptyCgroup(c trackerCgroup) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	deadline := time.Now().Add(cgroupDrainTimeout)
+	for {
+		<-timer.C
+		pids, err := c.PIDs()
+		if err != nil {
+			return errors.Wrap(err, "finding tracked processes")
+		}
+		if len(pids) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return errors.Errorf("%d process(es) still in cgroup after kill", len(pids))
+		}
+		timer.Reset(cgroupDrainInterval)
+	}
+}
+
 // linuxProcessTracker uses cgroups to track processes. If cgroups is not
 // available, it kills processes by checking the process' environment variables
 // for the marker ManagerEnvironID.
 type linuxProcessTracker struct {
 	*processTrackerBase
-	cgroup cgroup1.Cgroup
+	cgroup trackerCgroup
 	infos  []ProcessInfo
 }
 
 // NewProcessTracker creates a cgroup for all tracked processes if supported.
-// Cgroups functionality requires admin privileges. It also tracks the
-// ProcessInfo for all added processes so that it can find processes to
-// terminate in Cleanup() based on their environment variables.
+// Cgroups functionality requires admin privileges. The cgroup v2 unified
+// hierarchy is used if the host supports it; otherwise, it falls back to the
+// cgroup v1 freezer subsystem. It also tracks the ProcessInfo for all added
+// processes so that it can find processes to terminate in Cleanup() based on
+// their environment variables.
 func NewProcessTracker(name string) (ProcessTracker, error) {
 	tracker := &linuxProcessTracker{
 		processTrackerBase: &processTrackerBase{Name: name},
@@ -46,7 +160,7 @@ func NewProcessTracker(name string) (ProcessTracker, error) {
 
 // validCgroup returns true if the cgroup is non-nil and not deleted.
 func (t *linuxProcessTracker) validCgroup() bool {
-	return t.cgroup != nil && t.cgroup.State() != cgroup1.Deleted
+	return t.cgroup != nil && !t.cgroup.Deleted()
 }
 
 // setDefaultCgroupIfInvalid attempts to set the tracker's cgroup if it is
@@ -57,7 +171,7 @@ func (t *linuxProcessTracker) setDefaultCgroupIfInvalid() error {
 		return nil
 	}
 
-	cgroup, err := cgroup1.New(cgroup1.StaticPath("/"+t.Name), &specs.LinuxResources{})
+	cgroup, err := newTrackerCgroup(t.Name)
 	if err != nil {
 		return errors.Wrap(err, "creating default cgroup")
 	}
@@ -75,8 +189,7 @@ func (t *linuxProcessTracker) Add(info ProcessInfo) error {
 		return nil
 	}
 
-	proc := cgroup1.Process{Subsystem: defaultSubsystem, Pid: info.PID}
-	if err := t.cgroup.Add(proc); err != nil {
+	if err := t.cgroup.Add(info.PID); err != nil {
 		return errors.Wrapf(err, "adding process with PID '%d' to cgroup", info.PID)
 	}
 	return nil
@@ -89,15 +202,10 @@ func (t *linuxProcessTracker) listCgroupPIDs() ([]int, error) {
 		return nil, nil
 	}
 
-	procs, err := t.cgroup.Processes(defaultSubsystem, false)
+	pids, err := t.cgroup.PIDs()
 	if err != nil {
 		return nil, errors.Wrap(err, "listing tracked PIDs")
 	}
-
-	pids := make([]int, 0, len(procs))
-	for _, proc := range procs {
-		pids = append(pids, proc.Pid)
-	}
 	return pids, nil
 }
 
@@ -108,15 +216,8 @@ func (t *linuxProcessTracker) doCleanupByCgroup() error {
 		return errors.New("cgroup is invalid so cannot cleanup by cgroup")
 	}
 
-	pids, err := t.listCgroupPIDs()
-	if err != nil {
-		return errors.Wrap(err, "finding tracked processes")
-	}
-
 	catcher := grip.NewBasicCatcher()
-	for _, pid := range pids {
-		catcher.Wrapf(cleanupProcess(pid), "cleaning up process with PID '%d'", pid)
-	}
+	catcher.Wrap(t.cgroup.Kill(), "killing tracked processes")
 
 	// Delete the cgroup. If the process tracker is still used, the cgroup must
 	// be re-initialized.
@@ -5,9 +5,12 @@ package jasper
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"syscall"
 	"testing"
+	"time"
 
+	"github.com/containerd/cgroups/v3"
 	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/testutil"
 	testoptions "github.com/mongodb/jasper/testutil/options"
@@ -33,6 +36,42 @@ func TestLinuxProcessTrackerWithCgroups(t *testing.T) {
 					require.NoError(t, err)
 					assert.Len(t, pids, 0)
 				},
+				"CgroupMatchesHostHierarchy": func(ctx context.Context, t *testing.T, tracker *linuxProcessTracker, proc Process) {
+					if cgroups.Mode() == cgroups.Unified {
+						assert.IsType(t, &cgroupV2{}, tracker.cgroup)
+					} else {
+						assert.IsType(t, &cgroupV1{}, tracker.cgroup)
+					}
+				},
+				"CleanupTerminatesDescendantsInCgroup": func(ctx context.Context, t *testing.T, tracker *linuxProcessTracker, proc Process) {
+					if cgroups.Mode() != cgroups.Unified {
+						t.Skip("only cgroup v2 tracks descendants in the subtree")
+					}
+					opts := &options.Create{Args: []string{"sh", "-c", "sleep 10 & sleep 10"}}
+					shell, err := makeProc(ctx, opts)
+					require.NoError(t, err)
+					require.NoError(t, tracker.Add(shell.Info(ctx)))
+
+					time.Sleep(100 * time.Millisecond)
+					pids, err := tracker.listCgroupPIDs()
+					require.NoError(t, err)
+					assert.True(t, len(pids) > 1)
+
+					assert.NoError(t, tracker.Cleanup())
+					_, err = shell.Wait(ctx)
+					assert.Error(t, err)
+				},
+				"DeleteRemovesChildCgroups": func(ctx context.Context, t *testing.T, tracker *linuxProcessTracker, proc Process) {
+					cgroup, ok := tracker.cgroup.(*cgroupV2)
+					if !ok {
+						t.Skip("only cgroup v2 deletes child cgroups")
+					}
+					require.NoError(t, os.MkdirAll(filepath.Join(cgroup.path, "foo", "bar"), 0755))
+					require.NoError(t, os.Mkdir(filepath.Join(cgroup.path, "baz"), 0755))
+
+					require.NoError(t, cgroup.Delete())
+					assert.True(t, cgroup.Deleted())
+				},
 				"NilCgroupIsInvalid": func(ctx context.Context, t *testing.T, tracker *linuxProcessTracker, proc Process) {
 					tracker.cgroup = nil
 					assert.False(t, tracker.validCgroup())
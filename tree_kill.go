@@ -0,0 +1,101 @@
+package jasper
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"syscall"
+
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
+	"github.com/mongodb/jasper/internal/executor"
+	"github.com/mongodb/jasper/options"
+)
+
+// processTreeKiller sends signals to the whole tree of a local process and
+// records the PIDs of the descendants that it kills. A nil processTreeKiller
+// only signals the process itself. It is thread-safe.
+type processTreeKiller struct {
+	mu     sync.Mutex
+	killed map[int]struct{}
+	// terminated maps the descendants that have been sent SIGTERM to the time
+	// that they started until they exit, since they can handle the signal.
+	terminated map[int]uint64
+}
+
+func newProcessTreeKiller() *processTreeKiller {
+	return &processTreeKiller{
+		killed:     map[int]struct{}{},
+		terminated: map[int]uint64{},
+	}
+}
+
+// signal sends the signal to the process and then to all of its descendants.
+// The descendants are found before the process is signaled because they can
+// no longer be found once it exits and they are reparented. The descendants
+// are recorded as killed once SIGKILL is sent to them, or once they exit after
+// being sent SIGTERM. The returned error only reflects the outcome of
+// signaling the process itself.
+func (k *processTreeKiller) signal(exec executor.Executor, sig syscall.Signal) error {
+	if k == nil {
+		return exec.Signal(sig)
+	}
+
+	pid := exec.PID()
+	descendants, err := stopTargetMembers(pid, options.StopTargetTree)
+	grip.Warning(context.Background(), message.WrapError(err, message.Fields{
+		"message": "could not find descendants of process to signal",
+		"pid":     pid,
+	}))
+
+	// The killed descendants must be recorded by the time the process
+	// completes, so the lock is held while it is signaled.
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	sigErr := exec.Signal(sig)
+	for descendant, startTicks := range descendants {
+		if err := signalPID(descendant, sig); err != nil {
+			continue
+		}
+		switch sig {
+		case syscall.SIGKILL:
+			k.killed[descendant] = struct{}{}
+			delete(k.terminated, descendant)
+		case syscall.SIGTERM:
+			if _, ok := k.killed[descendant]; !ok {
+				k.terminated[descendant] = startTicks
+			}
+		}
+	}
+
+	return sigErr
+}
+
+// killedPIDs returns the sorted PIDs of the descendants that have been
+// killed. Descendants that were sent SIGTERM are only included once they have
+// exited.
+func (k *processTreeKiller) killedPIDs() []int {
+	if k == nil {
+		return nil
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for pid, startTicks := range k.terminated {
+		if !isProcessAlive(pid, startTicks) {
+			k.killed[pid] = struct{}{}
+			delete(k.terminated, pid)
+		}
+	}
+	if len(k.killed) == 0 {
+		return nil
+	}
+	pids := make([]int, 0, len(k.killed))
+	for pid := range k.killed {
+		pids = append(pids, pid)
+	}
+	sort.Ints(pids)
+	return pids
+}
@@ -0,0 +1,153 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/mongodb/jasper/options"
+	"github.com/mongodb/jasper/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKillTree(t *testing.T) {
+	// waitForChild waits for the process to start its child and returns the
+	// PID of the child.
+	waitForChild := func(ctx context.Context, t *testing.T, proc Process) int {
+		var children []int
+		require.Eventually(t, func() bool {
+			children = listDescendants(proc.Info(ctx).PID)
+			return len(children) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+		return children[0]
+	}
+	// assertExits asserts that the process with the given PID exits soon,
+	// since a signaled process does not exit immediately.
+	assertExits := func(t *testing.T, pid int) {
+		assert.Eventually(t, func() bool {
+			stat, err := readProcStat(pid)
+			return err != nil || stat.state == 'Z'
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	for procType, impl := range map[string]string{
+		"Basic":    options.ProcessImplementationBasic,
+		"Blocking": options.ProcessImplementationBlocking,
+	} {
+		t.Run(procType, func(t *testing.T) {
+			makeOpts := func() *options.Create {
+				return &options.Create{
+					Args:           []string{"sh", "-c", "sleep 30 & wait"},
+					Implementation: impl,
+					KillTree:       true,
+				}
+			}
+
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T){
+				"SignalKillsDescendants": func(ctx context.Context, t *testing.T) {
+					proc, err := NewProcess(ctx, makeOpts())
+					require.NoError(t, err)
+					child := waitForChild(ctx, t, proc)
+
+					require.NoError(t, proc.Signal(ctx, syscall.SIGTERM))
+					_, err = proc.Wait(ctx)
+					assert.Error(t, err)
+
+					assertExits(t, child)
+					assert.Equal(t, []int{child}, proc.Info(ctx).KilledPIDs)
+				},
+				"NonTerminatingSignalDoesNotRecordDescendants": func(ctx context.Context, t *testing.T) {
+					opts := makeOpts()
+					opts.Args = []string{"sh", "-c", "trap '' USR1; sleep 30 & wait; wait"}
+					proc, err := NewProcess(ctx, opts)
+					require.NoError(t, err)
+					waitForChild(ctx, t, proc)
+
+					require.NoError(t, proc.Signal(ctx, syscall.SIGUSR1))
+					assert.True(t, proc.Running(ctx))
+					assert.Empty(t, proc.Info(ctx).KilledPIDs)
+
+					require.NoError(t, proc.Signal(ctx, syscall.SIGKILL))
+					_, err = proc.Wait(ctx)
+					assert.Error(t, err)
+				},
+				"DescendantThatHandlesTerminationIsNotRecorded": func(ctx context.Context, t *testing.T) {
+					opts := makeOpts()
+					opts.Args = []string{"sh", "-c", "(trap '' TERM; exec sleep 30 >/dev/null 2>&1) & wait"}
+					proc, err := NewProcess(ctx, opts)
+					require.NoError(t, err)
+					child := waitForChild(ctx, t, proc)
+					defer func() {
+						assert.NoError(t, syscall.Kill(child, syscall.SIGKILL))
+					}()
+					// The child only ignores SIGTERM once it runs sleep.
+					require.Eventually(t, func() bool {
+						comm, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", child))
+						return err == nil && strings.TrimSpace(string(comm)) == "sleep"
+					}, 5*time.Second, 10*time.Millisecond)
+
+					require.NoError(t, proc.Signal(ctx, syscall.SIGTERM))
+					_, err = proc.Wait(ctx)
+					assert.Error(t, err)
+
+					stat, err := readProcStat(child)
+					require.NoError(t, err)
+					assert.NotEqual(t, 'Z', stat.state)
+					assert.Empty(t, proc.Info(ctx).KilledPIDs)
+				},
+				"TimeoutKillsDescendants": func(ctx context.Context, t *testing.T) {
+					opts := makeOpts()
+					opts.Timeout = time.Second
+					proc, err := NewProcess(ctx, opts)
+					require.NoError(t, err)
+					child := waitForChild(ctx, t, proc)
+
+					_, err = proc.Wait(ctx)
+					assert.Error(t, err)
+
+					info := proc.Info(ctx)
+					assert.True(t, info.Timeout)
+					assertExits(t, child)
+					assert.Equal(t, []int{child}, info.KilledPIDs)
+				},
+				"ManagerCloseKillsDescendants": func(ctx context.Context, t *testing.T) {
+					mngr, err := newBasicProcessManager(map[string]Process{}, false)
+					require.NoError(t, err)
+					proc, err := mngr.CreateProcess(ctx, makeOpts())
+					require.NoError(t, err)
+					child := waitForChild(ctx, t, proc)
+
+					require.NoError(t, mngr.Close(ctx))
+
+					assertExits(t, child)
+					assert.Equal(t, []int{child}, proc.Info(ctx).KilledPIDs)
+				},
+				"ManagerCloseWithTrackerKillsDescendants": func(ctx context.Context, t *testing.T) {
+					mngr, err := newBasicProcessManager(map[string]Process{}, true)
+					require.NoError(t, err)
+					proc, err := mngr.CreateProcess(ctx, makeOpts())
+					require.NoError(t, err)
+					child := waitForChild(ctx, t, proc)
+
+					require.NoError(t, mngr.Close(ctx))
+
+					_, err = proc.Wait(ctx)
+					assert.Error(t, err)
+					assertExits(t, child)
+					assert.Equal(t, []int{child}, proc.Info(ctx).KilledPIDs)
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+					defer cancel()
+
+					testCase(ctx, t)
+				})
+			}
+		})
+	}
+}